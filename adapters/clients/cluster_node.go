@@ -18,6 +18,7 @@ import (
 	"net/http"
 	"net/url"
 	"path"
+	"strconv"
 
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
@@ -94,3 +95,37 @@ func (c *RemoteNode) GetStatistics(ctx context.Context, hostName string) (*model
 
 	return &statistics, nil
 }
+
+func (c *RemoteNode) GetSlowQueries(ctx context.Context, hostName, collection string, limit int) ([]*models.SlowQuery, error) {
+	p := "/nodes/slow-queries"
+	method := http.MethodGet
+	params := url.Values{"limit": []string{strconv.Itoa(limit)}}
+	if collection != "" {
+		params.Set("collection", collection)
+	}
+	url := url.URL{Scheme: "http", Host: hostName, Path: p, RawQuery: params.Encode()}
+
+	req, err := http.NewRequestWithContext(ctx, method, url.String(), nil)
+	if err != nil {
+		return nil, enterrors.NewErrOpenHttpRequest(err)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, enterrors.NewErrSendHttpRequest(err)
+	}
+
+	defer res.Body.Close()
+	body, _ := io.ReadAll(res.Body)
+	if res.StatusCode != http.StatusOK {
+		return nil, enterrors.NewErrUnexpectedStatusCode(res.StatusCode, body)
+	}
+
+	var queries []*models.SlowQuery
+	err = json.Unmarshal(body, &queries)
+	if err != nil {
+		return nil, enterrors.NewErrUnmarshalBody(err)
+	}
+
+	return queries, nil
+}
@@ -39,6 +39,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/scaler"
+	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 type RemoteIndex struct {
@@ -390,6 +391,36 @@ func (c *RemoteIndex) SearchShard(ctx context.Context, host, index, shard string
 	return resp.Objects, resp.Distributions, err
 }
 
+func (c *RemoteIndex) SearchShardBatch(ctx context.Context, host, index, shard string,
+	queries []sharding.ShardSearchQuery,
+) ([]sharding.ShardSearchResult, error) {
+	body, err := clusterapi.IndicesPayloads.SearchBatchParams.Marshal(queries)
+	if err != nil {
+		return nil, fmt.Errorf("marshal request payload: %w", err)
+	}
+	req, err := setupRequest(ctx, http.MethodPost, host,
+		fmt.Sprintf("/indices/%s/shards/%s/objects/_search_batch", index, shard),
+		"", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("create http request: %w", err)
+	}
+	clusterapi.IndicesPayloads.SearchBatchParams.SetContentTypeHeaderReq(req)
+
+	// send request
+	resp := &searchShardBatchResp{}
+	err = c.doWithCustomMarshaller(c.timeoutUnit*20, req, body, resp.decode, successCode, 9)
+	return resp.Results, err
+}
+
+type searchShardBatchResp struct {
+	Results []sharding.ShardSearchResult
+}
+
+func (r *searchShardBatchResp) decode(data []byte) (err error) {
+	r.Results, err = clusterapi.IndicesPayloads.SearchBatchResults.Unmarshal(data)
+	return
+}
+
 type searchShardResp struct {
 	Objects       []*storobj.Object
 	Distributions []float32
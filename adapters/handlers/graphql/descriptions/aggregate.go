@@ -20,6 +20,16 @@ const (
 
 const GroupBy = "Specify which properties to group by"
 
+const (
+	AggregateSubAggregation        = "Split every group into buckets and aggregate the properties selected in 'subGroups' for each bucket"
+	AggregateSubGroups             = "The buckets created by the subAggregation argument"
+	AggregateHistogram             = "Bucket numeric values into intervals of a fixed width"
+	AggregateHistogramInterval     = "The width of every interval, buckets start at multiples of it"
+	AggregateDateHistogram         = "Bucket date values by calendar interval"
+	AggregateDateHistogramInterval = "The calendar interval to bucket dates by, weeks start on Monday and all intervals are in UTC"
+	AggregateSubAggregationLimit   = "Maximum number of buckets per group, defaults to 100 for groupBy and no limit for histograms"
+)
+
 const (
 	AggregatePropertyObject = "An object containing Aggregation information about this property"
 )
@@ -37,6 +47,14 @@ const (
 	AggregateGroupedBy = "Indicates the group of returned data"
 )
 
+const (
+	AggregatePercentiles          = "Aggregate on approximate percentiles of the property values, computed from a mergeable t-digest sketch"
+	AggregatePercentilesValues    = "The percentiles to compute, between 0 and 100, defaults to 50, 90 and 99"
+	AggregatePercentilePercentile = "The requested percentile"
+	AggregatePercentileValue      = "The approximate property value at the requested percentile"
+	AggregateCardinality          = "Aggregate on the approximate number of distinct property values, computed from a HyperLogLog sketch"
+)
+
 const AggregateNumericObj = "An object containing the %s of numeric properties"
 
 const AggregateCountObj = "An object containing countable properties"
@@ -152,3 +152,11 @@ const (
 	GroupByGroups          = "Specify the number of groups to be created"
 	GroupByObjectsPerGroup = "Specify the number of max objects in group"
 )
+
+const (
+	QueryPipeline               = "Run a query pipeline declared on the class"
+	QueryPipelineName           = "The name of the query pipeline"
+	QueryPipelineParameters     = "The values of the ${name} placeholders of the stages of the query pipeline"
+	QueryPipelineParameterName  = "The name of the placeholder"
+	QueryPipelineParameterValue = "The value the placeholder is replaced with"
+)
@@ -48,3 +48,5 @@ const ConsistencyLevel = "Determines how many replicas must acknowledge a reques
 	"before it is considered successful. Can be 'ONE', 'QUORUM', or 'ALL'"
 
 const Tenant = "The value by which a tenant is identified, specified in the class schema"
+
+const Profile = "Return a breakdown of where the time of the query was spent in the extensions of the response"
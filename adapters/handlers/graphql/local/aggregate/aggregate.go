@@ -85,10 +85,11 @@ func classField(class *models.Class, description string,
 ) (*graphql.Field, error) {
 	metaClassName := fmt.Sprintf("Aggregate%s", class.Class)
 
+	var fieldsObject *graphql.Object
 	fields := graphql.ObjectConfig{
 		Name: metaClassName,
 		Fields: (graphql.FieldsThunk)(func() graphql.Fields {
-			fields, err := classPropertyFields(class)
+			fields, err := classPropertyFields(class, fieldsObject)
 			if err != nil {
 				// we cannot return an error in this FieldsThunk and have to panic unfortunately
 				panic(fmt.Sprintf("Failed to assemble single Local Aggregate Class field: %s", err))
@@ -99,7 +100,7 @@ func classField(class *models.Class, description string,
 		Description: description,
 	}
 
-	fieldsObject := graphql.NewObject(fields)
+	fieldsObject = graphql.NewObject(fields)
 	fieldsField := &graphql.Field{
 		Type:        graphql.NewList(fieldsObject),
 		Description: description,
@@ -128,7 +129,9 @@ func classField(class *models.Class, description string,
 				Description: descriptions.First,
 				Type:        graphql.Int,
 			},
-			"hybrid": hybridArgument(fieldsObject, class, modulesProvider),
+			"hybrid":         hybridArgument(fieldsObject, class, modulesProvider),
+			"subAggregation": subAggregationArgument(class.Class),
+			"profile":        common_filters.ProfileArgument(),
 		},
 		Resolve: makeResolveClass(authorizer, modulesProvider, class),
 	}
@@ -146,7 +149,7 @@ func classField(class *models.Class, description string,
 	return fieldsField, nil
 }
 
-func classPropertyFields(class *models.Class) (graphql.Fields, error) {
+func classPropertyFields(class *models.Class, fieldsObject *graphql.Object) (graphql.Fields, error) {
 	fields := graphql.Fields{}
 	for _, property := range class.Properties {
 		propertyType, err := schema.GetPropertyDataType(class, property.Name)
@@ -188,6 +191,10 @@ func classPropertyFields(class *models.Class) (graphql.Fields, error) {
 		},
 	}
 
+	// the buckets of a sub-aggregation have the same shape as the top-level
+	// groups, so they can be nested arbitrarily deep
+	fields[SubGroupsFieldName] = subGroupsField(fieldsObject)
+
 	return fields, nil
 }
 
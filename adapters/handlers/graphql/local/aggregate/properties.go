@@ -58,6 +58,8 @@ func numericPropertyFields(class *models.Class, property *models.Property, prefi
 			Type:        graphql.Float,
 			Resolve:     makeResolveNumericFieldAggregator("median"),
 		},
+		"percentiles": percentilesField(class, property, prefix, graphql.Float),
+		"cardinality": cardinalityField(class, property, prefix),
 		"count": &graphql.Field{
 			Name:        fmt.Sprintf("%s%s%sCount", prefix, class.Class, property.Name),
 			Description: descriptions.AggregateCount,
@@ -120,6 +122,8 @@ func datePropertyFields(class *models.Class,
 			Type:        graphql.String,
 			Resolve:     makeResolveDateFieldAggregator("median"),
 		},
+		"percentiles": percentilesField(class, property, prefix, graphql.String),
+		"cardinality": cardinalityField(class, property, prefix),
 	}
 
 	return graphql.NewObject(graphql.ObjectConfig{
@@ -290,6 +294,7 @@ func stringPropertyFields(class *models.Class,
 				return prop.SchemaType, nil
 			},
 		},
+		"cardinality": cardinalityField(class, property, prefix),
 		"topOccurrences": &graphql.Field{
 			Name:        fmt.Sprintf("%s%sTopOccurrences", prefix, class.Class),
 			Description: descriptions.AggregatePropertyTopOccurrences,
@@ -98,6 +98,11 @@ func resolveAggregate(p graphql.ResolveParams, authorizer authorization.Authoriz
 		return nil, fmt.Errorf("could not extract groupBy path: %w", err)
 	}
 
+	subAggregation, err := extractSubAggregation(p.Args, selections, p.Info.FieldName)
+	if err != nil {
+		return nil, fmt.Errorf("could not extract subAggregation: %w", err)
+	}
+
 	limit, err := extractLimit(p.Args)
 	if err != nil {
 		return nil, fmt.Errorf("could not extract limit: %w", err)
@@ -172,6 +177,7 @@ func resolveAggregate(p graphql.ResolveParams, authorizer authorization.Authoriz
 		ModuleParams:     moduleParams,
 		Hybrid:           hybridParams,
 		Tenant:           tenant,
+		SubAggregation:   subAggregation,
 	}
 
 	// we might support objectLimit without nearMedia filters later, e.g. with sort
@@ -179,7 +185,9 @@ func resolveAggregate(p graphql.ResolveParams, authorizer authorization.Authoriz
 		return nil, fmt.Errorf("objectLimit can only be used with a near<Media> or hybrid filter")
 	}
 
-	res, err := resolver.Aggregate(p.Context, principal, params)
+	ctx, addProfile := common_filters.ProfileQuery(p)
+	defer addProfile()
+	res, err := resolver.Aggregate(ctx, principal, params)
 	if err != nil {
 		return nil, err
 	}
@@ -215,6 +223,12 @@ func extractProperties(selections *ast.SelectionSet) ([]aggregation.ParamPropert
 			continue
 		}
 
+		if name == SubGroupsFieldName {
+			// the properties of the sub-groups are extracted along with the
+			// subAggregation argument
+			continue
+		}
+
 		if name == "__typename" {
 			continue
 		}
@@ -244,6 +258,17 @@ func extractAggregators(selections *ast.SelectionSet) ([]aggregation.Aggregator,
 		if name == "__typename" {
 			continue
 		}
+		if name == "percentiles" {
+			percentiles, err := extractPercentilesFromArgs(field.Arguments)
+			if err != nil {
+				return nil, err
+			}
+			for _, percentile := range percentiles {
+				analyses = append(analyses, aggregation.NewPercentileAggregator(percentile))
+			}
+			continue
+		}
+
 		property, err := aggregation.ParseAggregatorProp(name)
 		if err != nil {
 			return nil, err
@@ -322,6 +347,37 @@ func extractLimitFromArgs(args []*ast.Argument) *int {
 	return nil
 }
 
+// extractPercentilesFromArgs reads the values argument of the percentiles
+// field, falling back to the default percentiles if it is not set
+func extractPercentilesFromArgs(args []*ast.Argument) ([]float64, error) {
+	for _, arg := range args {
+		if arg.Name.Value != "values" {
+			continue
+		}
+
+		list, ok := arg.Value.(*ast.ListValue)
+		if !ok {
+			return nil, fmt.Errorf("percentiles: values must be a list, got %T", arg.Value)
+		}
+
+		percentiles := make([]float64, len(list.Values))
+		for i, value := range list.Values {
+			raw, ok := value.GetValue().(string)
+			if !ok {
+				return nil, fmt.Errorf("percentiles: values must be numbers, got %T", value)
+			}
+			percentile, err := strconv.ParseFloat(raw, 64)
+			if err != nil {
+				return nil, fmt.Errorf("percentiles: %w", err)
+			}
+			percentiles[i] = percentile
+		}
+		return percentiles, nil
+	}
+
+	return aggregation.DefaultPercentiles, nil
+}
+
 func validateObjectLimitUsage(params *aggregation.Params) bool {
 	return params.NearObject != nil ||
 		params.NearVector != nil ||
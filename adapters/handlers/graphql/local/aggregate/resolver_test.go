@@ -37,6 +37,7 @@ type testCase struct {
 	expectedIncludeMetaCount bool
 	expectedLimit            *int
 	expectedObjectLimit      *int
+	expectedSubAggregation   *aggregation.SubAggregation
 }
 
 type testCases []testCase
@@ -465,6 +466,90 @@ func Test_Resolve(t *testing.T) {
 				},
 			}},
 		},
+		testCase{
+			name: "nested sub-aggregations with histograms",
+			query: `{ Aggregate { Car(
+					groupBy:["modelName"]
+					subAggregation:{
+						histogram:{path:["horsepower"], interval:100}
+						subAggregation:{dateHistogram:{path:["startOfProduction"], interval:month}, limit:3}
+					}
+				) {
+					groupedBy { value }
+					subGroups {
+						groupedBy { value }
+						meta { count }
+						weight { mean }
+						subGroups { groupedBy { value } meta { count } }
+					}
+				} } }`,
+			expectedProps:   []aggregation.ParamProperty{},
+			expectedGroupBy: &filters.Path{Class: "Car", Property: "modelName"},
+			expectedSubAggregation: &aggregation.SubAggregation{
+				Histogram: &aggregation.Histogram{Property: "horsepower", Interval: 100},
+				Properties: []aggregation.ParamProperty{
+					{
+						Name:        "weight",
+						Aggregators: []aggregation.Aggregator{aggregation.MeanAggregator},
+					},
+				},
+				SubAggregation: &aggregation.SubAggregation{
+					DateHistogram: &aggregation.DateHistogram{
+						Property: "startOfProduction",
+						Interval: aggregation.CalendarIntervalMonth,
+					},
+					Limit:      ptInt(3),
+					Properties: []aggregation.ParamProperty{},
+				},
+			},
+			resolverReturn: []aggregation.Group{
+				{
+					GroupedBy: &aggregation.GroupedBy{Path: []string{"modelName"}, Value: "fast"},
+					SubGroups: []aggregation.Group{
+						{
+							GroupedBy: &aggregation.GroupedBy{Path: []string{"horsepower"}, Value: 200.0},
+							Count:     2,
+							Properties: map[string]aggregation.Property{
+								"weight": {
+									Type:                  aggregation.PropertyTypeNumerical,
+									NumericalAggregations: map[string]interface{}{"mean": 1250.5},
+								},
+							},
+							SubGroups: []aggregation.Group{
+								{
+									GroupedBy: &aggregation.GroupedBy{
+										Path:  []string{"startOfProduction"},
+										Value: "2020-01-01T00:00:00Z",
+									},
+									Count: 2,
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedResults: []result{{
+				pathToField: []string{"Aggregate", "Car"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"groupedBy": map[string]interface{}{"value": "fast"},
+						"subGroups": []interface{}{
+							map[string]interface{}{
+								"groupedBy": map[string]interface{}{"value": "200"},
+								"meta":      map[string]interface{}{"count": 2},
+								"weight":    map[string]interface{}{"mean": 1250.5},
+								"subGroups": []interface{}{
+									map[string]interface{}{
+										"groupedBy": map[string]interface{}{"value": "2020-01-01T00:00:00Z"},
+										"meta":      map[string]interface{}{"count": 2},
+									},
+								},
+							},
+						},
+					},
+				},
+			}},
+		},
 		testCase{
 			name: "hybrid vector distance",
 			query: `{
@@ -672,6 +757,68 @@ func Test_Resolve(t *testing.T) {
 			}},
 		},
 
+		testCase{
+			name: "percentiles and cardinality",
+			query: `{ Aggregate { Car(groupBy:["madeBy", "Manufacturer", "name"]) {
+				horsepower { percentiles(values: [25, 99.9]) { percentile value } cardinality }
+				modelName { cardinality }
+			} } }`,
+			expectedProps: []aggregation.ParamProperty{
+				{
+					Name: "horsepower",
+					Aggregators: []aggregation.Aggregator{
+						aggregation.NewPercentileAggregator(25),
+						aggregation.NewPercentileAggregator(99.9),
+						aggregation.CardinalityAggregator,
+					},
+				},
+				{
+					Name:        "modelName",
+					Aggregators: []aggregation.Aggregator{aggregation.CardinalityAggregator},
+				},
+			},
+			resolverReturn: []aggregation.Group{
+				{
+					GroupedBy: &aggregation.GroupedBy{
+						Path:  []string{"madeBy", "Manufacturer", "name"},
+						Value: "best-manufacturer",
+					},
+					Properties: map[string]aggregation.Property{
+						"horsepower": {
+							Type: aggregation.PropertyTypeNumerical,
+							Percentiles: []aggregation.Percentile{
+								{Percentile: 25, Value: 115.0},
+								{Percentile: 99.9, Value: 610.0},
+							},
+							Cardinality: ptInt64(17),
+						},
+						"modelName": {
+							Type:        aggregation.PropertyTypeText,
+							Cardinality: ptInt64(5),
+						},
+					},
+				},
+			},
+			expectedGroupBy: groupCarByMadeByManufacturerName(),
+			expectedResults: []result{{
+				pathToField: []string{"Aggregate", "Car"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"horsepower": map[string]interface{}{
+							"percentiles": []interface{}{
+								map[string]interface{}{"percentile": 25.0, "value": 115.0},
+								map[string]interface{}{"percentile": 99.9, "value": 610.0},
+							},
+							"cardinality": 17,
+						},
+						"modelName": map[string]interface{}{
+							"cardinality": 5,
+						},
+					},
+				},
+			}},
+		},
+
 		testCase{
 			name: "with objectLimit + nearObject (distance)",
 			query: `
@@ -999,6 +1146,7 @@ func (tests testCases) AssertExtraction(t *testing.T, className string) {
 				Limit:            testCase.expectedLimit,
 				ObjectLimit:      testCase.expectedObjectLimit,
 				Hybrid:           testCase.expectedNearHybrid,
+				SubAggregation:   testCase.expectedSubAggregation,
 			}
 
 			resolver.On("Aggregate", expectedParams).
@@ -1018,3 +1166,7 @@ func (tests testCases) AssertExtraction(t *testing.T, className string) {
 func ptInt(in int) *int {
 	return &in
 }
+
+func ptInt64(in int64) *int64 {
+	return &in
+}
@@ -0,0 +1,107 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregate
+
+import (
+	"fmt"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// percentilesField exposes the approximate percentiles of a numerical or date
+// property, valueType is the type of the property values (Float or String).
+func percentilesField(class *models.Class, property *models.Property, prefix string,
+	valueType graphql.Output,
+) *graphql.Field {
+	percentileObj := graphql.NewObject(graphql.ObjectConfig{
+		Name: fmt.Sprintf("%s%s%sPercentilesObj", prefix, class.Class, property.Name),
+		Fields: graphql.Fields{
+			"percentile": &graphql.Field{
+				Name:        fmt.Sprintf("%s%s%sPercentilesPercentile", prefix, class.Class, property.Name),
+				Description: descriptions.AggregatePercentilePercentile,
+				Type:        graphql.Float,
+				Resolve: percentileResolver(func(p aggregation.Percentile) interface{} {
+					return p.Percentile
+				}),
+			},
+			"value": &graphql.Field{
+				Name:        fmt.Sprintf("%s%s%sPercentilesValue", prefix, class.Class, property.Name),
+				Description: descriptions.AggregatePercentileValue,
+				Type:        valueType,
+				Resolve: percentileResolver(func(p aggregation.Percentile) interface{} {
+					return p.Value
+				}),
+			},
+		},
+		Description: descriptions.AggregatePercentiles,
+	})
+
+	return &graphql.Field{
+		Name:        fmt.Sprintf("%s%s%sPercentiles", prefix, class.Class, property.Name),
+		Description: descriptions.AggregatePercentiles,
+		Type:        graphql.NewList(percentileObj),
+		Args: graphql.FieldConfigArgument{
+			"values": &graphql.ArgumentConfig{
+				Description: descriptions.AggregatePercentilesValues,
+				Type:        graphql.NewList(graphql.Float),
+			},
+		},
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			prop, ok := p.Source.(aggregation.Property)
+			if !ok {
+				return nil, fmt.Errorf("percentiles: expected aggregation.Property, got %T", p.Source)
+			}
+
+			list := make([]interface{}, len(prop.Percentiles))
+			for i, percentile := range prop.Percentiles {
+				list[i] = percentile
+			}
+			return list, nil
+		},
+	}
+}
+
+func cardinalityField(class *models.Class, property *models.Property, prefix string) *graphql.Field {
+	return &graphql.Field{
+		Name:        fmt.Sprintf("%s%s%sCardinality", prefix, class.Class, property.Name),
+		Description: descriptions.AggregateCardinality,
+		Type:        graphql.Int,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			prop, ok := p.Source.(aggregation.Property)
+			if !ok {
+				return nil, fmt.Errorf("cardinality: expected aggregation.Property, got %T", p.Source)
+			}
+
+			if prop.Cardinality == nil {
+				return nil, nil
+			}
+			return *prop.Cardinality, nil
+		},
+	}
+}
+
+type percentileExtractorFunc func(aggregation.Percentile) interface{}
+
+func percentileResolver(extractor percentileExtractorFunc) func(p graphql.ResolveParams) (interface{}, error) {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		percentile, ok := p.Source.(aggregation.Percentile)
+		if !ok {
+			return nil, fmt.Errorf("percentile: %s: expected aggregation.Percentile, but got %T",
+				p.Info.FieldName, p.Source)
+		}
+
+		return extractor(percentile), nil
+	}
+}
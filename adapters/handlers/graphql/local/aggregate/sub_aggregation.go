@@ -0,0 +1,231 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregate
+
+import (
+	"fmt"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/tailor-inc/graphql/language/ast"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/filters"
+)
+
+// SubGroupsFieldName is the field which holds the buckets of a
+// sub-aggregation. Its selection set defines which properties are
+// aggregated per bucket.
+const SubGroupsFieldName = "subGroups"
+
+func subAggregationArgument(className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("AggregateObjects%s", className)
+
+	histogram := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: fmt.Sprintf("%sHistogramInpObj", prefix),
+		Fields: graphql.InputObjectConfigFieldMap{
+			"path": &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.String)),
+			},
+			"interval": &graphql.InputObjectFieldConfig{
+				Description: descriptions.AggregateHistogramInterval,
+				Type:        graphql.NewNonNull(graphql.Float),
+			},
+		},
+		Description: descriptions.AggregateHistogram,
+	})
+
+	dateHistogram := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: fmt.Sprintf("%sDateHistogramInpObj", prefix),
+		Fields: graphql.InputObjectConfigFieldMap{
+			"path": &graphql.InputObjectFieldConfig{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.String)),
+			},
+			"interval": &graphql.InputObjectFieldConfig{
+				Description: descriptions.AggregateDateHistogramInterval,
+				Type: graphql.NewNonNull(graphql.NewEnum(graphql.EnumConfig{
+					Name: fmt.Sprintf("%sCalendarIntervalEnum", prefix),
+					Values: graphql.EnumValueConfigMap{
+						string(aggregation.CalendarIntervalMinute):  &graphql.EnumValueConfig{},
+						string(aggregation.CalendarIntervalHour):    &graphql.EnumValueConfig{},
+						string(aggregation.CalendarIntervalDay):     &graphql.EnumValueConfig{},
+						string(aggregation.CalendarIntervalWeek):    &graphql.EnumValueConfig{},
+						string(aggregation.CalendarIntervalMonth):   &graphql.EnumValueConfig{},
+						string(aggregation.CalendarIntervalQuarter): &graphql.EnumValueConfig{},
+						string(aggregation.CalendarIntervalYear):    &graphql.EnumValueConfig{},
+					},
+				})),
+			},
+		},
+		Description: descriptions.AggregateDateHistogram,
+	})
+
+	var subAggregation *graphql.InputObject
+	subAggregation = graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: fmt.Sprintf("%sSubAggregationInpObj", prefix),
+		Fields: (graphql.InputObjectConfigFieldMapThunk)(func() graphql.InputObjectConfigFieldMap {
+			return graphql.InputObjectConfigFieldMap{
+				"groupBy": &graphql.InputObjectFieldConfig{
+					Description: descriptions.GroupBy,
+					Type:        graphql.NewList(graphql.String),
+				},
+				"histogram": &graphql.InputObjectFieldConfig{
+					Type: histogram,
+				},
+				"dateHistogram": &graphql.InputObjectFieldConfig{
+					Type: dateHistogram,
+				},
+				"limit": &graphql.InputObjectFieldConfig{
+					Description: descriptions.AggregateSubAggregationLimit,
+					Type:        graphql.Int,
+				},
+				"subAggregation": &graphql.InputObjectFieldConfig{
+					Description: descriptions.AggregateSubAggregation,
+					Type:        subAggregation,
+				},
+			}
+		}),
+		Description: descriptions.AggregateSubAggregation,
+	})
+
+	return &graphql.ArgumentConfig{
+		Description: descriptions.AggregateSubAggregation,
+		Type:        subAggregation,
+	}
+}
+
+// extractSubAggregation parses the (possibly nested) subAggregation argument.
+// The properties to aggregate on every level are taken from the selection
+// set of the subGroups field on the same level.
+func extractSubAggregation(args map[string]interface{}, selections *ast.SelectionSet,
+	rootClass string,
+) (*aggregation.SubAggregation, error) {
+	arg, ok := args["subAggregation"]
+	if !ok {
+		return nil, nil
+	}
+
+	asMap, ok := arg.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("subAggregation must be an object, instead got: %#v", arg)
+	}
+
+	out := &aggregation.SubAggregation{}
+
+	if groupBy, ok := asMap["groupBy"]; ok {
+		path, err := parseSubAggregationPath(groupBy, rootClass)
+		if err != nil {
+			return nil, fmt.Errorf("groupBy: %w", err)
+		}
+		out.GroupBy = path
+	}
+
+	if histogram, ok := asMap["histogram"].(map[string]interface{}); ok {
+		path, err := parseSubAggregationPath(histogram["path"], rootClass)
+		if err != nil {
+			return nil, fmt.Errorf("histogram: %w", err)
+		}
+		interval, ok := histogram["interval"].(float64)
+		if !ok {
+			return nil, fmt.Errorf("histogram: interval must be a number, instead got: %#v", histogram["interval"])
+		}
+		out.Histogram = &aggregation.Histogram{Property: path.Property, Interval: interval}
+	}
+
+	if dateHistogram, ok := asMap["dateHistogram"].(map[string]interface{}); ok {
+		path, err := parseSubAggregationPath(dateHistogram["path"], rootClass)
+		if err != nil {
+			return nil, fmt.Errorf("dateHistogram: %w", err)
+		}
+		asString, _ := dateHistogram["interval"].(string)
+		interval, err := aggregation.ParseCalendarInterval(asString)
+		if err != nil {
+			return nil, fmt.Errorf("dateHistogram: %w", err)
+		}
+		out.DateHistogram = &aggregation.DateHistogram{Property: path.Property, Interval: interval}
+	}
+
+	if limit, ok := asMap["limit"]; ok {
+		limitInt, ok := limit.(int)
+		if !ok {
+			return nil, fmt.Errorf("limit must be an int, instead got: %#v", limit)
+		}
+		out.Limit = &limitInt
+	}
+
+	subGroups := subGroupsSelection(selections)
+	if subGroups != nil {
+		properties, _, err := extractProperties(subGroups)
+		if err != nil {
+			return nil, fmt.Errorf("subGroups: %w", err)
+		}
+		out.Properties = properties
+	}
+
+	nested, err := extractSubAggregation(asMap, subGroups, rootClass)
+	if err != nil {
+		return nil, fmt.Errorf("subAggregation: %w", err)
+	}
+	out.SubAggregation = nested
+
+	if err := out.Validate(); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func parseSubAggregationPath(in interface{}, rootClass string) (*filters.Path, error) {
+	pathSegments, ok := in.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("path must be a list, instead got: %#v", in)
+	}
+
+	path, err := filters.ParsePath(pathSegments, rootClass)
+	if err != nil {
+		return nil, err
+	}
+	if path.Child != nil {
+		return nil, fmt.Errorf("grouping by cross-refs not supported")
+	}
+
+	return path, nil
+}
+
+func subGroupsSelection(selections *ast.SelectionSet) *ast.SelectionSet {
+	if selections == nil {
+		return nil
+	}
+
+	for _, selection := range selections.Selections {
+		field, ok := selection.(*ast.Field)
+		if ok && field.Name.Value == SubGroupsFieldName {
+			return field.SelectionSet
+		}
+	}
+
+	return nil
+}
+
+func subGroupsField(fieldsObject *graphql.Object) *graphql.Field {
+	return &graphql.Field{
+		Description: descriptions.AggregateSubGroups,
+		Type:        graphql.NewList(fieldsObject),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			group, ok := p.Source.(aggregation.Group)
+			if !ok {
+				return nil, fmt.Errorf("%s: expected aggregation.Group, got %T", SubGroupsFieldName, p.Source)
+			}
+
+			return group.SubGroups, nil
+		},
+	}
+}
@@ -0,0 +1,55 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common_filters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/tailor-inc/graphql"
+
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/weaviate/weaviate/entities/queryprofile"
+)
+
+func ProfileArgument() *graphql.ArgumentConfig {
+	return &graphql.ArgumentConfig{
+		Description: descriptions.Profile,
+		Type:        graphql.Boolean,
+	}
+}
+
+// ProfileQuery returns a context profiling the query resolved by p if the
+// profile argument is set. The returned function adds the profile to the
+// profiles of the request under the path of the query, e.g. "Get.Article".
+func ProfileQuery(p graphql.ResolveParams) (context.Context, func()) {
+	enabled, _ := p.Args["profile"].(bool)
+	profiles := queryprofile.ProfilesFromContext(p.Context)
+	if !enabled || profiles == nil {
+		return p.Context, func() {}
+	}
+
+	profile := queryprofile.New()
+	return queryprofile.ContextWithProfile(p.Context, profile), func() {
+		profiles.Add(responsePath(p.Info.Path), profile)
+	}
+}
+
+func responsePath(path *graphql.ResponsePath) string {
+	keys := path.AsArray()
+	parts := make([]string, len(keys))
+	for i, key := range keys {
+		parts[i] = fmt.Sprint(key)
+	}
+	return strings.Join(parts, ".")
+}
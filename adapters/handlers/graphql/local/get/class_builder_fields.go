@@ -19,6 +19,7 @@ import (
 
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	moduleadditional "github.com/weaviate/weaviate/usecases/modulecomponents/additional"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/settings"
 
 	"github.com/tailor-inc/graphql"
 	"github.com/tailor-inc/graphql/language/ast"
@@ -235,6 +236,7 @@ func buildGetClassField(classObject *graphql.Object,
 			"where":      whereArgument(class.Class),
 			"group":      groupArgument(class.Class),
 			"groupBy":    groupByArgument(class.Class),
+			"profile":    common_filters.ProfileArgument(),
 		},
 		Resolve: newResolver(authorizer, modulesProvider).makeResolveGetClass(class.Class),
 	}
@@ -256,6 +258,17 @@ func buildGetClassField(classObject *graphql.Object,
 		field.Args["tenant"] = tenantArgument()
 	}
 
+	if len(class.QueryPipelines) > 0 {
+		field.Args["pipeline"] = pipelineArgument(class.Class)
+	}
+
+	if cs, err := settings.ClassChunkingSettings(class); err == nil && cs != nil {
+		field.Args["collapseChunks"] = &graphql.ArgumentConfig{
+			Description: "Search the chunks of the class and return each matching object once, scored by its best chunk",
+			Type:        graphql.Boolean,
+		}
+	}
+
 	return field
 }
 
@@ -357,6 +370,16 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		return nil, err
 	}
 
+	var collapseChunks bool
+	if cc, ok := p.Args["collapseChunks"]; ok {
+		collapseChunks = cc.(bool)
+	}
+
+	var queryPipeline *dto.QueryPipeline
+	if pipeline, ok := p.Args["pipeline"]; ok {
+		queryPipeline = extractPipeline(pipeline.(map[string]interface{}))
+	}
+
 	cursor, err := filters.ExtractCursorFromArgs(p.Args)
 	if err != nil {
 		return nil, err
@@ -433,9 +456,6 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 	// extracts bm25 (sparseSearch) from the query
 	var keywordRankingParams *searchparams.KeywordRanking
 	if bm25, ok := p.Args["bm25"]; ok {
-		if len(sort) > 0 {
-			return nil, fmt.Errorf("bm25 search is not compatible with sort")
-		}
 		p := common_filters.ExtractBM25(bm25.(map[string]interface{}), addlProps.ExplainScore)
 		keywordRankingParams = &p
 	}
@@ -445,9 +465,6 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 	// refactored
 	var hybridParams *searchparams.HybridSearch
 	if hybrid, ok := p.Args["hybrid"]; ok {
-		if len(sort) > 0 {
-			return nil, fmt.Errorf("hybrid search is not compatible with sort")
-		}
 		p, targetCombination, err := common_filters.ExtractHybridSearch(hybrid.(map[string]interface{}), addlProps.ExplainScore)
 		if err != nil {
 			return nil, fmt.Errorf("failed to extract hybrid params: %w", err)
@@ -490,6 +507,8 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		GroupBy:                 groupByParams,
 		Tenant:                  tenant,
 		TargetVectorCombination: targetVectorCombination,
+		CollapseChunks:          collapseChunks,
+		QueryPipeline:           queryPipeline,
 	}
 
 	// need to perform vector search by distance
@@ -497,7 +516,9 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 	setLimitBasedOnVectorSearchParams(&params)
 
 	return func() (interface{}, error) {
-		result, err := resolver.GetClass(p.Context, principal, params)
+		ctx, addProfile := common_filters.ProfileQuery(p)
+		defer addProfile()
+		result, err := resolver.GetClass(ctx, principal, params)
 		if err != nil {
 			return result, enterrors.NewErrGraphQLUser(err, "Get", params.ClassName)
 		}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
+	logrustest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tailor-inc/graphql/language/ast"
@@ -31,6 +32,7 @@ import (
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	helper "github.com/weaviate/weaviate/test/helper"
+	"github.com/weaviate/weaviate/usecases/config"
 )
 
 func TestSimpleFieldParamsOK(t *testing.T) {
@@ -2178,15 +2180,45 @@ func TestNearTextNoNoModules(t *testing.T) {
 func TestBM25WithSort(t *testing.T) {
 	t.Parallel()
 	resolver := newMockResolverWithNoModules()
-	query := `{Get{SomeAction(bm25:{query:"apple",properties:["name"]},sort:[{path:["name"],order:desc}]){intField}}}`
-	resolver.AssertFailToResolve(t, query, "bm25 search is not compatible with sort")
+	query := `{Get{SomeAction(bm25:{query:"apple",properties:["name"]},sort:[{path:["name"],order:desc},{path:["_score"],order:desc}]){intField}}}`
+	expectedParams := dto.GetParams{
+		ClassName:  "SomeAction",
+		Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		KeywordRanking: &searchparams.KeywordRanking{
+			Type:       "bm25",
+			Query:      "apple",
+			Properties: []string{"name"},
+		},
+		Sort: []filters.Sort{
+			{Path: []string{"name"}, Order: "desc"},
+			{Path: []string{"_score"}, Order: "desc"},
+		},
+	}
+	resolver.On("GetClass", expectedParams).
+		Return([]interface{}{}, nil).Once()
+	resolver.AssertResolve(t, query)
 }
 
 func TestHybridWithSort(t *testing.T) {
 	t.Parallel()
 	resolver := newMockResolverWithNoModules()
 	query := `{Get{SomeAction(hybrid:{query:"apple"},sort:[{path:["name"],order:desc}]){intField}}}`
-	resolver.AssertFailToResolve(t, query, "hybrid search is not compatible with sort")
+	var emptySubsearches []searchparams.WeightedSearchResult
+	expectedParams := dto.GetParams{
+		ClassName:  "SomeAction",
+		Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		HybridSearch: &searchparams.HybridSearch{
+			Query:           "apple",
+			Alpha:           0.75,
+			Type:            "hybrid",
+			FusionAlgorithm: 1,
+			SubSearches:     emptySubsearches,
+		},
+		Sort: []filters.Sort{{Path: []string{"name"}, Order: "desc"}},
+	}
+	resolver.On("GetClass", expectedParams).
+		Return([]interface{}{}, nil).Once()
+	resolver.AssertResolve(t, query)
 }
 
 func TestHybridWithTargets(t *testing.T) {
@@ -3507,3 +3539,40 @@ func timeMust(t strfmt.DateTime, err error) strfmt.DateTime {
 
 	return t
 }
+
+func TestExtractPipeline(t *testing.T) {
+	t.Parallel()
+
+	logger, _ := logrustest.NewNullLogger()
+	simpleSchema := test_helper.CreateSimpleSchema(config.VectorizerModuleText2VecContextionary)
+	for _, class := range simpleSchema.Objects.Classes {
+		if class.Class == "SomeThing" {
+			class.QueryPipelines = []*models.QueryPipeline{{Name: "keyword", Stages: []*models.QueryPipelineStage{
+				{Type: models.QueryPipelineStageTypeBm25, Query: "${q}"},
+			}}}
+		}
+	}
+	field, err := Build(&simpleSchema, logger, getFakeModulesProvider(), getFakeAuthorizer())
+	require.NoError(t, err)
+	resolver := &mockResolver{}
+	resolver.RootFieldName = "Get"
+	resolver.RootField = field
+	resolver.RootObject = map[string]interface{}{"Resolver": Resolver(resolver), "RequestsLog": RequestsLog(&mockRequestsLog{})}
+
+	expectedParams := dto.GetParams{
+		ClassName:  "SomeThing",
+		Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		QueryPipeline: &dto.QueryPipeline{
+			Name:       "keyword",
+			Parameters: map[string]string{"q": "hello"},
+		},
+	}
+	resolver.On("GetClass", expectedParams).
+		Return(test_helper.EmptyList(), nil).Once()
+
+	resolver.AssertResolve(t, `{ Get { SomeThing(pipeline: {name: "keyword", parameters: [{name: "q", value: "hello"}]}) { intField } } }`)
+
+	t.Run("only classes with pipelines have the argument", func(t *testing.T) {
+		resolver.AssertFailToResolve(t, `{ Get { SomeAction(pipeline: {name: "keyword"}) { intField } } }`)
+	})
+}
@@ -0,0 +1,74 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package get
+
+import (
+	"fmt"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/weaviate/weaviate/entities/dto"
+)
+
+func pipelineArgument(className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("GetObjects%s", className)
+	return &graphql.ArgumentConfig{
+		Description: descriptions.QueryPipeline,
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name:   fmt.Sprintf("%sPipelineInpObj", prefix),
+				Fields: pipelineFields(prefix),
+			},
+		),
+	}
+}
+
+func pipelineFields(prefix string) graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"name": &graphql.InputObjectFieldConfig{
+			Description: descriptions.QueryPipelineName,
+			Type:        graphql.NewNonNull(graphql.String),
+		},
+		"parameters": &graphql.InputObjectFieldConfig{
+			Description: descriptions.QueryPipelineParameters,
+			Type: graphql.NewList(graphql.NewInputObject(
+				graphql.InputObjectConfig{
+					Name: fmt.Sprintf("%sPipelineParameterInpObj", prefix),
+					Fields: graphql.InputObjectConfigFieldMap{
+						"name": &graphql.InputObjectFieldConfig{
+							Description: descriptions.QueryPipelineParameterName,
+							Type:        graphql.NewNonNull(graphql.String),
+						},
+						"value": &graphql.InputObjectFieldConfig{
+							Description: descriptions.QueryPipelineParameterValue,
+							Type:        graphql.NewNonNull(graphql.String),
+						},
+					},
+				},
+			)),
+		},
+	}
+}
+
+func extractPipeline(args map[string]interface{}) *dto.QueryPipeline {
+	pipeline := &dto.QueryPipeline{Name: args["name"].(string)}
+	parameters, ok := args["parameters"].([]interface{})
+	if !ok {
+		return pipeline
+	}
+	pipeline.Parameters = make(map[string]string, len(parameters))
+	for _, p := range parameters {
+		parameter := p.(map[string]interface{})
+		pipeline.Parameters[parameter["name"].(string)] = parameter["value"].(string)
+	}
+	return pipeline
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/tailor-inc/graphql"
 	"github.com/weaviate/weaviate/adapters/handlers/graphql/local"
 	"github.com/weaviate/weaviate/adapters/handlers/graphql/local/get"
+	"github.com/weaviate/weaviate/entities/queryprofile"
 	"github.com/weaviate/weaviate/entities/schema"
 	entsentry "github.com/weaviate/weaviate/entities/sentry"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
@@ -70,7 +71,8 @@ func Build(schema *schema.Schema, traverser Traverser,
 
 // Resolve at query time
 func (g *graphQL) Resolve(context context.Context, query string, operationName string, variables map[string]interface{}) *graphql.Result {
-	return graphql.Do(graphql.Params{
+	context, profiles := queryprofile.ContextWithProfiles(context)
+	result := graphql.Do(graphql.Params{
 		Schema: g.schema,
 		RootObject: map[string]interface{}{
 			"Resolver": g.traverser,
@@ -81,6 +83,15 @@ func (g *graphQL) Resolve(context context.Context, query string, operationName s
 		VariableValues: variables,
 		Context:        context,
 	})
+
+	// profiles of queries run with the profile argument
+	if all := profiles.All(); len(all) > 0 {
+		if result.Extensions == nil {
+			result.Extensions = map[string]interface{}{}
+		}
+		result.Extensions["profile"] = all
+	}
+	return result
 }
 
 func buildGraphqlSchema(dbSchema *schema.Schema, logger logrus.FieldLogger,
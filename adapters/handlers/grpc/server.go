@@ -24,14 +24,18 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
 	pbv0 "github.com/weaviate/weaviate/grpc/generated/protocol/v0"
 	pbv1 "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/agent"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
 	authErrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/crosscluster"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/replica"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	_ "google.golang.org/grpc/encoding/gzip" // Install the gzip compressor
 	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 
@@ -61,7 +65,11 @@ func CreateGRPCServer(state *state.State, options ...grpc.ServerOption) *grpc.Se
 
 	var interceptors []grpc.UnaryServerInterceptor
 
-	interceptors = append(interceptors, makeAuthInterceptor())
+	interceptors = append(interceptors, makeAuthInterceptor(), makeSessionConsistencyInterceptor())
+
+	if state.CrossClusterGuard != nil {
+		interceptors = append(interceptors, makeCrossClusterGuardInterceptor(state.CrossClusterGuard))
+	}
 
 	// If sentry is enabled add automatic spans on gRPC requests
 	if state.ServerConfig.Config.Sentry.Enabled {
@@ -78,6 +86,12 @@ func CreateGRPCServer(state *state.State, options ...grpc.ServerOption) *grpc.Se
 		o = append(o, grpc.ChainUnaryInterceptor(interceptors...))
 	}
 
+	streamInterceptors := []grpc.StreamServerInterceptor{makeAuthStreamInterceptor(), makeSessionConsistencyStreamInterceptor()}
+	if state.CrossClusterGuard != nil {
+		streamInterceptors = append(streamInterceptors, makeCrossClusterGuardStreamInterceptor(state.CrossClusterGuard))
+	}
+	o = append(o, grpc.ChainStreamInterceptor(streamInterceptors...))
+
 	s := grpc.NewServer(o...)
 	weaviateV0 := v0.NewService()
 	weaviateV1 := v1.NewService(
@@ -87,9 +101,13 @@ func CreateGRPCServer(state *state.State, options ...grpc.ServerOption) *grpc.Se
 			state.APIKey, state.OIDC),
 		state.ServerConfig.Config.Authentication.AnonymousAccess.Enabled,
 		state.SchemaManager,
+		state.ObjectsManager,
 		state.BatchManager,
+		state.DB,
+		state.MemWatch,
 		&state.ServerConfig.Config,
 		state.Authorizer,
+		agent.New(state.Traverser, state.SchemaManager, state.Modules, state.Logger),
 		state.Logger,
 	)
 	pbv0.RegisterWeaviateServer(s, weaviateV0)
@@ -136,19 +154,179 @@ func makeAuthInterceptor() grpc.UnaryServerInterceptor {
 		ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
 	) (any, error) {
 		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, authStatusError(err)
+		}
+		return resp, nil
+	}
+}
 
-		if errors.As(err, &authErrs.Unauthenticated{}) {
-			return nil, status.Error(codes.Unauthenticated, err.Error())
+func makeAuthStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler,
+	) error {
+		if err := handler(srv, ss); err != nil {
+			return authStatusError(err)
 		}
+		return nil
+	}
+}
+
+func authStatusError(err error) error {
+	if errors.As(err, &authErrs.Unauthenticated{}) {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if errors.As(err, &authErrs.Forbidden{}) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	return err
+}
 
-		if errors.As(err, &authErrs.Forbidden{}) {
-			return nil, status.Error(codes.PermissionDenied, err.Error())
+// sessionTokenMetadataKey carries the session token of read-your-writes
+// consistency for replicated collections, like the X-Weaviate-Session-Token
+// header of the REST API
+const sessionTokenMetadataKey = "x-weaviate-session-token"
+
+// sessionTruncatedMetadataKey is set to true if writes were dropped from the
+// session, like the X-Weaviate-Session-Truncated header of the REST API
+const sessionTruncatedMetadataKey = "x-weaviate-session-truncated"
+
+// makeSessionConsistencyInterceptor adds the session of the token sent with
+// a request to its context, or a new session if there is none. The token of
+// the session, including the replicated writes of the request, is returned
+// in the response header.
+func makeSessionConsistencyInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	) (any, error) {
+		session, err := incomingSession(ctx)
+		if err != nil {
+			return nil, err
 		}
 
+		resp, err := handler(replica.ContextWithSession(ctx, session), req)
+		if md := sessionMetadata(session); len(md) > 0 {
+			// fails only if the header was already sent, which unary
+			// handlers do not do
+			_ = grpc.SetHeader(ctx, md)
+		}
 		return resp, err
 	}
 }
 
+// makeSessionConsistencyStreamInterceptor is the stream counterpart of
+// makeSessionConsistencyInterceptor. The header of a stream is sent along
+// with its first message, so the token including the writes of the whole
+// stream is returned in the trailer instead.
+func makeSessionConsistencyStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler,
+	) error {
+		session, err := incomingSession(ss.Context())
+		if err != nil {
+			return err
+		}
+
+		wrapped := grpc_middleware.WrapServerStream(ss)
+		wrapped.WrappedContext = replica.ContextWithSession(ss.Context(), session)
+		err = handler(srv, wrapped)
+		if md := sessionMetadata(session); len(md) > 0 {
+			ss.SetTrailer(md)
+		}
+		return err
+	}
+}
+
+// incomingSession parses the session token sent with a request, or returns a
+// new session if there is none.
+func incomingSession(ctx context.Context) (*replica.Session, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	tokens := md.Get(sessionTokenMetadataKey)
+	if len(tokens) == 0 || tokens[0] == "" {
+		return replica.NewSession(), nil
+	}
+	session, err := replica.ParseSessionToken(tokens[0])
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return session, nil
+}
+
+// sessionMetadata returns the token of the session, and whether writes were
+// dropped from it, to be sent back to the client.
+func sessionMetadata(session *replica.Session) metadata.MD {
+	md := metadata.MD{}
+	if token := session.Token(); token != "" {
+		md.Set(sessionTokenMetadataKey, token)
+	}
+	if session.Truncated() {
+		md.Set(sessionTruncatedMetadataKey, "true")
+	}
+	return md
+}
+
+// crossClusterGuardedMethods are the methods writing objects or the schema,
+// which a cross-cluster replication standby rejects until promoted
+var crossClusterGuardedMethods = map[string]struct{}{
+	"/weaviate.v1.Weaviate/BatchObjects":            {},
+	"/weaviate.v1.Weaviate/BatchStream":             {},
+	"/weaviate.v1.Weaviate/BatchDelete":             {},
+	"/weaviate.v1.Weaviate/CollectionCreate":        {},
+	"/weaviate.v1.Weaviate/CollectionUpdate":        {},
+	"/weaviate.v1.Weaviate/CollectionDelete":        {},
+	"/weaviate.v1.Weaviate/PropertyAdd":             {},
+	"/weaviate.v1.Weaviate/VectorIndexConfigUpdate": {},
+	"/weaviate.v1.Weaviate/TenantsCreate":           {},
+	"/weaviate.v1.Weaviate/TenantsUpdate":           {},
+	"/weaviate.v1.Weaviate/TenantsDelete":           {},
+	"/weaviate.v1.Weaviate/ObjectsReplace":          {},
+	"/weaviate.v1.Weaviate/ObjectsMerge":            {},
+	"/weaviate.v1.Weaviate/ObjectsDelete":           {},
+	"/weaviate.v1.Weaviate/ReferenceAdd":            {},
+	"/weaviate.v1.Weaviate/ReferenceDelete":         {},
+}
+
+func makeCrossClusterGuardInterceptor(guard *crosscluster.Guard) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	) (any, error) {
+		if _, ok := crossClusterGuardedMethods[info.FullMethod]; !ok {
+			return handler(ctx, req)
+		}
+
+		if err := checkCrossClusterWrite(ctx, guard); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func makeCrossClusterGuardStreamInterceptor(guard *crosscluster.Guard) grpc.StreamServerInterceptor {
+	return func(
+		srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler,
+	) error {
+		if _, ok := crossClusterGuardedMethods[info.FullMethod]; !ok {
+			return handler(srv, ss)
+		}
+
+		if err := checkCrossClusterWrite(ss.Context(), guard); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func checkCrossClusterWrite(ctx context.Context, guard *crosscluster.Guard) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	replicated := len(md.Get(crosscluster.ReplicationSourceHeader)) > 0
+	if err := guard.CheckWrite(replicated); err != nil {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return nil
+}
+
 func StartAndListen(s *grpc.Server, state *state.State) error {
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d",
 		state.ServerConfig.Config.GRPC.Port))
@@ -0,0 +1,94 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/replica"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	trailer metadata.MD
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeServerStream) SetTrailer(md metadata.MD) {
+	s.trailer = metadata.Join(s.trailer, md)
+}
+
+func TestSessionConsistencyStreamInterceptor(t *testing.T) {
+	sessionToken := func(updated time.Time) string {
+		return base64.RawURLEncoding.EncodeToString(
+			fmt.Appendf(nil, `{"C1/S1":{"t":%d,"l":"ONE"}}`, updated.UnixMilli()))
+	}
+	interceptor := makeSessionConsistencyStreamInterceptor()
+
+	serve := func(token string) (*fakeServerStream, *replica.Session, error) {
+		ctx := context.Background()
+		if token != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(sessionTokenMetadataKey, token))
+		}
+		ss := &fakeServerStream{ctx: ctx}
+		var session *replica.Session
+		err := interceptor(nil, ss, &grpc.StreamServerInfo{}, func(srv any, stream grpc.ServerStream) error {
+			session = replica.SessionFromContext(stream.Context())
+			return nil
+		})
+		return ss, session, err
+	}
+
+	t.Run("without token", func(t *testing.T) {
+		ss, session, err := serve("")
+		require.NoError(t, err)
+		require.NotNil(t, session)
+		assert.Empty(t, ss.trailer)
+	})
+
+	t.Run("with token", func(t *testing.T) {
+		token := sessionToken(time.Now())
+		ss, session, err := serve(token)
+		require.NoError(t, err)
+		require.NotNil(t, session)
+		assert.Equal(t, []string{token}, ss.trailer.Get(sessionTokenMetadataKey))
+		assert.Empty(t, ss.trailer.Get(sessionTruncatedMetadataKey))
+	})
+
+	t.Run("expired token", func(t *testing.T) {
+		ss, session, err := serve(sessionToken(time.Now().Add(-time.Hour)))
+		require.NoError(t, err)
+		require.NotNil(t, session)
+		assert.Empty(t, ss.trailer.Get(sessionTokenMetadataKey))
+		assert.Equal(t, []string{"true"}, ss.trailer.Get(sessionTruncatedMetadataKey))
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		_, session, err := serve("invalid")
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, session)
+	})
+}
@@ -0,0 +1,102 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/handlers/grpc/v1/generative"
+	restCtx "github.com/weaviate/weaviate/adapters/handlers/rest/context"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/agent"
+)
+
+// AgentSearch lets a generative model answer the prompt by searching the
+// collection. The searches of the model run with the permissions of the
+// caller.
+func (s *Service) AgentSearch(ctx context.Context, req *pb.AgentSearchRequest) (*pb.AgentSearchReply, error) {
+	var result *pb.AgentSearchReply
+	var errInner error
+
+	if err := enterrors.GoWrapperWithBlock(func() {
+		result, errInner = s.agentSearch(ctx, req)
+	}, s.logger); err != nil {
+		return nil, err
+	}
+
+	return result, errInner
+}
+
+func (s *Service) agentSearch(ctx context.Context, req *pb.AgentSearchRequest) (*pb.AgentSearchReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	class, err := s.classGetterWithAuthzFunc(principal, req.GetTenant())(req.Collection)
+	if err != nil {
+		return nil, objectsStatusError("agent search", err)
+	}
+
+	params := agentParamsFromProto(req)
+	params.ClassName = class.Class
+	res, err := s.agent.Run(ctx, principal, params)
+	if err != nil {
+		return nil, objectsStatusError("agent search", err)
+	}
+
+	reply := agentReplyToProto(res)
+	reply.Took = float32(time.Since(before).Seconds())
+	return reply, nil
+}
+
+func agentParamsFromProto(req *pb.AgentSearchRequest) agent.Params {
+	params := agent.Params{
+		ClassName:  req.Collection,
+		Tenant:     req.GetTenant(),
+		Prompt:     req.Prompt,
+		MaxSteps:   int(req.GetMaxSteps()),
+		Limit:      int(req.GetLimit()),
+		Properties: req.ReturnProperties,
+	}
+	params.Provider, params.ProviderOptions = generative.NewParser(true).Provider(req.Provider)
+	return params
+}
+
+func agentReplyToProto(res *agent.Result) *pb.AgentSearchReply {
+	reply := &pb.AgentSearchReply{
+		Answer: res.Answer,
+		Steps:  make([]*pb.AgentStep, len(res.Steps)),
+	}
+	for i, step := range res.Steps {
+		ids := make([]string, len(step.ObjectIDs))
+		for j, id := range step.ObjectIDs {
+			ids[j] = id.String()
+		}
+		reply.Steps[i] = &pb.AgentStep{
+			Tool:      step.Tool,
+			Arguments: step.Arguments,
+			ObjectIds: ids,
+			Output:    step.Output,
+		}
+		if step.Error != "" {
+			reply.Steps[i].Error = &step.Error
+		}
+	}
+	return reply
+}
@@ -0,0 +1,77 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	openaiParams "github.com/weaviate/weaviate/modules/generative-openai/parameters"
+	"github.com/weaviate/weaviate/usecases/agent"
+)
+
+func TestAgentParamsFromProto(t *testing.T) {
+	tenant := "tenant1"
+	maxSteps := uint32(3)
+	model := "gpt-4o"
+	params := agentParamsFromProto(&pb.AgentSearchRequest{
+		Collection:       "Planet",
+		Tenant:           &tenant,
+		Prompt:           "Which planet is red?",
+		MaxSteps:         &maxSteps,
+		ReturnProperties: []string{"name"},
+		Provider: &pb.GenerativeProvider{
+			Kind: &pb.GenerativeProvider_Openai{Openai: &pb.GenerativeOpenAI{Model: &model}},
+		},
+	})
+
+	assert.Equal(t, "Planet", params.ClassName)
+	assert.Equal(t, "tenant1", params.Tenant)
+	assert.Equal(t, 3, params.MaxSteps)
+	assert.Equal(t, 0, params.Limit)
+	assert.Equal(t, []string{"name"}, params.Properties)
+	assert.Equal(t, openaiParams.Name, params.Provider)
+	options, ok := params.ProviderOptions.(openaiParams.Params)
+	require.True(t, ok)
+	assert.Equal(t, "gpt-4o", options.Model)
+
+	params = agentParamsFromProto(&pb.AgentSearchRequest{Collection: "Planet", Prompt: "Which planet is red?"})
+	assert.Empty(t, params.Provider)
+	assert.Nil(t, params.ProviderOptions)
+}
+
+func TestAgentReplyToProto(t *testing.T) {
+	reply := agentReplyToProto(&agent.Result{
+		Answer: "Mars",
+		Steps: []agent.Step{
+			{
+				Tool:      "search",
+				Arguments: `{"query":"red planet"}`,
+				ObjectIDs: []strfmt.UUID{"8fc3c4ef-5ba6-4c8c-a0a1-a5b4bca4c0ea"},
+				Output:    `[{"name":"Mars"}]`,
+			},
+			{Tool: "aggregate", Arguments: `{"property":"moons"}`, Error: `cannot aggregate property "moons"`},
+		},
+	})
+
+	assert.Equal(t, "Mars", reply.Answer)
+	require.Len(t, reply.Steps, 2)
+	assert.Equal(t, []string{"8fc3c4ef-5ba6-4c8c-a0a1-a5b4bca4c0ea"}, reply.Steps[0].ObjectIds)
+	assert.Nil(t, reply.Steps[0].Error)
+	require.NotNil(t, reply.Steps[1].Error)
+	assert.Equal(t, `cannot aggregate property "moons"`, *reply.Steps[1].Error)
+	assert.Empty(t, reply.Steps[1].ObjectIds)
+}
@@ -0,0 +1,204 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	restCtx "github.com/weaviate/weaviate/adapters/handlers/rest/context"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+)
+
+// IndexQueue reports how many vectors are waiting to be indexed on this node
+type IndexQueue interface {
+	VectorIndexQueueSize() int64
+}
+
+const batchStreamMinBatchSize = 10
+
+// batchStreamPollInterval is how often a paused batch stream checks whether
+// the node can accept objects again
+var batchStreamPollInterval = 250 * time.Millisecond
+
+// BatchStream imports the objects sent over the stream and replies with the
+// result of every object. While the node is under pressure it stops reading
+// from the stream, which makes gRPC flow control block the client, and asks
+// the client to send smaller messages.
+func (s *Service) BatchStream(stream pb.Weaviate_BatchStreamServer) error {
+	ctx := stream.Context()
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	b := &batchStream{
+		service:      s,
+		stream:       stream,
+		principal:    principal,
+		maxBatchSize: s.config.GRPC.BatchStreamMaxBatchSize,
+	}
+	return b.run(ctx)
+}
+
+type batchStream struct {
+	service   *Service
+	stream    pb.Weaviate_BatchStreamServer
+	principal *models.Principal
+
+	maxBatchSize int
+	batchSize    int
+	// offset is the stream position of the first object of the next message
+	offset int64
+	// lastMsgSize estimates the memory needed to import the next message
+	lastMsgSize int64
+	started     bool
+	consistency *pb.ConsistencyLevel
+}
+
+func (b *batchStream) run(ctx context.Context) error {
+	if b.maxBatchSize < batchStreamMinBatchSize {
+		b.maxBatchSize = batchStreamMinBatchSize
+	}
+	if err := b.sendBackoff(b.maxBatchSize); err != nil {
+		return err
+	}
+
+	for {
+		if err := b.waitForCapacity(ctx); err != nil {
+			return err
+		}
+
+		req, err := b.stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		switch msg := req.Message.(type) {
+		case *pb.BatchStreamRequest_Start_:
+			if b.started {
+				return status.Error(codes.InvalidArgument, "start must be the first message of the stream")
+			}
+			b.consistency = msg.Start.ConsistencyLevel
+		case *pb.BatchStreamRequest_Objects_:
+			b.lastMsgSize = int64(proto.Size(msg.Objects))
+			if err := b.importObjects(ctx, msg.Objects.Values); err != nil {
+				return err
+			}
+		default:
+			return status.Error(codes.InvalidArgument, "empty message")
+		}
+		b.started = true
+	}
+}
+
+// waitForCapacity blocks until the node can accept more objects, halving the
+// recommended batch size while it waits. Once there is no pressure the batch
+// size doubles again with every message.
+func (b *batchStream) waitForCapacity(ctx context.Context) error {
+	paused := false
+	for {
+		err := b.service.batchPressure(b.lastMsgSize)
+		if err == nil {
+			break
+		}
+
+		if !paused {
+			b.service.logger.WithField("action", "grpc_batch_stream").
+				WithError(err).Debug("pausing batch stream")
+			paused = true
+		}
+		if size := max(b.batchSize/2, batchStreamMinBatchSize); size != b.batchSize {
+			if err := b.sendBackoff(size); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(batchStreamPollInterval):
+		}
+	}
+
+	if !paused && b.batchSize < b.maxBatchSize {
+		return b.sendBackoff(min(b.batchSize*2, b.maxBatchSize))
+	}
+	return nil
+}
+
+func (b *batchStream) sendBackoff(batchSize int) error {
+	b.batchSize = batchSize
+	return b.stream.Send(&pb.BatchStreamReply{
+		Message: &pb.BatchStreamReply_Backoff_{
+			Backoff: &pb.BatchStreamReply_Backoff{BatchSize: int32(batchSize)},
+		},
+	})
+}
+
+func (b *batchStream) importObjects(ctx context.Context, objs []*pb.BatchObject) error {
+	if len(objs) == 0 {
+		return nil
+	}
+
+	objErrors, err := b.service.addBatchObjects(ctx, b.principal, &pb.BatchObjectsRequest{
+		Objects:          objs,
+		ConsistencyLevel: b.consistency,
+	})
+	if err != nil {
+		return err
+	}
+
+	results := make([]*pb.BatchStreamReply_Results_Result, len(objs))
+	for i, obj := range objs {
+		results[i] = &pb.BatchStreamReply_Results_Result{Index: b.offset + int64(i), Uuid: obj.Uuid}
+	}
+	for _, objErr := range objErrors {
+		results[objErr.Index].Error = objErr.Error
+	}
+	b.offset += int64(len(objs))
+
+	return b.stream.Send(&pb.BatchStreamReply{
+		Message: &pb.BatchStreamReply_Results_{
+			Results: &pb.BatchStreamReply_Results{Values: results},
+		},
+	})
+}
+
+// batchPressure returns an error if the node should not accept further
+// objects, because too many vectors are waiting to be indexed or importing
+// another msgSize bytes would exceed the memory limit
+func (s *Service) batchPressure(msgSize int64) error {
+	if maxSize := int64(s.config.GRPC.BatchStreamMaxQueueSize); s.indexQueue != nil && maxSize > 0 {
+		if size := s.indexQueue.VectorIndexQueueSize(); size >= maxSize {
+			return fmt.Errorf("%d vectors waiting to be indexed", size)
+		}
+	}
+	if s.allocChecker != nil {
+		if err := s.allocChecker.CheckAlloc(msgSize); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+type fakeBatchStream struct {
+	grpc.ServerStream
+	requests []*pb.BatchStreamRequest
+	replies  []*pb.BatchStreamReply
+}
+
+func (f *fakeBatchStream) Context() context.Context { return context.Background() }
+
+func (f *fakeBatchStream) Send(reply *pb.BatchStreamReply) error {
+	f.replies = append(f.replies, reply)
+	return nil
+}
+
+func (f *fakeBatchStream) Recv() (*pb.BatchStreamRequest, error) {
+	if len(f.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := f.requests[0]
+	f.requests = f.requests[1:]
+	return req, nil
+}
+
+// fakeIndexQueue reports the queue sizes one after the other, the last one
+// is repeated
+type fakeIndexQueue struct {
+	sizes []int64
+}
+
+func (f *fakeIndexQueue) VectorIndexQueueSize() int64 {
+	size := f.sizes[0]
+	if len(f.sizes) > 1 {
+		f.sizes = f.sizes[1:]
+	}
+	return size
+}
+
+func newBatchStreamTestService(queue IndexQueue, allocChecker memwatch.AllocChecker) *Service {
+	logger, _ := test.NewNullLogger()
+	cfg := &config.Config{}
+	cfg.GRPC.BatchStreamMaxBatchSize = 100
+	cfg.GRPC.BatchStreamMaxQueueSize = 1000
+	cfg.Authentication.AnonymousAccess.Enabled = true
+	return NewService(nil, nil, true, nil, nil, nil, queue, allocChecker, cfg, nil, nil, logger)
+}
+
+func batchSizes(replies []*pb.BatchStreamReply) []int32 {
+	var sizes []int32
+	for _, reply := range replies {
+		if backoff := reply.GetBackoff(); backoff != nil {
+			sizes = append(sizes, backoff.BatchSize)
+		}
+	}
+	return sizes
+}
+
+func TestBatchStreamBackpressure(t *testing.T) {
+	defer func(interval time.Duration) { batchStreamPollInterval = interval }(batchStreamPollInterval)
+	batchStreamPollInterval = time.Millisecond
+
+	t.Run("no pressure", func(t *testing.T) {
+		stream := &fakeBatchStream{requests: []*pb.BatchStreamRequest{
+			{Message: &pb.BatchStreamRequest_Start_{Start: &pb.BatchStreamRequest_Start{}}},
+			{Message: &pb.BatchStreamRequest_Objects_{Objects: &pb.BatchStreamRequest_Objects{}}},
+		}}
+		s := newBatchStreamTestService(&fakeIndexQueue{sizes: []int64{0}}, nil)
+		require.NoError(t, s.BatchStream(stream))
+		assert.Equal(t, []int32{100}, batchSizes(stream.replies))
+	})
+
+	t.Run("index queue", func(t *testing.T) {
+		stream := &fakeBatchStream{requests: []*pb.BatchStreamRequest{
+			{Message: &pb.BatchStreamRequest_Objects_{Objects: &pb.BatchStreamRequest_Objects{}}},
+			{Message: &pb.BatchStreamRequest_Objects_{Objects: &pb.BatchStreamRequest_Objects{}}},
+		}}
+		// the queue is full for three checks, then drains
+		s := newBatchStreamTestService(&fakeIndexQueue{sizes: []int64{2000, 1500, 1000, 10}}, nil)
+		require.NoError(t, s.BatchStream(stream))
+		assert.Equal(t, []int32{100, 50, 25, 12, 24, 48}, batchSizes(stream.replies))
+	})
+
+	t.Run("memory", func(t *testing.T) {
+		stream := &fakeBatchStream{requests: []*pb.BatchStreamRequest{
+			{Message: &pb.BatchStreamRequest_Objects_{Objects: &pb.BatchStreamRequest_Objects{}}},
+		}}
+		monitor := memwatch.NewDummyMonitor()
+		s := newBatchStreamTestService(nil, &fakeAllocChecker{AllocChecker: monitor, failures: 1})
+		require.NoError(t, s.BatchStream(stream))
+		assert.Equal(t, []int32{100, 50, 100}, batchSizes(stream.replies))
+	})
+
+	t.Run("start after objects", func(t *testing.T) {
+		stream := &fakeBatchStream{requests: []*pb.BatchStreamRequest{
+			{Message: &pb.BatchStreamRequest_Objects_{Objects: &pb.BatchStreamRequest_Objects{}}},
+			{Message: &pb.BatchStreamRequest_Start_{Start: &pb.BatchStreamRequest_Start{}}},
+		}}
+		s := newBatchStreamTestService(nil, nil)
+		assert.Error(t, s.BatchStream(stream))
+	})
+}
+
+type fakeAllocChecker struct {
+	memwatch.AllocChecker
+	failures int
+}
+
+func (f *fakeAllocChecker) CheckAlloc(int64) error {
+	if f.failures > 0 {
+		f.failures--
+		return memwatch.ErrNotEnoughMemory
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	restCtx "github.com/weaviate/weaviate/adapters/handlers/rest/context"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// Export streams all objects of a collection page by page. Every page comes
+// with a cursor to resume the export after it.
+func (s *Service) Export(req *pb.ExportRequest, stream pb.Weaviate_ExportServer) error {
+	ctx := stream.Context()
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	params, err := s.exportParams(principal, req)
+	if err != nil {
+		return objectsStatusError("export", err)
+	}
+
+	err = s.objectsManager.Export(ctx, principal, params, func(page *objects.ExportPage) error {
+		reply, err := exportReply(page, req.Properties)
+		if err != nil {
+			return err
+		}
+		return stream.Send(reply)
+	})
+	if err != nil {
+		return objectsStatusError("export", err)
+	}
+	return nil
+}
+
+func (s *Service) exportParams(principal *models.Principal, req *pb.ExportRequest) (*objects.ExportParams, error) {
+	if req.Collection == "" {
+		return nil, objects.NewErrInvalidUserInput("missing collection")
+	}
+
+	params := &objects.ExportParams{
+		Class:      req.Collection,
+		Tenants:    req.Tenants,
+		Additional: additional.Properties{Vector: req.IncludeVector},
+		Cursor:     req.GetCursor(),
+		PageSize:   int(req.GetPageSize()),
+	}
+
+	if req.Filters != nil {
+		tenant := ""
+		if len(req.Tenants) == 1 {
+			tenant = req.Tenants[0]
+		}
+		authorizedGetClass := s.classGetterWithAuthzFunc(principal, tenant)
+		clause, err := ExtractFilters(req.Filters, authorizedGetClass, req.Collection, tenant)
+		if err != nil {
+			return nil, objects.NewErrInvalidUserInput("extract filters: %v", err)
+		}
+		filter := &filters.LocalFilter{Root: &clause}
+		if err := filters.ValidateFilters(authorizedGetClass, filter); err != nil {
+			return nil, objects.NewErrInvalidUserInput("validate filters: %v", err)
+		}
+		params.Filters = filter
+	}
+	return params, nil
+}
+
+// exportReply converts a page of exported objects, keeping only the given
+// properties if any
+func exportReply(page *objects.ExportPage, properties []string) (*pb.ExportReply, error) {
+	reply := &pb.ExportReply{
+		Objects: make([]*structpb.Struct, len(page.Objects)),
+		Cursor:  page.Cursor,
+	}
+	for i, obj := range page.Objects {
+		if props, ok := obj.Properties.(map[string]any); ok && len(properties) > 0 {
+			selected := make(map[string]any, len(properties))
+			for _, name := range properties {
+				if val, ok := props[name]; ok {
+					selected[name] = val
+				}
+			}
+			obj.Properties = selected
+		}
+
+		var err error
+		if reply.Objects[i], err = modelToStruct(obj); err != nil {
+			return nil, fmt.Errorf("object %s: %w", obj.ID, err)
+		}
+	}
+	return reply, nil
+}
@@ -0,0 +1,48 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestGRPCExportReply(t *testing.T) {
+	page := func() *objects.ExportPage {
+		return &objects.ExportPage{
+			Objects: []*models.Object{{
+				ID:         "c8f8d1a0-9f0e-4b7a-9d5f-3c1a2b3c4d5e",
+				Class:      "Article",
+				Properties: map[string]any{"title": "hello", "body": "world"},
+			}},
+			Cursor: "cursor",
+		}
+	}
+
+	reply, err := exportReply(page(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "cursor", reply.Cursor)
+	require.Len(t, reply.Objects, 1)
+	assert.Equal(t, "Article", reply.Objects[0].Fields["class"].GetStringValue())
+	assert.Len(t, reply.Objects[0].Fields["properties"].GetStructValue().Fields, 2)
+
+	reply, err = exportReply(page(), []string{"title", "missing"})
+	require.NoError(t, err)
+	props := reply.Objects[0].Fields["properties"].GetStructValue().Fields
+	assert.Len(t, props, 1)
+	assert.Equal(t, "hello", props["title"].GetStringValue())
+}
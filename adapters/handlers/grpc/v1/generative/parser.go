@@ -60,6 +60,17 @@ func (p *Parser) Extract(req *pb.GenerativeSearch, class *models.Class) *generat
 	}
 }
 
+// Provider returns the name and the request parameters of a single provider,
+// the name is empty when the collection's generative module should be used
+func (p *Parser) Provider(query *pb.GenerativeProvider) (string, interface{}) {
+	if query == nil {
+		return "", nil
+	}
+	generative := generate.Params{}
+	p.extractFromQuery(&generative, []*pb.GenerativeProvider{query})
+	return p.providerName, generative.Options[p.providerName]
+}
+
 func (p *Parser) ProviderName() string {
 	return p.providerName
 }
@@ -0,0 +1,213 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	authzerrors "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func (s *Service) objectsGet(ctx context.Context, principal *models.Principal, req *pb.ObjectsGetRequest) (*structpb.Struct, error) {
+	id, err := objectID(req.Collection, req.Uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := s.objectsManager.GetObject(ctx, principal, req.Collection, id,
+		additional.Properties{Vector: req.IncludeVector},
+		extractReplicationProperties(req.ConsistencyLevel), req.GetTenant())
+	if err != nil {
+		return nil, err
+	}
+	return modelToStruct(obj)
+}
+
+func (s *Service) objectsExists(ctx context.Context, principal *models.Principal, req *pb.ObjectsExistsRequest) (bool, error) {
+	id, err := objectID(req.Collection, req.Uuid)
+	if err != nil {
+		return false, err
+	}
+
+	exists, objErr := s.objectsManager.HeadObject(ctx, principal, req.Collection, id,
+		extractReplicationProperties(req.ConsistencyLevel), req.GetTenant())
+	if objErr != nil {
+		return false, objErr
+	}
+	return exists, nil
+}
+
+func (s *Service) objectsReplace(ctx context.Context, principal *models.Principal, req *pb.ObjectsReplaceRequest) (*structpb.Struct, error) {
+	id, err := objectID(req.Collection, req.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := objectFromGRPC(req.Collection, id, req.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.objectsManager.UpdateObject(ctx, principal, req.Collection, id, obj,
+		extractReplicationProperties(req.ConsistencyLevel))
+	if err != nil {
+		return nil, err
+	}
+	return modelToStruct(updated)
+}
+
+func (s *Service) objectsMerge(ctx context.Context, principal *models.Principal, req *pb.ObjectsMergeRequest) error {
+	id, err := objectID(req.Collection, req.Uuid)
+	if err != nil {
+		return err
+	}
+	obj, err := objectFromGRPC(req.Collection, id, req.Object)
+	if err != nil {
+		return err
+	}
+
+	if objErr := s.objectsManager.MergeObject(ctx, principal, obj,
+		extractReplicationProperties(req.ConsistencyLevel)); objErr != nil {
+		return objErr
+	}
+	return nil
+}
+
+func (s *Service) objectsDelete(ctx context.Context, principal *models.Principal, req *pb.ObjectsDeleteRequest) error {
+	id, err := objectID(req.Collection, req.Uuid)
+	if err != nil {
+		return err
+	}
+
+	return s.objectsManager.DeleteObject(ctx, principal, req.Collection, id,
+		extractReplicationProperties(req.ConsistencyLevel), req.GetTenant())
+}
+
+func (s *Service) referenceAdd(ctx context.Context, principal *models.Principal, req *pb.ReferenceAddRequest) error {
+	id, ref, err := referenceFromGRPC(req.Collection, req.Uuid, req.Property, req.TargetCollection, req.TargetUuid)
+	if err != nil {
+		return err
+	}
+
+	input := &objects.AddReferenceInput{
+		Class:    req.Collection,
+		ID:       id,
+		Property: req.Property,
+		Ref:      *ref,
+	}
+	if objErr := s.objectsManager.AddObjectReference(ctx, principal, input,
+		extractReplicationProperties(req.ConsistencyLevel), req.GetTenant()); objErr != nil {
+		return objErr
+	}
+	return nil
+}
+
+func (s *Service) referenceDelete(ctx context.Context, principal *models.Principal, req *pb.ReferenceDeleteRequest) error {
+	id, ref, err := referenceFromGRPC(req.Collection, req.Uuid, req.Property, req.TargetCollection, req.TargetUuid)
+	if err != nil {
+		return err
+	}
+
+	input := &objects.DeleteReferenceInput{
+		Class:     req.Collection,
+		ID:        id,
+		Property:  req.Property,
+		Reference: *ref,
+	}
+	if objErr := s.objectsManager.DeleteObjectReference(ctx, principal, input,
+		extractReplicationProperties(req.ConsistencyLevel), req.GetTenant()); objErr != nil {
+		return objErr
+	}
+	return nil
+}
+
+func objectID(collection, uuid string) (strfmt.UUID, error) {
+	if collection == "" {
+		return "", objects.NewErrInvalidUserInput("missing collection")
+	}
+	if !strfmt.IsUUID(uuid) {
+		return "", objects.NewErrInvalidUserInput("invalid uuid %q", uuid)
+	}
+	return strfmt.UUID(uuid), nil
+}
+
+// objectFromGRPC decodes the object body of a replace or merge request. The
+// collection and uuid of the request identify the object, a body naming a
+// different one is rejected, like the REST objects endpoints do.
+func objectFromGRPC(collection string, id strfmt.UUID, s *structpb.Struct) (*models.Object, error) {
+	obj := &models.Object{}
+	if err := structToModel(s, obj); err != nil {
+		return nil, objects.NewErrInvalidUserInput("object: %v", err)
+	}
+	if obj.Class != "" && schema.UppercaseClassName(obj.Class) != schema.UppercaseClassName(collection) {
+		return nil, objects.NewErrInvalidUserInput("object: class %q does not match collection %q",
+			obj.Class, collection)
+	}
+	if obj.ID != "" && !strings.EqualFold(obj.ID.String(), id.String()) {
+		return nil, objects.NewErrInvalidUserInput("object: id %q does not match uuid %q", obj.ID, id)
+	}
+	obj.Class = collection
+	obj.ID = id
+	return obj, nil
+}
+
+func referenceFromGRPC(collection, uuid, property, targetCollection, targetUUID string,
+) (strfmt.UUID, *models.SingleRef, error) {
+	id, err := objectID(collection, uuid)
+	if err != nil {
+		return "", nil, err
+	}
+	if property == "" {
+		return "", nil, objects.NewErrInvalidUserInput("missing property")
+	}
+	if !strfmt.IsUUID(targetUUID) {
+		return "", nil, objects.NewErrInvalidUserInput("invalid target uuid %q", targetUUID)
+	}
+	return id, crossref.NewLocalhost(targetCollection, strfmt.UUID(targetUUID)).SingleRef(), nil
+}
+
+// objectsStatusError maps the errors of the objects manager to the status
+// codes matching the ones of the REST objects endpoints
+func objectsStatusError(action string, err error) error {
+	code := codes.Internal
+	var objErr *objects.Error
+	switch {
+	case errors.As(err, &objErr):
+		switch {
+		case objErr.Forbidden():
+			code = codes.PermissionDenied
+		case objErr.NotFound():
+			code = codes.NotFound
+		case objErr.BadRequest(), objErr.UnprocessableEntity():
+			code = codes.InvalidArgument
+		}
+	case errors.As(err, &authzerrors.Forbidden{}):
+		code = codes.PermissionDenied
+	case errors.As(err, &objects.ErrNotFound{}):
+		code = codes.NotFound
+	case errors.As(err, &objects.ErrInvalidUserInput{}), errors.As(err, &objects.ErrMultiTenancy{}):
+		code = codes.InvalidArgument
+	}
+	return status.Errorf(code, "%s: %v", action, err)
+}
@@ -0,0 +1,119 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/weaviate/weaviate/entities/models"
+	authzerrors "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestGRPCObjectFromGRPC(t *testing.T) {
+	id := strfmt.UUID("c8f8d1a0-9f0e-4b7a-9d5f-3c1a2b3c4d5e")
+	body, err := structpb.NewStruct(map[string]any{
+		"tenant":     "t1",
+		"properties": map[string]any{"title": "hello", "count": 3},
+		"vector":     []any{1, 2},
+	})
+	require.NoError(t, err)
+
+	obj, err := objectFromGRPC("Article", id, body)
+	require.NoError(t, err)
+	assert.Equal(t, "Article", obj.Class)
+	assert.Equal(t, id, obj.ID)
+	assert.Equal(t, "t1", obj.Tenant)
+	assert.Equal(t, []float32{1, 2}, []float32(obj.Vector))
+	assert.Equal(t, map[string]any{"title": "hello", "count": float64(3)}, obj.Properties)
+
+	t.Run("body naming the same object", func(t *testing.T) {
+		body, err := structpb.NewStruct(map[string]any{"class": "article", "id": strings.ToUpper(id.String())})
+		require.NoError(t, err)
+		obj, err := objectFromGRPC("Article", id, body)
+		require.NoError(t, err)
+		assert.Equal(t, "Article", obj.Class)
+		assert.Equal(t, id, obj.ID)
+	})
+
+	t.Run("body naming another object", func(t *testing.T) {
+		for _, other := range []map[string]any{
+			{"class": "Other"},
+			{"id": "00000000-0000-0000-0000-000000000001"},
+		} {
+			body, err := structpb.NewStruct(other)
+			require.NoError(t, err)
+			_, err = objectFromGRPC("Article", id, body)
+			assert.ErrorAs(t, err, &objects.ErrInvalidUserInput{}, other)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := objectFromGRPC("Article", id, nil)
+		assert.ErrorAs(t, err, &objects.ErrInvalidUserInput{})
+	})
+}
+
+func TestGRPCReferenceFromGRPC(t *testing.T) {
+	id, ref, err := referenceFromGRPC("Article", "c8f8d1a0-9f0e-4b7a-9d5f-3c1a2b3c4d5e",
+		"author", "Person", "00000000-0000-0000-0000-000000000001")
+	require.NoError(t, err)
+	assert.Equal(t, strfmt.UUID("c8f8d1a0-9f0e-4b7a-9d5f-3c1a2b3c4d5e"), id)
+	assert.Equal(t, strfmt.URI("weaviate://localhost/Person/00000000-0000-0000-0000-000000000001"), ref.Beacon)
+
+	for _, tc := range []struct {
+		name                                   string
+		collection, uuid, property, targetUUID string
+	}{
+		{"missing collection", "", "c8f8d1a0-9f0e-4b7a-9d5f-3c1a2b3c4d5e", "author", "00000000-0000-0000-0000-000000000001"},
+		{"invalid uuid", "Article", "foo", "author", "00000000-0000-0000-0000-000000000001"},
+		{"missing property", "Article", "c8f8d1a0-9f0e-4b7a-9d5f-3c1a2b3c4d5e", "", "00000000-0000-0000-0000-000000000001"},
+		{"invalid target uuid", "Article", "c8f8d1a0-9f0e-4b7a-9d5f-3c1a2b3c4d5e", "author", "bar"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := referenceFromGRPC(tc.collection, tc.uuid, tc.property, "Person", tc.targetUUID)
+			assert.ErrorAs(t, err, &objects.ErrInvalidUserInput{})
+		})
+	}
+}
+
+func TestGRPCObjectsStatusError(t *testing.T) {
+	for _, tc := range []struct {
+		err  error
+		code codes.Code
+	}{
+		{&objects.Error{Msg: "not found", Code: objects.StatusNotFound}, codes.NotFound},
+		{&objects.Error{Msg: "forbidden", Code: objects.StatusForbidden}, codes.PermissionDenied},
+		{&objects.Error{Msg: "bad request", Code: objects.StatusBadRequest}, codes.InvalidArgument},
+		{&objects.Error{Msg: "unprocessable", Code: objects.StatusUnprocessableEntity}, codes.InvalidArgument},
+		{&objects.Error{Msg: "internal", Code: objects.StatusInternalServerError}, codes.Internal},
+		{fmt.Errorf("get: %w", objects.NewErrNotFound("no object")), codes.NotFound},
+		{objects.NewErrInvalidUserInput("invalid"), codes.InvalidArgument},
+		{objects.NewErrMultiTenancy(errors.New("tenant required")), codes.InvalidArgument},
+		{authzerrors.NewForbidden(&models.Principal{Username: "user"}, "read", "objects"), codes.PermissionDenied},
+		{errors.New("other"), codes.Internal},
+	} {
+		t.Run(tc.err.Error(), func(t *testing.T) {
+			assert.Equal(t, tc.code, status.Code(objectsStatusError("action", tc.err)))
+		})
+	}
+}
@@ -63,16 +63,14 @@ func (p *AggregateParser) Aggregate(req *pb.AggregateRequest) (*aggregation.Para
 	}
 
 	params.IncludeMetaCount = req.ObjectsCount
+	params.Properties = parseAggregationProperties(req.Aggregations)
 
-	if len(req.Aggregations) > 0 {
-		properties := make([]aggregation.ParamProperty, len(req.Aggregations))
-		for i := range req.Aggregations {
-			properties[i] = aggregation.ParamProperty{
-				Name:        schema.PropertyName(req.Aggregations[i].Property),
-				Aggregators: parseAggregations(req.Aggregations[i]),
-			}
+	if req.SubAggregation != nil {
+		subAggregation, err := parseSubAggregation(req.SubAggregation, class.Class)
+		if err != nil {
+			return nil, fmt.Errorf("sub aggregation: %w", err)
 		}
-		params.Properties = properties
+		params.SubAggregation = subAggregation
 	}
 
 	if req.Filters != nil {
@@ -327,6 +325,87 @@ func (p *AggregateParser) Aggregate(req *pb.AggregateRequest) (*aggregation.Para
 	return params, nil
 }
 
+func parseAggregationProperties(in []*pb.AggregateRequest_Aggregation) []aggregation.ParamProperty {
+	if len(in) == 0 {
+		return nil
+	}
+
+	properties := make([]aggregation.ParamProperty, len(in))
+	for i := range in {
+		properties[i] = aggregation.ParamProperty{
+			Name:        schema.PropertyName(in[i].Property),
+			Aggregators: parseAggregations(in[i]),
+		}
+	}
+	return properties
+}
+
+func parseSubAggregation(in *pb.AggregateRequest_SubAggregation, collection string) (*aggregation.SubAggregation, error) {
+	out := &aggregation.SubAggregation{
+		Properties: parseAggregationProperties(in.Aggregations),
+	}
+
+	switch bucket := in.Bucket.(type) {
+	case *pb.AggregateRequest_SubAggregation_GroupBy:
+		out.GroupBy = &filters.Path{
+			Class:    schema.ClassName(collection),
+			Property: schema.PropertyName(bucket.GroupBy.Property),
+		}
+	case *pb.AggregateRequest_SubAggregation_Histogram:
+		out.Histogram = &aggregation.Histogram{
+			Property: schema.PropertyName(bucket.Histogram.Property),
+			Interval: bucket.Histogram.Interval,
+		}
+	case *pb.AggregateRequest_SubAggregation_DateHistogram:
+		interval, err := parseCalendarInterval(bucket.DateHistogram.Interval)
+		if err != nil {
+			return nil, err
+		}
+		out.DateHistogram = &aggregation.DateHistogram{
+			Property: schema.PropertyName(bucket.DateHistogram.Property),
+			Interval: interval,
+		}
+	default:
+		return nil, fmt.Errorf("one of group_by, histogram or date_histogram must be set")
+	}
+
+	if in.Limit != nil {
+		limit := int(*in.Limit)
+		out.Limit = &limit
+	}
+
+	if in.SubAggregation != nil {
+		nested, err := parseSubAggregation(in.SubAggregation, collection)
+		if err != nil {
+			return nil, err
+		}
+		out.SubAggregation = nested
+	}
+
+	return out, nil
+}
+
+func parseCalendarInterval(in pb.AggregateRequest_DateHistogram_CalendarInterval) (aggregation.CalendarInterval, error) {
+	switch in {
+	case pb.AggregateRequest_DateHistogram_CALENDAR_INTERVAL_MINUTE:
+		return aggregation.CalendarIntervalMinute, nil
+	case pb.AggregateRequest_DateHistogram_CALENDAR_INTERVAL_HOUR:
+		return aggregation.CalendarIntervalHour, nil
+	case pb.AggregateRequest_DateHistogram_CALENDAR_INTERVAL_DAY:
+		return aggregation.CalendarIntervalDay, nil
+	case pb.AggregateRequest_DateHistogram_CALENDAR_INTERVAL_WEEK:
+		return aggregation.CalendarIntervalWeek, nil
+	case pb.AggregateRequest_DateHistogram_CALENDAR_INTERVAL_MONTH:
+		return aggregation.CalendarIntervalMonth, nil
+	case pb.AggregateRequest_DateHistogram_CALENDAR_INTERVAL_QUARTER:
+		return aggregation.CalendarIntervalQuarter, nil
+	case pb.AggregateRequest_DateHistogram_CALENDAR_INTERVAL_YEAR:
+		return aggregation.CalendarIntervalYear, nil
+	default:
+		return "", fmt.Errorf("unrecognized calendar interval %s", in)
+	}
+}
+
 func parseAggregations(in *pb.AggregateRequest_Aggregation) []aggregation.Aggregator {
 	switch a := in.GetAggregation().(type) {
 	case *pb.AggregateRequest_Aggregation_Int:
@@ -355,7 +434,7 @@ func parseAggregations(in *pb.AggregateRequest_Aggregation) []aggregation.Aggreg
 		if a.Int.Sum {
 			aggregators = append(aggregators, aggregation.SumAggregator)
 		}
-		return aggregators
+		return appendSketchAggregators(aggregators, a.Int.Cardinality, a.Int.Percentiles)
 	case *pb.AggregateRequest_Aggregation_Number_:
 		var aggregators []aggregation.Aggregator
 		if a.Number.Count {
@@ -382,7 +461,7 @@ func parseAggregations(in *pb.AggregateRequest_Aggregation) []aggregation.Aggreg
 		if a.Number.Sum {
 			aggregators = append(aggregators, aggregation.SumAggregator)
 		}
-		return aggregators
+		return appendSketchAggregators(aggregators, a.Number.Cardinality, a.Number.Percentiles)
 	case *pb.AggregateRequest_Aggregation_Text_:
 		var aggregators []aggregation.Aggregator
 		if a.Text.Count {
@@ -399,7 +478,7 @@ func parseAggregations(in *pb.AggregateRequest_Aggregation) []aggregation.Aggreg
 				aggregators = append(aggregators, aggregation.TotalTrueAggregator)
 			}
 		}
-		return aggregators
+		return appendSketchAggregators(aggregators, a.Text.Cardinality, nil)
 	case *pb.AggregateRequest_Aggregation_Boolean_:
 		var aggregators []aggregation.Aggregator
 		if a.Boolean.Count {
@@ -441,7 +520,7 @@ func parseAggregations(in *pb.AggregateRequest_Aggregation) []aggregation.Aggreg
 		if a.Date.Minimum {
 			aggregators = append(aggregators, aggregation.MinimumAggregator)
 		}
-		return aggregators
+		return appendSketchAggregators(aggregators, a.Date.Cardinality, a.Date.Percentiles)
 	case *pb.AggregateRequest_Aggregation_Reference_:
 		var aggregators []aggregation.Aggregator
 		if a.Reference.Type {
@@ -456,6 +535,18 @@ func parseAggregations(in *pb.AggregateRequest_Aggregation) []aggregation.Aggreg
 	}
 }
 
+func appendSketchAggregators(aggregators []aggregation.Aggregator,
+	cardinality bool, percentiles []float64,
+) []aggregation.Aggregator {
+	if cardinality {
+		aggregators = append(aggregators, aggregation.CardinalityAggregator)
+	}
+	for _, percentile := range percentiles {
+		aggregators = append(aggregators, aggregation.NewPercentileAggregator(percentile))
+	}
+	return aggregators
+}
+
 func extractTargetVectorsForAggregate(req *pb.AggregateRequest, class *models.Class) ([]string, *dto.TargetCombination, bool, error) {
 	var targetVectors []string
 	var targets *pb.Targets
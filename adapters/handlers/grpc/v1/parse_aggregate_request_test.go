@@ -17,6 +17,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/adapters/handlers/graphql/local/common_filters"
 	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
@@ -130,6 +131,128 @@ func TestGRPCAggregateRequest(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "nested sub aggregations",
+			req: &pb.AggregateRequest{
+				Collection: mixedVectorsClass,
+				SubAggregation: &pb.AggregateRequest_SubAggregation{
+					Bucket: &pb.AggregateRequest_SubAggregation_GroupBy{
+						GroupBy: &pb.AggregateRequest_GroupBy{Collection: mixedVectorsClass, Property: "first"},
+					},
+					Limit: ptr(uint32(5)),
+					Aggregations: []*pb.AggregateRequest_Aggregation{
+						{
+							Property: "first",
+							Aggregation: &pb.AggregateRequest_Aggregation_Text_{
+								Text: &pb.AggregateRequest_Aggregation_Text{Count: true},
+							},
+						},
+					},
+					SubAggregation: &pb.AggregateRequest_SubAggregation{
+						Bucket: &pb.AggregateRequest_SubAggregation_DateHistogram{
+							DateHistogram: &pb.AggregateRequest_DateHistogram{
+								Property: "created",
+								Interval: pb.AggregateRequest_DateHistogram_CALENDAR_INTERVAL_WEEK,
+							},
+						},
+						SubAggregation: &pb.AggregateRequest_SubAggregation{
+							Bucket: &pb.AggregateRequest_SubAggregation_Histogram{
+								Histogram: &pb.AggregateRequest_Histogram{Property: "price", Interval: 2.5},
+							},
+						},
+					},
+				},
+			},
+			out: &aggregation.Params{
+				ClassName: schema.ClassName(mixedVectorsClass),
+				SubAggregation: &aggregation.SubAggregation{
+					GroupBy: &filters.Path{
+						Class:    schema.ClassName(mixedVectorsClass),
+						Property: "first",
+					},
+					Limit: ptr(5),
+					Properties: []aggregation.ParamProperty{
+						{
+							Name:        "first",
+							Aggregators: []aggregation.Aggregator{aggregation.CountAggregator},
+						},
+					},
+					SubAggregation: &aggregation.SubAggregation{
+						DateHistogram: &aggregation.DateHistogram{
+							Property: "created",
+							Interval: aggregation.CalendarIntervalWeek,
+						},
+						SubAggregation: &aggregation.SubAggregation{
+							Histogram: &aggregation.Histogram{Property: "price", Interval: 2.5},
+						},
+					},
+				},
+			},
+			error: false,
+		},
+		{
+			name: "percentiles and cardinality",
+			req: &pb.AggregateRequest{
+				Collection: mixedVectorsClass,
+				Aggregations: []*pb.AggregateRequest_Aggregation{
+					{
+						Property: "price",
+						Aggregation: &pb.AggregateRequest_Aggregation_Number_{
+							Number: &pb.AggregateRequest_Aggregation_Number{
+								Mean:        true,
+								Cardinality: true,
+								Percentiles: []float64{50, 99.9},
+							},
+						},
+					},
+					{
+						Property: "first",
+						Aggregation: &pb.AggregateRequest_Aggregation_Text_{
+							Text: &pb.AggregateRequest_Aggregation_Text{Cardinality: true},
+						},
+					},
+				},
+			},
+			out: &aggregation.Params{
+				ClassName: schema.ClassName(mixedVectorsClass),
+				Properties: []aggregation.ParamProperty{
+					{
+						Name: "price",
+						Aggregators: []aggregation.Aggregator{
+							aggregation.MeanAggregator,
+							aggregation.CardinalityAggregator,
+							aggregation.NewPercentileAggregator(50),
+							aggregation.NewPercentileAggregator(99.9),
+						},
+					},
+					{
+						Name:        "first",
+						Aggregators: []aggregation.Aggregator{aggregation.CardinalityAggregator},
+					},
+				},
+			},
+			error: false,
+		},
+		{
+			name: "sub aggregation without bucket",
+			req: &pb.AggregateRequest{
+				Collection:     mixedVectorsClass,
+				SubAggregation: &pb.AggregateRequest_SubAggregation{},
+			},
+			error: true,
+		},
+		{
+			name: "sub aggregation with unspecified calendar interval",
+			req: &pb.AggregateRequest{
+				Collection: mixedVectorsClass,
+				SubAggregation: &pb.AggregateRequest_SubAggregation{
+					Bucket: &pb.AggregateRequest_SubAggregation_DateHistogram{
+						DateHistogram: &pb.AggregateRequest_DateHistogram{Property: "created"},
+					},
+				},
+			},
+			error: true,
+		},
 	}
 
 	parser := NewAggregateParser(getClass)
@@ -279,6 +279,11 @@ func (p *Parser) Search(req *pb.SearchRequest, config *config.Config) (dto.GetPa
 		}
 		nearVec := req.HybridSearch.NearVector
 
+		vectorizer, err := extractVectorizerOverride(hs.Vectorizer)
+		if err != nil {
+			return dto.GetParams{}, fmt.Errorf("hybrid: %w", err)
+		}
+
 		out.HybridSearch = &searchparams.HybridSearch{
 			Query:           hs.Query,
 			Properties:      schema.LowercaseFirstLetterOfStrings(hs.Properties),
@@ -288,6 +293,7 @@ func (p *Parser) Search(req *pb.SearchRequest, config *config.Config) (dto.GetPa
 			TargetVectors:   targetVectors,
 			Distance:        distance,
 			WithDistance:    withDistance,
+			Vectorizer:      vectorizer,
 		}
 
 		if nearVec != nil {
@@ -343,6 +349,11 @@ func (p *Parser) Search(req *pb.SearchRequest, config *config.Config) (dto.GetPa
 		out.AdditionalProperties.ModuleParams["rerank"] = extractRerank(req)
 	}
 
+	if req.Pipeline != nil {
+		out.QueryPipeline = &dto.QueryPipeline{Name: req.Pipeline.Name, Parameters: req.Pipeline.Parameters}
+	}
+	out.CollapseChunks = req.CollapseChunks
+
 	if len(req.After) > 0 {
 		out.Cursor = &filters.Cursor{After: req.After, Limit: out.Pagination.Limit}
 	}
@@ -360,9 +371,6 @@ func (p *Parser) Search(req *pb.SearchRequest, config *config.Config) (dto.GetPa
 	}
 
 	if len(req.SortBy) > 0 {
-		if req.NearText != nil || req.NearVideo != nil || req.NearAudio != nil || req.NearImage != nil || req.NearObject != nil || req.NearVector != nil || req.HybridSearch != nil || req.Bm25Search != nil || req.Generative != nil {
-			return dto.GetParams{}, errors.New("sorting cannot be combined with search")
-		}
 		out.Sort = extractSorting(req.SortBy)
 	}
 
@@ -385,6 +393,10 @@ func (p *Parser) Search(req *pb.SearchRequest, config *config.Config) (dto.GetPa
 	if out.HybridSearch != nil && out.HybridSearch.NearVectorParams != nil && out.HybridSearch.Vector != nil {
 		return dto.GetParams{}, errors.New("cannot combine nearVector and vector in hybrid search")
 	}
+	if out.HybridSearch != nil && out.HybridSearch.Vectorizer != nil &&
+		(out.HybridSearch.NearVectorParams != nil || out.HybridSearch.Vector != nil) {
+		return dto.GetParams{}, errors.New("cannot combine a vectorizer override with a vector in hybrid search")
+	}
 	if err := p.extractPropertiesForModules(&out); err != nil {
 		return dto.GetParams{}, err
 	}
@@ -643,9 +655,29 @@ func extractNearText(classname string, limit int, nearTextIn *pb.NearTextSearch,
 		nearText.Distance = *nearTextIn.Distance
 		nearText.WithDistance = true
 	}
+	nearText.Vectorizer, err = extractVectorizerOverride(nearTextIn.Vectorizer)
+	if err != nil {
+		return &nearText2.NearTextParams{}, fmt.Errorf("nearText: %w", err)
+	}
 	return nearText, nil
 }
 
+// extractVectorizerOverride returns the vectorizer module config of the
+// override in the format of the vectorizer config of a named vector.
+func extractVectorizerOverride(in *pb.VectorizerOverride) (map[string]interface{}, error) {
+	if in == nil {
+		return nil, nil
+	}
+	if in.Module == "" {
+		return nil, errors.New("vectorizer override: module is required")
+	}
+	config := map[string]interface{}{}
+	if in.Config != nil {
+		config = in.Config.AsMap()
+	}
+	return map[string]interface{}{in.Module: config}, nil
+}
+
 func extractNearTextMove(classname string, Move *pb.NearTextSearch_Move) (nearText2.ExploreMove, error) {
 	var moveAwayOut nearText2.ExploreMove
 
@@ -42,6 +42,7 @@ import (
 	"github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 var (
@@ -268,6 +269,8 @@ func TestGRPCSearchRequest(t *testing.T) {
 	quorum := pb.ConsistencyLevel_CONSISTENCY_LEVEL_QUORUM
 	someString1 := "a word"
 	someString2 := "other"
+	vectorizerConfig, err := structpb.NewStruct(map[string]interface{}{"model": "other-model", "dimensions": 3})
+	require.NoError(t, err)
 
 	tests := []struct {
 		name  string
@@ -916,6 +919,39 @@ func TestGRPCSearchRequest(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "hybrid vectorizer override",
+			req: &pb.SearchRequest{
+				Collection: multiVecClass, Metadata: &pb.MetadataRequest{Vector: true, Certainty: false},
+				HybridSearch: &pb.Hybrid{
+					TargetVectors: []string{"first"}, Query: "query",
+					Vectorizer: &pb.VectorizerOverride{Module: "text2vec-other", Config: vectorizerConfig},
+				},
+			},
+			out: dto.GetParams{
+				ClassName: multiVecClass, Pagination: defaultPagination,
+				HybridSearch: &searchparams.HybridSearch{
+					TargetVectors: []string{"first"}, Query: "query", FusionAlgorithm: common_filters.HybridRelativeScoreFusion,
+					Vectorizer: map[string]interface{}{
+						"text2vec-other": map[string]interface{}{"model": "other-model", "dimensions": float64(3)},
+					},
+				},
+				Properties:           defaultNamedVecProps,
+				AdditionalProperties: additional.Properties{Vectors: []string{"custom", "first", "second"}, NoProps: false, Vector: true},
+			},
+			error: false,
+		},
+		{
+			name: "hybrid vectorizer override with vector",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true, Certainty: false},
+				HybridSearch: &pb.Hybrid{
+					Query: "query", Vector: []float32{1, 2, 3},
+					Vectorizer: &pb.VectorizerOverride{Module: "text2vec-other"},
+				},
+			},
+			error: true,
+		},
 		{
 			name: "hybrid relative",
 			req: &pb.SearchRequest{
@@ -1480,6 +1516,40 @@ func TestGRPCSearchRequest(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "near text search with vectorizer override",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
+				NearText: &pb.NearTextSearch{
+					Query:      []string{"query"},
+					Vectorizer: &pb.VectorizerOverride{Module: "text2vec-other"},
+				},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				Properties:           defaultTestClassProps,
+				AdditionalProperties: additional.Properties{Vector: true, NoProps: false},
+				ModuleParams: map[string]interface{}{
+					"nearText": &nearText2.NearTextParams{
+						Values:     []string{"query"},
+						Limit:      10, // default
+						Vectorizer: map[string]interface{}{"text2vec-other": map[string]interface{}{}},
+					},
+				},
+			},
+			error: false,
+		},
+		{
+			name: "near text search with vectorizer override without module",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
+				NearText: &pb.NearTextSearch{
+					Query:      []string{"query"},
+					Vectorizer: &pb.VectorizerOverride{Config: vectorizerConfig},
+				},
+			},
+			error: true,
+		},
 		{
 			name: "near audio search",
 			req: &pb.SearchRequest{
@@ -1673,11 +1743,26 @@ func TestGRPCSearchRequest(t *testing.T) {
 			name: "Sort and vector search",
 			req: &pb.SearchRequest{
 				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
-				SortBy:     []*pb.SortBy{{Ascending: false, Path: []string{"name"}}},
+				SortBy: []*pb.SortBy{
+					{Ascending: false, Path: []string{"name"}},
+					{Ascending: true, Path: []string{"_distance"}},
+				},
 				NearVector: &pb.NearVector{Vector: []float32{1, 2, 3}},
 			},
-			out:   dto.GetParams{},
-			error: true,
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				Properties: defaultTestClassProps,
+				AdditionalProperties: additional.Properties{
+					Vector:  true,
+					NoProps: false,
+				},
+				NearVector: &searchparams.NearVector{Vectors: []models.Vector{[]float32{1, 2, 3}}},
+				Sort: []filters.Sort{
+					{Order: "desc", Path: []string{"name"}},
+					{Order: "asc", Path: []string{"_distance"}},
+				},
+			},
+			error: false,
 		},
 		{
 			name: "group by normal prop",
@@ -1905,6 +1990,20 @@ func TestGRPCSearchRequest(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "Query pipeline",
+			req: &pb.SearchRequest{
+				Collection: classname,
+				Pipeline:   &pb.QueryPipeline{Name: "rag", Parameters: map[string]string{"q": someString1}},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				Properties:           defaultTestClassProps,
+				AdditionalProperties: additional.Properties{NoProps: false},
+				QueryPipeline:        &dto.QueryPipeline{Name: "rag", Parameters: map[string]string{"q": someString1}},
+			},
+			error: false,
+		},
 
 		{
 			name: "Target vector join min",
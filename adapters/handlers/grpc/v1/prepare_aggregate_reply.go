@@ -40,8 +40,6 @@ func NewAggregateReplier(authorizedGetClass classGetterWithAuthzFunc, params *ag
 }
 
 func (r *AggregateReplier) Aggregate(res interface{}, isGroupby bool) (*pb.AggregateReply, error) {
-	var groups []*pb.AggregateReply_Group
-
 	if res != nil {
 		result, ok := res.(*aggregation.Result)
 		if !ok {
@@ -58,29 +56,21 @@ func (r *AggregateReplier) Aggregate(res interface{}, isGroupby bool) (*pb.Aggre
 			if err != nil {
 				return nil, fmt.Errorf("aggregations: %w", err)
 			}
+			subGroups, err := r.parseAggregateGroups(group.SubGroups)
+			if err != nil {
+				return nil, fmt.Errorf("sub groups: %w", err)
+			}
 			return &pb.AggregateReply{Result: &pb.AggregateReply_SingleResult{SingleResult: &pb.AggregateReply_Single{
 				ObjectsCount: &count,
 				Aggregations: aggregations,
+				SubGroups:    subGroups,
 			}}}, nil
 		}
 
 		if len(result.Groups) > 0 {
-			groups = make([]*pb.AggregateReply_Group, len(result.Groups))
-			for i := range result.Groups {
-				count := int64(result.Groups[i].Count)
-				aggregations, err := r.parseAggregatedProperties(result.Groups[i].Properties)
-				if err != nil {
-					return nil, fmt.Errorf("aggregations: %w", err)
-				}
-				groupedBy, err := r.parseAggregateGroupedBy(result.Groups[i].GroupedBy)
-				if err != nil {
-					return nil, fmt.Errorf("groupedBy: %w", err)
-				}
-				groups[i] = &pb.AggregateReply_Group{
-					ObjectsCount: &count,
-					Aggregations: aggregations,
-					GroupedBy:    groupedBy,
-				}
+			groups, err := r.parseAggregateGroups(result.Groups)
+			if err != nil {
+				return nil, err
 			}
 			return &pb.AggregateReply{Result: &pb.AggregateReply_GroupedResults{GroupedResults: &pb.AggregateReply_Grouped{Groups: groups}}}, nil
 		}
@@ -88,6 +78,36 @@ func (r *AggregateReplier) Aggregate(res interface{}, isGroupby bool) (*pb.Aggre
 	return &pb.AggregateReply{}, nil
 }
 
+func (r *AggregateReplier) parseAggregateGroups(in []aggregation.Group) ([]*pb.AggregateReply_Group, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+
+	groups := make([]*pb.AggregateReply_Group, len(in))
+	for i := range in {
+		count := int64(in[i].Count)
+		aggregations, err := r.parseAggregatedProperties(in[i].Properties)
+		if err != nil {
+			return nil, fmt.Errorf("aggregations: %w", err)
+		}
+		groupedBy, err := r.parseAggregateGroupedBy(in[i].GroupedBy)
+		if err != nil {
+			return nil, fmt.Errorf("groupedBy: %w", err)
+		}
+		subGroups, err := r.parseAggregateGroups(in[i].SubGroups)
+		if err != nil {
+			return nil, fmt.Errorf("sub groups: %w", err)
+		}
+		groups[i] = &pb.AggregateReply_Group{
+			ObjectsCount: &count,
+			Aggregations: aggregations,
+			GroupedBy:    groupedBy,
+			SubGroups:    subGroups,
+		}
+	}
+	return groups, nil
+}
+
 func (r *AggregateReplier) parseAggregateGroupedBy(in *aggregation.GroupedBy) (*pb.AggregateReply_Group_GroupedBy, error) {
 	if in != nil {
 		switch val := in.Value.(type) {
@@ -165,7 +185,7 @@ func (r *AggregateReplier) parseAggregationResult(propertyName string, property
 		}
 		switch dataType {
 		case "int", "int[]":
-			integerAggregation, err := parseIntegerAggregation(property.SchemaType, property.NumericalAggregations)
+			integerAggregation, err := parseIntegerAggregation(property)
 			if err != nil {
 				return nil, fmt.Errorf("parse integer aggregation: %w", err)
 			}
@@ -174,7 +194,7 @@ func (r *AggregateReplier) parseAggregationResult(propertyName string, property
 				Aggregation: &pb.AggregateReply_Aggregations_Aggregation_Int{Int: integerAggregation},
 			}, nil
 		default:
-			numericalAggregation, err := parseNumericalAggregation(property.SchemaType, property.NumericalAggregations)
+			numericalAggregation, err := parseNumericalAggregation(property)
 			if err != nil {
 				return nil, fmt.Errorf("parse numerical aggregation: %w", err)
 			}
@@ -184,7 +204,7 @@ func (r *AggregateReplier) parseAggregationResult(propertyName string, property
 			}, nil
 		}
 	case aggregation.PropertyTypeText:
-		textAggregation := parseTextAggregation(property.SchemaType, property.TextAggregation)
+		textAggregation := parseTextAggregation(property)
 		return &pb.AggregateReply_Aggregations_Aggregation{
 			Property:    propertyName,
 			Aggregation: &pb.AggregateReply_Aggregations_Aggregation_Text_{Text: textAggregation},
@@ -196,7 +216,7 @@ func (r *AggregateReplier) parseAggregationResult(propertyName string, property
 			Aggregation: &pb.AggregateReply_Aggregations_Aggregation_Boolean_{Boolean: booleanAggregation},
 		}, nil
 	case aggregation.PropertyTypeDate:
-		dateAggregation, err := parseDateAggregation(property.SchemaType, property.DateAggregations)
+		dateAggregation, err := parseDateAggregation(property)
 		if err != nil {
 			return nil, fmt.Errorf("parse date aggregation: %w", err)
 		}
@@ -215,10 +235,14 @@ func (r *AggregateReplier) parseAggregationResult(propertyName string, property
 	}
 }
 
-func parseNumericalAggregation(schemaType string, in map[string]interface{}) (*pb.AggregateReply_Aggregations_Aggregation_Number, error) {
+func parseNumericalAggregation(property aggregation.Property) (*pb.AggregateReply_Aggregations_Aggregation_Number, error) {
 	var number *pb.AggregateReply_Aggregations_Aggregation_Number
-	if len(in) > 0 {
-		number = &pb.AggregateReply_Aggregations_Aggregation_Number{}
+	schemaType, in := property.SchemaType, property.NumericalAggregations
+	if len(in) > 0 || hasSketchAggregations(property) {
+		number = &pb.AggregateReply_Aggregations_Aggregation_Number{
+			Percentiles: parsePercentiles(property.Percentiles),
+			Cardinality: property.Cardinality,
+		}
 		number.Type = &schemaType
 		for name, value := range in {
 			switch val := value.(type) {
@@ -249,10 +273,14 @@ func parseNumericalAggregation(schemaType string, in map[string]interface{}) (*p
 	return number, nil
 }
 
-func parseIntegerAggregation(schemaType string, in map[string]interface{}) (*pb.AggregateReply_Aggregations_Aggregation_Integer, error) {
+func parseIntegerAggregation(property aggregation.Property) (*pb.AggregateReply_Aggregations_Aggregation_Integer, error) {
 	var number *pb.AggregateReply_Aggregations_Aggregation_Integer
-	if len(in) > 0 {
-		number = &pb.AggregateReply_Aggregations_Aggregation_Integer{}
+	schemaType, in := property.SchemaType, property.NumericalAggregations
+	if len(in) > 0 || hasSketchAggregations(property) {
+		number = &pb.AggregateReply_Aggregations_Aggregation_Integer{
+			Percentiles: parsePercentiles(property.Percentiles),
+			Cardinality: property.Cardinality,
+		}
 		number.Type = &schemaType
 		for name, value := range in {
 			switch val := value.(type) {
@@ -283,7 +311,8 @@ func parseIntegerAggregation(schemaType string, in map[string]interface{}) (*pb.
 	return number, nil
 }
 
-func parseTextAggregation(schemaType string, in aggregation.Text) *pb.AggregateReply_Aggregations_Aggregation_Text {
+func parseTextAggregation(property aggregation.Property) *pb.AggregateReply_Aggregations_Aggregation_Text {
+	schemaType, in := property.SchemaType, property.TextAggregation
 	var topOccurences *pb.AggregateReply_Aggregations_Aggregation_Text_TopOccurrences
 	if len(in.Items) > 0 {
 		items := make([]*pb.AggregateReply_Aggregations_Aggregation_Text_TopOccurrences_TopOccurrence, len(in.Items))
@@ -299,6 +328,7 @@ func parseTextAggregation(schemaType string, in aggregation.Text) *pb.AggregateR
 		Count:         ptInt64(in.Count),
 		Type:          &schemaType,
 		TopOccurences: topOccurences,
+		Cardinality:   property.Cardinality,
 	}
 }
 
@@ -314,10 +344,14 @@ func parseBooleanAggregation(schemaType string, in aggregation.Boolean) *pb.Aggr
 	}
 }
 
-func parseDateAggregation(schemaType string, in map[string]interface{}) (*pb.AggregateReply_Aggregations_Aggregation_Date, error) {
+func parseDateAggregation(property aggregation.Property) (*pb.AggregateReply_Aggregations_Aggregation_Date, error) {
 	var date *pb.AggregateReply_Aggregations_Aggregation_Date
-	if len(in) > 0 {
-		date = &pb.AggregateReply_Aggregations_Aggregation_Date{}
+	schemaType, in := property.SchemaType, property.DateAggregations
+	if len(in) > 0 || hasSketchAggregations(property) {
+		date = &pb.AggregateReply_Aggregations_Aggregation_Date{
+			Percentiles: parseDatePercentiles(property.Percentiles),
+			Cardinality: property.Cardinality,
+		}
 		date.Type = &schemaType
 		for name, value := range in {
 			switch val := value.(type) {
@@ -346,6 +380,40 @@ func parseDateAggregation(schemaType string, in map[string]interface{}) (*pb.Agg
 	return date, nil
 }
 
+func hasSketchAggregations(property aggregation.Property) bool {
+	return len(property.Percentiles) > 0 || property.Cardinality != nil
+}
+
+func parsePercentiles(in []aggregation.Percentile) []*pb.AggregateReply_Aggregations_Aggregation_Percentile {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := make([]*pb.AggregateReply_Aggregations_Aggregation_Percentile, len(in))
+	for i := range in {
+		out[i] = &pb.AggregateReply_Aggregations_Aggregation_Percentile{Percentile: in[i].Percentile}
+		if value, ok := in[i].Value.(float64); ok {
+			out[i].Value = &value
+		}
+	}
+	return out
+}
+
+func parseDatePercentiles(in []aggregation.Percentile) []*pb.AggregateReply_Aggregations_Aggregation_DatePercentile {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := make([]*pb.AggregateReply_Aggregations_Aggregation_DatePercentile, len(in))
+	for i := range in {
+		out[i] = &pb.AggregateReply_Aggregations_Aggregation_DatePercentile{Percentile: in[i].Percentile}
+		if value, ok := in[i].Value.(string); ok {
+			out[i].Value = &value
+		}
+	}
+	return out
+}
+
 func parseReferenceAggregation(schemaType string, in aggregation.Reference) *pb.AggregateReply_Aggregations_Aggregation_Reference {
 	return &pb.AggregateReply_Aggregations_Aggregation_Reference{
 		Type:       &schemaType,
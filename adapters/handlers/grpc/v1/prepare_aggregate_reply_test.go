@@ -48,6 +48,112 @@ func TestGRPCAggregateReply(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "nested sub groups",
+			res: &aggregation.Result{
+				Groups: []aggregation.Group{
+					{
+						Count:     3,
+						GroupedBy: &aggregation.GroupedBy{Path: []string{"category"}, Value: "books"},
+						SubGroups: []aggregation.Group{
+							{
+								Count:     2,
+								GroupedBy: &aggregation.GroupedBy{Path: []string{"published"}, Value: "2024-01-01T00:00:00Z"},
+								SubGroups: []aggregation.Group{
+									{
+										Count:     2,
+										GroupedBy: &aggregation.GroupedBy{Path: []string{"price"}, Value: 10.0},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			outRes: &pb.AggregateReply{
+				Result: &pb.AggregateReply_GroupedResults{
+					GroupedResults: &pb.AggregateReply_Grouped{
+						Groups: []*pb.AggregateReply_Group{
+							{
+								ObjectsCount: ptInt64(3),
+								GroupedBy: &pb.AggregateReply_Group_GroupedBy{
+									Path:  []string{"category"},
+									Value: &pb.AggregateReply_Group_GroupedBy_Text{Text: "books"},
+								},
+								SubGroups: []*pb.AggregateReply_Group{
+									{
+										ObjectsCount: ptInt64(2),
+										GroupedBy: &pb.AggregateReply_Group_GroupedBy{
+											Path:  []string{"published"},
+											Value: &pb.AggregateReply_Group_GroupedBy_Text{Text: "2024-01-01T00:00:00Z"},
+										},
+										SubGroups: []*pb.AggregateReply_Group{
+											{
+												ObjectsCount: ptInt64(2),
+												GroupedBy: &pb.AggregateReply_Group_GroupedBy{
+													Path:  []string{"price"},
+													Value: &pb.AggregateReply_Group_GroupedBy_Number{Number: 10},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "percentiles and cardinality",
+			res: &aggregation.Result{
+				Groups: []aggregation.Group{
+					{
+						Count: 4,
+						Properties: map[string]aggregation.Property{
+							"published": {
+								Type:             aggregation.PropertyTypeDate,
+								SchemaType:       "date",
+								DateAggregations: map[string]interface{}{},
+								Percentiles: []aggregation.Percentile{
+									{Percentile: 50, Value: "2024-01-02T00:00:00Z"},
+									{Percentile: 99, Value: nil},
+								},
+								Cardinality: ptInt64(3),
+							},
+						},
+					},
+				},
+			},
+			outRes: &pb.AggregateReply{
+				Result: &pb.AggregateReply_GroupedResults{
+					GroupedResults: &pb.AggregateReply_Grouped{
+						Groups: []*pb.AggregateReply_Group{
+							{
+								ObjectsCount: ptInt64(4),
+								Aggregations: &pb.AggregateReply_Aggregations{
+									Aggregations: []*pb.AggregateReply_Aggregations_Aggregation{
+										{
+											Property: "published",
+											Aggregation: &pb.AggregateReply_Aggregations_Aggregation_Date_{
+												Date: &pb.AggregateReply_Aggregations_Aggregation_Date{
+													Type: ptr("date"),
+													Percentiles: []*pb.AggregateReply_Aggregations_Aggregation_DatePercentile{
+														{Percentile: 50, Value: ptr("2024-01-02T00:00:00Z")},
+														{Percentile: 99},
+													},
+													Cardinality: ptInt64(3),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/weaviate/weaviate/entities/queryprofile"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+)
+
+// withProfile returns a context profiling the query if requested
+func withProfile(ctx context.Context, enabled bool) (context.Context, *queryprofile.Profile) {
+	if !enabled {
+		return ctx, nil
+	}
+	profile := queryprofile.New()
+	return queryprofile.ContextWithProfile(ctx, profile), profile
+}
+
+func profileToProto(profile *queryprofile.Profile) *pb.QueryProfile {
+	if profile == nil {
+		return nil
+	}
+
+	shards := profile.Shards()
+	out := &pb.QueryProfile{
+		Shards:  make([]*pb.QueryProfile_Shard, len(shards)),
+		Details: profileDetailsToStruct(profile.Details()),
+	}
+	for i, shard := range shards {
+		out.Shards[i] = &pb.QueryProfile_Shard{
+			Name:    shard.Name,
+			Node:    shard.Node,
+			Remote:  shard.Remote,
+			Took:    shard.Took.Seconds(),
+			Details: profileDetailsToStruct(shard.Details),
+		}
+	}
+	return out
+}
+
+func profileDetailsToStruct(details map[string]any) *structpb.Struct {
+	if len(details) == 0 {
+		return nil
+	}
+	fields := make(map[string]*structpb.Value, len(details))
+	for key, val := range details {
+		fields[key] = profileValue(val)
+	}
+	return &structpb.Struct{Fields: fields}
+}
+
+func profileValue(val any) *structpb.Value {
+	switch v := val.(type) {
+	case time.Duration:
+		return structpb.NewNumberValue(v.Seconds())
+	case []any:
+		values := make([]*structpb.Value, len(v))
+		for i := range v {
+			values[i] = profileValue(v[i])
+		}
+		return structpb.NewListValue(&structpb.ListValue{Values: values})
+	case map[string]any:
+		return structpb.NewStructValue(profileDetailsToStruct(v))
+	}
+	if value, err := structpb.NewValue(val); err == nil {
+		return value
+	}
+	return structpb.NewStringValue(fmt.Sprint(val))
+}
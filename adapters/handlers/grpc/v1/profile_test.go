@@ -0,0 +1,77 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/queryprofile"
+)
+
+func TestProfileToProto(t *testing.T) {
+	ctx, profile := withProfile(context.Background(), false)
+	assert.Nil(t, profile)
+	assert.Nil(t, queryprofile.FromContext(ctx))
+	assert.Nil(t, profileToProto(nil))
+
+	ctx, profile = withProfile(context.Background(), true)
+	require.Same(t, profile, queryprofile.FromContext(ctx))
+
+	profile.AddShard(queryprofile.Shard{
+		Name: "shard1",
+		Node: "node1",
+		Took: 1500 * time.Millisecond,
+		Details: map[string]any{
+			"vector_search_took":         250 * time.Millisecond,
+			"filters_ids_matched":        42,
+			"knn_search_filter_strategy": "acorn",
+			"hnsw_flat_search":           false,
+			"build_allow_list_doc_bitmap": []any{
+				map[string]any{"took": time.Second, "prop": "name"},
+			},
+			"unsupported": []int{1, 2},
+		},
+	})
+	profile.AddShard(queryprofile.Shard{Name: "shard2", Node: "node2", Remote: true, Took: time.Second})
+	profile.Annotate("vectorize_took", 2*time.Second)
+
+	pp := profileToProto(profile)
+	require.Len(t, pp.Shards, 2)
+
+	local := pp.Shards[0]
+	assert.Equal(t, "shard1", local.Name)
+	assert.Equal(t, "node1", local.Node)
+	assert.False(t, local.Remote)
+	assert.Equal(t, 1.5, local.Took)
+	assert.Equal(t, map[string]any{
+		"vector_search_took":         0.25,
+		"filters_ids_matched":        float64(42),
+		"knn_search_filter_strategy": "acorn",
+		"hnsw_flat_search":           false,
+		"build_allow_list_doc_bitmap": []any{
+			map[string]any{"took": float64(1), "prop": "name"},
+		},
+		"unsupported": "[1 2]",
+	}, local.Details.AsMap())
+
+	remote := pp.Shards[1]
+	assert.True(t, remote.Remote)
+	assert.Equal(t, float64(1), remote.Took)
+	assert.Nil(t, remote.Details)
+
+	assert.Equal(t, map[string]any{"vectorize_took": float64(2)}, pp.Details.AsMap())
+}
@@ -0,0 +1,306 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	authzerrors "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/objects"
+	schemaManager "github.com/weaviate/weaviate/usecases/schema"
+)
+
+func (s *Service) collectionsGet(ctx context.Context, principal *models.Principal, req *pb.CollectionsGetRequest) ([]*structpb.Struct, error) {
+	var classes []*models.Class
+	if req.Collection != nil {
+		class, _, err := s.schemaManager.GetConsistentClass(ctx, principal, *req.Collection, req.Consistency)
+		if err != nil {
+			return nil, err
+		}
+		if class == nil {
+			return nil, objects.NewErrNotFound("collection %q not found", *req.Collection)
+		}
+		classes = []*models.Class{class}
+	} else {
+		sch, err := s.schemaManager.GetConsistentSchema(principal, req.Consistency)
+		if err != nil {
+			return nil, err
+		}
+		classes = sch.Objects.Classes
+	}
+
+	collections := make([]*structpb.Struct, len(classes))
+	for i, class := range classes {
+		collection, err := modelToStruct(class)
+		if err != nil {
+			return nil, fmt.Errorf("collection %q: %w", class.Class, err)
+		}
+		collections[i] = collection
+	}
+	return collections, nil
+}
+
+func (s *Service) collectionCreate(ctx context.Context, principal *models.Principal, req *pb.CollectionCreateRequest) (*structpb.Struct, error) {
+	class := &models.Class{}
+	if err := structToModel(req.Collection, class); err != nil {
+		return nil, fmt.Errorf("collection: %w", err)
+	}
+
+	created, _, err := s.schemaManager.AddClass(ctx, principal, class)
+	if err != nil {
+		// the error of a collection which exists already does not survive
+		// the forwarding to the leader, the schema tells instead
+		if !errors.As(err, &authzerrors.Forbidden{}) && s.schemaManager.ReadOnlyClass(class.Class) != nil {
+			return nil, errCollectionExists{err}
+		}
+		return nil, err
+	}
+	return modelToStruct(created)
+}
+
+func (s *Service) collectionUpdate(ctx context.Context, principal *models.Principal, req *pb.CollectionUpdateRequest) error {
+	if req.Collection == "" {
+		return objects.NewErrInvalidUserInput("missing collection")
+	}
+	updated := &models.Class{}
+	if err := structToModel(req.Updated, updated); err != nil {
+		return fmt.Errorf("updated collection: %w", err)
+	}
+	return s.schemaManager.UpdateClass(ctx, principal, req.Collection, updated)
+}
+
+func (s *Service) collectionDelete(ctx context.Context, principal *models.Principal, req *pb.CollectionDeleteRequest) error {
+	if req.Collection == "" {
+		return objects.NewErrInvalidUserInput("missing collection")
+	}
+	return s.schemaManager.DeleteClass(ctx, principal, req.Collection)
+}
+
+func (s *Service) propertyAdd(ctx context.Context, principal *models.Principal, req *pb.PropertyAddRequest) (*structpb.Struct, error) {
+	if req.Collection == "" {
+		return nil, objects.NewErrInvalidUserInput("missing collection")
+	}
+	prop := &models.Property{}
+	if err := structToModel(req.Property, prop); err != nil {
+		return nil, fmt.Errorf("property: %w", err)
+	}
+
+	_, _, err := s.schemaManager.AddClassProperty(ctx, principal,
+		s.schemaManager.ReadOnlyClass(req.Collection), req.Collection, false, prop)
+	if err != nil {
+		return nil, err
+	}
+	return modelToStruct(prop)
+}
+
+// vectorIndexConfigUpdate changes some settings of a vector index of a
+// collection, it is a shorthand for updating the whole collection with the
+// merged vector index config
+func (s *Service) vectorIndexConfigUpdate(ctx context.Context, principal *models.Principal, req *pb.VectorIndexConfigUpdateRequest) error {
+	if req.Collection == "" {
+		return objects.NewErrInvalidUserInput("missing collection")
+	}
+	if req.VectorIndexConfig == nil {
+		return objects.NewErrInvalidUserInput("missing vector index config")
+	}
+
+	class, _, err := s.schemaManager.GetConsistentClass(ctx, principal, req.Collection, true)
+	if err != nil {
+		return err
+	}
+	if class == nil {
+		return objects.NewErrNotFound("collection %q not found", req.Collection)
+	}
+
+	updated := *class
+	if req.TargetVector == nil {
+		merged, err := mergeVectorIndexConfig(class.VectorIndexConfig, req.VectorIndexConfig)
+		if err != nil {
+			return err
+		}
+		updated.VectorIndexConfig = merged
+	} else {
+		vectorConfig, ok := class.VectorConfig[*req.TargetVector]
+		if !ok {
+			return objects.NewErrNotFound("collection %q has no target vector %q", req.Collection, *req.TargetVector)
+		}
+		merged, err := mergeVectorIndexConfig(vectorConfig.VectorIndexConfig, req.VectorIndexConfig)
+		if err != nil {
+			return err
+		}
+		vectorConfig.VectorIndexConfig = merged
+		updated.VectorConfig = maps.Clone(class.VectorConfig)
+		updated.VectorConfig[*req.TargetVector] = vectorConfig
+	}
+
+	return s.schemaManager.UpdateClass(ctx, principal, req.Collection, &updated)
+}
+
+func (s *Service) tenantsCreate(ctx context.Context, principal *models.Principal, req *pb.TenantsCreateRequest) error {
+	if req.Collection == "" {
+		return objects.NewErrInvalidUserInput("missing collection")
+	}
+	tenants, err := tenantsFromGRPC(req.Tenants)
+	if err != nil {
+		return err
+	}
+	_, err = s.schemaManager.AddTenants(ctx, principal, req.Collection, tenants)
+	return err
+}
+
+func (s *Service) tenantsUpdate(ctx context.Context, principal *models.Principal, req *pb.TenantsUpdateRequest) ([]*pb.Tenant, error) {
+	if req.Collection == "" {
+		return nil, objects.NewErrInvalidUserInput("missing collection")
+	}
+	tenants, err := tenantsFromGRPC(req.Tenants)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := s.schemaManager.UpdateTenants(ctx, principal, req.Collection, tenants)
+	if err != nil {
+		return nil, err
+	}
+
+	retTenants := make([]*pb.Tenant, len(updated))
+	for i, tenant := range updated {
+		if retTenants[i], err = tenantToGRPC(tenant); err != nil {
+			return nil, err
+		}
+	}
+	return retTenants, nil
+}
+
+func (s *Service) tenantsDelete(ctx context.Context, principal *models.Principal, req *pb.TenantsDeleteRequest) error {
+	if req.Collection == "" {
+		return objects.NewErrInvalidUserInput("missing collection")
+	}
+	if len(req.Tenants) == 0 {
+		return objects.NewErrInvalidUserInput("must specify at least one tenant name")
+	}
+	return s.schemaManager.DeleteTenants(ctx, principal, req.Collection, req.Tenants)
+}
+
+// errCollectionExists is returned when creating a collection which exists
+type errCollectionExists struct{ error }
+
+func (e errCollectionExists) Unwrap() error { return e.error }
+
+// schemaStatusError maps the error of a schema request to a gRPC status.
+// Errors not known to be caused by the request get the fallback code, the
+// REST API answers the errors of invalid schema changes with 422 without
+// telling them apart from the other ones either.
+func schemaStatusError(action string, err error, fallback codes.Code) error {
+	code := fallback
+	switch {
+	case errors.As(err, &authzerrors.Forbidden{}):
+		code = codes.PermissionDenied
+	case errors.As(err, &errCollectionExists{}), errors.Is(err, clusterSchema.ErrClassExists):
+		code = codes.AlreadyExists
+	case errors.Is(err, schemaManager.ErrNotFound), errors.As(err, &objects.ErrNotFound{}):
+		code = codes.NotFound
+	case errors.As(err, &objects.ErrInvalidUserInput{}), errors.As(err, &objects.ErrMultiTenancy{}):
+		code = codes.InvalidArgument
+	}
+	return status.Errorf(code, "%s: %v", action, err)
+}
+
+func tenantsFromGRPC(tenants []*pb.Tenant) ([]*models.Tenant, error) {
+	if len(tenants) == 0 {
+		return nil, objects.NewErrInvalidUserInput("must specify at least one tenant")
+	}
+	res := make([]*models.Tenant, len(tenants))
+	for i, tenant := range tenants {
+		res[i] = &models.Tenant{Name: tenant.Name}
+		if tenant.ActivityStatus != pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_UNSPECIFIED {
+			status, ok := pb.TenantActivityStatus_name[int32(tenant.ActivityStatus)]
+			if !ok {
+				return nil, objects.NewErrInvalidUserInput("unknown tenant activity status %d", tenant.ActivityStatus)
+			}
+			res[i].ActivityStatus = strings.TrimPrefix(status, "TENANT_ACTIVITY_STATUS_")
+		}
+	}
+	return res, nil
+}
+
+// structToModel decodes s into the REST model m and validates it like the
+// REST API validates request bodies
+func structToModel(s *structpb.Struct, m interface {
+	Validate(strfmt.Registry) error
+},
+) error {
+	if s == nil {
+		return fmt.Errorf("missing value")
+	}
+	b, err := protojson.Marshal(s)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, m); err != nil {
+		return err
+	}
+	return m.Validate(strfmt.Default)
+}
+
+func modelToStruct(m any) (*structpb.Struct, error) {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	s := &structpb.Struct{}
+	if err := protojson.Unmarshal(b, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// mergeVectorIndexConfig overrides the settings of current present in updates,
+// nested settings are merged recursively
+func mergeVectorIndexConfig(current any, updates *structpb.Struct) (map[string]any, error) {
+	merged := map[string]any{}
+	if current != nil {
+		b, err := json.Marshal(current)
+		if err != nil {
+			return nil, fmt.Errorf("current vector index config: %w", err)
+		}
+		if err := json.Unmarshal(b, &merged); err != nil {
+			return nil, fmt.Errorf("current vector index config: %w", err)
+		}
+	}
+	mergeMaps(merged, updates.AsMap())
+	return merged, nil
+}
+
+func mergeMaps(dst, src map[string]any) {
+	for k, v := range src {
+		srcMap, srcIsMap := v.(map[string]any)
+		dstMap, dstIsMap := dst[k].(map[string]any)
+		if srcIsMap && dstIsMap {
+			mergeMaps(dstMap, srcMap)
+			continue
+		}
+		dst[k] = v
+	}
+}
@@ -0,0 +1,118 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	clusterSchema "github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	authzerrors "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/objects"
+	schemaManager "github.com/weaviate/weaviate/usecases/schema"
+)
+
+func TestGRPCCollectionConversion(t *testing.T) {
+	collection, err := structpb.NewStruct(map[string]any{
+		"class":      "Article",
+		"vectorizer": "none",
+		"properties": []any{
+			map[string]any{"name": "title", "dataType": []any{"text"}},
+		},
+		"replicationConfig": map[string]any{"factor": 3},
+	})
+	require.NoError(t, err)
+
+	class := &models.Class{}
+	require.NoError(t, structToModel(collection, class))
+	assert.Equal(t, "Article", class.Class)
+	assert.Equal(t, int64(3), class.ReplicationConfig.Factor)
+	require.Len(t, class.Properties, 1)
+	assert.Equal(t, []string{"text"}, class.Properties[0].DataType)
+
+	back, err := modelToStruct(class)
+	require.NoError(t, err)
+	assert.Equal(t, "Article", back.Fields["class"].GetStringValue())
+
+	t.Run("invalid", func(t *testing.T) {
+		invalid, err := structpb.NewStruct(map[string]any{"class": 42})
+		require.NoError(t, err)
+		assert.Error(t, structToModel(invalid, &models.Class{}))
+		assert.Error(t, structToModel(nil, &models.Class{}))
+	})
+}
+
+func TestGRPCTenantsFromGRPC(t *testing.T) {
+	tenants, err := tenantsFromGRPC([]*pb.Tenant{
+		{Name: "t1"},
+		{Name: "t2", ActivityStatus: pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_COLD},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*models.Tenant{
+		{Name: "t1"},
+		{Name: "t2", ActivityStatus: models.TenantActivityStatusCOLD},
+	}, tenants)
+
+	_, err = tenantsFromGRPC(nil)
+	assert.Error(t, err)
+	_, err = tenantsFromGRPC([]*pb.Tenant{{Name: "t1", ActivityStatus: 42}})
+	assert.Error(t, err)
+}
+
+func TestGRPCMergeVectorIndexConfig(t *testing.T) {
+	current := hnsw.NewDefaultUserConfig()
+	updates, err := structpb.NewStruct(map[string]any{
+		"ef": 256,
+		"pq": map[string]any{"enabled": true},
+	})
+	require.NoError(t, err)
+
+	merged, err := mergeVectorIndexConfig(current, updates)
+	require.NoError(t, err)
+	assert.Equal(t, float64(256), merged["ef"])
+	assert.Equal(t, float64(current.MaxConnections), merged["maxConnections"])
+
+	pq := merged["pq"].(map[string]any)
+	assert.Equal(t, true, pq["enabled"])
+	assert.Equal(t, float64(current.PQ.Segments), pq["segments"])
+}
+
+func TestGRPCSchemaStatusError(t *testing.T) {
+	for _, tc := range []struct {
+		err      error
+		fallback codes.Code
+		code     codes.Code
+	}{
+		{fmt.Errorf("collection %q: %w", "Article", schemaManager.ErrNotFound), codes.Internal, codes.NotFound},
+		{objects.NewErrNotFound("collection %q not found", "Article"), codes.Internal, codes.NotFound},
+		{objects.NewErrInvalidUserInput("missing collection"), codes.Internal, codes.InvalidArgument},
+		{errCollectionExists{errors.New("class name Article already exists")}, codes.InvalidArgument, codes.AlreadyExists},
+		{fmt.Errorf("%w: found similar class %q", clusterSchema.ErrClassExists, "article"), codes.InvalidArgument, codes.AlreadyExists},
+		{authzerrors.NewForbidden(&models.Principal{Username: "user"}, "create", "collections"), codes.InvalidArgument, codes.PermissionDenied},
+		{errors.New("invalid vectorizer"), codes.InvalidArgument, codes.InvalidArgument},
+		{errors.New("other"), codes.Internal, codes.Internal},
+	} {
+		t.Run(tc.err.Error(), func(t *testing.T) {
+			assert.Equal(t, tc.code, status.Code(schemaStatusError("action", tc.err, tc.fallback)))
+		})
+	}
+}
@@ -0,0 +1,104 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	restCtx "github.com/weaviate/weaviate/adapters/handlers/rest/context"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/traverser"
+)
+
+// maxSearchBatchQueries limits the number of queries of a single batch, as
+// every query of a batch is executed concurrently
+const maxSearchBatchQueries = 1000
+
+// SearchBatch runs many queries against one collection. The queries run
+// concurrently and their vector searches are collected, so every shard is
+// only searched once for all of them. Every query gets its own result, which
+// holds either its reply or its error.
+func (s *Service) SearchBatch(ctx context.Context, req *pb.SearchBatchRequest) (*pb.SearchBatchReply, error) {
+	before := time.Now()
+
+	if err := validateSearchBatch(req); err != nil {
+		return nil, err
+	}
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+	ctx, batch := traverser.ContextWithSearchBatch(ctx, len(req.Queries))
+
+	results := make([]*pb.SearchBatchReply_Result, len(req.Queries))
+	wg := sync.WaitGroup{}
+	for i, query := range req.Queries {
+		wg.Add(1)
+		enterrors.GoWrapper(func() {
+			defer wg.Done()
+			defer batch.Done()
+			results[i] = searchBatchResult(s.search(ctx, query))
+		}, s.logger)
+	}
+	wg.Wait()
+
+	return &pb.SearchBatchReply{
+		Took:    float32(time.Since(before).Seconds()),
+		Results: results,
+	}, nil
+}
+
+// searchBatchResult reports the outcome of a single query of a batch, a failing
+// query does not fail the batch
+func searchBatchResult(reply *pb.SearchReply, err error) *pb.SearchBatchReply_Result {
+	if err != nil {
+		errMsg := err.Error()
+		return &pb.SearchBatchReply_Result{Error: &errMsg}
+	}
+	if reply == nil {
+		errMsg := "no result"
+		return &pb.SearchBatchReply_Result{Error: &errMsg}
+	}
+	return &pb.SearchBatchReply_Result{Reply: reply}
+}
+
+func validateSearchBatch(req *pb.SearchBatchRequest) error {
+	if len(req.Queries) == 0 {
+		return status.Error(codes.InvalidArgument, "at least one query is required")
+	}
+	if len(req.Queries) > maxSearchBatchQueries {
+		return status.Errorf(codes.InvalidArgument, "at most %d queries are allowed per batch, got %d",
+			maxSearchBatchQueries, len(req.Queries))
+	}
+
+	collection := req.Queries[0].GetCollection()
+	for i, query := range req.Queries {
+		if query == nil {
+			return status.Errorf(codes.InvalidArgument, "query %d is empty", i)
+		}
+		if query.Collection != collection {
+			return status.Errorf(codes.InvalidArgument,
+				"query %d targets collection %q, all queries must target collection %q",
+				i, query.Collection, collection)
+		}
+	}
+	return nil
+}
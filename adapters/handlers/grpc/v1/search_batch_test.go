@@ -0,0 +1,74 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+)
+
+func TestValidateSearchBatch(t *testing.T) {
+	tooMany := make([]*pb.SearchRequest, maxSearchBatchQueries+1)
+	for i := range tooMany {
+		tooMany[i] = &pb.SearchRequest{Collection: "Foo"}
+	}
+
+	tests := []struct {
+		name    string
+		queries []*pb.SearchRequest
+		wantErr bool
+	}{
+		{name: "no queries", wantErr: true},
+		{name: "too many queries", queries: tooMany, wantErr: true},
+		{name: "empty query", queries: []*pb.SearchRequest{nil}, wantErr: true},
+		{
+			name:    "different collections",
+			queries: []*pb.SearchRequest{{Collection: "Foo"}, {Collection: "Bar"}},
+			wantErr: true,
+		},
+		{
+			name:    "same collection",
+			queries: []*pb.SearchRequest{{Collection: "Foo"}, {Collection: "Foo", Tenant: "t1"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSearchBatch(&pb.SearchBatchRequest{Queries: tt.queries})
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestSearchBatchResult(t *testing.T) {
+	reply := &pb.SearchReply{Took: 1}
+	res := searchBatchResult(reply, nil)
+	assert.Equal(t, reply, res.Reply)
+	assert.Nil(t, res.Error)
+
+	res = searchBatchResult(nil, errors.New("class Foo not found"))
+	assert.Nil(t, res.Reply)
+	assert.Equal(t, "class Foo not found", res.GetError())
+
+	res = searchBatchResult(nil, nil)
+	assert.Nil(t, res.Reply)
+	assert.Equal(t, "no result", res.GetError())
+}
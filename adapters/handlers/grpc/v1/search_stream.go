@@ -0,0 +1,65 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"sync"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/additional/generate"
+)
+
+// SearchStream runs a search like Search, but sends the generative results
+// while they are being generated. The last message of the stream is the
+// complete search reply.
+func (s *Service) SearchStream(req *pb.SearchRequest, stream pb.Weaviate_SearchStreamServer) error {
+	sender := &searchStreamSender{stream: stream}
+	ctx := generate.WithStream(stream.Context(), sender.delta)
+
+	var reply *pb.SearchReply
+	var errInner error
+	if err := enterrors.GoWrapperWithBlock(func() {
+		reply, errInner = s.search(ctx, req)
+	}, s.logger); err != nil {
+		return err
+	}
+	if errInner != nil {
+		return errInner
+	}
+	return sender.result(reply)
+}
+
+// searchStreamSender serializes the sends of the concurrent generations
+type searchStreamSender struct {
+	lock   sync.Mutex
+	stream pb.Weaviate_SearchStreamServer
+}
+
+func (s *searchStreamSender) delta(delta generate.StreamDelta) error {
+	out := &pb.GenerativeDelta{Text: delta.Text}
+	if delta.ID != "" {
+		id := delta.ID.String()
+		out.ObjectId = &id
+	}
+	return s.send(&pb.SearchStreamReply{Kind: &pb.SearchStreamReply_GenerativeDelta{GenerativeDelta: out}})
+}
+
+func (s *searchStreamSender) result(reply *pb.SearchReply) error {
+	return s.send(&pb.SearchStreamReply{Kind: &pb.SearchStreamReply_Result{Result: reply}})
+}
+
+func (s *searchStreamSender) send(reply *pb.SearchStreamReply) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.stream.Send(reply)
+}
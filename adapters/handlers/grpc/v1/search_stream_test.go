@@ -0,0 +1,67 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/additional/generate"
+)
+
+type fakeSearchStream struct {
+	grpc.ServerStream
+	replies []*pb.SearchStreamReply
+}
+
+func (f *fakeSearchStream) Context() context.Context { return context.Background() }
+
+func (f *fakeSearchStream) Send(reply *pb.SearchStreamReply) error {
+	f.replies = append(f.replies, reply)
+	return nil
+}
+
+func TestGRPCSearchStreamSender(t *testing.T) {
+	stream := &fakeSearchStream{}
+	sender := &searchStreamSender{stream: stream}
+
+	var wg sync.WaitGroup
+	for _, id := range []string{"", "73f2eb5f-5abf-447a-81ca-74b1dd168247"} {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			for _, text := range []string{"a", "b", "c"} {
+				require.Nil(t, sender.delta(generate.StreamDelta{ID: strfmt.UUID(id), Text: text}))
+			}
+		}(id)
+	}
+	wg.Wait()
+	require.Nil(t, sender.result(&pb.SearchReply{Took: 1}))
+
+	require.Len(t, stream.replies, 7)
+	texts := map[string]string{}
+	for _, reply := range stream.replies[:6] {
+		delta := reply.GetGenerativeDelta()
+		require.NotNil(t, delta)
+		texts[delta.GetObjectId()] += delta.Text
+	}
+	assert.Equal(t, map[string]string{"": "abc", "73f2eb5f-5abf-447a-81ca-74b1dd168247": "abc"}, texts)
+	assert.Equal(t, float32(1), stream.replies[6].GetResult().GetTook())
+	assert.Nil(t, stream.replies[0].GetResult())
+}
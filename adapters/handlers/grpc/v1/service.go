@@ -13,6 +13,7 @@ package v1
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -23,10 +24,14 @@ import (
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	restCtx "github.com/weaviate/weaviate/adapters/handlers/rest/context"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/memwatch"
 
 	"github.com/weaviate/weaviate/usecases/objects"
 
@@ -34,6 +39,7 @@ import (
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/schema"
 	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/agent"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/composer"
 	schemaManager "github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/traverser"
@@ -45,26 +51,35 @@ type Service struct {
 	authComposer         composer.TokenFunc
 	allowAnonymousAccess bool
 	schemaManager        *schemaManager.Manager
+	objectsManager       *objects.Manager
 	batchManager         *objects.BatchManager
+	indexQueue           IndexQueue
+	allocChecker         memwatch.AllocChecker
 	config               *config.Config
 	authorizer           authorization.Authorizer
+	agent                *agent.Agent
 	logger               logrus.FieldLogger
 }
 
 func NewService(traverser *traverser.Traverser, authComposer composer.TokenFunc,
 	allowAnonymousAccess bool, schemaManager *schemaManager.Manager,
-	batchManager *objects.BatchManager, config *config.Config, authorization authorization.Authorizer,
-	logger logrus.FieldLogger,
+	objectsManager *objects.Manager, batchManager *objects.BatchManager,
+	indexQueue IndexQueue, allocChecker memwatch.AllocChecker, config *config.Config, authorization authorization.Authorizer,
+	agent *agent.Agent, logger logrus.FieldLogger,
 ) *Service {
 	return &Service{
 		traverser:            traverser,
 		authComposer:         authComposer,
 		allowAnonymousAccess: allowAnonymousAccess,
 		schemaManager:        schemaManager,
+		objectsManager:       objectsManager,
 		batchManager:         batchManager,
+		indexQueue:           indexQueue,
+		allocChecker:         allocChecker,
 		config:               config,
 		logger:               logger,
 		authorizer:           authorization,
+		agent:                agent,
 	}
 }
 
@@ -99,6 +114,7 @@ func (s *Service) aggregate(ctx context.Context, req *pb.AggregateRequest) (*pb.
 		return nil, fmt.Errorf("parse params: %w", err)
 	}
 
+	ctx, profile := withProfile(ctx, req.Profile)
 	res, err := s.traverser.Aggregate(restCtx.AddPrincipalToContext(ctx, principal), principal, params)
 	if err != nil {
 		return nil, fmt.Errorf("aggregate: %w", err)
@@ -113,6 +129,7 @@ func (s *Service) aggregate(ctx context.Context, req *pb.AggregateRequest) (*pb.
 		return nil, fmt.Errorf("prepare reply: %w", err)
 	}
 
+	reply.Profile = profileToProto(profile)
 	reply.Took = float32(time.Since(before).Seconds())
 	return reply, nil
 }
@@ -138,6 +155,293 @@ func (s *Service) TenantsGet(ctx context.Context, req *pb.TenantsGetRequest) (*p
 	return result, nil
 }
 
+func (s *Service) CollectionsGet(ctx context.Context, req *pb.CollectionsGetRequest) (*pb.CollectionsGetReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	collections, err := s.collectionsGet(ctx, principal, req)
+	if err != nil {
+		return nil, schemaStatusError("get collections", err, codes.Internal)
+	}
+
+	return &pb.CollectionsGetReply{
+		Took:        float32(time.Since(before).Seconds()),
+		Collections: collections,
+	}, nil
+}
+
+func (s *Service) CollectionCreate(ctx context.Context, req *pb.CollectionCreateRequest) (*pb.CollectionCreateReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	collection, err := s.collectionCreate(ctx, principal, req)
+	if err != nil {
+		return nil, schemaStatusError("create collection", err, codes.InvalidArgument)
+	}
+
+	return &pb.CollectionCreateReply{
+		Took:       float32(time.Since(before).Seconds()),
+		Collection: collection,
+	}, nil
+}
+
+func (s *Service) CollectionUpdate(ctx context.Context, req *pb.CollectionUpdateRequest) (*pb.CollectionUpdateReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	if err := s.collectionUpdate(ctx, principal, req); err != nil {
+		return nil, schemaStatusError("update collection", err, codes.InvalidArgument)
+	}
+
+	return &pb.CollectionUpdateReply{Took: float32(time.Since(before).Seconds())}, nil
+}
+
+func (s *Service) CollectionDelete(ctx context.Context, req *pb.CollectionDeleteRequest) (*pb.CollectionDeleteReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	if err := s.collectionDelete(ctx, principal, req); err != nil {
+		return nil, schemaStatusError("delete collection", err, codes.Internal)
+	}
+
+	return &pb.CollectionDeleteReply{Took: float32(time.Since(before).Seconds())}, nil
+}
+
+func (s *Service) PropertyAdd(ctx context.Context, req *pb.PropertyAddRequest) (*pb.PropertyAddReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	property, err := s.propertyAdd(ctx, principal, req)
+	if err != nil {
+		return nil, schemaStatusError("add property", err, codes.InvalidArgument)
+	}
+
+	return &pb.PropertyAddReply{
+		Took:     float32(time.Since(before).Seconds()),
+		Property: property,
+	}, nil
+}
+
+func (s *Service) VectorIndexConfigUpdate(ctx context.Context, req *pb.VectorIndexConfigUpdateRequest) (*pb.VectorIndexConfigUpdateReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	if err := s.vectorIndexConfigUpdate(ctx, principal, req); err != nil {
+		return nil, schemaStatusError("update vector index config", err, codes.InvalidArgument)
+	}
+
+	return &pb.VectorIndexConfigUpdateReply{Took: float32(time.Since(before).Seconds())}, nil
+}
+
+func (s *Service) TenantsCreate(ctx context.Context, req *pb.TenantsCreateRequest) (*pb.TenantsCreateReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	if err := s.tenantsCreate(ctx, principal, req); err != nil {
+		return nil, schemaStatusError("create tenants", err, codes.InvalidArgument)
+	}
+
+	return &pb.TenantsCreateReply{
+		Took:    float32(time.Since(before).Seconds()),
+		Tenants: req.Tenants,
+	}, nil
+}
+
+func (s *Service) TenantsUpdate(ctx context.Context, req *pb.TenantsUpdateRequest) (*pb.TenantsUpdateReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	tenants, err := s.tenantsUpdate(ctx, principal, req)
+	if err != nil {
+		return nil, schemaStatusError("update tenants", err, codes.InvalidArgument)
+	}
+
+	return &pb.TenantsUpdateReply{
+		Took:    float32(time.Since(before).Seconds()),
+		Tenants: tenants,
+	}, nil
+}
+
+func (s *Service) TenantsDelete(ctx context.Context, req *pb.TenantsDeleteRequest) (*pb.TenantsDeleteReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	if err := s.tenantsDelete(ctx, principal, req); err != nil {
+		return nil, schemaStatusError("delete tenants", err, codes.Internal)
+	}
+
+	return &pb.TenantsDeleteReply{Took: float32(time.Since(before).Seconds())}, nil
+}
+
+func (s *Service) ObjectsGet(ctx context.Context, req *pb.ObjectsGetRequest) (*pb.ObjectsGetReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	object, err := s.objectsGet(ctx, principal, req)
+	if err != nil {
+		return nil, objectsStatusError("get object", err)
+	}
+
+	return &pb.ObjectsGetReply{
+		Took:   float32(time.Since(before).Seconds()),
+		Object: object,
+	}, nil
+}
+
+func (s *Service) ObjectsExists(ctx context.Context, req *pb.ObjectsExistsRequest) (*pb.ObjectsExistsReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	exists, err := s.objectsExists(ctx, principal, req)
+	if err != nil {
+		return nil, objectsStatusError("check object", err)
+	}
+
+	return &pb.ObjectsExistsReply{
+		Took:   float32(time.Since(before).Seconds()),
+		Exists: exists,
+	}, nil
+}
+
+func (s *Service) ObjectsReplace(ctx context.Context, req *pb.ObjectsReplaceRequest) (*pb.ObjectsReplaceReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	object, err := s.objectsReplace(ctx, principal, req)
+	if err != nil {
+		return nil, objectsStatusError("replace object", err)
+	}
+
+	return &pb.ObjectsReplaceReply{
+		Took:   float32(time.Since(before).Seconds()),
+		Object: object,
+	}, nil
+}
+
+func (s *Service) ObjectsMerge(ctx context.Context, req *pb.ObjectsMergeRequest) (*pb.ObjectsMergeReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	if err := s.objectsMerge(ctx, principal, req); err != nil {
+		return nil, objectsStatusError("merge object", err)
+	}
+
+	return &pb.ObjectsMergeReply{Took: float32(time.Since(before).Seconds())}, nil
+}
+
+func (s *Service) ObjectsDelete(ctx context.Context, req *pb.ObjectsDeleteRequest) (*pb.ObjectsDeleteReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	if err := s.objectsDelete(ctx, principal, req); err != nil {
+		return nil, objectsStatusError("delete object", err)
+	}
+
+	return &pb.ObjectsDeleteReply{Took: float32(time.Since(before).Seconds())}, nil
+}
+
+func (s *Service) ReferenceAdd(ctx context.Context, req *pb.ReferenceAddRequest) (*pb.ReferenceAddReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	if err := s.referenceAdd(ctx, principal, req); err != nil {
+		return nil, objectsStatusError("add reference", err)
+	}
+
+	return &pb.ReferenceAddReply{Took: float32(time.Since(before).Seconds())}, nil
+}
+
+func (s *Service) ReferenceDelete(ctx context.Context, req *pb.ReferenceDeleteRequest) (*pb.ReferenceDeleteReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	if err := s.referenceDelete(ctx, principal, req); err != nil {
+		return nil, objectsStatusError("delete reference", err)
+	}
+
+	return &pb.ReferenceDeleteReply{Took: float32(time.Since(before).Seconds())}, nil
+}
+
 func (s *Service) BatchDelete(ctx context.Context, req *pb.BatchDeleteRequest) (*pb.BatchDeleteReply, error) {
 	var result *pb.BatchDeleteReply
 	var errInner error
@@ -210,6 +514,23 @@ func (s *Service) batchObjects(ctx context.Context, req *pb.BatchObjectsRequest)
 	}
 	ctx = restCtx.AddPrincipalToContext(ctx, principal)
 
+	objErrors, err := s.addBatchObjects(ctx, principal, req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &pb.BatchObjectsReply{
+		Took:   float32(time.Since(before).Seconds()),
+		Errors: objErrors,
+	}
+	return result, nil
+}
+
+// addBatchObjects imports the objects of req and returns the errors of the
+// objects which could not be imported
+func (s *Service) addBatchObjects(ctx context.Context, principal *models.Principal,
+	req *pb.BatchObjectsRequest,
+) ([]*pb.BatchObjectsReply_BatchError, error) {
 	ctx = classcache.ContextWithClassCache(ctx)
 
 	// we need to save the class two times:
@@ -253,11 +574,7 @@ func (s *Service) batchObjects(ctx context.Context, req *pb.BatchObjectsRequest)
 
 	// If every object failed to parse, return early with the errors
 	if len(objs) == 0 {
-		result := &pb.BatchObjectsReply{
-			Took:   float32(time.Since(before).Seconds()),
-			Errors: objErrors,
-		}
-		return result, nil
+		return objErrors, nil
 	}
 
 	replicationProperties := extractReplicationProperties(req.ConsistencyLevel)
@@ -273,11 +590,7 @@ func (s *Service) batchObjects(ctx context.Context, req *pb.BatchObjectsRequest)
 		}
 	}
 
-	result := &pb.BatchObjectsReply{
-		Took:   float32(time.Since(before).Seconds()),
-		Errors: objErrors,
-	}
-	return result, nil
+	return objErrors, nil
 }
 
 func (s *Service) Search(ctx context.Context, req *pb.SearchRequest) (*pb.SearchReply, error) {
@@ -322,13 +635,35 @@ func (s *Service) search(ctx context.Context, req *pb.SearchRequest) (*pb.Search
 		return nil, err
 	}
 
+	// the replier needs the rerank and generate params of a query pipeline,
+	// so expand it before the search instead of leaving it to GetClass
+	if err := s.traverser.ResolveQueryPipeline(&searchParams); err != nil {
+		return nil, queryPipelineStatusError(err)
+	}
+
+	ctx, profile := withProfile(ctx, req.Profile)
 	res, err := s.traverser.GetClass(restCtx.AddPrincipalToContext(ctx, principal), principal, searchParams)
 	if err != nil {
 		return nil, err
 	}
 
 	scheme := s.schemaManager.GetSchemaSkipAuth()
-	return replier.Search(res, before, searchParams, scheme)
+	reply, err := replier.Search(res, before, searchParams, scheme)
+	if err != nil {
+		return nil, err
+	}
+	reply.Profile = profileToProto(profile)
+	return reply, nil
+}
+
+// queryPipelineStatusError maps the errors of resolving a query pipeline to
+// NotFound for an unknown class or pipeline and InvalidArgument otherwise
+func queryPipelineStatusError(err error) error {
+	code := codes.InvalidArgument
+	if errors.As(err, &objects.ErrNotFound{}) {
+		code = codes.NotFound
+	}
+	return status.Error(code, err.Error())
 }
 
 func (s *Service) validateClassAndProperty(searchParams dto.GetParams) error {
@@ -368,7 +703,7 @@ func (s *Service) classGetterWithAuthzFunc(principal *models.Principal, tenant s
 			authorizedCollections[name] = class
 		}
 		if class == nil {
-			return nil, fmt.Errorf("could not find class %s in schema", name)
+			return nil, objects.NewErrNotFound("could not find class %s in schema", name)
 		}
 		return class, nil
 	}
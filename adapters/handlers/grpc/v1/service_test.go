@@ -0,0 +1,29 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestGRPCQueryPipelineStatusError(t *testing.T) {
+	assert.Equal(t, codes.NotFound,
+		status.Code(queryPipelineStatusError(objects.NewErrNotFound("query pipeline \"rag\" is not declared"))))
+	assert.Equal(t, codes.InvalidArgument,
+		status.Code(queryPipelineStatusError(objects.NewErrInvalidUserInput("query pipeline \"rag\": missing parameters"))))
+}
@@ -43,6 +43,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/replica/hashtree"
+	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 type indices struct {
@@ -58,6 +59,7 @@ type indices struct {
 	regexObjectsDigestsInRange *regexp.Regexp
 	regexObjectsHashTreeLevel  *regexp.Regexp
 	regexpObjectsSearch        *regexp.Regexp
+	regexpObjectsSearchBatch   *regexp.Regexp
 	regexpObjectsFind          *regexp.Regexp
 
 	regexpObjectsAggregations *regexp.Regexp
@@ -97,6 +99,8 @@ const (
 		`\/shards\/(` + sh + `)\/objects\/hashtree\/(` + l + `)`
 	urlPatternObjectsSearch = `\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/objects\/_search`
+	urlPatternObjectsSearchBatch = `\/indices\/(` + cl + `)` +
+		`\/shards\/(` + sh + `)\/objects\/_search_batch`
 	urlPatternObjectsFind = `\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/objects\/_find`
 	urlPatternObjectsAggregations = `\/indices\/(` + cl + `)` +
@@ -151,6 +155,8 @@ type shards interface {
 		sort []filters.Sort, cursor *filters.Cursor, groupBy *searchparams.GroupBy,
 		additional additional.Properties, targetCombination *dto.TargetCombination, properties []string,
 	) ([]*storobj.Object, []float32, error)
+	SearchBatch(ctx context.Context, indexName, shardName string,
+		queries []sharding.ShardSearchQuery) ([]sharding.ShardSearchResult, error)
 	Aggregate(ctx context.Context, indexName, shardName string,
 		params aggregation.Params) (*aggregation.Result, error)
 	FindUUIDs(ctx context.Context, indexName, shardName string,
@@ -206,6 +212,7 @@ func NewIndices(shards shards, db db, auth auth, maintenanceModeEnabled func() b
 		regexObjectsDigestsInRange: regexp.MustCompile(urlPatternObjectsDigestsInRange),
 		regexObjectsHashTreeLevel:  regexp.MustCompile(urlPatternHashTreeLevel),
 		regexpObjectsSearch:        regexp.MustCompile(urlPatternObjectsSearch),
+		regexpObjectsSearchBatch:   regexp.MustCompile(urlPatternObjectsSearchBatch),
 		regexpObjectsFind:          regexp.MustCompile(urlPatternObjectsFind),
 
 		regexpObjectsAggregations:           regexp.MustCompile(urlPatternObjectsAggregations),
@@ -243,6 +250,15 @@ func (i *indices) indicesHandler() http.HandlerFunc {
 		// NOTE if you update any of these handler methods/paths, also update the indices_test.go
 		// TestMaintenanceModeIndices test to include the new methods/paths.
 		switch {
+		// the batch has to be matched first, the search pattern matches it too
+		case i.regexpObjectsSearchBatch.MatchString(path):
+			if r.Method != http.MethodPost {
+				http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+				return
+			}
+
+			i.postSearchObjectsBatch().ServeHTTP(w, r)
+			return
 		case i.regexpObjectsSearch.MatchString(path):
 			if r.Method != http.MethodPost {
 				http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
@@ -829,6 +845,64 @@ func (i *indices) postSearchObjects() http.Handler {
 	})
 }
 
+func (i *indices) postSearchObjectsBatch() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpObjectsSearchBatch.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		defer r.Body.Close()
+		reqPayload, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "read request body: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		ct, ok := IndicesPayloads.SearchBatchParams.CheckContentTypeHeaderReq(r)
+		if !ok {
+			http.Error(w, errors.Errorf("unexpected content type: %s", ct).Error(),
+				http.StatusUnsupportedMediaType)
+			return
+		}
+
+		queries, err := IndicesPayloads.SearchBatchParams.Unmarshal(reqPayload)
+		if err != nil {
+			http.Error(w, "unmarshal search batch params from json: "+err.Error(),
+				http.StatusBadRequest)
+			return
+		}
+
+		i.logger.WithFields(logrus.Fields{
+			"shard":   shard,
+			"action":  "SearchBatch",
+			"queries": len(queries),
+		}).Debug("searching ...")
+
+		results, err := i.shards.SearchBatch(r.Context(), index, shard, queries)
+		if err != nil && errors.As(err, &enterrors.ErrUnprocessable{}) {
+			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		resBytes, err := IndicesPayloads.SearchBatchResults.Marshal(results)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		IndicesPayloads.SearchBatchResults.SetContentTypeHeader(w)
+		w.Write(resBytes)
+	})
+}
+
 func (i *indices) postReferences() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := i.regexpReferences.FindStringSubmatch(r.URL.Path)
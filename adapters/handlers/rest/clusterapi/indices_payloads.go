@@ -36,6 +36,7 @@ import (
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/scaler"
+	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 var IndicesPayloads = indicesPayloads{}
@@ -48,6 +49,8 @@ type indicesPayloads struct {
 	VersionedObjectList           versionedObjectListPayload
 	SearchResults                 searchResultsPayload
 	SearchParams                  searchParamsPayload
+	SearchBatchParams             searchBatchParamsPayload
+	SearchBatchResults            searchBatchResultsPayload
 	VectorDistanceParams          vectorDistanceParamsPayload
 	VectorDistanceResults         vectorDistanceResultsPayload
 	ReferenceList                 referenceListPayload
@@ -675,6 +678,144 @@ func (p searchResultsPayload) CheckContentTypeHeader(r *http.Response) (string,
 	return ct, ct == p.MIME()
 }
 
+type searchBatchParamsPayload struct{}
+
+func (p searchBatchParamsPayload) Marshal(queries []sharding.ShardSearchQuery) ([]byte, error) {
+	par := make([]searchParametersPayload, len(queries))
+	for i, q := range queries {
+		par[i] = searchParametersPayload{
+			Distance:          q.Distance,
+			Limit:             q.Limit,
+			Filters:           q.Filters,
+			Sort:              q.Sort,
+			GroupBy:           q.GroupBy,
+			Additional:        q.Additional,
+			SearchVectors:     q.Vectors,
+			TargetVectors:     q.TargetVectors,
+			TargetCombination: q.TargetCombination,
+			Properties:        q.Properties,
+		}
+	}
+	return json.Marshal(par)
+}
+
+func (p searchBatchParamsPayload) Unmarshal(in []byte) ([]sharding.ShardSearchQuery, error) {
+	var par []searchParametersPayload
+	if err := json.Unmarshal(in, &par); err != nil {
+		return nil, err
+	}
+	queries := make([]sharding.ShardSearchQuery, len(par))
+	for i, q := range par {
+		queries[i] = sharding.ShardSearchQuery{
+			Vectors:           q.SearchVectors,
+			TargetVectors:     q.TargetVectors,
+			Distance:          q.Distance,
+			Limit:             q.Limit,
+			Filters:           q.Filters,
+			Sort:              q.Sort,
+			GroupBy:           q.GroupBy,
+			Additional:        q.Additional,
+			TargetCombination: q.TargetCombination,
+			Properties:        q.Properties,
+		}
+	}
+	return queries, nil
+}
+
+func (p searchBatchParamsPayload) MIME() string {
+	return "vnd.weaviate.searchbatchparams+json"
+}
+
+func (p searchBatchParamsPayload) CheckContentTypeHeaderReq(r *http.Request) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+func (p searchBatchParamsPayload) SetContentTypeHeaderReq(r *http.Request) {
+	r.Header.Set("content-type", p.MIME())
+}
+
+// searchBatchResultsPayload encodes the result of every query as the length
+// of its error message, the message, the length of its search results and
+// the search results, see searchResultsPayload
+type searchBatchResultsPayload struct{}
+
+func (p searchBatchResultsPayload) Marshal(results []sharding.ShardSearchResult) ([]byte, error) {
+	reusableLengthBuf := make([]byte, 8)
+	var out []byte
+	for _, r := range results {
+		var errMsg []byte
+		var resBytes []byte
+		if r.Err != nil {
+			errMsg = []byte(r.Err.Error())
+		} else {
+			var err error
+			if resBytes, err = IndicesPayloads.SearchResults.Marshal(r.Objects, r.Scores); err != nil {
+				return nil, err
+			}
+		}
+
+		binary.LittleEndian.PutUint64(reusableLengthBuf, uint64(len(errMsg)))
+		out = append(out, reusableLengthBuf...)
+		out = append(out, errMsg...)
+		binary.LittleEndian.PutUint64(reusableLengthBuf, uint64(len(resBytes)))
+		out = append(out, reusableLengthBuf...)
+		out = append(out, resBytes...)
+	}
+	return out, nil
+}
+
+func (p searchBatchResultsPayload) Unmarshal(in []byte) ([]sharding.ShardSearchResult, error) {
+	var results []sharding.ShardSearchResult
+	read := uint64(0)
+	next := func() ([]byte, error) {
+		if uint64(len(in))-read < 8 {
+			return nil, errors.Errorf("corrupt read: missing length at %d", read)
+		}
+		length := binary.LittleEndian.Uint64(in[read : read+8])
+		read += 8
+		if uint64(len(in))-read < length {
+			return nil, errors.Errorf("corrupt read: %d bytes at %d exceed %d", length, read, len(in))
+		}
+		b := in[read : read+length]
+		read += length
+		return b, nil
+	}
+
+	for read < uint64(len(in)) {
+		errMsg, err := next()
+		if err != nil {
+			return nil, err
+		}
+		resBytes, err := next()
+		if err != nil {
+			return nil, err
+		}
+
+		var r sharding.ShardSearchResult
+		if len(errMsg) > 0 {
+			r.Err = errors.New(string(errMsg))
+		} else if r.Objects, r.Scores, err = IndicesPayloads.SearchResults.Unmarshal(resBytes); err != nil {
+			return nil, err
+		}
+		results = append(results, r)
+	}
+	return results, nil
+}
+
+func (p searchBatchResultsPayload) MIME() string {
+	return "application/vnd.weaviate.shardsearchbatchresults+octet-stream"
+}
+
+func (p searchBatchResultsPayload) SetContentTypeHeader(w http.ResponseWriter) {
+	w.Header().Set("content-type", p.MIME())
+}
+
+func (p searchBatchResultsPayload) CheckContentTypeHeader(r *http.Response) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
 type referenceListPayload struct{}
 
 func (p referenceListPayload) MIME() string {
@@ -13,6 +13,7 @@ package clusterapi
 
 import (
 	"encoding/json"
+	"errors"
 	"testing"
 	"time"
 
@@ -26,6 +27,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 func Test_objectListPayload_Marshal(t *testing.T) {
@@ -277,3 +279,53 @@ func Test_searchParametersPayload_Unmarshal(t *testing.T) {
 		})
 	}
 }
+
+func TestSearchBatchPayloads(t *testing.T) {
+	t.Run("params", func(t *testing.T) {
+		queries := []sharding.ShardSearchQuery{
+			{
+				Vectors:       []models.Vector{[]float32{1, 2, 3}},
+				TargetVectors: []string{"title"},
+				Distance:      0.5,
+				Limit:         10,
+				Properties:    []string{"title"},
+			},
+			{
+				Vectors:       []models.Vector{[][]float32{{1, 2}, {3, 4}}},
+				TargetVectors: []string{"colbert"},
+				Limit:         3,
+			},
+		}
+		b, err := IndicesPayloads.SearchBatchParams.Marshal(queries)
+		require.NoError(t, err)
+
+		got, err := IndicesPayloads.SearchBatchParams.Unmarshal(b)
+		require.NoError(t, err)
+		assert.Equal(t, queries, got)
+	})
+
+	t.Run("results", func(t *testing.T) {
+		id := strfmt.UUID("c6f85bf5-c3b7-4c1d-bd51-e899f9605336")
+		obj := storobj.FromObject(&models.Object{Class: "Article", ID: id}, nil, nil, nil)
+		results := []sharding.ShardSearchResult{
+			{Objects: []*storobj.Object{obj}, Scores: []float32{0.1}},
+			{Err: errors.New("boom")},
+			{},
+		}
+		b, err := IndicesPayloads.SearchBatchResults.Marshal(results)
+		require.NoError(t, err)
+
+		got, err := IndicesPayloads.SearchBatchResults.Unmarshal(b)
+		require.NoError(t, err)
+		require.Len(t, got, 3)
+		require.Len(t, got[0].Objects, 1)
+		assert.Equal(t, id, got[0].Objects[0].ID())
+		assert.Equal(t, []float32{0.1}, got[0].Scores)
+		assert.EqualError(t, got[1].Err, "boom")
+		assert.NoError(t, got[2].Err)
+		assert.Empty(t, got[2].Objects)
+
+		_, err = IndicesPayloads.SearchBatchResults.Unmarshal(b[:len(b)-1])
+		assert.Error(t, err)
+	})
+}
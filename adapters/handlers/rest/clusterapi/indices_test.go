@@ -37,6 +37,7 @@ func TestMaintenanceModeIndices(t *testing.T) {
 	}
 	indicesTestRequests := []indicesTestRequest{
 		{"POST", "/objects/_search"},
+		{"POST", "/objects/_search_batch"},
 		{"POST", "/objects/_find"},
 		{"POST", "/objects/_aggregations"},
 		{"PUT", "/objects:overwrite"},
@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/weaviate/weaviate/entities/models"
@@ -27,6 +28,7 @@ import (
 type nodesManager interface {
 	GetNodeStatus(ctx context.Context, className, output string) (*models.NodeStatus, error)
 	GetStatistics(ctx context.Context) (*models.Statistics, error)
+	GetSlowQueries(ctx context.Context, collection string, limit int) ([]*models.SlowQuery, error)
 }
 
 type nodes struct {
@@ -39,9 +41,10 @@ func NewNodes(manager nodesManager, auth auth) *nodes {
 }
 
 var (
-	regxNodes      = regexp.MustCompile(`/status`)
-	regxNodesClass = regexp.MustCompile(`/status/(` + entschema.ClassNameRegexCore + `)`)
-	regxStatistics = regexp.MustCompile(`/statistics`)
+	regxNodes       = regexp.MustCompile(`/status`)
+	regxNodesClass  = regexp.MustCompile(`/status/(` + entschema.ClassNameRegexCore + `)`)
+	regxStatistics  = regexp.MustCompile(`/statistics`)
+	regxSlowQueries = regexp.MustCompile(`/slow-queries`)
 )
 
 func (s *nodes) Nodes() http.Handler {
@@ -70,6 +73,15 @@ func (s *nodes) nodesHandler() http.HandlerFunc {
 
 			s.incomingStatistics().ServeHTTP(w, r)
 			return
+		case regxSlowQueries.MatchString(path):
+			if r.Method != http.MethodGet {
+				msg := fmt.Sprintf("/nodes api path %q not found", path)
+				http.Error(w, msg, http.StatusMethodNotAllowed)
+				return
+			}
+
+			s.incomingSlowQueries().ServeHTTP(w, r)
+			return
 		default:
 			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
 			return
@@ -141,3 +153,31 @@ func (s *nodes) incomingStatistics() http.Handler {
 		w.Write(statisticsBytes)
 	})
 }
+
+func (s *nodes) incomingSlowQueries() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+
+		limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+		if err != nil || limit <= 0 {
+			http.Error(w, "/nodes invalid limit", http.StatusBadRequest)
+			return
+		}
+
+		queries, err := s.nodesManager.GetSlowQueries(r.Context(), r.URL.Query().Get("collection"), limit)
+		if err != nil {
+			http.Error(w, "/nodes fulfill request: "+err.Error(),
+				http.StatusBadRequest)
+			return
+		}
+
+		queriesBytes, err := json.Marshal(queries)
+		if err != nil {
+			http.Error(w, "/nodes marshal response: "+err.Error(),
+				http.StatusInternalServerError)
+			return
+		}
+
+		w.Write(queriesBytes)
+	})
+}
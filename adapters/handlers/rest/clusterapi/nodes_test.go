@@ -0,0 +1,75 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clusterapi_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/adapters/clients"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/clusterapi"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+type fakeNodesManager struct {
+	collection string
+	limit      int
+	queries    []*models.SlowQuery
+}
+
+func (f *fakeNodesManager) GetNodeStatus(ctx context.Context, className, output string) (*models.NodeStatus, error) {
+	return nil, nil
+}
+
+func (f *fakeNodesManager) GetStatistics(ctx context.Context) (*models.Statistics, error) {
+	return nil, nil
+}
+
+func (f *fakeNodesManager) GetSlowQueries(ctx context.Context, collection string, limit int) ([]*models.SlowQuery, error) {
+	f.collection, f.limit = collection, limit
+	return f.queries, nil
+}
+
+func TestInternalSlowQueriesAPI(t *testing.T) {
+	manager := &fakeNodesManager{queries: []*models.SlowQuery{{
+		Node:  "node1",
+		Took:  1200,
+		Shape: &models.SlowQueryShape{Collection: "Article", QueryType: "get", SearchType: "bm25"},
+	}}}
+	mux := http.NewServeMux()
+	mux.Handle("/nodes/", clusterapi.NewNodes(manager, clusterapi.NewNoopAuthHandler()).Nodes())
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	host, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	client := clients.NewRemoteNode(server.Client())
+
+	t.Run("get slow queries", func(t *testing.T) {
+		queries, err := client.GetSlowQueries(context.Background(), host.Host, "Article", 10)
+		require.NoError(t, err)
+		assert.Equal(t, manager.queries, queries)
+		assert.Equal(t, "Article", manager.collection)
+		assert.Equal(t, 10, manager.limit)
+	})
+
+	t.Run("invalid limit", func(t *testing.T) {
+		_, err := client.GetSlowQueries(context.Background(), host.Host, "", 0)
+		require.Error(t, err)
+	})
+}
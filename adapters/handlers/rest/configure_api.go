@@ -42,6 +42,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/cluster/distributedtask"
+	cmd "github.com/weaviate/weaviate/cluster/proto/api"
 	"google.golang.org/grpc"
 
 	"github.com/weaviate/fgprof"
@@ -56,6 +57,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/classifications"
 	"github.com/weaviate/weaviate/adapters/repos/db"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/embeddingcache"
 	modulestorage "github.com/weaviate/weaviate/adapters/repos/modules"
 	schemarepo "github.com/weaviate/weaviate/adapters/repos/schema"
 	rCluster "github.com/weaviate/weaviate/cluster"
@@ -98,6 +100,7 @@ import (
 	modqnaopenai "github.com/weaviate/weaviate/modules/qna-openai"
 	modqna "github.com/weaviate/weaviate/modules/qna-transformers"
 	modcentroid "github.com/weaviate/weaviate/modules/ref2vec-centroid"
+	modrerankerbm25 "github.com/weaviate/weaviate/modules/reranker-bm25"
 	modrerankercohere "github.com/weaviate/weaviate/modules/reranker-cohere"
 	modrerankerdummy "github.com/weaviate/weaviate/modules/reranker-dummy"
 	modrerankerjinaai "github.com/weaviate/weaviate/modules/reranker-jinaai"
@@ -133,7 +136,10 @@ import (
 	"github.com/weaviate/weaviate/usecases/cluster"
 	"github.com/weaviate/weaviate/usecases/config"
 	configRuntime "github.com/weaviate/weaviate/usecases/config/runtime"
+	"github.com/weaviate/weaviate/usecases/crosscluster"
 	"github.com/weaviate/weaviate/usecases/memwatch"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/batch"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/objects"
@@ -141,6 +147,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/scaler"
 	"github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/sharding"
+	"github.com/weaviate/weaviate/usecases/slowquery"
 	"github.com/weaviate/weaviate/usecases/telemetry"
 	"github.com/weaviate/weaviate/usecases/traverser"
 )
@@ -342,6 +349,15 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 
 	limitResources(appState)
 
+	moduleClients := appState.ServerConfig.Config.ModuleClients
+	httpclient.Configure(httpclient.Config{
+		MaxRetries:       moduleClients.MaxRetries,
+		InitialBackoff:   moduleClients.InitialBackoff,
+		MaxBackoff:       moduleClients.MaxBackoff,
+		FailureThreshold: moduleClients.FailureThreshold,
+		OpenDuration:     moduleClients.OpenDuration,
+	})
+
 	err := registerModules(appState)
 	if err != nil {
 		appState.Logger.
@@ -519,6 +535,9 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		ReplicationEngineMaxWorkers:          appState.ServerConfig.Config.ReplicationEngineMaxWorkers,
 		DistributedTasks:                     appState.ServerConfig.Config.DistributedTasks,
 		ReplicaMovementMinimumFinalizingWait: appState.ServerConfig.Config.ReplicaMovementMinimumFinalizingWait,
+		ReplicaRebalancer:                    appState.ServerConfig.Config.ReplicaRebalancer,
+		NodeStatus:                           repo,
+		NodeInfo:                             appState.Cluster,
 	}
 	for _, name := range appState.ServerConfig.Config.Raft.Join[:rConfig.BootstrapExpect] {
 		if strings.Contains(name, rConfig.NodeID) {
@@ -529,6 +548,8 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 
 	appState.ClusterService = rCluster.New(rConfig, appState.AuthzController, appState.AuthzSnapshotter, appState.GRPCServerMetrics)
 	migrator.SetCluster(appState.ClusterService.Raft)
+	appState.CrossClusterGuard = crosscluster.NewGuard(
+		appState.ServerConfig.Config.CrossClusterReplication.Standby, appState.ClusterService.Raft)
 
 	executor := schema.NewExecutor(migrator,
 		appState.ClusterService.SchemaReader(),
@@ -575,13 +596,41 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 
 	vectorRepo.SetSchemaGetter(schemaManager)
 	vectorRepo.SetRouter(appState.ClusterService.NewRouter(appState.Logger))
+	if appState.ServerConfig.Config.HintedHandoff.Enabled {
+		appState.HintedHandoff = configureHintedHandoff(appState, replicationClient, metricsRegisterer)
+		repo.SetHintedHandoff(appState.HintedHandoff)
+		enterrors.GoWrapper(func() { appState.HintedHandoff.Start(context.Background()) }, appState.Logger)
+	}
 	explorer.SetSchemaGetter(schemaManager)
 	appState.Modules.SetSchemaGetter(schemaManager)
+	appState.Modules.SetVectorDimensions(repo)
+	repo.SetModuleHealth(appState.Modules)
 
 	appState.Traverser = traverser.NewTraverser(appState.ServerConfig,
 		appState.Logger, appState.Authorizer, vectorRepo, explorer, schemaManager,
 		appState.Modules, traverser.NewMetrics(appState.Metrics),
 		appState.ServerConfig.Config.MaximumConcurrentGetRequests)
+	if appState.ServerConfig.Config.SlowQueryLog.Enabled {
+		appState.SlowQueryLog, err = slowquery.New(appState.ServerConfig.Config.SlowQueryLog,
+			appState.Cluster.LocalName(), appState.Logger)
+		if err != nil {
+			appState.Logger.
+				WithField("action", "startup").WithError(err).
+				Fatal("could not initialize slow query log")
+		}
+		appState.Traverser.SetSlowQueryLog(appState.SlowQueryLog)
+		repo.SetSlowQueryLog(appState.SlowQueryLog)
+	}
+	if appState.ServerConfig.Config.EmbeddingCache.Enabled {
+		appState.EmbeddingCache, err = embeddingcache.New(appState.ServerConfig.Config.EmbeddingCache,
+			appState.Logger)
+		if err != nil {
+			appState.Logger.
+				WithField("action", "startup").WithError(err).
+				Fatal("could not initialize embedding cache")
+		}
+		batch.SetEmbeddingCache(appState.EmbeddingCache)
+	}
 
 	updateSchemaCallback := makeUpdateSchemaCall(appState)
 	executor.RegisterSchemaUpdateCallback(updateSchemaCallback)
@@ -686,16 +735,30 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 	appState.DistributedTaskScheduler = distributedtask.NewScheduler(distributedtask.SchedulerParams{
 		CompletionRecorder: appState.ClusterService.Raft,
 		TasksLister:        appState.ClusterService.Raft,
-		Providers:          map[string]distributedtask.Provider{},
-		Logger:             appState.Logger,
-		MetricsRegisterer:  metricsRegisterer,
-		LocalNode:          appState.Cluster.LocalName(),
-		TickInterval:       appState.ServerConfig.Config.DistributedTasks.SchedulerTickInterval,
+		Providers: map[string]distributedtask.Provider{
+			cmd.ReshardingTasksNamespace:  db.NewReshardingProvider(repo, appState.Logger),
+			cmd.RevectorizeTasksNamespace: db.NewRevectorizeProvider(repo, appState.Modules, appState.Logger),
+		},
+		Logger:            appState.Logger,
+		MetricsRegisterer: metricsRegisterer,
+		LocalNode:         appState.Cluster.LocalName(),
+		TickInterval:      appState.ServerConfig.Config.DistributedTasks.SchedulerTickInterval,
 
 		// Using a single global value for now to keep it simple. If there is a need
 		// this can be changed to provide a value per provider.
 		CompletedTaskTTL: appState.ServerConfig.Config.DistributedTasks.CompletedTaskTTL,
 	})
+	if appState.ServerConfig.Config.CrossClusterReplication.Enabled {
+		appState.CrossClusterReplicator = configureCrossClusterReplication(appState, repo, metricsRegisterer)
+		enterrors.GoWrapper(func() {
+			<-storeReadyCtx.Done()
+			if !errors.Is(context.Cause(storeReadyCtx), metaStoreReadyErr) {
+				return
+			}
+			appState.CrossClusterReplicator.Start(context.Background())
+		}, appState.Logger)
+	}
+
 	enterrors.GoWrapper(func() {
 		// Do not launch scheduler until the full RAFT state is restored to avoid needlessly starting
 		// and stopping tasks.
@@ -713,6 +776,55 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 	return appState
 }
 
+func configureCrossClusterReplication(appState *state.State, repo *db.DB, reg prometheus.Registerer) *crosscluster.Replicator {
+	cfg := appState.ServerConfig.Config.CrossClusterReplication
+	logger := appState.Logger.WithField("action", "startup")
+
+	target, err := crosscluster.NewRESTTarget(cfg.TargetURL, cfg.TargetAPIKey, appState.Cluster.LocalName())
+	if err != nil {
+		logger.WithError(err).Fatal("invalid cross-cluster replication target")
+	}
+	replicator, err := crosscluster.NewReplicator(crosscluster.ReplicatorParams{
+		Logger:             appState.Logger,
+		Source:             repo,
+		SchemaReader:       appState.SchemaManager,
+		Leader:             appState.ClusterService.Raft,
+		Target:             target,
+		NodeName:           appState.Cluster.LocalName(),
+		Members:            appState.Cluster,
+		CheckpointPath:     filepath.Join(appState.ServerConfig.Config.Persistence.DataPath, crosscluster.CheckpointsFileName),
+		MetricsRegisterer:  reg,
+		Collections:        cfg.Collections,
+		Interval:           cfg.Interval,
+		DeleteSyncInterval: cfg.DeleteSyncInterval,
+		BatchSize:          cfg.BatchSize,
+		Paused:             cfg.Paused,
+	})
+	if err != nil {
+		logger.WithError(err).Fatal("could not create cross-cluster replicator")
+	}
+	return replicator
+}
+
+func configureHintedHandoff(appState *state.State, client replica.Client, reg prometheus.Registerer) *replica.HintedHandoff {
+	cfg := appState.ServerConfig.Config.HintedHandoff
+	h, err := replica.NewHintedHandoff(replica.HintedHandoffParams{
+		Logger:            appState.Logger,
+		Router:            appState.ClusterService.NewRouter(appState.Logger),
+		Client:            client,
+		MetricsRegisterer: reg,
+		Dir:               filepath.Join(appState.ServerConfig.Config.Persistence.DataPath, replica.HintsDirName),
+		MaxHintsPerNode:   cfg.MaxHintsPerNode,
+		MaxHintAge:        cfg.MaxHintAge,
+		ReplayInterval:    cfg.ReplayInterval,
+	})
+	if err != nil {
+		appState.Logger.WithField("action", "startup").WithError(err).
+			Fatal("could not load replication hints")
+	}
+	return h
+}
+
 func configureReindexer(appState *state.State, reindexCtx context.Context) db.ShardReindexerV3 {
 	tasks := []db.ShardReindexTaskV3{}
 	logger := appState.Logger.WithField("action", "reindexV3")
@@ -814,15 +926,16 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		appState.Authorizer,
 		appState.Logger)
 	replicationHandlers.SetupHandlers(api, appState.ClusterService.Raft, appState.Metrics, appState.Authorizer, appState.Logger)
+	replicationHandlers.SetupCrossClusterHandlers(api, appState.CrossClusterGuard, appState.Authorizer, appState.Logger)
 
 	remoteDbUsers := clients.NewRemoteUser(appState.ClusterHttpClient, appState.Cluster)
 	db_users.SetupHandlers(api, appState.ClusterService.Raft, appState.Authorizer, appState.ServerConfig.Config.Authentication, appState.ServerConfig.Config.Authorization, remoteDbUsers, appState.SchemaManager, appState.Logger)
 
 	setupSchemaHandlers(api, appState.SchemaManager, appState.Metrics, appState.Logger)
-	objectsManager := objects.NewManager(appState.SchemaManager, appState.ServerConfig, appState.Logger,
+	appState.ObjectsManager = objects.NewManager(appState.SchemaManager, appState.ServerConfig, appState.Logger,
 		appState.Authorizer, appState.DB, appState.Modules,
 		objects.NewMetrics(appState.Metrics), appState.MemWatch, appState.AutoSchemaManager)
-	setupObjectHandlers(api, objectsManager, appState.ServerConfig.Config, appState.Logger,
+	setupObjectHandlers(api, appState.ObjectsManager, appState.ServerConfig.Config, appState.Logger,
 		appState.Modules, appState.Metrics)
 	setupObjectBatchHandlers(api, appState.BatchManager, appState.Metrics, appState.Logger)
 	setupGraphQLHandlers(api, appState, appState.SchemaManager, appState.ServerConfig.Config.DisableGraphQL,
@@ -880,6 +993,24 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		appState.ReindexCtxCancel(fmt.Errorf("server shutdown"))
 
 		appState.DistributedTaskScheduler.Close()
+		if appState.CrossClusterReplicator != nil {
+			appState.CrossClusterReplicator.Close()
+		}
+		if appState.HintedHandoff != nil {
+			appState.HintedHandoff.Close()
+		}
+		if err := appState.SlowQueryLog.Close(); err != nil {
+			appState.Logger.
+				WithError(err).
+				WithField("action", "shutdown slow query log").
+				Errorf("failed to gracefully shutdown")
+		}
+		if err := appState.EmbeddingCache.Shutdown(context.Background()); err != nil {
+			appState.Logger.
+				WithError(err).
+				WithField("action", "shutdown embedding cache").
+				Errorf("failed to gracefully shutdown")
+		}
 
 		// gracefully stop gRPC server
 		grpcServer.GracefulStop()
@@ -1176,6 +1307,14 @@ func registerModules(appState *state.State) error {
 			Debug("enabled module")
 	}
 
+	if _, ok := enabledModules[modrerankerbm25.Name]; ok {
+		appState.Modules.Register(modrerankerbm25.New())
+		appState.Logger.
+			WithField("action", "startup").
+			WithField("module", modrerankerbm25.Name).
+			Debug("enabled module")
+	}
+
 	if _, ok := enabledModules[modrerankerjinaai.Name]; ok {
 		appState.Modules.Register(modrerankerjinaai.New())
 		appState.Logger.
@@ -1755,6 +1894,11 @@ func initRuntimeOverrides(appState *state.State) {
 		registered.AsyncReplicationDisabled = appState.ServerConfig.Config.Replication.AsyncReplicationDisabled
 		registered.AutoschemaEnabled = appState.ServerConfig.Config.AutoSchema.Enabled
 		registered.ReplicaMovementMinimumFinalizingWait = appState.ServerConfig.Config.ReplicaMovementMinimumFinalizingWait
+		registered.ReplicaRebalancerDryRun = appState.ServerConfig.Config.ReplicaRebalancer.DryRun
+		registered.ReplicaRebalancerPaused = appState.ServerConfig.Config.ReplicaRebalancer.Paused
+		registered.CrossClusterReplicationPaused = appState.ServerConfig.Config.CrossClusterReplication.Paused
+		registered.QuerySlowLogThreshold = appState.ServerConfig.Config.SlowQueryLog.Threshold
+		registered.QuerySlowLogSampleRate = appState.ServerConfig.Config.SlowQueryLog.SampleRate
 
 		cm, err := configRuntime.NewConfigManager(
 			appState.ServerConfig.Config.RuntimeOverrides.Path,
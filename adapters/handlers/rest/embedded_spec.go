@@ -1822,6 +1822,64 @@ func init() {
         ]
       }
     },
+    "/cluster/slow-queries": {
+      "get": {
+        "description": "Returns the queries which exceeded the slow query threshold on any node of the cluster, most recent first. Only the shape of a query is recorded, never the values searched for.",
+        "tags": [
+          "cluster"
+        ],
+        "summary": "List slow queries",
+        "operationId": "cluster.get.slowQueries",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Only return slow queries of this collection.",
+            "name": "collection",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "default": 100,
+            "description": "The maximum number of slow queries to return.",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Slow queries successfully returned",
+            "schema": {
+              "$ref": "#/definitions/SlowQueriesResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid limit.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.cluster.slowQueries.get"
+        ]
+      }
+    },
     "/cluster/statistics": {
       "get": {
         "description": "Returns Raft cluster statistics of Weaviate DB.",
@@ -3449,6 +3507,45 @@ func init() {
         ]
       }
     },
+    "/replication/cross-cluster/promote": {
+      "post": {
+        "description": "Promotes this cluster from a standby receiving cross-cluster replication to a primary accepting client writes. Replicated writes from the former primary are rejected from then on. Promotion cannot be undone.",
+        "tags": [
+          "replication"
+        ],
+        "summary": "Promote a cross-cluster replication standby.",
+        "operationId": "promoteStandby",
+        "responses": {
+          "204": {
+            "description": "Successfully promoted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The cluster is not a cross-cluster replication standby.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.replication.crosscluster.promote"
+        ]
+      }
+    },
     "/replication/replicate": {
       "post": {
         "tags": [
@@ -4228,6 +4325,76 @@ func init() {
         ]
       }
     },
+    "/schema/{className}/shards/{shardName}/reshard": {
+      "post": {
+        "description": "Split a shard of a collection into two shards, or merge it into another shard of the same collection. The operation runs in the background, its progress can be followed with the distributed tasks API. Shards can only be merged if their replicas are hosted on the same nodes. Multi-tenant collections are not supported.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Split or merge a shard.",
+        "operationId": "schema.objects.shards.reshard",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "shardName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ShardReshardRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Resharding of the shard was started successfully",
+            "schema": {
+              "$ref": "#/definitions/ShardReshardResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Shard to be resharded does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid resharding request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
     "/schema/{className}/tenants": {
       "get": {
         "description": "get all tenants from a specific class",
@@ -4576,6 +4743,76 @@ func init() {
         }
       }
     },
+    "/schema/{className}/vectors/{vectorName}/revectorize": {
+      "post": {
+        "description": "Re-embed all objects of a named vector with a different vectorizer module or module configuration. The objects are embedded into a new vector index in the background while queries keep being served from the existing index, which is replaced once every node has finished. The progress can be followed with the distributed tasks API and the verbose nodes API. Multi-tenant collections and multi-vectors are not supported.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Re-vectorize a named vector.",
+        "operationId": "schema.objects.vectors.revectorize",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "vectorName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/RevectorizeRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Re-vectorization of the named vector was started successfully",
+            "schema": {
+              "$ref": "#/definitions/RevectorizeResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Collection or named vector does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid re-vectorization request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
     "/tasks": {
       "get": {
         "tags": [
@@ -5749,6 +5986,14 @@ func init() {
             "$ref": "#/definitions/Property"
           }
         },
+        "queryPipelines": {
+          "description": "Named, server-side query pipelines that can be invoked by name at query time.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/QueryPipeline"
+          },
+          "x-omitempty": true
+        },
         "replicationConfig": {
           "$ref": "#/definitions/ReplicationConfig"
         },
@@ -6185,6 +6430,13 @@ func init() {
             "$ref": "#/definitions/GraphQLError"
           },
           "x-omitempty": true
+        },
+        "extensions": {
+          "description": "GraphQL extensions, e.g. the profiles of queries run with the profile argument.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/JsonObject"
+          }
         }
       }
     },
@@ -6354,6 +6606,43 @@ func init() {
         }
       }
     },
+    "NodeModuleStatus": {
+      "description": "The health of the provider a module calls, as seen by the requests of the node",
+      "type": "object",
+      "properties": {
+        "consecutiveFailures": {
+          "description": "Number of failed requests to the provider since the last successful one.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "lastError": {
+          "description": "The error of the last failed request.",
+          "type": "string"
+        },
+        "lastFailureTimeUnix": {
+          "description": "Time of the last failed request in ms since epoch.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "name": {
+          "description": "The name of the module.",
+          "type": "string"
+        },
+        "provider": {
+          "description": "The provider called by the module.",
+          "type": "string"
+        },
+        "status": {
+          "description": "HEALTHY if the last request succeeded, DEGRADED if requests recently failed, UNAVAILABLE while requests are not sent to the provider.",
+          "type": "string",
+          "enum": [
+            "HEALTHY",
+            "DEGRADED",
+            "UNAVAILABLE"
+          ]
+        }
+      }
+    },
     "NodeShardStatus": {
       "description": "The definition of a node shard status response body",
       "properties": {
@@ -6390,6 +6679,13 @@ func init() {
           "format": "int64",
           "x-omitempty": false
         },
+        "revectorization": {
+          "description": "The progress of re-vectorizing named vectors of the shard.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/RevectorizationProgress"
+          }
+        },
         "vectorIndexingStatus": {
           "description": "The status of the vector indexing process.",
           "format": "string",
@@ -6432,6 +6728,13 @@ func init() {
           "description": "The gitHash of Weaviate.",
           "type": "string"
         },
+        "modules": {
+          "description": "The health of the providers called by the modules of the node.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeModuleStatus"
+          }
+        },
         "name": {
           "description": "The name of the node.",
           "type": "string"
@@ -6959,22 +7262,106 @@ func init() {
       "description": "Names and values of an individual property. A returned response may also contain additional metadata, such as from classification or feature projection.",
       "type": "object"
     },
-    "RaftStatistics": {
-      "description": "The definition of Raft statistics.",
+    "QueryPipeline": {
+      "description": "A named, multi-stage query declared on a collection. Stages run in order: optional ` + "`" + `filter` + "`" + ` stages, a retrieval stage (` + "`" + `hybrid` + "`" + ` or ` + "`" + `bm25` + "`" + `), then optional ` + "`" + `rerank` + "`" + ` and ` + "`" + `generate` + "`" + ` stages.",
+      "type": "object",
       "properties": {
-        "appliedIndex": {
-          "type": "string"
-        },
-        "commitIndex": {
-          "type": "string"
-        },
-        "fsmPending": {
+        "description": {
+          "description": "Description of the pipeline for metadata purposes.",
           "type": "string"
         },
-        "lastContact": {
+        "name": {
+          "description": "Name of the pipeline (required), used to invoke it at query time. Must be unique within the collection.",
           "type": "string"
         },
-        "lastLogIndex": {
+        "stages": {
+          "description": "The ordered stages of the pipeline.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/QueryPipelineStage"
+          }
+        }
+      }
+    },
+    "QueryPipelineStage": {
+      "description": "A single stage of a query pipeline. String fields may reference query-time parameters as ` + "`" + `${name}` + "`" + `.",
+      "type": "object",
+      "properties": {
+        "alpha": {
+          "description": "Weighting of the vector search against the keyword search in a ` + "`" + `hybrid` + "`" + ` stage (default: 0.75).",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "groupedProperties": {
+          "description": "Properties passed to the grouped task of a ` + "`" + `generate` + "`" + ` stage.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "groupedTask": {
+          "description": "Task run once over all results of a ` + "`" + `generate` + "`" + ` stage.",
+          "type": "string"
+        },
+        "limit": {
+          "description": "Number of results kept by a ` + "`" + `hybrid` + "`" + `, ` + "`" + `bm25` + "`" + ` or ` + "`" + `rerank` + "`" + ` stage.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "properties": {
+          "description": "Properties searched by a ` + "`" + `hybrid` + "`" + ` or ` + "`" + `bm25` + "`" + ` stage.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "property": {
+          "description": "Property used by a ` + "`" + `rerank` + "`" + ` stage to score the results.",
+          "type": "string"
+        },
+        "query": {
+          "description": "Search query of a ` + "`" + `hybrid` + "`" + ` or ` + "`" + `bm25` + "`" + ` stage, or the query of a ` + "`" + `rerank` + "`" + ` stage. A ` + "`" + `rerank` + "`" + ` stage without a query reuses the query of the retrieval stage.",
+          "type": "string"
+        },
+        "singlePrompt": {
+          "description": "Prompt run for each result of a ` + "`" + `generate` + "`" + ` stage.",
+          "type": "string"
+        },
+        "type": {
+          "description": "The kind of stage.",
+          "type": "string",
+          "enum": [
+            "hybrid",
+            "bm25",
+            "filter",
+            "rerank",
+            "generate"
+          ]
+        },
+        "where": {
+          "description": "Filter of a ` + "`" + `filter` + "`" + ` stage. Filter stages precede the retrieval stage and restrict the candidates it retrieves.",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        }
+      }
+    },
+    "RaftStatistics": {
+      "description": "The definition of Raft statistics.",
+      "properties": {
+        "appliedIndex": {
+          "type": "string"
+        },
+        "commitIndex": {
+          "type": "string"
+        },
+        "fsmPending": {
+          "type": "string"
+        },
+        "lastContact": {
+          "type": "string"
+        },
+        "lastLogIndex": {
           "type": "string"
         },
         "lastLogTerm": {
@@ -7341,6 +7728,55 @@ func init() {
         }
       }
     },
+    "RevectorizationProgress": {
+      "description": "The progress of re-vectorizing a named vector of a shard",
+      "properties": {
+        "phase": {
+          "description": "The phase of the re-vectorization: 'build' while embedding the objects into the new index, 'swap' while replacing the serving index.",
+          "type": "string"
+        },
+        "processedObjects": {
+          "description": "The number of objects embedded so far.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "targetVector": {
+          "description": "The named vector being re-vectorized.",
+          "type": "string"
+        },
+        "totalObjects": {
+          "description": "The number of objects in the shard.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "RevectorizeRequest": {
+      "description": "Request body to re-vectorize a named vector",
+      "required": [
+        "vectorizer"
+      ],
+      "properties": {
+        "objectsPerSecond": {
+          "description": "The maximum number of objects embedded per second on each node. Omit or set to 0 for no limit.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "vectorizer": {
+          "description": "The vectorizer module and its configuration to embed the objects with, in the same format as the 'vectorizer' of a named vector config.",
+          "type": "object"
+        }
+      }
+    },
+    "RevectorizeResponse": {
+      "description": "The re-vectorization that was started",
+      "properties": {
+        "taskId": {
+          "description": "The id of the distributed task embedding the objects, in the 'revectorize' namespace",
+          "type": "string"
+        }
+      }
+    },
     "Role": {
       "type": "object",
       "required": [
@@ -7425,6 +7861,47 @@ func init() {
       "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
       "type": "object"
     },
+    "ShardReshardRequest": {
+      "description": "Request body to split or merge a shard",
+      "required": [
+        "operation"
+      ],
+      "properties": {
+        "operation": {
+          "description": "The resharding operation to perform: 'SPLIT' moves half of the shard's data to a new shard, 'MERGE' moves all of the shard's data into the target shard.",
+          "type": "string",
+          "enum": [
+            "SPLIT",
+            "MERGE"
+          ]
+        },
+        "targetShard": {
+          "description": "The shard receiving the data. Required for 'MERGE', optional for 'SPLIT' where a name is generated if omitted.",
+          "type": "string"
+        }
+      }
+    },
+    "ShardReshardResponse": {
+      "description": "The resharding operation that was started",
+      "properties": {
+        "operation": {
+          "description": "The resharding operation: 'SPLIT' or 'MERGE'",
+          "type": "string"
+        },
+        "sourceShard": {
+          "description": "The shard the data is moved from",
+          "type": "string"
+        },
+        "targetShard": {
+          "description": "The shard the data is moved to",
+          "type": "string"
+        },
+        "taskId": {
+          "description": "The id of the distributed task copying the data, in the 'resharding' namespace",
+          "type": "string"
+        }
+      }
+    },
     "ShardStatus": {
       "description": "The status of a single shard",
       "properties": {
@@ -7487,6 +7964,160 @@ func init() {
         }
       }
     },
+    "SlowQueriesResponse": {
+      "description": "The slow queries recorded across the nodes of the cluster",
+      "type": "object",
+      "properties": {
+        "queries": {
+          "description": "The slow queries, most recent first.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SlowQuery"
+          }
+        },
+        "unreachableNodes": {
+          "description": "Nodes whose slow queries could not be retrieved.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "SlowQuery": {
+      "description": "A query which exceeded the slow query threshold",
+      "type": "object",
+      "properties": {
+        "error": {
+          "description": "The error the query failed with, if any.",
+          "type": "string"
+        },
+        "node": {
+          "description": "The node which executed the query.",
+          "type": "string"
+        },
+        "phases": {
+          "description": "Duration in milliseconds of each phase of the query.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "number",
+            "format": "double"
+          }
+        },
+        "principal": {
+          "description": "The user who issued the query.",
+          "type": "string"
+        },
+        "shape": {
+          "description": "The sanitized shape of the query.",
+          "$ref": "#/definitions/SlowQueryShape"
+        },
+        "shards": {
+          "description": "The shards searched by the query.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SlowQueryShard"
+          }
+        },
+        "timestamp": {
+          "description": "Unix timestamp in milliseconds of when the query finished.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "took": {
+          "description": "Duration of the query in milliseconds.",
+          "type": "number",
+          "format": "double"
+        }
+      }
+    },
+    "SlowQueryFilter": {
+      "description": "The structure of a where filter, without the values compared against",
+      "type": "object",
+      "properties": {
+        "on": {
+          "description": "The path of the property the clause applies to.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "operands": {
+          "description": "The operands of a compound clause.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SlowQueryFilter"
+          }
+        },
+        "operator": {
+          "description": "The operator of the filter clause.",
+          "type": "string"
+        },
+        "valueType": {
+          "description": "The type of the value compared against.",
+          "type": "string"
+        }
+      }
+    },
+    "SlowQueryShape": {
+      "description": "The sanitized shape of a query, without any of the values searched for",
+      "type": "object",
+      "properties": {
+        "collection": {
+          "description": "The collection queried.",
+          "type": "string"
+        },
+        "filter": {
+          "description": "The structure of the where filter.",
+          "$ref": "#/definitions/SlowQueryFilter"
+        },
+        "limit": {
+          "description": "The limit of the query.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "offset": {
+          "description": "The offset of the query.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "queryType": {
+          "description": "The kind of query, either get or aggregate.",
+          "type": "string"
+        },
+        "searchType": {
+          "description": "The search used to retrieve objects, e.g. nearVector, hybrid or bm25.",
+          "type": "string"
+        },
+        "tenant": {
+          "description": "The tenant queried.",
+          "type": "string"
+        }
+      }
+    },
+    "SlowQueryShard": {
+      "description": "The time spent searching a single shard",
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "The name of the shard.",
+          "type": "string"
+        },
+        "node": {
+          "description": "The node the shard was searched on.",
+          "type": "string"
+        },
+        "remote": {
+          "description": "Whether the shard was searched on another node.",
+          "type": "boolean"
+        },
+        "took": {
+          "description": "Duration of the shard search in milliseconds.",
+          "type": "number",
+          "format": "double"
+        }
+      }
+    },
     "Statistics": {
       "description": "The definition of node statistics.",
       "properties": {
@@ -9791,6 +10422,64 @@ func init() {
         ]
       }
     },
+    "/cluster/slow-queries": {
+      "get": {
+        "description": "Returns the queries which exceeded the slow query threshold on any node of the cluster, most recent first. Only the shape of a query is recorded, never the values searched for.",
+        "tags": [
+          "cluster"
+        ],
+        "summary": "List slow queries",
+        "operationId": "cluster.get.slowQueries",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Only return slow queries of this collection.",
+            "name": "collection",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "default": 100,
+            "description": "The maximum number of slow queries to return.",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Slow queries successfully returned",
+            "schema": {
+              "$ref": "#/definitions/SlowQueriesResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid limit.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.cluster.slowQueries.get"
+        ]
+      }
+    },
     "/cluster/statistics": {
       "get": {
         "description": "Returns Raft cluster statistics of Weaviate DB.",
@@ -11528,7 +12217,46 @@ func init() {
         ]
       }
     },
-    "/replication/replicate": {
+    "/replication/cross-cluster/promote": {
+      "post": {
+        "description": "Promotes this cluster from a standby receiving cross-cluster replication to a primary accepting client writes. Replicated writes from the former primary are rejected from then on. Promotion cannot be undone.",
+        "tags": [
+          "replication"
+        ],
+        "summary": "Promote a cross-cluster replication standby.",
+        "operationId": "promoteStandby",
+        "responses": {
+          "204": {
+            "description": "Successfully promoted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "The cluster is not a cross-cluster replication standby.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.replication.crosscluster.promote"
+        ]
+      }
+    },
+    "/replication/replicate": {
       "post": {
         "tags": [
           "replication"
@@ -12307,6 +13035,76 @@ func init() {
         ]
       }
     },
+    "/schema/{className}/shards/{shardName}/reshard": {
+      "post": {
+        "description": "Split a shard of a collection into two shards, or merge it into another shard of the same collection. The operation runs in the background, its progress can be followed with the distributed tasks API. Shards can only be merged if their replicas are hosted on the same nodes. Multi-tenant collections are not supported.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Split or merge a shard.",
+        "operationId": "schema.objects.shards.reshard",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "shardName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ShardReshardRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Resharding of the shard was started successfully",
+            "schema": {
+              "$ref": "#/definitions/ShardReshardResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Shard to be resharded does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid resharding request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
     "/schema/{className}/tenants": {
       "get": {
         "description": "get all tenants from a specific class",
@@ -12655,6 +13453,76 @@ func init() {
         }
       }
     },
+    "/schema/{className}/vectors/{vectorName}/revectorize": {
+      "post": {
+        "description": "Re-embed all objects of a named vector with a different vectorizer module or module configuration. The objects are embedded into a new vector index in the background while queries keep being served from the existing index, which is replaced once every node has finished. The progress can be followed with the distributed tasks API and the verbose nodes API. Multi-tenant collections and multi-vectors are not supported.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Re-vectorize a named vector.",
+        "operationId": "schema.objects.vectors.revectorize",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "vectorName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/RevectorizeRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Re-vectorization of the named vector was started successfully",
+            "schema": {
+              "$ref": "#/definitions/RevectorizeResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Collection or named vector does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid re-vectorization request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
     "/tasks": {
       "get": {
         "tags": [
@@ -13963,6 +14831,14 @@ func init() {
             "$ref": "#/definitions/Property"
           }
         },
+        "queryPipelines": {
+          "description": "Named, server-side query pipelines that can be invoked by name at query time.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/QueryPipeline"
+          },
+          "x-omitempty": true
+        },
         "replicationConfig": {
           "$ref": "#/definitions/ReplicationConfig"
         },
@@ -14440,6 +15316,13 @@ func init() {
             "$ref": "#/definitions/GraphQLError"
           },
           "x-omitempty": true
+        },
+        "extensions": {
+          "description": "GraphQL extensions, e.g. the profiles of queries run with the profile argument.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/JsonObject"
+          }
         }
       }
     },
@@ -14609,6 +15492,43 @@ func init() {
         }
       }
     },
+    "NodeModuleStatus": {
+      "description": "The health of the provider a module calls, as seen by the requests of the node",
+      "type": "object",
+      "properties": {
+        "consecutiveFailures": {
+          "description": "Number of failed requests to the provider since the last successful one.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "lastError": {
+          "description": "The error of the last failed request.",
+          "type": "string"
+        },
+        "lastFailureTimeUnix": {
+          "description": "Time of the last failed request in ms since epoch.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "name": {
+          "description": "The name of the module.",
+          "type": "string"
+        },
+        "provider": {
+          "description": "The provider called by the module.",
+          "type": "string"
+        },
+        "status": {
+          "description": "HEALTHY if the last request succeeded, DEGRADED if requests recently failed, UNAVAILABLE while requests are not sent to the provider.",
+          "type": "string",
+          "enum": [
+            "HEALTHY",
+            "DEGRADED",
+            "UNAVAILABLE"
+          ]
+        }
+      }
+    },
     "NodeShardStatus": {
       "description": "The definition of a node shard status response body",
       "properties": {
@@ -14645,6 +15565,13 @@ func init() {
           "format": "int64",
           "x-omitempty": false
         },
+        "revectorization": {
+          "description": "The progress of re-vectorizing named vectors of the shard.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/RevectorizationProgress"
+          }
+        },
         "vectorIndexingStatus": {
           "description": "The status of the vector indexing process.",
           "format": "string",
@@ -14687,6 +15614,13 @@ func init() {
           "description": "The gitHash of Weaviate.",
           "type": "string"
         },
+        "modules": {
+          "description": "The health of the providers called by the modules of the node.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeModuleStatus"
+          }
+        },
         "name": {
           "description": "The name of the node.",
           "type": "string"
@@ -15341,6 +16275,90 @@ func init() {
       "description": "Names and values of an individual property. A returned response may also contain additional metadata, such as from classification or feature projection.",
       "type": "object"
     },
+    "QueryPipeline": {
+      "description": "A named, multi-stage query declared on a collection. Stages run in order: optional ` + "`" + `filter` + "`" + ` stages, a retrieval stage (` + "`" + `hybrid` + "`" + ` or ` + "`" + `bm25` + "`" + `), then optional ` + "`" + `rerank` + "`" + ` and ` + "`" + `generate` + "`" + ` stages.",
+      "type": "object",
+      "properties": {
+        "description": {
+          "description": "Description of the pipeline for metadata purposes.",
+          "type": "string"
+        },
+        "name": {
+          "description": "Name of the pipeline (required), used to invoke it at query time. Must be unique within the collection.",
+          "type": "string"
+        },
+        "stages": {
+          "description": "The ordered stages of the pipeline.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/QueryPipelineStage"
+          }
+        }
+      }
+    },
+    "QueryPipelineStage": {
+      "description": "A single stage of a query pipeline. String fields may reference query-time parameters as ` + "`" + `${name}` + "`" + `.",
+      "type": "object",
+      "properties": {
+        "alpha": {
+          "description": "Weighting of the vector search against the keyword search in a ` + "`" + `hybrid` + "`" + ` stage (default: 0.75).",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "groupedProperties": {
+          "description": "Properties passed to the grouped task of a ` + "`" + `generate` + "`" + ` stage.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "groupedTask": {
+          "description": "Task run once over all results of a ` + "`" + `generate` + "`" + ` stage.",
+          "type": "string"
+        },
+        "limit": {
+          "description": "Number of results kept by a ` + "`" + `hybrid` + "`" + `, ` + "`" + `bm25` + "`" + ` or ` + "`" + `rerank` + "`" + ` stage.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "properties": {
+          "description": "Properties searched by a ` + "`" + `hybrid` + "`" + ` or ` + "`" + `bm25` + "`" + ` stage.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "property": {
+          "description": "Property used by a ` + "`" + `rerank` + "`" + ` stage to score the results.",
+          "type": "string"
+        },
+        "query": {
+          "description": "Search query of a ` + "`" + `hybrid` + "`" + ` or ` + "`" + `bm25` + "`" + ` stage, or the query of a ` + "`" + `rerank` + "`" + ` stage. A ` + "`" + `rerank` + "`" + ` stage without a query reuses the query of the retrieval stage.",
+          "type": "string"
+        },
+        "singlePrompt": {
+          "description": "Prompt run for each result of a ` + "`" + `generate` + "`" + ` stage.",
+          "type": "string"
+        },
+        "type": {
+          "description": "The kind of stage.",
+          "type": "string",
+          "enum": [
+            "hybrid",
+            "bm25",
+            "filter",
+            "rerank",
+            "generate"
+          ]
+        },
+        "where": {
+          "description": "Filter of a ` + "`" + `filter` + "`" + ` stage. Filter stages precede the retrieval stage and restrict the candidates it retrieves.",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        }
+      }
+    },
     "RaftStatistics": {
       "description": "The definition of Raft statistics.",
       "properties": {
@@ -15723,6 +16741,55 @@ func init() {
         }
       }
     },
+    "RevectorizationProgress": {
+      "description": "The progress of re-vectorizing a named vector of a shard",
+      "properties": {
+        "phase": {
+          "description": "The phase of the re-vectorization: 'build' while embedding the objects into the new index, 'swap' while replacing the serving index.",
+          "type": "string"
+        },
+        "processedObjects": {
+          "description": "The number of objects embedded so far.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "targetVector": {
+          "description": "The named vector being re-vectorized.",
+          "type": "string"
+        },
+        "totalObjects": {
+          "description": "The number of objects in the shard.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "RevectorizeRequest": {
+      "description": "Request body to re-vectorize a named vector",
+      "required": [
+        "vectorizer"
+      ],
+      "properties": {
+        "objectsPerSecond": {
+          "description": "The maximum number of objects embedded per second on each node. Omit or set to 0 for no limit.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "vectorizer": {
+          "description": "The vectorizer module and its configuration to embed the objects with, in the same format as the 'vectorizer' of a named vector config.",
+          "type": "object"
+        }
+      }
+    },
+    "RevectorizeResponse": {
+      "description": "The re-vectorization that was started",
+      "properties": {
+        "taskId": {
+          "description": "The id of the distributed task embedding the objects, in the 'revectorize' namespace",
+          "type": "string"
+        }
+      }
+    },
     "Role": {
       "type": "object",
       "required": [
@@ -15807,6 +16874,47 @@ func init() {
       "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
       "type": "object"
     },
+    "ShardReshardRequest": {
+      "description": "Request body to split or merge a shard",
+      "required": [
+        "operation"
+      ],
+      "properties": {
+        "operation": {
+          "description": "The resharding operation to perform: 'SPLIT' moves half of the shard's data to a new shard, 'MERGE' moves all of the shard's data into the target shard.",
+          "type": "string",
+          "enum": [
+            "SPLIT",
+            "MERGE"
+          ]
+        },
+        "targetShard": {
+          "description": "The shard receiving the data. Required for 'MERGE', optional for 'SPLIT' where a name is generated if omitted.",
+          "type": "string"
+        }
+      }
+    },
+    "ShardReshardResponse": {
+      "description": "The resharding operation that was started",
+      "properties": {
+        "operation": {
+          "description": "The resharding operation: 'SPLIT' or 'MERGE'",
+          "type": "string"
+        },
+        "sourceShard": {
+          "description": "The shard the data is moved from",
+          "type": "string"
+        },
+        "targetShard": {
+          "description": "The shard the data is moved to",
+          "type": "string"
+        },
+        "taskId": {
+          "description": "The id of the distributed task copying the data, in the 'resharding' namespace",
+          "type": "string"
+        }
+      }
+    },
     "ShardStatus": {
       "description": "The status of a single shard",
       "properties": {
@@ -15869,6 +16977,160 @@ func init() {
         }
       }
     },
+    "SlowQueriesResponse": {
+      "description": "The slow queries recorded across the nodes of the cluster",
+      "type": "object",
+      "properties": {
+        "queries": {
+          "description": "The slow queries, most recent first.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SlowQuery"
+          }
+        },
+        "unreachableNodes": {
+          "description": "Nodes whose slow queries could not be retrieved.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "SlowQuery": {
+      "description": "A query which exceeded the slow query threshold",
+      "type": "object",
+      "properties": {
+        "error": {
+          "description": "The error the query failed with, if any.",
+          "type": "string"
+        },
+        "node": {
+          "description": "The node which executed the query.",
+          "type": "string"
+        },
+        "phases": {
+          "description": "Duration in milliseconds of each phase of the query.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "number",
+            "format": "double"
+          }
+        },
+        "principal": {
+          "description": "The user who issued the query.",
+          "type": "string"
+        },
+        "shape": {
+          "description": "The sanitized shape of the query.",
+          "$ref": "#/definitions/SlowQueryShape"
+        },
+        "shards": {
+          "description": "The shards searched by the query.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SlowQueryShard"
+          }
+        },
+        "timestamp": {
+          "description": "Unix timestamp in milliseconds of when the query finished.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "took": {
+          "description": "Duration of the query in milliseconds.",
+          "type": "number",
+          "format": "double"
+        }
+      }
+    },
+    "SlowQueryFilter": {
+      "description": "The structure of a where filter, without the values compared against",
+      "type": "object",
+      "properties": {
+        "on": {
+          "description": "The path of the property the clause applies to.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "operands": {
+          "description": "The operands of a compound clause.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SlowQueryFilter"
+          }
+        },
+        "operator": {
+          "description": "The operator of the filter clause.",
+          "type": "string"
+        },
+        "valueType": {
+          "description": "The type of the value compared against.",
+          "type": "string"
+        }
+      }
+    },
+    "SlowQueryShape": {
+      "description": "The sanitized shape of a query, without any of the values searched for",
+      "type": "object",
+      "properties": {
+        "collection": {
+          "description": "The collection queried.",
+          "type": "string"
+        },
+        "filter": {
+          "description": "The structure of the where filter.",
+          "$ref": "#/definitions/SlowQueryFilter"
+        },
+        "limit": {
+          "description": "The limit of the query.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "offset": {
+          "description": "The offset of the query.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "queryType": {
+          "description": "The kind of query, either get or aggregate.",
+          "type": "string"
+        },
+        "searchType": {
+          "description": "The search used to retrieve objects, e.g. nearVector, hybrid or bm25.",
+          "type": "string"
+        },
+        "tenant": {
+          "description": "The tenant queried.",
+          "type": "string"
+        }
+      }
+    },
+    "SlowQueryShard": {
+      "description": "The time spent searching a single shard",
+      "type": "object",
+      "properties": {
+        "name": {
+          "description": "The name of the shard.",
+          "type": "string"
+        },
+        "node": {
+          "description": "The node the shard was searched on.",
+          "type": "string"
+        },
+        "remote": {
+          "description": "Whether the shard was searched on another node.",
+          "type": "boolean"
+        },
+        "took": {
+          "description": "Duration of the shard search in milliseconds.",
+          "type": "number",
+          "format": "double"
+        }
+      }
+    },
     "Statistics": {
       "description": "The definition of node statistics.",
       "properties": {
@@ -13,6 +13,7 @@ package rest
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/sirupsen/logrus"
@@ -24,6 +25,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/verbosity"
 	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/monitoring"
@@ -100,6 +102,35 @@ func (n *nodesHandlers) getNodesStatistics(params cluster.ClusterGetStatisticsPa
 	return cluster.NewClusterGetStatisticsOK().WithPayload(statistics)
 }
 
+func (n *nodesHandlers) getSlowQueries(params cluster.ClusterGetSlowQueriesParams, principal *models.Principal) middleware.Responder {
+	var collection string
+	if params.Collection != nil {
+		collection = schema.UppercaseClassName(*params.Collection)
+	}
+	limit := 100
+	if params.Limit != nil {
+		limit = int(*params.Limit)
+	}
+	if limit <= 0 {
+		return cluster.NewClusterGetSlowQueriesUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(fmt.Errorf("limit must be positive")))
+	}
+
+	queries, err := n.manager.GetSlowQueries(params.HTTPRequest.Context(), principal, collection, limit)
+	if err != nil {
+		n.metricRequestsTotal.logError("", err)
+		if errors.As(err, &autherrs.Forbidden{}) {
+			return cluster.NewClusterGetSlowQueriesForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+		return cluster.NewClusterGetSlowQueriesInternalServerError().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	n.metricRequestsTotal.logOk("")
+	return cluster.NewClusterGetSlowQueriesOK().WithPayload(queries)
+}
+
 func (n *nodesHandlers) handleGetNodesError(err error) middleware.Responder {
 	n.metricRequestsTotal.logError("", err)
 	if errors.As(err, &enterrors.ErrNotFound{}) {
@@ -131,6 +162,8 @@ func setupNodesHandlers(api *operations.WeaviateAPI,
 		NodesGetClassHandlerFunc(h.getNodesStatusByClass)
 	api.ClusterClusterGetStatisticsHandler = cluster.
 		ClusterGetStatisticsHandlerFunc(h.getNodesStatistics)
+	api.ClusterClusterGetSlowQueriesHandler = cluster.
+		ClusterGetSlowQueriesHandlerFunc(h.getSlowQueries)
 }
 
 type nodesRequestsTotal struct {
@@ -21,11 +21,13 @@ import (
 	restCtx "github.com/weaviate/weaviate/adapters/handlers/rest/context"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	cmd "github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/entities/models"
 	authzerrors "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	uco "github.com/weaviate/weaviate/usecases/objects"
 	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 type schemaHandlers struct {
@@ -217,6 +219,71 @@ func (s *schemaHandlers) updateShardStatus(params schema.SchemaObjectsShardsUpda
 	return schema.NewSchemaObjectsShardsUpdateOK().WithPayload(payload)
 }
 
+func (s *schemaHandlers) reshardShard(params schema.SchemaObjectsShardsReshardParams,
+	principal *models.Principal,
+) middleware.Responder {
+	ctx := restCtx.AddPrincipalToContext(params.HTTPRequest.Context(), principal)
+	target, err := s.manager.ReshardShard(
+		ctx, principal, params.ClassName, params.ShardName, *params.Body.Operation, params.Body.TargetShard)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &authzerrors.Forbidden{}):
+			return schema.NewSchemaObjectsShardsReshardForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return schema.NewSchemaObjectsShardsReshardNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsShardsReshardUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	payload := &models.ShardReshardResponse{
+		Operation:   *params.Body.Operation,
+		SourceShard: params.ShardName,
+		TargetShard: target,
+		TaskID:      cmd.ReshardingTaskID(params.ClassName, params.ShardName, sharding.ReshardingPhaseCopy),
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return schema.NewSchemaObjectsShardsReshardOK().WithPayload(payload)
+}
+
+func (s *schemaHandlers) revectorizeVector(params schema.SchemaObjectsVectorsRevectorizeParams,
+	principal *models.Principal,
+) middleware.Responder {
+	vectorizer, ok := params.Body.Vectorizer.(map[string]interface{})
+	if !ok {
+		err := fmt.Errorf("vectorizer must be an object, got %T", params.Body.Vectorizer)
+		s.metricRequestsTotal.logUserError("")
+		return schema.NewSchemaObjectsVectorsRevectorizeUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	ctx := restCtx.AddPrincipalToContext(params.HTTPRequest.Context(), principal)
+	taskID, err := s.manager.RevectorizeVector(ctx, principal, params.ClassName, params.VectorName,
+		vectorizer, int(params.Body.ObjectsPerSecond))
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch {
+		case errors.As(err, &authzerrors.Forbidden{}):
+			return schema.NewSchemaObjectsVectorsRevectorizeForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.Is(err, schemaUC.ErrNotFound):
+			return schema.NewSchemaObjectsVectorsRevectorizeNotFound().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsVectorsRevectorizeUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return schema.NewSchemaObjectsVectorsRevectorizeOK().WithPayload(&models.RevectorizeResponse{TaskID: taskID})
+}
+
 func (s *schemaHandlers) createTenants(params schema.TenantsCreateParams,
 	principal *models.Principal,
 ) middleware.Responder {
@@ -379,6 +446,10 @@ func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager,
 		SchemaObjectsShardsGetHandlerFunc(h.getShardsStatus)
 	api.SchemaSchemaObjectsShardsUpdateHandler = schema.
 		SchemaObjectsShardsUpdateHandlerFunc(h.updateShardStatus)
+	api.SchemaSchemaObjectsShardsReshardHandler = schema.
+		SchemaObjectsShardsReshardHandlerFunc(h.reshardShard)
+	api.SchemaSchemaObjectsVectorsRevectorizeHandler = schema.
+		SchemaObjectsVectorsRevectorizeHandlerFunc(h.revectorizeVector)
 
 	api.SchemaTenantsCreateHandler = schema.TenantsCreateHandlerFunc(h.createTenants)
 	api.SchemaTenantsUpdateHandler = schema.TenantsUpdateHandlerFunc(h.updateTenants)
@@ -13,9 +13,12 @@ package rest
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -28,8 +31,10 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/swagger_middleware"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/crosscluster"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/replica"
 )
 
 // The middleware configuration is for the handler executors. These do not apply to the swagger.json document.
@@ -128,6 +133,8 @@ func makeSetupGlobalMiddleware(appState *state.State, context *middleware.Contex
 		handler = addHandleRoot(handler)
 		handler = makeAddModuleHandlers(appState.Modules)(handler)
 		handler = addInjectHeadersIntoContext(handler)
+		handler = addSessionConsistency(handler)
+		handler = addCrossClusterGuard(handler, appState.CrossClusterGuard)
 		handler = makeCatchPanics(appState.Logger, newPanicsRequestsTotal(appState.Metrics, appState.Logger))(handler)
 		if appState.ServerConfig.Config.Monitoring.Enabled {
 			handler = monitoring.InstrumentHTTP(
@@ -243,6 +250,116 @@ func addInjectHeadersIntoContext(next http.Handler) http.Handler {
 	})
 }
 
+// sessionTokenHeader carries the session token of read-your-writes
+// consistency for replicated collections
+const sessionTokenHeader = "X-Weaviate-Session-Token"
+
+// sessionTruncatedHeader is set to true if writes were dropped from the
+// session, because they expired or too many shards were written to. Reads are
+// no longer guaranteed to reflect them.
+const sessionTruncatedHeader = "X-Weaviate-Session-Truncated"
+
+// sessionConsistencyPaths are the endpoints reading or writing objects
+var sessionConsistencyPaths = []string{"/v1/objects", "/v1/batch", "/v1/graphql"}
+
+// addSessionConsistency adds the session of the token sent with object and
+// GraphQL requests to their context, or a new session if there is none.
+// Replicated writes are recorded in the session, its token is returned in
+// the response so that subsequent reads passing it along reflect the writes.
+func addSessionConsistency(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !slices.ContainsFunc(sessionConsistencyPaths, func(p string) bool {
+			return strings.HasPrefix(r.URL.Path, p)
+		}) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		session := replica.NewSession()
+		if token := r.Header.Get(sessionTokenHeader); token != "" {
+			var err error
+			if session, err = replica.ParseSessionToken(token); err != nil {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				json.NewEncoder(w).Encode(errPayloadFromSingleErr(err))
+				return
+			}
+		}
+
+		ctx := replica.ContextWithSession(r.Context(), session)
+		next.ServeHTTP(&sessionResponseWriter{ResponseWriter: w, session: session}, r.WithContext(ctx))
+	})
+}
+
+// sessionResponseWriter sets the session token header before the response
+// is written, once the request has been handled.
+type sessionResponseWriter struct {
+	http.ResponseWriter
+	session     *replica.Session
+	wroteHeader bool
+}
+
+func (w *sessionResponseWriter) WriteHeader(statusCode int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		if token := w.session.Token(); token != "" {
+			w.Header().Set(sessionTokenHeader, token)
+		}
+		if w.session.Truncated() {
+			w.Header().Set(sessionTruncatedHeader, "true")
+		}
+	}
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *sessionResponseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// addCrossClusterGuard rejects the writes to objects and schema which the
+// cluster must not accept given its role in cross-cluster replication: client
+// writes while it is a standby, and replicated writes once it is a primary.
+func addCrossClusterGuard(next http.Handler, guard *crosscluster.Guard) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if guard == nil || !isGuardedWrite(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		err := guard.CheckWrite(r.Header.Get(crosscluster.ReplicationSourceHeader) != "")
+		if err == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		status := http.StatusForbidden
+		if errors.Is(err, crosscluster.ErrFenced) {
+			status = http.StatusConflict
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(errPayloadFromSingleErr(err))
+	})
+}
+
+func isGuardedWrite(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	if r.URL.Path == "/v1/objects/validate" {
+		return false
+	}
+	for _, prefix := range []string{"/v1/objects", "/v1/batch", "/v1/schema"} {
+		if strings.HasPrefix(r.URL.Path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 func addLiveAndReadyness(state *state.State, next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.String() == "/v1/.well-known/live" {
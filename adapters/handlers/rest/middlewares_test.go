@@ -12,14 +12,21 @@
 package rest
 
 import (
+	"context"
+	"encoding/base64"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/go-openapi/loads"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/usecases/crosscluster"
+	"github.com/weaviate/weaviate/usecases/replica"
 )
 
 func Test_staticRoute(t *testing.T) {
@@ -74,3 +81,112 @@ func newRequest(t *testing.T, path string) *http.Request {
 	require.NoError(t, err)
 	return r
 }
+
+func Test_addSessionConsistency(t *testing.T) {
+	token := base64.RawURLEncoding.EncodeToString(
+		fmt.Appendf(nil, `{"C1/S1":{"t":%d,"l":"ONE"}}`, time.Now().UnixMilli()))
+
+	var session *replica.Session
+	handler := addSessionConsistency(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		session = replica.SessionFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	serve := func(path, token string) *httptest.ResponseRecorder {
+		session = nil
+		r := newRequest(t, path)
+		if token != "" {
+			r.Header.Set(sessionTokenHeader, token)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	t.Run("without token", func(t *testing.T) {
+		w := serve("/v1/objects/C1/123", "")
+		assert.Equal(t, http.StatusOK, w.Code)
+		require.NotNil(t, session)
+		assert.Empty(t, w.Header().Get(sessionTokenHeader))
+	})
+
+	t.Run("with token", func(t *testing.T) {
+		w := serve("/v1/batch/objects", token)
+		assert.Equal(t, http.StatusOK, w.Code)
+		require.NotNil(t, session)
+		assert.Equal(t, token, w.Header().Get(sessionTokenHeader))
+		assert.Empty(t, w.Header().Get(sessionTruncatedHeader))
+	})
+
+	t.Run("expired token", func(t *testing.T) {
+		expired := base64.RawURLEncoding.EncodeToString(
+			fmt.Appendf(nil, `{"C1/S1":{"t":%d,"l":"ONE"}}`, time.Now().Add(-time.Hour).UnixMilli()))
+		w := serve("/v1/objects/C1/123", expired)
+		assert.Equal(t, http.StatusOK, w.Code)
+		require.NotNil(t, session)
+		assert.Empty(t, w.Header().Get(sessionTokenHeader))
+		assert.Equal(t, "true", w.Header().Get(sessionTruncatedHeader))
+	})
+
+	t.Run("graphql", func(t *testing.T) {
+		w := serve("/v1/graphql", token)
+		assert.Equal(t, http.StatusOK, w.Code)
+		require.NotNil(t, session)
+		assert.Equal(t, token, w.Header().Get(sessionTokenHeader))
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		w := serve("/v1/objects/C1/123", "invalid")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Nil(t, session)
+		assert.Contains(t, w.Body.String(), "invalid session token")
+	})
+
+	t.Run("other endpoints", func(t *testing.T) {
+		w := serve("/v1/schema", token)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Nil(t, session)
+		assert.Empty(t, w.Header().Get(sessionTokenHeader))
+	})
+}
+
+type fakePromotion struct {
+	promoted bool
+}
+
+func (f *fakePromotion) PromoteStandby(context.Context) error {
+	f.promoted = true
+	return nil
+}
+
+func (f *fakePromotion) StandbyPromoted() bool {
+	return f.promoted
+}
+
+func Test_addCrossClusterGuard(t *testing.T) {
+	promotion := &fakePromotion{}
+	handler := addCrossClusterGuard(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}), crosscluster.NewGuard(true, promotion))
+
+	serve := func(method, path string, replicated bool) int {
+		r := httptest.NewRequest(method, path, nil)
+		if replicated {
+			r.Header.Set(crosscluster.ReplicationSourceHeader, "node1")
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusForbidden, serve(http.MethodPost, "/v1/batch/objects", false))
+	assert.Equal(t, http.StatusForbidden, serve(http.MethodDelete, "/v1/schema/C1", false))
+	assert.Equal(t, http.StatusOK, serve(http.MethodPost, "/v1/batch/objects", true))
+	assert.Equal(t, http.StatusOK, serve(http.MethodGet, "/v1/objects", false))
+	assert.Equal(t, http.StatusOK, serve(http.MethodPost, "/v1/objects/validate", false))
+	assert.Equal(t, http.StatusOK, serve(http.MethodPost, "/v1/graphql", false))
+
+	promotion.promoted = true
+	assert.Equal(t, http.StatusOK, serve(http.MethodPost, "/v1/batch/objects", false))
+	assert.Equal(t, http.StatusConflict, serve(http.MethodPost, "/v1/batch/objects", true))
+}
@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replication
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/sirupsen/logrus"
+	cerrors "github.com/weaviate/weaviate/adapters/handlers/rest/errors"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/replication"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/crosscluster"
+)
+
+type crossClusterHandler struct {
+	authorizer authorization.Authorizer
+	guard      *crosscluster.Guard
+	logger     logrus.FieldLogger
+}
+
+func SetupCrossClusterHandlers(api *operations.WeaviateAPI, guard *crosscluster.Guard, authorizer authorization.Authorizer, logger logrus.FieldLogger) {
+	h := &crossClusterHandler{
+		authorizer: authorizer,
+		guard:      guard,
+		logger:     logger,
+	}
+	api.ReplicationPromoteStandbyHandler = replication.PromoteStandbyHandlerFunc(h.promoteStandby)
+}
+
+func (h *crossClusterHandler) promoteStandby(params replication.PromoteStandbyParams, principal *models.Principal) middleware.Responder {
+	// promotion makes every collection writable, it is authorized like
+	// updating all of them
+	if err := h.authorizer.Authorize(principal, authorization.UPDATE, authorization.CollectionsMetadata()...); err != nil {
+		return replication.NewPromoteStandbyForbidden()
+	}
+
+	if err := h.guard.Promote(params.HTTPRequest.Context()); err != nil {
+		if errors.Is(err, crosscluster.ErrNotStandby) {
+			return replication.NewPromoteStandbyUnprocessableEntity().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
+		}
+		return replication.NewPromoteStandbyInternalServerError().WithPayload(cerrors.ErrPayloadFromSingleErr(
+			fmt.Errorf("promote standby: %w", err)))
+	}
+
+	h.logger.WithFields(logrus.Fields{
+		"action": "replication",
+		"op":     "promote_standby",
+	}).Info("cross-cluster replication standby promoted")
+
+	return replication.NewPromoteStandbyNoContent()
+}
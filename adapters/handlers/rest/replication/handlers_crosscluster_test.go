@@ -0,0 +1,74 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replication
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/replication"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/crosscluster"
+)
+
+type fakePromotion struct {
+	promoted bool
+}
+
+func (f *fakePromotion) PromoteStandby(context.Context) error {
+	f.promoted = true
+	return nil
+}
+
+func (f *fakePromotion) StandbyPromoted() bool {
+	return f.promoted
+}
+
+func TestPromoteStandby(t *testing.T) {
+	params := replication.PromoteStandbyParams{HTTPRequest: &http.Request{}}
+
+	t.Run("standby", func(t *testing.T) {
+		mockAuthorizer := authorization.NewMockAuthorizer(t)
+		mockAuthorizer.On("Authorize", mock.Anything, authorization.UPDATE, authorization.CollectionsMetadata()[0]).Return(nil)
+		promotion := &fakePromotion{}
+		h := &crossClusterHandler{
+			authorizer: mockAuthorizer,
+			guard:      crosscluster.NewGuard(true, promotion),
+			logger:     createNullLogger(t),
+		}
+
+		response := h.promoteStandby(params, &models.Principal{})
+
+		assert.IsType(t, &replication.PromoteStandbyNoContent{}, response)
+		assert.True(t, promotion.promoted)
+	})
+
+	t.Run("not a standby", func(t *testing.T) {
+		mockAuthorizer := authorization.NewMockAuthorizer(t)
+		mockAuthorizer.On("Authorize", mock.Anything, authorization.UPDATE, authorization.CollectionsMetadata()[0]).Return(nil)
+		promotion := &fakePromotion{}
+		h := &crossClusterHandler{
+			authorizer: mockAuthorizer,
+			guard:      crosscluster.NewGuard(false, promotion),
+			logger:     createNullLogger(t),
+		}
+
+		response := h.promoteStandby(params, &models.Principal{})
+
+		assert.IsType(t, &replication.PromoteStandbyUnprocessableEntity{}, response)
+		assert.False(t, promotion.promoted)
+	})
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/rest/tenantactivity"
 	"github.com/weaviate/weaviate/adapters/repos/classifications"
 	"github.com/weaviate/weaviate/adapters/repos/db"
+	"github.com/weaviate/weaviate/adapters/repos/embeddingcache"
 	rCluster "github.com/weaviate/weaviate/cluster"
 	"github.com/weaviate/weaviate/cluster/distributedtask"
 	"github.com/weaviate/weaviate/cluster/fsm"
@@ -33,6 +34,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/cluster"
 	"github.com/weaviate/weaviate/usecases/config"
 	configRuntime "github.com/weaviate/weaviate/usecases/config/runtime"
+	"github.com/weaviate/weaviate/usecases/crosscluster"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
@@ -41,6 +43,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/scaler"
 	"github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/sharding"
+	"github.com/weaviate/weaviate/usecases/slowquery"
 	"github.com/weaviate/weaviate/usecases/traverser"
 )
 
@@ -76,6 +79,7 @@ type State struct {
 	GRPCServerMetrics  *monitoring.GRPCServerMetrics
 	BackupManager      *backup.Handler
 	DB                 *db.DB
+	ObjectsManager     *objects.Manager
 	BatchManager       *objects.BatchManager
 	AutoSchemaManager  *objects.AutoSchemaManager
 	ClusterHttpClient  *http.Client
@@ -87,6 +91,16 @@ type State struct {
 
 	DistributedTaskScheduler *distributedtask.Scheduler
 	Migrator                 *db.Migrator
+
+	CrossClusterGuard *crosscluster.Guard
+	// CrossClusterReplicator is nil unless cross-cluster replication is enabled
+	CrossClusterReplicator *crosscluster.Replicator
+	// HintedHandoff is nil unless hinted handoff is enabled
+	HintedHandoff *replica.HintedHandoff
+	// SlowQueryLog is nil unless the slow query log is enabled
+	SlowQueryLog *slowquery.Log
+	// EmbeddingCache is nil unless the embedding cache is enabled
+	EmbeddingCache *embeddingcache.Cache
 }
 
 // GetGraphQL is the safe way to retrieve GraphQL from the state as it can be
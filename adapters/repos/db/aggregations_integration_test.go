@@ -72,6 +72,12 @@ func Test_Aggregations(t *testing.T) {
 	t.Run("date aggregations with filters",
 		testDateAggregationsWithFilters(repo))
 
+	t.Run("sub-aggregations",
+		testSubAggregations(repo))
+
+	t.Run("percentiles and cardinality",
+		testSketchAggregations(repo))
+
 	t.Run("clean up",
 		cleanupCompanyTestSchemaAndData(repo, migrator))
 }
@@ -117,6 +123,12 @@ func Test_Aggregations_MultiShard(t *testing.T) {
 	t.Run("date aggregations with filters",
 		testDateAggregationsWithFilters(repo))
 
+	t.Run("sub-aggregations",
+		testSubAggregations(repo))
+
+	t.Run("percentiles and cardinality",
+		testSketchAggregations(repo))
+
 	t.Run("clean up",
 		cleanupCompanyTestSchemaAndData(repo, migrator))
 }
@@ -1212,6 +1224,160 @@ func testNumericalAggregationsWithGrouping(repo *DB, exact bool) func(t *testing
 	}
 }
 
+func testSubAggregations(repo *DB) func(t *testing.T) {
+	return func(t *testing.T) {
+		t.Run("grouped by sector, price histogram, grouped by location", func(t *testing.T) {
+			params := aggregation.Params{
+				ClassName: schema.ClassName(companyClass.Class),
+				GroupBy: &filters.Path{
+					Class:    schema.ClassName(companyClass.Class),
+					Property: schema.PropertyName("sector"),
+				},
+				IncludeMetaCount: true,
+				SubAggregation: &aggregation.SubAggregation{
+					Histogram: &aggregation.Histogram{Property: "price", Interval: 100},
+					Properties: []aggregation.ParamProperty{
+						{
+							Name:        schema.PropertyName("dividendYield"),
+							Aggregators: []aggregation.Aggregator{aggregation.MeanAggregator},
+						},
+					},
+					SubAggregation: &aggregation.SubAggregation{
+						GroupBy: &filters.Path{
+							Class:    schema.ClassName(companyClass.Class),
+							Property: schema.PropertyName("location"),
+						},
+					},
+				},
+			}
+
+			res, err := repo.Aggregate(context.Background(), params, nil)
+			require.Nil(t, err)
+			require.Len(t, res.Groups, 2)
+
+			food := res.Groups[0]
+			assert.Equal(t, "Food", food.GroupedBy.Value)
+			require.Len(t, food.SubGroups, 4)
+
+			keys := make([]interface{}, len(food.SubGroups))
+			counts := make([]int, len(food.SubGroups))
+			for i, sub := range food.SubGroups {
+				keys[i] = sub.GroupedBy.Value
+				counts[i] = sub.Count
+			}
+			assert.Equal(t, []interface{}{0.0, 100.0, 200.0, 800.0}, keys)
+			assert.Equal(t, []int{30, 10, 10, 10}, counts)
+
+			cheapest := food.SubGroups[0]
+			assert.InDelta(t, 3.7, cheapest.Properties["dividendYield"].NumericalAggregations["mean"], 0.001)
+
+			locations := map[interface{}]int{}
+			for _, sub := range cheapest.SubGroups {
+				locations[sub.GroupedBy.Value] = sub.Count
+			}
+			assert.Equal(t, map[interface{}]int{"Atlanta": 10, "Detroit": 10, "New York": 10}, locations)
+
+			financials := res.Groups[1]
+			assert.Equal(t, "Financials", financials.GroupedBy.Value)
+			require.Len(t, financials.SubGroups, 3)
+			assert.Equal(t, 600.0, financials.SubGroups[2].GroupedBy.Value)
+		})
+
+		t.Run("without grouping, limited sub-groups", func(t *testing.T) {
+			limit := 1
+			params := aggregation.Params{
+				ClassName:        schema.ClassName(companyClass.Class),
+				IncludeMetaCount: true,
+				SubAggregation: &aggregation.SubAggregation{
+					GroupBy: &filters.Path{
+						Class:    schema.ClassName(companyClass.Class),
+						Property: schema.PropertyName("sector"),
+					},
+					Limit: &limit,
+				},
+			}
+
+			res, err := repo.Aggregate(context.Background(), params, nil)
+			require.Nil(t, err)
+			require.Len(t, res.Groups, 1)
+			assert.Equal(t, 90, res.Groups[0].Count)
+			require.Len(t, res.Groups[0].SubGroups, 1)
+			assert.Equal(t, "Food", res.Groups[0].SubGroups[0].GroupedBy.Value)
+			assert.Equal(t, 60, res.Groups[0].SubGroups[0].Count)
+		})
+	}
+}
+
+func testSketchAggregations(repo *DB) func(t *testing.T) {
+	return func(t *testing.T) {
+		properties := []aggregation.ParamProperty{
+			{
+				Name: schema.PropertyName("price"),
+				Aggregators: []aggregation.Aggregator{
+					aggregation.NewPercentileAggregator(50),
+					aggregation.NewPercentileAggregator(100),
+					aggregation.CardinalityAggregator,
+				},
+			},
+			{
+				Name:        schema.PropertyName("location"),
+				Aggregators: []aggregation.Aggregator{aggregation.CardinalityAggregator},
+			},
+		}
+
+		t.Run("without filters", func(t *testing.T) {
+			params := aggregation.Params{
+				ClassName:  schema.ClassName(companyClass.Class),
+				Properties: properties,
+			}
+
+			res, err := repo.Aggregate(context.Background(), params, nil)
+			require.Nil(t, err)
+			require.Len(t, res.Groups, 1)
+
+			price := res.Groups[0].Properties["price"]
+			require.Len(t, price.Percentiles, 2)
+			assert.InDelta(t, 150, price.Percentiles[0].Value, 1)
+			assert.Equal(t, 800.0, price.Percentiles[1].Value)
+			require.NotNil(t, price.Cardinality)
+			assert.Equal(t, int64(8), *price.Cardinality)
+			assert.Nil(t, price.TDigest)
+
+			location := res.Groups[0].Properties["location"]
+			require.NotNil(t, location.Cardinality)
+			assert.Equal(t, int64(5), *location.Cardinality)
+		})
+
+		t.Run("with a filter", func(t *testing.T) {
+			params := aggregation.Params{
+				ClassName: schema.ClassName(companyClass.Class),
+				Filters: &filters.LocalFilter{
+					Root: &filters.Clause{
+						Operator: filters.OperatorEqual,
+						Value: &filters.Value{
+							Type:  schema.DataTypeText,
+							Value: "Financials",
+						},
+						On: &filters.Path{
+							Property: "sector",
+						},
+					},
+				},
+				Properties: properties,
+			}
+
+			res, err := repo.Aggregate(context.Background(), params, nil)
+			require.Nil(t, err)
+			require.Len(t, res.Groups, 1)
+
+			price := res.Groups[0].Properties["price"]
+			assert.Equal(t, 600.0, price.Percentiles[1].Value)
+			assert.Equal(t, int64(3), *price.Cardinality)
+			assert.Equal(t, int64(2), *res.Groups[0].Properties["location"].Cardinality)
+		})
+	}
+}
+
 func testDateAggregationsWithFilters(repo *DB) func(t *testing.T) {
 	return func(t *testing.T) {
 		t.Run("Aggregations with filter that matches nothing", func(t *testing.T) {
@@ -29,6 +29,7 @@ func addDateAggregations(prop *aggregation.Property,
 		prop.DateAggregations = map[string]interface{}{}
 	}
 	agg.buildPairsFromCounts()
+	addSketchAggregations(prop, aggs, agg.fillSketches)
 
 	// if there are no elements to aggregate over because a filter does not match anything, calculating median etc. makes
 	// no sense. Non-existent entries evaluate to nil with an interface{} map
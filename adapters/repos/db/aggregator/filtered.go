@@ -370,6 +370,15 @@ func (fa *filteredAggregator) prepareResult(ctx context.Context, foundIDs []uint
 	}
 
 	out.Groups[0].Properties = props
+
+	if fa.params.SubAggregation != nil {
+		subGroups, err := newSubAggregator(fa.Aggregator, fa.params.SubAggregation).Do(ctx, foundIDs)
+		if err != nil {
+			return nil, err
+		}
+		out.Groups[0].SubGroups = subGroups
+	}
+
 	return &out, nil
 }
 
@@ -428,6 +437,7 @@ func (pa propAggs) results() (map[string]aggregation.Property, error) {
 			out[prop.name.String()] = aggProp
 		case aggregation.PropertyTypeText:
 			aggProp.TextAggregation = prop.textAgg.Res()
+			addSketchAggregations(&aggProp, prop.specifiedAggregators, prop.textAgg.fillSketches)
 			out[prop.name.String()] = aggProp
 		case aggregation.PropertyTypeNumerical:
 			addNumericalAggregations(&aggProp, prop.specifiedAggregators,
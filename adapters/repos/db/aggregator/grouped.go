@@ -79,5 +79,14 @@ func (ga *groupedAggregator) aggregateGroup(ctx context.Context,
 	}
 
 	out.Properties = props
+
+	if ga.params.SubAggregation != nil {
+		subGroups, err := newSubAggregator(ga.Aggregator, ga.params.SubAggregation).Do(ctx, ids)
+		if err != nil {
+			return out, err
+		}
+		out.SubGroups = subGroups
+	}
+
 	return out, nil
 }
@@ -27,6 +27,7 @@ func addNumericalAggregations(prop *aggregation.Property,
 		prop.NumericalAggregations = map[string]interface{}{}
 	}
 	agg.buildPairsFromCounts()
+	addSketchAggregations(prop, aggs, agg.fillSketches)
 
 	// if there are no elements to aggregate over because a filter does not match anything, calculating mean etc. makes
 	// no sense. Non-existent entries evaluate to nil with an interface{} map
@@ -16,14 +16,24 @@ import (
 	"time"
 
 	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/sketch"
 )
 
-type ShardCombiner struct{}
+type ShardCombiner struct {
+	sub *aggregation.SubAggregation
+}
 
 func NewShardCombiner() *ShardCombiner {
 	return &ShardCombiner{}
 }
 
+// WithSubAggregation makes the combiner order and limit merged sub-groups
+// the same way the shards did
+func (sc *ShardCombiner) WithSubAggregation(sub *aggregation.SubAggregation) *ShardCombiner {
+	sc.sub = sub
+	return sc
+}
+
 func (sc *ShardCombiner) Do(results []*aggregation.Result) *aggregation.Result {
 	allResultsAreNil := true
 	firstNonNilRes := 0
@@ -58,7 +68,7 @@ func (sc *ShardCombiner) combineUngrouped(results []*aggregation.Result) *aggreg
 		sc.mergeIntoCombinedGroupAtPos(combined.Groups, 0, shard.Groups[0])
 	}
 
-	sc.finalizeGroup(&combined.Groups[0])
+	sc.finalizeGroup(&combined.Groups[0], sc.sub)
 	return &combined
 }
 
@@ -77,7 +87,7 @@ func (sc *ShardCombiner) combineGrouped(results []*aggregation.Result) *aggregat
 	}
 
 	for i := range combined.Groups {
-		sc.finalizeGroup(&combined.Groups[i])
+		sc.finalizeGroup(&combined.Groups[i], sc.sub)
 	}
 
 	sort.Slice(combined.Groups, func(a, b int) bool {
@@ -125,9 +135,19 @@ func (sc *ShardCombiner) mergeIntoCombinedGroupAtPos(combinedGroups []aggregatio
 		default:
 			panic("unknown prop type: " + prop.Type)
 		}
+		sc.mergeSketches(&combinedProp, &prop)
 		combinedGroups[pos].Properties[propName] = combinedProp
 
 	}
+
+	for _, shardSubGroup := range shardGroup.SubGroups {
+		subPos := getPosOfGroup(combinedGroups[pos].SubGroups, shardSubGroup.GroupedBy.Value)
+		if subPos < 0 {
+			combinedGroups[pos].SubGroups = append(combinedGroups[pos].SubGroups, shardSubGroup)
+		} else {
+			sc.mergeIntoCombinedGroupAtPos(combinedGroups[pos].SubGroups, subPos, shardSubGroup)
+		}
+	}
 }
 
 func (sc *ShardCombiner) mergeDateProp(first, second map[string]interface{}) {
@@ -258,6 +278,33 @@ func (sc *ShardCombiner) mergeNumericalProp(first, second map[string]interface{}
 	}
 }
 
+func (sc *ShardCombiner) mergeSketches(combined, source *aggregation.Property) {
+	if source.TDigest != nil {
+		if combined.TDigest == nil {
+			combined.TDigest = sketch.NewTDigest(sketch.DefaultCompression)
+			combined.Percentiles = make([]aggregation.Percentile, len(source.Percentiles))
+			copy(combined.Percentiles, source.Percentiles)
+		}
+		combined.TDigest.Merge(source.TDigest)
+	}
+
+	if source.HyperLogLog != nil {
+		if combined.HyperLogLog == nil {
+			combined.HyperLogLog = sketch.NewHyperLogLog(sketch.DefaultPrecision)
+		}
+		// all shards use the same precision, so this can't fail
+		_ = combined.HyperLogLog.Merge(source.HyperLogLog)
+	}
+}
+
+// finalizeSketches computes the approximate aggregations from the merged
+// sketches, which are not returned to the user
+func (sc *ShardCombiner) finalizeSketches(combined *aggregation.Property) {
+	computeSketchAggregations(combined)
+	combined.TDigest = nil
+	combined.HyperLogLog = nil
+}
+
 func (sc *ShardCombiner) finalizeDateProp(combined map[string]interface{}) {
 	delete(combined, "_dateAggregator")
 }
@@ -310,7 +357,7 @@ func getPosOfTextOcc(haystack []aggregation.TextOccurrence, needle string) int {
 	return -1
 }
 
-func (sc *ShardCombiner) finalizeGroup(group *aggregation.Group) {
+func (sc *ShardCombiner) finalizeGroup(group *aggregation.Group, sub *aggregation.SubAggregation) {
 	for propName, prop := range group.Properties {
 		switch prop.Type {
 		case aggregation.PropertyTypeNumerical:
@@ -326,8 +373,26 @@ func (sc *ShardCombiner) finalizeGroup(group *aggregation.Group) {
 		default:
 			panic("Unknown prop type: " + prop.Type)
 		}
+		sc.finalizeSketches(&prop)
 		group.Properties[propName] = prop
 	}
+
+	if len(group.SubGroups) == 0 {
+		return
+	}
+
+	var nested *aggregation.SubAggregation
+	if sub != nil {
+		nested = sub.SubAggregation
+	}
+	for i := range group.SubGroups {
+		sc.finalizeGroup(&group.SubGroups[i], nested)
+	}
+
+	if sub != nil {
+		sortSubGroups(sub, group.SubGroups, func(g aggregation.Group) aggregation.Group { return g })
+		group.SubGroups = group.SubGroups[:subGroupLimit(sub, len(group.SubGroups))]
+	}
 }
 
 func getPosOfGroup(haystack []aggregation.Group, needle interface{}) int {
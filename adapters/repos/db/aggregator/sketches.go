@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregator
+
+import (
+	"math"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/sketch"
+)
+
+// sketchFiller adds values to the sketches which were requested, the other
+// one is nil
+type sketchFiller func(td *sketch.TDigest, hll *sketch.HyperLogLog)
+
+// addSketchAggregations builds the sketches required for the percentile and
+// cardinality aggregators and computes their results. The sketches are kept
+// on the property, so that the ShardCombiner can merge them.
+func addSketchAggregations(prop *aggregation.Property,
+	aggs []aggregation.Aggregator, fill sketchFiller,
+) {
+	var percentiles []aggregation.Percentile
+	withCardinality := false
+	for _, agg := range aggs {
+		switch {
+		case agg.Type == aggregation.PercentileType && agg.Percentile != nil &&
+			prop.Type != aggregation.PropertyTypeText:
+			percentiles = append(percentiles, aggregation.Percentile{Percentile: *agg.Percentile})
+		case agg == aggregation.CardinalityAggregator:
+			withCardinality = true
+		}
+	}
+
+	if len(percentiles) == 0 && !withCardinality {
+		return
+	}
+
+	var td *sketch.TDigest
+	if len(percentiles) > 0 {
+		td = sketch.NewTDigest(sketch.DefaultCompression)
+		prop.Percentiles = percentiles
+	}
+	var hll *sketch.HyperLogLog
+	if withCardinality {
+		hll = sketch.NewHyperLogLog(sketch.DefaultPrecision)
+	}
+
+	fill(td, hll)
+
+	prop.TDigest = td
+	prop.HyperLogLog = hll
+	computeSketchAggregations(prop)
+}
+
+// computeSketchAggregations (re-)computes the percentiles and the
+// cardinality from the sketches of prop
+func computeSketchAggregations(prop *aggregation.Property) {
+	if prop.TDigest != nil {
+		for i := range prop.Percentiles {
+			value := prop.TDigest.Quantile(prop.Percentiles[i].Percentile / 100)
+			prop.Percentiles[i].Value = percentileValue(prop.Type, value)
+		}
+	}
+
+	if prop.HyperLogLog != nil {
+		cardinality := int64(prop.HyperLogLog.Count())
+		prop.Cardinality = &cardinality
+	}
+}
+
+func percentileValue(propType aggregation.PropertyType, value float64) interface{} {
+	if math.IsNaN(value) {
+		return nil
+	}
+
+	if propType == aggregation.PropertyTypeDate {
+		// dates are added as epoch nanoseconds
+		return time.Unix(0, int64(value)).UTC().Format(time.RFC3339Nano)
+	}
+
+	return value
+}
+
+func (a *numericalAggregator) fillSketches(td *sketch.TDigest, hll *sketch.HyperLogLog) {
+	for value, count := range a.valueCounter {
+		if td != nil {
+			td.Add(value, float64(count))
+		}
+		if hll != nil {
+			hll.AddFloat64(value)
+		}
+	}
+}
+
+func (a *dateAggregator) fillSketches(td *sketch.TDigest, hll *sketch.HyperLogLog) {
+	for value, count := range a.valueCounter {
+		if td != nil {
+			td.Add(float64(value.epochNano), float64(count))
+		}
+		if hll != nil {
+			hll.AddInt64(value.epochNano)
+		}
+	}
+}
+
+func (a *textAggregator) fillSketches(td *sketch.TDigest, hll *sketch.HyperLogLog) {
+	// percentiles are not defined on text
+	if hll == nil {
+		return
+	}
+
+	for value := range a.itemCounter {
+		hll.AddString(value)
+	}
+}
@@ -0,0 +1,110 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregator
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/aggregation"
+)
+
+func TestSketchAggregations(t *testing.T) {
+	aggs := []aggregation.Aggregator{
+		aggregation.CountAggregator,
+		aggregation.NewPercentileAggregator(50),
+		aggregation.NewPercentileAggregator(90),
+		aggregation.CardinalityAggregator,
+	}
+
+	shardResult := func(from, to int) *aggregation.Result {
+		agg := newNumericalAggregator()
+		for i := from; i < to; i++ {
+			require.Nil(t, agg.AddFloat64(float64(i%500)))
+		}
+		prop := aggregation.Property{Type: aggregation.PropertyTypeNumerical}
+		addNumericalAggregations(&prop, aggs, agg)
+
+		// remote shard results are sent as JSON
+		res := &aggregation.Result{Groups: []aggregation.Group{{
+			Count:      to - from,
+			Properties: map[string]aggregation.Property{"prop": prop},
+		}}}
+		raw, err := json.Marshal(res)
+		require.Nil(t, err)
+		var out aggregation.Result
+		require.Nil(t, json.Unmarshal(raw, &out))
+		return &out
+	}
+
+	t.Run("single shard", func(t *testing.T) {
+		res := NewShardCombiner().Do([]*aggregation.Result{shardResult(0, 1000)})
+		prop := res.Groups[0].Properties["prop"]
+
+		require.Len(t, prop.Percentiles, 2)
+		assert.InDelta(t, 250, prop.Percentiles[0].Value, 10)
+		assert.InDelta(t, 450, prop.Percentiles[1].Value, 10)
+		require.NotNil(t, prop.Cardinality)
+		assert.InDelta(t, 500, *prop.Cardinality, 10)
+		assert.Nil(t, prop.TDigest)
+		assert.Nil(t, prop.HyperLogLog)
+	})
+
+	t.Run("merged across shards", func(t *testing.T) {
+		res := NewShardCombiner().Do([]*aggregation.Result{
+			shardResult(0, 250), shardResult(250, 1000),
+		})
+		prop := res.Groups[0].Properties["prop"]
+
+		require.Len(t, prop.Percentiles, 2)
+		assert.Equal(t, 50.0, prop.Percentiles[0].Percentile)
+		assert.InDelta(t, 250, prop.Percentiles[0].Value, 10)
+		assert.InDelta(t, 450, prop.Percentiles[1].Value, 10)
+		require.NotNil(t, prop.Cardinality)
+		assert.InDelta(t, 500, *prop.Cardinality, 10)
+		assert.Equal(t, 1000.0, prop.NumericalAggregations["count"])
+	})
+
+	t.Run("dates and text", func(t *testing.T) {
+		dates := newDateAggregator()
+		for _, d := range []string{
+			"2024-01-01T00:00:00Z", "2024-01-02T00:00:00Z", "2024-01-02T00:00:00Z",
+		} {
+			require.Nil(t, dates.AddTimestamp(d))
+		}
+		dateProp := aggregation.Property{Type: aggregation.PropertyTypeDate}
+		addDateAggregations(&dateProp, aggs, dates)
+		require.NotNil(t, dateProp.Cardinality)
+		assert.Equal(t, int64(2), *dateProp.Cardinality)
+		assert.Equal(t, "2024-01-02T00:00:00Z", dateProp.Percentiles[1].Value)
+
+		text := newTextAggregator(5)
+		for _, v := range []string{"a", "b", "b", "c"} {
+			require.Nil(t, text.AddText(v))
+		}
+		textProp := aggregation.Property{Type: aggregation.PropertyTypeText}
+		addSketchAggregations(&textProp, aggs, text.fillSketches)
+		require.NotNil(t, textProp.Cardinality)
+		assert.Equal(t, int64(3), *textProp.Cardinality)
+		assert.Nil(t, textProp.Percentiles)
+	})
+
+	t.Run("no values", func(t *testing.T) {
+		prop := aggregation.Property{Type: aggregation.PropertyTypeNumerical}
+		addNumericalAggregations(&prop, aggs, newNumericalAggregator())
+		require.Len(t, prop.Percentiles, 2)
+		assert.Nil(t, prop.Percentiles[0].Value)
+		assert.Equal(t, int64(0), *prop.Cardinality)
+	})
+}
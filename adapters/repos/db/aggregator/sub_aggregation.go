@@ -0,0 +1,283 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregator
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/docid"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// subAggregator splits a set of documents into buckets as described by a
+// SubAggregation and aggregates the requested properties for every bucket.
+// It is applied to each group of the parent aggregation and recurses into
+// nested sub-aggregations with the docIDs of the respective bucket.
+type subAggregator struct {
+	*Aggregator
+	sub *aggregation.SubAggregation
+}
+
+func newSubAggregator(agg *Aggregator, sub *aggregation.SubAggregation) *subAggregator {
+	return &subAggregator{Aggregator: agg, sub: sub}
+}
+
+// Do buckets and aggregates the objects identified by ids
+func (sa *subAggregator) Do(ctx context.Context, ids []uint64) ([]aggregation.Group, error) {
+	b := newBucketer(sa.sub)
+	if err := docid.ScanObjectsLSM(sa.store, ids, b.scan,
+		[]string{sa.sub.Property().String()}); err != nil {
+		return nil, errors.Wrap(err, "sub-aggregation: scan objects")
+	}
+
+	return sa.aggregateBuckets(ctx, b)
+}
+
+// DoAll buckets and aggregates every object in the shard
+func (sa *subAggregator) DoAll(ctx context.Context) ([]aggregation.Group, error) {
+	b := newBucketer(sa.sub)
+	if err := ScanAllLSM(ctx, sa.store, b.scan, &storobj.PropertyExtraction{
+		PropertyPaths: [][]string{{sa.sub.Property().String()}},
+	}); err != nil {
+		return nil, errors.Wrap(err, "sub-aggregation: scan all objects")
+	}
+
+	return sa.aggregateBuckets(ctx, b)
+}
+
+func (sa *subAggregator) aggregateBuckets(ctx context.Context, b *bucketer) ([]aggregation.Group, error) {
+	// the per-bucket aggregation only differs from the parent in the set of
+	// properties it is interested in
+	child := *sa.Aggregator
+	child.params.Properties = sa.sub.Properties
+	fa := newFilteredAggregator(&child)
+
+	buckets := b.selectBuckets()
+	out := make([]aggregation.Group, len(buckets))
+	for i, bucket := range buckets {
+		props, err := fa.properties(ctx, bucket.docIDs)
+		if err != nil {
+			return nil, errors.Wrapf(err, "sub-aggregation: aggregate bucket %v",
+				bucket.res.GroupedBy.Value)
+		}
+
+		out[i] = bucket.res
+		out[i].Properties = props
+
+		if sa.sub.SubAggregation != nil {
+			subGroups, err := newSubAggregator(sa.Aggregator, sa.sub.SubAggregation).
+				Do(ctx, bucket.docIDs)
+			if err != nil {
+				return nil, err
+			}
+			out[i].SubGroups = subGroups
+		}
+	}
+
+	return out, nil
+}
+
+// bucketer assigns docIDs to buckets based on the value of a single
+// property. Depending on the sub-aggregation the bucket key is either the
+// raw value, the lower bound of a numeric interval or the start of a calendar
+// interval.
+type bucketer struct {
+	sub     *aggregation.SubAggregation
+	buckets map[interface{}]map[uint64]struct{}
+}
+
+func newBucketer(sub *aggregation.SubAggregation) *bucketer {
+	return &bucketer{
+		sub:     sub,
+		buckets: map[interface{}]map[uint64]struct{}{},
+	}
+}
+
+func (b *bucketer) scan(s *models.PropertySchema, docID uint64) (bool, error) {
+	if s == nil {
+		return true, nil
+	}
+
+	item, ok := (*s).(map[string]interface{})[b.sub.Property().String()]
+	if !ok {
+		return true, nil
+	}
+
+	switch val := item.(type) {
+	case []string:
+		for i := range val {
+			if err := b.add(val[i], docID); err != nil {
+				return false, err
+			}
+		}
+	case []float64:
+		for i := range val {
+			if err := b.add(val[i], docID); err != nil {
+				return false, err
+			}
+		}
+	case []bool:
+		for i := range val {
+			if err := b.add(val[i], docID); err != nil {
+				return false, err
+			}
+		}
+	case []interface{}:
+		for i := range val {
+			if err := b.add(val[i], docID); err != nil {
+				return false, err
+			}
+		}
+	case models.MultipleRef:
+		for i := range val {
+			if err := b.add(val[i].Beacon, docID); err != nil {
+				return false, err
+			}
+		}
+	default:
+		if err := b.add(val, docID); err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func (b *bucketer) add(value interface{}, docID uint64) error {
+	key, err := b.key(value)
+	if err != nil {
+		return fmt.Errorf("property %s: %w", b.sub.Property(), err)
+	}
+
+	ids, ok := b.buckets[key]
+	if !ok {
+		ids = map[uint64]struct{}{}
+		b.buckets[key] = ids
+	}
+	ids[docID] = struct{}{}
+	return nil
+}
+
+func (b *bucketer) key(value interface{}) (interface{}, error) {
+	switch {
+	case b.sub.Histogram != nil:
+		asFloat, ok := value.(float64)
+		if !ok {
+			return nil, fmt.Errorf("histogram: expected numeric value, received %T", value)
+		}
+		interval := b.sub.Histogram.Interval
+		return math.Floor(asFloat/interval) * interval, nil
+	case b.sub.DateHistogram != nil:
+		var asTime time.Time
+		switch val := value.(type) {
+		case time.Time:
+			asTime = val
+		case string:
+			parsed, err := time.Parse(time.RFC3339Nano, val)
+			if err != nil {
+				return nil, fmt.Errorf("date histogram: %w", err)
+			}
+			asTime = parsed
+		default:
+			return nil, fmt.Errorf("date histogram: expected date value, received %T", value)
+		}
+		return b.sub.DateHistogram.Interval.Truncate(asTime).Format(time.RFC3339), nil
+	default:
+		return value, nil
+	}
+}
+
+// selectBuckets turns the collected buckets into groups. Histogram buckets
+// are ordered by their key, regular groups by the number of objects they
+// contain. If a limit is set only the first buckets are kept.
+func (b *bucketer) selectBuckets() []group {
+	path := []string{b.sub.Property().String()}
+	if b.sub.GroupBy != nil {
+		path = b.sub.GroupBy.Slice()
+	}
+
+	out := make([]group, 0, len(b.buckets))
+	for key, idsMap := range b.buckets {
+		ids := make([]uint64, 0, len(idsMap))
+		for id := range idsMap {
+			ids = append(ids, id)
+		}
+
+		out = append(out, group{
+			res: aggregation.Group{
+				GroupedBy: &aggregation.GroupedBy{
+					Path:  path,
+					Value: key,
+				},
+				Count: len(ids),
+			},
+			docIDs: ids,
+		})
+	}
+
+	sortSubGroups(b.sub, out, func(g group) aggregation.Group { return g.res })
+	return out[:subGroupLimit(b.sub, len(out))]
+}
+
+// subGroupLimit returns how many of n buckets are kept. Histograms are
+// complete unless limited explicitly, groupBy buckets default to the same
+// limit as the top-level groupBy.
+func subGroupLimit(sub *aggregation.SubAggregation, n int) int {
+	limit := n
+	if sub.Limit != nil {
+		limit = *sub.Limit
+	} else if !sub.IsHistogram() {
+		limit = 100
+	}
+	if n < limit {
+		return n
+	}
+	return limit
+}
+
+func sortSubGroups[T any](sub *aggregation.SubAggregation, groups []T,
+	get func(T) aggregation.Group,
+) {
+	sort.SliceStable(groups, func(i, j int) bool {
+		a, b := get(groups[i]), get(groups[j])
+		if sub.IsHistogram() {
+			return lessBucketKey(a.GroupedBy.Value, b.GroupedBy.Value)
+		}
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		// tie-break on the value to keep the order stable across shards
+		return fmt.Sprint(a.GroupedBy.Value) < fmt.Sprint(b.GroupedBy.Value)
+	})
+}
+
+func lessBucketKey(a, b interface{}) bool {
+	switch aTyped := a.(type) {
+	case float64:
+		bTyped, ok := b.(float64)
+		return ok && aTyped < bTyped
+	case string:
+		// date histogram keys are RFC3339 timestamps in UTC and therefore
+		// sort lexicographically
+		bTyped, ok := b.(string)
+		return ok && aTyped < bTyped
+	default:
+		return false
+	}
+}
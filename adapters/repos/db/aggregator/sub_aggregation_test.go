@@ -0,0 +1,157 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestBucketer(t *testing.T) {
+	scan := func(t *testing.T, b *bucketer, objects ...map[string]interface{}) []group {
+		for i, obj := range objects {
+			var props models.PropertySchema = obj
+			_, err := b.scan(&props, uint64(i))
+			require.NoError(t, err)
+		}
+		return b.selectBuckets()
+	}
+
+	keysAndCounts := func(groups []group) ([]interface{}, []int) {
+		keys := make([]interface{}, len(groups))
+		counts := make([]int, len(groups))
+		for i, g := range groups {
+			keys[i] = g.res.GroupedBy.Value
+			counts[i] = g.res.Count
+		}
+		return keys, counts
+	}
+
+	t.Run("histogram", func(t *testing.T) {
+		b := newBucketer(&aggregation.SubAggregation{
+			Histogram: &aggregation.Histogram{Property: "price", Interval: 10},
+		})
+
+		groups := scan(t, b,
+			map[string]interface{}{"price": 25.0},
+			map[string]interface{}{"price": 3.0},
+			map[string]interface{}{"price": 29.99},
+			map[string]interface{}{"price": -1.0},
+			map[string]interface{}{"other": 1.0},
+			map[string]interface{}{"price": []interface{}{5.0, 7.0}},
+		)
+
+		keys, counts := keysAndCounts(groups)
+		assert.Equal(t, []interface{}{-10.0, 0.0, 20.0}, keys)
+		assert.Equal(t, []int{1, 2, 2}, counts)
+		assert.Equal(t, []string{"price"}, groups[0].res.GroupedBy.Path)
+	})
+
+	t.Run("date histogram", func(t *testing.T) {
+		b := newBucketer(&aggregation.SubAggregation{
+			DateHistogram: &aggregation.DateHistogram{
+				Property: "published",
+				Interval: aggregation.CalendarIntervalMonth,
+			},
+		})
+
+		groups := scan(t, b,
+			map[string]interface{}{"published": "2024-03-31T23:30:00-02:00"},
+			map[string]interface{}{"published": "2024-02-29T10:00:00Z"},
+			map[string]interface{}{"published": "2024-04-15T10:00:00Z"},
+		)
+
+		keys, counts := keysAndCounts(groups)
+		assert.Equal(t, []interface{}{"2024-02-01T00:00:00Z", "2024-04-01T00:00:00Z"}, keys)
+		assert.Equal(t, []int{1, 2}, counts)
+	})
+
+	t.Run("date histogram with invalid value", func(t *testing.T) {
+		b := newBucketer(&aggregation.SubAggregation{
+			DateHistogram: &aggregation.DateHistogram{
+				Property: "published",
+				Interval: aggregation.CalendarIntervalDay,
+			},
+		})
+
+		var props models.PropertySchema = map[string]interface{}{"published": 17.0}
+		_, err := b.scan(&props, 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("group by with limit", func(t *testing.T) {
+		limit := 2
+		b := newBucketer(&aggregation.SubAggregation{
+			GroupBy: &filters.Path{Class: "Article", Property: "category"},
+			Limit:   &limit,
+		})
+
+		groups := scan(t, b,
+			map[string]interface{}{"category": "news"},
+			map[string]interface{}{"category": "sports"},
+			map[string]interface{}{"category": "news"},
+			map[string]interface{}{"category": []string{"sports", "weather"}},
+			map[string]interface{}{"category": "culture"},
+		)
+
+		keys, counts := keysAndCounts(groups)
+		assert.Equal(t, []interface{}{"news", "sports"}, keys)
+		assert.Equal(t, []int{2, 2}, counts)
+		assert.ElementsMatch(t, []uint64{1, 3}, groups[1].docIDs)
+	})
+}
+
+func TestShardCombinerSubGroups(t *testing.T) {
+	bucket := func(value interface{}, count int, subGroups ...aggregation.Group) aggregation.Group {
+		return aggregation.Group{
+			GroupedBy: &aggregation.GroupedBy{Path: []string{"prop"}, Value: value},
+			Count:     count,
+			SubGroups: subGroups,
+		}
+	}
+
+	shard1 := &aggregation.Result{Groups: []aggregation.Group{{
+		Count: 3,
+		SubGroups: []aggregation.Group{
+			bucket("news", 2, bucket(10.0, 1), bucket(0.0, 1)),
+			bucket("sports", 1, bucket(0.0, 1)),
+		},
+	}}}
+	shard2 := &aggregation.Result{Groups: []aggregation.Group{{
+		Count: 3,
+		SubGroups: []aggregation.Group{
+			bucket("sports", 2, bucket(20.0, 2)),
+			bucket("weather", 1, bucket(0.0, 1)),
+		},
+	}}}
+
+	limit := 2
+	res := NewShardCombiner().WithSubAggregation(&aggregation.SubAggregation{
+		GroupBy: &filters.Path{Property: "prop"},
+		Limit:   &limit,
+		SubAggregation: &aggregation.SubAggregation{
+			Histogram: &aggregation.Histogram{Property: "prop", Interval: 10},
+		},
+	}).Do([]*aggregation.Result{shard1, shard2})
+
+	require.Len(t, res.Groups, 1)
+	assert.Equal(t, 6, res.Groups[0].Count)
+	assert.Equal(t, []aggregation.Group{
+		bucket("sports", 3, bucket(0.0, 1), bucket(20.0, 2)),
+		bucket("news", 2, bucket(0.0, 1), bucket(10.0, 1)),
+	}, res.Groups[0].SubGroups)
+}
@@ -57,6 +57,14 @@ func (ua *unfilteredAggregator) Do(ctx context.Context) (*aggregation.Result, er
 
 	out.Groups[0].Properties = props
 
+	if ua.params.SubAggregation != nil {
+		subGroups, err := newSubAggregator(ua.Aggregator, ua.params.SubAggregation).DoAll(ctx)
+		if err != nil {
+			return nil, err
+		}
+		out.Groups[0].SubGroups = subGroups
+	}
+
 	return &out, nil
 }
 
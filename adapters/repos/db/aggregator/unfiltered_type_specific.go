@@ -469,6 +469,7 @@ func (ua unfilteredAggregator) textProperty(ctx context.Context,
 	}
 
 	out.TextAggregation = agg.Res()
+	addSketchAggregations(&out, prop.Aggregators, agg.fillSketches)
 
 	return &out, nil
 }
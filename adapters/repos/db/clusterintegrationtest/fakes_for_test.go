@@ -152,6 +152,10 @@ func (f *fakeSchemaManager) CopyShardingState(class string) *sharding.State {
 	return f.shardState
 }
 
+func (f *fakeSchemaManager) IsResharding(class string) bool {
+	return f.shardState != nil && f.shardState.IsResharding()
+}
+
 func (f *fakeSchemaManager) Statistics() map[string]any {
 	return nil
 }
@@ -0,0 +1,119 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/multi"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// LocalObjectIDsUpdatedSince returns up to limit ids of the objects of a
+// local shard last updated at or after since, in unix millis. The ids are
+// returned in their order, starting after the given one, so that a shard is
+// paged through without holding all of its ids. Only the headers of the
+// objects are decoded, the objects themselves are fetched with
+// LocalObjectsByID.
+func (db *DB) LocalObjectIDsUpdatedSince(ctx context.Context, class, shardName string, since int64,
+	after strfmt.UUID, limit int,
+) ([]strfmt.UUID, error) {
+	shard, release, err := db.localShard(ctx, class, shardName)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	cursor := shard.Store().Bucket(helpers.ObjectsBucketLSM).Cursor()
+	defer cursor.Close()
+
+	var key, val []byte
+	if after == "" {
+		key, val = cursor.First()
+	} else {
+		afterBytes, err := uuid.MustParse(after.String()).MarshalBinary()
+		if err != nil {
+			return nil, fmt.Errorf("invalid id %q: %w", after, err)
+		}
+		key, val = cursor.Seek(afterBytes)
+		if bytes.Equal(key, afterBytes) {
+			key, val = cursor.Next()
+		}
+	}
+
+	ids := make([]strfmt.UUID, 0, limit)
+	for ; key != nil && len(ids) < limit; key, val = cursor.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		obj, err := storobj.FromBinaryUUIDOnly(val)
+		if err != nil {
+			return nil, fmt.Errorf("iterate objects of shard %q: unmarshal object: %w", shardName, err)
+		}
+		if obj.LastUpdateTimeUnix() >= since {
+			ids = append(ids, obj.ID())
+		}
+	}
+	return ids, nil
+}
+
+// LocalObjectsByID returns the objects of a local shard including their
+// vectors. An object deleted in the meantime is nil.
+func (db *DB) LocalObjectsByID(ctx context.Context, class, shardName string, ids []strfmt.UUID,
+) ([]*storobj.Object, error) {
+	shard, release, err := db.localShard(ctx, class, shardName)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	query := make([]multi.Identifier, len(ids))
+	for i, id := range ids {
+		query[i] = multi.Identifier{ID: id.String(), ClassName: class}
+	}
+	return shard.MultiObjectByID(ctx, query)
+}
+
+// MultiExists returns whether each of the objects exists in the class. The
+// ids are checked per shard and, if the class is replicated, on every
+// replica of the shard. It fails if a replica cannot be reached.
+func (db *DB) MultiExists(ctx context.Context, class, tenant string, ids []strfmt.UUID,
+) ([]bool, error) {
+	idx := db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return nil, fmt.Errorf("collection %q not found", class)
+	}
+	return idx.multiExists(ctx, ids, tenant)
+}
+
+func (db *DB) localShard(ctx context.Context, class, shardName string) (ShardLike, func(), error) {
+	idx := db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return nil, nil, fmt.Errorf("collection %q not found", class)
+	}
+	shard, release, err := idx.GetShard(ctx, shardName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get shard %q: %w", shardName, err)
+	}
+	if shard == nil {
+		release()
+		return nil, nil, fmt.Errorf("shard %q of collection %q is not loaded on this node", shardName, class)
+	}
+	return shard, release, nil
+}
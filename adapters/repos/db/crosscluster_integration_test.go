@@ -0,0 +1,100 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+func TestLocalObjectIDsUpdatedSince(t *testing.T) {
+	className := "CrossClusterClass"
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Class:               className,
+	}
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: nil}},
+		shardState: singleShardState(),
+	}
+	repo, err := New(logger, Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  t.TempDir(),
+		QueryMaximumResults:       10,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil, memwatch.NewDummyMonitor())
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+	schemaGetter.schema = schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}}
+
+	ids := make([]strfmt.UUID, 7)
+	for i := range ids {
+		ids[i] = strfmt.UUID(fmt.Sprintf("7c8183ae-150d-433f-92b6-ed095b00000%d", i))
+		obj := &models.Object{
+			ID:                 ids[i],
+			Class:              className,
+			LastUpdateTimeUnix: int64(1000 + i),
+		}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil, nil, nil, 0))
+	}
+
+	shards, err := repo.ExportShards(context.Background(), className)
+	require.Nil(t, err)
+	require.Len(t, shards, 1)
+
+	pages := func(since int64, limit int) [][]strfmt.UUID {
+		var out [][]strfmt.UUID
+		var after strfmt.UUID
+		for {
+			page, err := repo.LocalObjectIDsUpdatedSince(context.Background(), className, shards[0],
+				since, after, limit)
+			require.Nil(t, err)
+			require.LessOrEqual(t, len(page), limit)
+			if len(page) == 0 {
+				return out
+			}
+			out = append(out, page)
+			if len(page) < limit {
+				return out
+			}
+			after = page[len(page)-1]
+		}
+	}
+
+	t.Run("all objects", func(t *testing.T) {
+		assert.Equal(t, [][]strfmt.UUID{ids[:3], ids[3:6], ids[6:]}, pages(0, 3))
+	})
+
+	t.Run("objects updated since", func(t *testing.T) {
+		assert.Equal(t, [][]strfmt.UUID{ids[3:5], ids[5:]}, pages(1003, 2))
+		assert.Empty(t, pages(2000, 2))
+	})
+}
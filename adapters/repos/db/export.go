@@ -0,0 +1,113 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// ExportShards returns the names of the shards of a class in the order they
+// are exported. Only active tenants of multi-tenant classes are returned.
+func (db *DB) ExportShards(ctx context.Context, class string) ([]string, error) {
+	idx := db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return nil, &objects.Error{Msg: "class not found " + class, Code: objects.StatusNotFound}
+	}
+
+	state := idx.shardState()
+	if !idx.partitioningEnabled {
+		return state.AllPhysicalShards(), nil
+	}
+
+	var shards []string
+	for _, name := range state.AllPhysicalShards() {
+		physical := state.Physical[name]
+		if physical.ActivityStatus() == models.TenantActivityStatusHOT {
+			shards = append(shards, name)
+		}
+	}
+	return shards, nil
+}
+
+// ExportShard passes the objects of a shard matching filters to fn page by
+// page, in the order of their ids starting after the given one. The walk stops
+// at the first error returned by fn.
+func (db *DB) ExportShard(ctx context.Context, class, shard string, filters *filters.LocalFilter,
+	after strfmt.UUID, limit int, addl additional.Properties, fn func(search.Results) error,
+) error {
+	idx := db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return &objects.Error{Msg: "class not found " + class, Code: objects.StatusNotFound}
+	}
+	return idx.exportShard(ctx, shard, filters, after, limit, addl, fn)
+}
+
+func (i *Index) exportShard(ctx context.Context, shardName string, filter *filters.LocalFilter,
+	after strfmt.UUID, limit int, addl additional.Properties, fn func(search.Results) error,
+) error {
+	tenant := ""
+	if i.partitioningEnabled {
+		tenant = shardName
+	}
+	// errors of fn are passed on as they are
+	var fnErr error
+	emit := func(objs []*storobj.Object) error {
+		fnErr = fn(storobj.SearchResults(objs, addl, tenant))
+		return fnErr
+	}
+
+	shard, release, err := i.GetShard(ctx, shardName)
+	if err != nil {
+		return fmt.Errorf("export shard %s: %w", shardName, err)
+	}
+	if shard != nil {
+		defer release()
+		if err := shard.ExportObjects(ctx, filter, after, limit, addl, emit); err != nil {
+			if fnErr != nil {
+				return fnErr
+			}
+			return fmt.Errorf("export shard %s: %w", shardName, err)
+		}
+		return nil
+	}
+
+	// a remote shard is exported page by page, every page is a search on the
+	// node holding it
+	for {
+		cursor := &filters.Cursor{After: after.String(), Limit: limit}
+		objs, _, err := i.objectSearchByShard(ctx, limit, filter, nil, nil, cursor, addl, []string{shardName}, nil)
+		if err != nil {
+			return fmt.Errorf("export shard %s: %w", shardName, err)
+		}
+		if len(objs) == 0 {
+			return nil
+		}
+		if err := emit(objs); err != nil {
+			return err
+		}
+		if len(objs) < limit {
+			return nil
+		}
+		after = objs[len(objs)-1].ID()
+	}
+}
@@ -0,0 +1,150 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+func TestExportShard(t *testing.T) {
+	className := "ExportClass"
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Class:               className,
+		Properties: []*models.Property{
+			{
+				Name:         "parity",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWhitespace,
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: nil}},
+		shardState: singleShardState(),
+	}
+	repo, err := New(logger, Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  t.TempDir(),
+		QueryMaximumResults:       10,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil, memwatch.NewDummyMonitor())
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+	schemaGetter.schema = schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}}
+
+	ids := make([]strfmt.UUID, 6)
+	for i := range ids {
+		ids[i] = strfmt.UUID(fmt.Sprintf("7c8183ae-150d-433f-92b6-ed095b00000%d", i))
+		parity := "even"
+		if i%2 == 1 {
+			parity = "odd"
+		}
+		obj := &models.Object{
+			ID:         ids[i],
+			Class:      className,
+			Properties: map[string]interface{}{"parity": parity},
+		}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil, nil, nil, 0))
+	}
+
+	shards, err := repo.ExportShards(context.Background(), className)
+	require.Nil(t, err)
+	require.Len(t, shards, 1)
+
+	exportPages := func(after strfmt.UUID, filter *filters.LocalFilter, limit int,
+		onPage func(),
+	) [][]strfmt.UUID {
+		var pages [][]strfmt.UUID
+		err := repo.ExportShard(context.Background(), className, shards[0], filter, after,
+			limit, additional.Properties{}, func(res search.Results) error {
+				var page []strfmt.UUID
+				for _, r := range res {
+					page = append(page, r.ID)
+				}
+				pages = append(pages, page)
+				if onPage != nil {
+					onPage()
+				}
+				return nil
+			})
+		require.Nil(t, err)
+		return pages
+	}
+
+	oddFilter := &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorEqual,
+		On:       &filters.Path{Class: schema.ClassName(className), Property: "parity"},
+		Value:    &filters.Value{Value: "odd", Type: schema.DataTypeText},
+	}}
+
+	t.Run("without filter", func(t *testing.T) {
+		assert.Equal(t, [][]strfmt.UUID{ids[:4], ids[4:]}, exportPages("", nil, 4, nil))
+		assert.Equal(t, [][]strfmt.UUID{ids[4:]}, exportPages(ids[3], nil, 4, nil))
+	})
+
+	t.Run("with filter", func(t *testing.T) {
+		assert.Equal(t, [][]strfmt.UUID{{ids[1], ids[3]}, {ids[5]}}, exportPages("", oddFilter, 2, nil))
+		assert.Equal(t, [][]strfmt.UUID{{ids[5]}}, exportPages(ids[3], oddFilter, 2, nil))
+		assert.Empty(t, exportPages(ids[5], oddFilter, 2, nil))
+	})
+
+	t.Run("objects written during a filtered export", func(t *testing.T) {
+		added := strfmt.UUID("7c8183ae-150d-433f-92b6-ed095b000009")
+		written := false
+		// after the allow list was built, an exported object is updated,
+		// which gives it a new doc id, and a new one is added
+		pages := exportPages("", oddFilter, 2, func() {
+			if written {
+				return
+			}
+			written = true
+			for _, id := range []strfmt.UUID{ids[5], added} {
+				obj := &models.Object{
+					ID:         id,
+					Class:      className,
+					Properties: map[string]interface{}{"parity": "odd"},
+				}
+				require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil, nil, nil, 0))
+			}
+		})
+		assert.Equal(t, [][]strfmt.UUID{{ids[1], ids[3]}, {ids[5], added}}, pages)
+	})
+
+	t.Run("unknown class", func(t *testing.T) {
+		_, err := repo.ExportShards(context.Background(), "Unknown")
+		assert.Error(t, err)
+	})
+}
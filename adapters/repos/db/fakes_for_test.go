@@ -56,6 +56,10 @@ func (f *fakeSchemaGetter) CopyShardingState(class string) *sharding.State {
 	return f.shardState
 }
 
+func (f *fakeSchemaGetter) IsResharding(class string) bool {
+	return f.shardState != nil && f.shardState.IsResharding()
+}
+
 func (f *fakeSchemaGetter) ShardOwner(class, shard string) (string, error) {
 	ss := f.shardState
 	x, ok := ss.Physical[shard]
@@ -216,6 +220,12 @@ func (f *fakeRemoteClient) SearchShard(ctx context.Context, hostName, indexName,
 	return nil, nil, nil
 }
 
+func (f *fakeRemoteClient) SearchShardBatch(ctx context.Context, hostName, indexName,
+	shardName string, queries []sharding.ShardSearchQuery,
+) ([]sharding.ShardSearchResult, error) {
+	return make([]sharding.ShardSearchResult, len(queries)), nil
+}
+
 func (f *fakeRemoteClient) Aggregate(ctx context.Context, hostName, indexName,
 	shardName string, params aggregation.Params,
 ) (*aggregation.Result, error) {
@@ -312,6 +322,10 @@ func (f *fakeRemoteNodeClient) GetStatistics(ctx context.Context, hostName strin
 	return &models.Statistics{}, nil
 }
 
+func (f *fakeRemoteNodeClient) GetSlowQueries(ctx context.Context, hostName, collection string, limit int) ([]*models.SlowQuery, error) {
+	return []*models.SlowQuery{}, nil
+}
+
 type fakeReplicationClient struct{}
 
 var _ replica.Client = (*fakeReplicationClient)(nil)
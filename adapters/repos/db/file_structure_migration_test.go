@@ -297,6 +297,11 @@ func (sg *fakeMigrationSchemaGetter) CopyShardingState(class string) *sharding.S
 	return sg.states[class]
 }
 
+func (sg *fakeMigrationSchemaGetter) IsResharding(class string) bool {
+	state, ok := sg.states[class]
+	return ok && state.IsResharding()
+}
+
 func (sg *fakeMigrationSchemaGetter) ShardOwner(class, shard string) (string, error) {
 	return "", nil
 }
@@ -986,6 +986,10 @@ func (f *fakeObjectSearcher) ResolveReferences(ctx context.Context, objs search.
 	return out, nil
 }
 
+func (f *fakeObjectSearcher) SortResults(objs search.Results, sort []filters.Sort) (search.Results, error) {
+	return objs, nil
+}
+
 func TestHybridOverSearch(t *testing.T) {
 	dirName := t.TempDir()
 
@@ -51,6 +51,7 @@ import (
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/multi"
+	"github.com/weaviate/weaviate/entities/queryprofile"
 	"github.com/weaviate/weaviate/entities/replication"
 	"github.com/weaviate/weaviate/entities/schema"
 	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
@@ -298,6 +299,7 @@ func NewIndex(ctx context.Context, cfg IndexConfig,
 
 	// TODO: Fix replica router instantiation to be at the top level
 	index.replicator = replica.NewReplicator(cfg.ClassName.String(), router, sg.NodeName(), getDeletionStrategy, replicaClient, logger)
+	index.replicator.SetHintedHandoff(cfg.HintedHandoff)
 
 	index.closingCtx, index.closingCancel = context.WithCancel(context.Background())
 
@@ -674,6 +676,7 @@ type IndexConfig struct {
 	LSMEnableSegmentsChecksumValidation bool
 	TrackVectorDimensions               bool
 	ShardLoadLimiter                    ShardLoadLimiter
+	HintedHandoff                       *replica.HintedHandoff
 }
 
 func indexID(class schema.ClassName) string {
@@ -1266,6 +1269,74 @@ func (i *Index) multiObjectByID(ctx context.Context,
 	return out, nil
 }
 
+func (i *Index) multiExists(ctx context.Context, ids []strfmt.UUID, tenant string,
+) ([]bool, error) {
+	if err := i.validateMultiTenancy(tenant); err != nil {
+		return nil, err
+	}
+
+	type idsAndPos struct {
+		ids []strfmt.UUID
+		pos []int
+	}
+
+	byShard := map[string]idsAndPos{}
+	for pos, id := range ids {
+		shardName, err := i.determineObjectShard(ctx, id, tenant)
+		if err != nil {
+			return nil, fmt.Errorf("determine shard: %w", err)
+		}
+
+		group := byShard[shardName]
+		group.ids = append(group.ids, id)
+		group.pos = append(group.pos, pos)
+		byShard[shardName] = group
+	}
+
+	out := make([]bool, len(ids))
+	for shardName, group := range byShard {
+		exists, err := i.multiExistsInShard(ctx, shardName, group.ids)
+		if err != nil {
+			return nil, err
+		}
+		for j, ok := range exists {
+			out[group.pos[j]] = ok
+		}
+	}
+
+	return out, nil
+}
+
+func (i *Index) multiExistsInShard(ctx context.Context, shardName string, ids []strfmt.UUID,
+) ([]bool, error) {
+	if i.replicationEnabled() {
+		return i.replicator.MultiExists(ctx, shardName, ids)
+	}
+
+	var objs []*storobj.Object
+	shard, release, err := i.GetShard(ctx, shardName)
+	if err != nil {
+		return nil, err
+	} else if shard != nil {
+		defer release()
+		objs, err = shard.MultiObjectByID(ctx, wrapIDsInMulti(ids))
+		if err != nil {
+			return nil, errors.Wrapf(err, "local shard %s", shardId(i.ID(), shardName))
+		}
+	} else {
+		objs, err = i.remote.MultiGetObjects(ctx, shardName, ids)
+		if err != nil {
+			return nil, errors.Wrapf(err, "remote shard %s", shardName)
+		}
+	}
+
+	exists := make([]bool, len(ids))
+	for j, obj := range objs {
+		exists[j] = obj != nil
+	}
+	return exists, nil
+}
+
 func extractIDsFromMulti(in []multi.Identifier) []strfmt.UUID {
 	out := make([]strfmt.UUID, len(in))
 
@@ -1395,6 +1466,14 @@ func (i *Index) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 		return nil, nil, err
 	}
 
+	if i.isResharding() {
+		// objects being moved between shards are present in both of them
+		outObjects, outScores, err = searchResultDedup(outObjects, outScores)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not deduplicate result while resharding: %w", err)
+		}
+	}
+
 	if len(outObjects) == len(outScores) {
 		if keywordRanking != nil && keywordRanking.Type == "bm25" {
 			for ii := range outObjects {
@@ -1422,7 +1501,23 @@ func (i *Index) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 		}
 	}
 
-	if len(sort) > 0 {
+	if len(sort) > 0 && keywordRanking != nil {
+		// the sort orders the top results of the keyword search, so these need
+		// to be selected by score first
+		outObjects, outScores = i.sortKeywordRanking(outObjects, outScores)
+		if autoCut > 0 {
+			cutOff := autocut.Autocut(outScores, autoCut)
+			outObjects, outScores = outObjects[:cutOff], outScores[:cutOff]
+			autoCut = 0
+		}
+		if len(outObjects) > limit {
+			outObjects, outScores = outObjects[:limit], outScores[:limit]
+		}
+		outObjects, outScores, err = i.sort(outObjects, outScores, sort, limit)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "sort")
+		}
+	} else if len(sort) > 0 {
 		if len(shardNames) > 1 {
 			var err error
 			outObjects, outScores, err = i.sort(outObjects, outScores, sort, limit)
@@ -1500,6 +1595,7 @@ func (i *Index) objectSearchByShard(ctx context.Context, limit int, filters *fil
 				return err
 			}
 
+			start := time.Now()
 			if shard != nil {
 				defer release()
 				localCtx := helpers.InitSlowQueryDetails(ctx)
@@ -1510,6 +1606,7 @@ func (i *Index) objectSearchByShard(ctx context.Context, limit int, filters *fil
 						"local shard object search %s: %w", shard.ID(), err)
 				}
 				nodeName = i.getSchema.NodeName()
+				i.profileShard(localCtx, shardName, nodeName, false, start)
 			} else {
 				i.logger.WithField("shardName", shardName).Debug("shard was not found locally, search for object remotely")
 
@@ -1520,6 +1617,7 @@ func (i *Index) objectSearchByShard(ctx context.Context, limit int, filters *fil
 					return fmt.Errorf(
 						"remote shard object search %s: %w", shardName, err)
 				}
+				i.profileShard(ctx, shardName, nodeName, true, start)
 			}
 
 			if i.replicationEnabled() {
@@ -1611,14 +1709,31 @@ func (i *Index) singleLocalShardObjectVectorSearch(ctx context.Context, searchVe
 	if shard.GetStatus() == storagestate.StatusLoading {
 		return nil, nil, enterrors.NewErrUnprocessable(fmt.Errorf("local %s shard is not ready", shard.Name()))
 	}
+	start := time.Now()
 	res, resDists, err := shard.ObjectVectorSearch(
 		ctx, searchVectors, targetVectors, dist, limit, filters, sort, groupBy, additional, targetCombination, properties)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "shard %s", shard.ID())
 	}
+	i.profileShard(ctx, shard.Name(), i.getSchema.NodeName(), false, start)
 	return res, resDists, nil
 }
 
+// profileShard records the search of a shard that started at the given time
+// if the query is profiled. The context of a local search carries the details
+// annotated while searching.
+func (i *Index) profileShard(ctx context.Context, shardName, node string, remote bool, start time.Time) {
+	profile := queryprofile.FromContext(ctx)
+	if profile == nil {
+		return
+	}
+	shard := queryprofile.Shard{Name: shardName, Node: node, Remote: remote, Took: time.Since(start)}
+	if !remote {
+		shard.Details = helpers.ExtractSlowQueryDetails(ctx)
+	}
+	profile.AddShard(shard)
+}
+
 // to be called after validating multi-tenancy
 func (i *Index) targetShardNames(ctx context.Context, tenant string) ([]string, error) {
 	className := i.Config.ClassName.String()
@@ -1658,16 +1773,28 @@ func (i *Index) localShardSearch(ctx context.Context, searchVectors []models.Vec
 		defer release()
 	}
 
+	return i.localShardSearchWith(ctx, shard, searchVectors, targetVectors, dist, limit, localFilters,
+		sort, groupBy, additionalProps, targetCombination, properties)
+}
+
+// localShardSearchWith searches an already acquired local shard
+func (i *Index) localShardSearchWith(ctx context.Context, shard ShardLike, searchVectors []models.Vector,
+	targetVectors []string, dist float32, limit int, localFilters *filters.LocalFilter,
+	sort []filters.Sort, groupBy *searchparams.GroupBy, additionalProps additional.Properties,
+	targetCombination *dto.TargetCombination, properties []string,
+) ([]*storobj.Object, []float32, error) {
 	localCtx := helpers.InitSlowQueryDetails(ctx)
 	helpers.AnnotateSlowQueryLog(localCtx, "is_coordinator", true)
+	start := time.Now()
 	localShardResult, localShardScores, err := shard.ObjectVectorSearch(
 		localCtx, searchVectors, targetVectors, dist, limit, localFilters, sort, groupBy, additionalProps, targetCombination, properties)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "shard %s", shard.ID())
 	}
+	i.profileShard(localCtx, shard.Name(), i.getSchema.NodeName(), false, start)
 	// Append result to out
 	if i.replicationEnabled() {
-		storobj.AddOwnership(localShardResult, i.getSchema.NodeName(), shardName)
+		storobj.AddOwnership(localShardResult, i.getSchema.NodeName(), shard.Name())
 	}
 	return localShardResult, localShardScores, nil
 }
@@ -1688,6 +1815,7 @@ func (i *Index) remoteShardSearch(ctx context.Context, searchVectors []models.Ve
 		defer release()
 	}
 
+	start := time.Now()
 	if i.Config.ForceFullReplicasSearch {
 		// Force a search on all the replicas for the shard
 		remoteSearchResults, err := i.remote.SearchAllReplicas(ctx,
@@ -1699,6 +1827,7 @@ func (i *Index) remoteShardSearch(ctx context.Context, searchVectors []models.Ve
 		}
 		// Append the result of the search to the outgoing result
 		for _, remoteShardResult := range remoteSearchResults {
+			i.profileShard(ctx, shardName, remoteShardResult.Node, true, start)
 			if i.replicationEnabled() {
 				storobj.AddOwnership(remoteShardResult.Objects, remoteShardResult.Node, shardName)
 			}
@@ -1713,6 +1842,7 @@ func (i *Index) remoteShardSearch(ctx context.Context, searchVectors []models.Ve
 		if err != nil {
 			return nil, nil, errors.Wrapf(err, "remote shard %s", shardName)
 		}
+		i.profileShard(ctx, shardName, nodeName, true, start)
 
 		if i.replicationEnabled() {
 			storobj.AddOwnership(remoteResult, nodeName, shardName)
@@ -1820,29 +1950,51 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVectors []models.V
 		return nil, nil, err
 	}
 
-	// If we are force querying all replicas, we need to run deduplication on the result.
-	if i.Config.ForceFullReplicasSearch {
+	if i.Config.ForceFullReplicasSearch || i.isResharding() {
 		if localSearches != localResponses.Load() {
 			i.logger.Warnf("(in full replica search) local search count does not match local response count: searches=%d responses=%d", localSearches, localResponses.Load())
 		}
 		if remoteSearches != remoteResponses.Load() {
 			i.logger.Warnf("(in full replica search) remote search count does not match remote response count: searches=%d responses=%d", remoteSearches, remoteResponses.Load())
 		}
+	}
+
+	return i.mergeShardVectorResults(ctx, out, dists, len(shardNames), limit, sort, groupBy, replProps)
+}
+
+// mergeShardVectorResults combines the results a vector search found in the
+// given number of shards into the final result
+func (i *Index) mergeShardVectorResults(ctx context.Context, out []*storobj.Object, dists []float32,
+	shardCount, limit int, sort []filters.Sort, groupBy *searchparams.GroupBy,
+	replProps *additional.ReplicationProperties,
+) ([]*storobj.Object, []float32, error) {
+	var err error
+
+	// If we are force querying all replicas, we need to run deduplication on the result.
+	// The same applies while shards are being split or merged, as moving objects are
+	// present in both the source and the target shard.
+	if i.Config.ForceFullReplicasSearch || i.isResharding() {
 		out, dists, err = searchResultDedup(out, dists)
 		if err != nil {
 			return nil, nil, fmt.Errorf("could not deduplicate result after full replicas search: %w", err)
 		}
 	}
 
-	if len(shardNames) == 1 {
+	if shardCount == 1 {
 		return out, dists, nil
 	}
 
-	if len(shardNames) > 1 && groupBy != nil {
-		return i.mergeGroups(out, dists, groupBy, limit, len(shardNames))
+	if shardCount > 1 && groupBy != nil {
+		return i.mergeGroups(out, dists, groupBy, limit, shardCount)
 	}
 
-	if len(shardNames) > 1 && len(sort) > 0 {
+	if shardCount > 1 && len(sort) > 0 {
+		// every shard sorted its own nearest results, the sort needs to be
+		// applied to the nearest results of all shards
+		out, dists = newDistancesSorter().sort(out, dists)
+		if limit > 0 && len(out) > limit {
+			out, dists = out[:limit], dists[:limit]
+		}
 		return i.sort(out, dists, sort, limit)
 	}
 
@@ -1882,20 +2034,8 @@ func (i *Index) IncomingSearch(ctx context.Context, shardName string,
 	ctx = helpers.InitSlowQueryDetails(ctx)
 	helpers.AnnotateSlowQueryLog(ctx, "is_coordinator", false)
 
-	// Hacky fix here
-	// shard.GetStatus() will force a lazy shard to load and we have usecases that rely on that behaviour that a search
-	// will force a lazy loaded shard to load
-	// However we also have cases (related to FORCE_FULL_REPLICAS_SEARCH) where we want to avoid waiting for a shard to
-	// load, therefore we only call GetStatusNoLoad if replication is enabled -> another replica will be able to answer
-	// the request and we want to exit early
-	if i.replicationEnabled() && shard.GetStatusNoLoad() == storagestate.StatusLoading {
-		return nil, nil, enterrors.NewErrUnprocessable(fmt.Errorf("local %s shard is not ready", shardName))
-	} else {
-		if shard.GetStatus() == storagestate.StatusLoading {
-			// This effectively never happens with lazy loaded shard as GetStatus will wait for the lazy shard to load
-			// and then status will never be "StatusLoading"
-			return nil, nil, enterrors.NewErrUnprocessable(fmt.Errorf("local %s shard is not ready", shardName))
-		}
+	if err := i.incomingShardSearchable(shard, shardName); err != nil {
+		return nil, nil, err
 	}
 
 	if len(searchVectors) == 0 {
@@ -1916,6 +2056,54 @@ func (i *Index) IncomingSearch(ctx context.Context, shardName string,
 	return res, resDists, nil
 }
 
+// IncomingSearchBatch executes the vector searches sent together by
+// objectVectorSearchBatch concurrently on a local shard
+func (i *Index) IncomingSearchBatch(ctx context.Context, shardName string,
+	queries []sharding.ShardSearchQuery,
+) ([]sharding.ShardSearchResult, error) {
+	shard, release, err := i.getOrInitShard(ctx, shardName)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	ctx = helpers.InitSlowQueryDetails(ctx)
+	helpers.AnnotateSlowQueryLog(ctx, "is_coordinator", false)
+
+	if err := i.incomingShardSearchable(shard, shardName); err != nil {
+		return nil, err
+	}
+
+	results := shard.ObjectVectorSearchBatch(ctx, queries)
+	for n := range results {
+		if results[n].Err != nil {
+			results[n].Err = errors.Wrapf(results[n].Err, "shard %s", shard.ID())
+		}
+	}
+	return results, nil
+}
+
+// incomingShardSearchable returns an error if a shard searched on behalf of
+// another node is still loading
+func (i *Index) incomingShardSearchable(shard ShardLike, shardName string) error {
+	// Hacky fix here
+	// shard.GetStatus() will force a lazy shard to load and we have usecases that rely on that behaviour that a search
+	// will force a lazy loaded shard to load
+	// However we also have cases (related to FORCE_FULL_REPLICAS_SEARCH) where we want to avoid waiting for a shard to
+	// load, therefore we only call GetStatusNoLoad if replication is enabled -> another replica will be able to answer
+	// the request and we want to exit early
+	if i.replicationEnabled() && shard.GetStatusNoLoad() == storagestate.StatusLoading {
+		return enterrors.NewErrUnprocessable(fmt.Errorf("local %s shard is not ready", shardName))
+	} else {
+		if shard.GetStatus() == storagestate.StatusLoading {
+			// This effectively never happens with lazy loaded shard as GetStatus will wait for the lazy shard to load
+			// and then status will never be "StatusLoading"
+			return enterrors.NewErrUnprocessable(fmt.Errorf("local %s shard is not ready", shardName))
+		}
+	}
+	return nil
+}
+
 func (i *Index) deleteObject(ctx context.Context, id strfmt.UUID,
 	deletionTime time.Time, replProps *additional.ReplicationProperties, tenant string, schemaVersion uint64,
 ) error {
@@ -2186,13 +2374,17 @@ func (i *Index) aggregate(ctx context.Context,
 		var release func()
 		shard, release, err = i.GetShard(ctx, shardName)
 		if err == nil {
+			start := time.Now()
 			if shard != nil {
 				func() {
 					defer release()
-					res, err = shard.Aggregate(ctx, params, modules)
+					localCtx := helpers.InitSlowQueryDetails(ctx)
+					res, err = shard.Aggregate(localCtx, params, modules)
+					i.profileShard(localCtx, shardName, i.getSchema.NodeName(), false, start)
 				}()
 			} else {
 				res, err = i.remote.Aggregate(ctx, shardName, params)
+				i.profileShard(ctx, shardName, "", true, start)
 			}
 		}
 
@@ -2203,7 +2395,7 @@ func (i *Index) aggregate(ctx context.Context,
 		results[j] = res
 	}
 
-	return aggregator.NewShardCombiner().Do(results), nil
+	return aggregator.NewShardCombiner().WithSubAggregation(params.SubAggregation).Do(results), nil
 }
 
 func (i *Index) IncomingAggregate(ctx context.Context, shardName string,
@@ -2420,6 +2612,10 @@ func (i *Index) shardState() *sharding.State {
 	return i.getSchema.CopyShardingState(i.Config.ClassName.String())
 }
 
+func (i *Index) isResharding() bool {
+	return !i.partitioningEnabled && i.getSchema.IsResharding(i.Config.ClassName.String())
+}
+
 func (i *Index) getShardsQueueSize(ctx context.Context, tenant string) (map[string]int64, error) {
 	shardsQueueSize := make(map[string]int64)
 
@@ -137,6 +137,7 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class,
 			AsyncReplicationEnabled:             class.ReplicationConfig.AsyncEnabled,
 			DeletionStrategy:                    class.ReplicationConfig.DeletionStrategy,
 			ShardLoadLimiter:                    m.db.shardLoadLimiter,
+			HintedHandoff:                       m.db.hintedHandoff,
 		},
 		shardState,
 		// no backward-compatibility check required, since newly added classes will
@@ -24,6 +24,10 @@ import (
 	"github.com/weaviate/weaviate/entities/verbosity"
 )
 
+type moduleHealthReporter interface {
+	ModuleHealth() []*models.NodeModuleStatus
+}
+
 // GetNodeStatus returns the status of all Weaviate nodes.
 func (db *DB) GetNodeStatus(ctx context.Context, className string, verbosity string) ([]*models.NodeStatus, error) {
 	nodeStatuses := make([]*models.NodeStatus, len(db.schemaGetter.Nodes()))
@@ -115,6 +119,9 @@ func (db *DB) LocalNodeStatus(ctx context.Context, className, output string) *mo
 		Stats:      nodeStats,
 		BatchStats: db.localNodeBatchStats(),
 	}
+	if db.moduleHealth != nil {
+		status.Modules = db.moduleHealth.ModuleHealth()
+	}
 
 	return &status
 }
@@ -211,6 +218,7 @@ func (i *Index) getShardsNodeStatus(ctx context.Context,
 			Compressed:             compressed,
 			Loaded:                 true,
 			AsyncReplicationStatus: shard.getAsyncReplicationStats(ctx),
+			Revectorization:        shard.revectorizationProgress(),
 		}
 		*status = append(*status, shardStatus)
 		shardCount++
@@ -322,3 +330,55 @@ func (db *DB) getNodeStatistics(ctx context.Context, nodeName string) (*models.S
 	}
 	return statistics, nil
 }
+
+// GetSlowQueries returns the most recent slow queries of all nodes. Nodes
+// which can't be reached are reported instead of failing the request.
+func (db *DB) GetSlowQueries(ctx context.Context, collection string, limit int) (*models.SlowQueriesResponse, error) {
+	nodes := db.schemaGetter.Nodes()
+	nodeQueries := make([][]*models.SlowQuery, len(nodes))
+	unreachable := make([]bool, len(nodes))
+	eg := enterrors.NewErrorGroupWrapper(db.logger)
+	eg.SetLimit(_NUMCPU)
+	for i, nodeName := range nodes {
+		eg.Go(func() error {
+			if db.schemaGetter.NodeName() == nodeName {
+				nodeQueries[i] = db.slowQueries.Entries(collection, limit)
+				return nil
+			}
+			queries, err := db.remoteNode.GetSlowQueries(ctx, nodeName, collection, limit)
+			if err != nil {
+				var errSendHttpRequest *enterrors.ErrSendHttpRequest
+				if errors.As(err, &errSendHttpRequest) || errors.As(err, &enterrors.ErrOpenHttpRequest{}) {
+					unreachable[i] = true
+					return nil
+				}
+				return fmt.Errorf("node: %v: %w", nodeName, err)
+			}
+			nodeQueries[i] = queries
+			return nil
+		}, nodeName)
+	}
+
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	res := &models.SlowQueriesResponse{Queries: []*models.SlowQuery{}}
+	for i, queries := range nodeQueries {
+		if unreachable[i] {
+			res.UnreachableNodes = append(res.UnreachableNodes, nodes[i])
+		}
+		res.Queries = append(res.Queries, queries...)
+	}
+	sort.SliceStable(res.Queries, func(i, j int) bool {
+		return res.Queries[i].Timestamp > res.Queries[j].Timestamp
+	})
+	if len(res.Queries) > limit {
+		res.Queries = res.Queries[:limit]
+	}
+	return res, nil
+}
+
+func (db *DB) IncomingGetSlowQueries(collection string, limit int) []*models.SlowQuery {
+	return db.slowQueries.Entries(collection, limit)
+}
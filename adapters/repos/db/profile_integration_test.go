@@ -0,0 +1,130 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/queryprofile"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+func TestQueryProfile(t *testing.T) {
+	className := "ProfileClass"
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Class:               className,
+		Properties: []*models.Property{
+			{
+				Name:         "parity",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWhitespace,
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: nil}},
+		shardState: multiShardState(),
+	}
+	repo, err := New(logger, Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  t.TempDir(),
+		QueryMaximumResults:       100,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil, memwatch.NewDummyMonitor())
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+	schemaGetter.schema = schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}}
+
+	for i := 0; i < 20; i++ {
+		parity := "even"
+		if i%2 == 1 {
+			parity = "odd"
+		}
+		obj := &models.Object{
+			ID:         strfmt.UUID(fmt.Sprintf("8c8183ae-150d-433f-92b6-ed095b0000%02d", i)),
+			Class:      className,
+			Properties: map[string]interface{}{"parity": parity},
+		}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{float32(i), 1, 1}, nil, nil, nil, 0))
+	}
+	oddFilter := &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorEqual,
+		On:       &filters.Path{Class: schema.ClassName(className), Property: "parity"},
+		Value:    &filters.Value{Value: "odd", Type: schema.DataTypeText},
+	}}
+	shardCount := len(schemaGetter.shardState.AllPhysicalShards())
+
+	t.Run("vector search", func(t *testing.T) {
+		profile := queryprofile.New()
+		ctx := queryprofile.ContextWithProfile(context.Background(), profile)
+		params := dto.GetParams{
+			ClassName:  className,
+			Pagination: &filters.Pagination{Limit: 5},
+			Filters:    oddFilter,
+		}
+		_, err := repo.VectorSearch(ctx, params, []string{""}, []models.Vector{[]float32{3, 1, 1}})
+		require.Nil(t, err)
+
+		shards := profile.Shards()
+		require.Len(t, shards, shardCount)
+		matched := 0
+		for _, shard := range shards {
+			assert.False(t, shard.Remote)
+			assert.Equal(t, "node1", shard.Node)
+			assert.Contains(t, shard.Details, "vector_search_took")
+			assert.Contains(t, shard.Details, "hnsw_flat_search")
+			require.Contains(t, shard.Details, "filters_ids_matched")
+			matched += shard.Details["filters_ids_matched"].(int)
+		}
+		assert.Equal(t, 10, matched)
+	})
+
+	t.Run("aggregate", func(t *testing.T) {
+		profile := queryprofile.New()
+		ctx := queryprofile.ContextWithProfile(context.Background(), profile)
+		_, err := repo.Aggregate(ctx, aggregation.Params{
+			ClassName:        schema.ClassName(className),
+			IncludeMetaCount: true,
+			Filters:          oddFilter,
+		}, nil)
+		require.Nil(t, err)
+		assert.Len(t, profile.Shards(), shardCount)
+	})
+
+	t.Run("not profiled", func(t *testing.T) {
+		params := dto.GetParams{ClassName: className, Pagination: &filters.Pagination{Limit: 5}}
+		_, err := repo.VectorSearch(context.Background(), params, []string{""}, []models.Vector{[]float32{3, 1, 1}})
+		require.Nil(t, err)
+	})
+}
@@ -38,6 +38,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/replica"
 	schemaUC "github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/sharding"
+	"github.com/weaviate/weaviate/usecases/slowquery"
 )
 
 type DB struct {
@@ -48,6 +49,9 @@ type DB struct {
 	indices           map[string]*Index
 	remoteIndex       sharding.RemoteIndexClient
 	replicaClient     replica.Client
+	hintedHandoff     *replica.HintedHandoff
+	slowQueries       *slowquery.Log
+	moduleHealth      moduleHealthReporter
 	nodeResolver      nodeResolver
 	remoteNode        *sharding.RemoteNode
 	promMetrics       *monitoring.PrometheusMetrics
@@ -87,6 +91,12 @@ type DB struct {
 	maxNumberGoroutines int
 	ratePerSecond       atomic.Int64
 
+	// the vector index queue size is cached, so that callers on hot paths do
+	// not walk all shards under the indexLock on every call
+	queueSizeLock    sync.Mutex
+	queueSize        atomic.Int64
+	queueSizeUpdated atomic.Int64
+
 	// in the case of metrics grouping we need to observe some metrics
 	// node-centric, rather than shard-centric
 	metricsObserver *nodeWideMetricsObserver
@@ -120,10 +130,71 @@ func (db *DB) SetRouter(r *router.Router) {
 	db.router = r
 }
 
+// SetHintedHandoff makes the indexes loaded afterwards store hints for the
+// replicas which miss a write
+func (db *DB) SetHintedHandoff(h *replica.HintedHandoff) {
+	db.hintedHandoff = h
+}
+
+// SetSlowQueryLog makes the slow queries of this node retrievable by the
+// other nodes of the cluster
+func (db *DB) SetSlowQueryLog(l *slowquery.Log) {
+	db.slowQueries = l
+}
+
+// SetModuleHealth makes the local node status report the health of the
+// providers the modules call
+func (db *DB) SetModuleHealth(h moduleHealthReporter) {
+	db.moduleHealth = h
+}
+
 func (db *DB) GetScheduler() *queue.Scheduler {
 	return db.scheduler
 }
 
+// vectorIndexQueueSizeInterval is how long the cached vector index queue
+// size is served before the shards are walked again
+var vectorIndexQueueSizeInterval = time.Second
+
+// VectorIndexQueueSize is the number of vectors waiting in the queues of all
+// loaded local shards to be added to their vector indexes. The value is
+// refreshed at most once per vectorIndexQueueSizeInterval, callers in between
+// get the cached value.
+func (db *DB) VectorIndexQueueSize() int64 {
+	if time.Since(time.Unix(0, db.queueSizeUpdated.Load())) < vectorIndexQueueSizeInterval {
+		return db.queueSize.Load()
+	}
+	// only one caller refreshes, the others keep using the previous value
+	if !db.queueSizeLock.TryLock() {
+		return db.queueSize.Load()
+	}
+	defer db.queueSizeLock.Unlock()
+	if time.Since(time.Unix(0, db.queueSizeUpdated.Load())) < vectorIndexQueueSizeInterval {
+		return db.queueSize.Load()
+	}
+
+	size := db.countVectorIndexQueueSize()
+	db.queueSize.Store(size)
+	db.queueSizeUpdated.Store(time.Now().UnixNano())
+	return size
+}
+
+func (db *DB) countVectorIndexQueueSize() int64 {
+	db.indexLock.RLock()
+	defer db.indexLock.RUnlock()
+
+	var size int64
+	for _, index := range db.indices {
+		_ = index.ForEachLoadedShard(func(_ string, shard ShardLike) error {
+			return shard.ForEachVectorQueue(func(_ string, queue *VectorIndexQueue) error {
+				size += queue.Size()
+				return nil
+			})
+		})
+	}
+	return size
+}
+
 func (db *DB) WaitForStartup(ctx context.Context) error {
 	err := db.init(ctx)
 	if err != nil {
@@ -65,3 +65,16 @@ func TestGetIndex(t *testing.T) {
 	idx = db.GetIndex(schema.ClassName("test3"))
 	require.NotNil(t, idx)
 }
+
+func TestVectorIndexQueueSizeIsCached(t *testing.T) {
+	db := &DB{indices: map[string]*Index{}}
+	require.Equal(t, int64(0), db.VectorIndexQueueSize())
+
+	// within the interval the cached value is served without walking the shards
+	db.queueSize.Store(7)
+	require.Equal(t, int64(7), db.VectorIndexQueueSize())
+
+	// once the interval elapsed the size is counted again
+	db.queueSizeUpdated.Store(time.Now().Add(-2 * vectorIndexQueueSizeInterval).UnixNano())
+	require.Equal(t, int64(0), db.VectorIndexQueueSize())
+}
@@ -0,0 +1,321 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/cluster/distributedtask"
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/additional"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+const reshardingBatchSize = 100
+
+// ReshardingProvider executes the distributed tasks moving objects between
+// the local copies of physical shards while they are split or merged. Every
+// node hosting the source shard moves its objects locally, so no data is
+// transferred between nodes.
+//
+// The provider keeps no local state, an interrupted phase is started over.
+// Copying is idempotent, as an object is only written to the target shard if
+// it is newer than the object already present there.
+type ReshardingProvider struct {
+	db     *DB
+	logger logrus.FieldLogger
+
+	mu       sync.Mutex
+	recorder distributedtask.TaskCompletionRecorder
+}
+
+func NewReshardingProvider(db *DB, logger logrus.FieldLogger) *ReshardingProvider {
+	return &ReshardingProvider{
+		db:     db,
+		logger: logger.WithField("action", "resharding"),
+	}
+}
+
+func (p *ReshardingProvider) SetCompletionRecorder(recorder distributedtask.TaskCompletionRecorder) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.recorder = recorder
+}
+
+func (p *ReshardingProvider) GetLocalTasks() []distributedtask.TaskDescriptor {
+	return nil
+}
+
+func (p *ReshardingProvider) CleanupTask(distributedtask.TaskDescriptor) error {
+	return nil
+}
+
+func (p *ReshardingProvider) StartTask(task *distributedtask.Task) (distributedtask.TaskHandle, error) {
+	var payload api.ReshardingTaskPayload
+	if err := json.Unmarshal(task.Payload, &payload); err != nil {
+		return nil, fmt.Errorf("unmarshal resharding task payload: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	enterrors.GoWrapper(func() { p.run(ctx, task, payload) }, p.logger)
+	return &reshardingTaskHandle{cancel: cancel}, nil
+}
+
+func (p *ReshardingProvider) run(ctx context.Context, task *distributedtask.Task, payload api.ReshardingTaskPayload) {
+	logger := p.logger.WithFields(logrus.Fields{
+		"class":        payload.Class,
+		"operation":    payload.Operation,
+		"phase":        payload.Phase,
+		"source_shard": payload.SourceShard,
+		"target_shard": payload.TargetShard,
+	})
+
+	execErr := p.execute(ctx, task, payload)
+	if ctx.Err() != nil {
+		logger.Info("resharding task terminated")
+		return
+	}
+	if execErr != nil {
+		logger.WithError(execErr).Error("resharding task failed")
+	}
+
+	p.mu.Lock()
+	recorder := p.recorder
+	p.mu.Unlock()
+
+	record := func() error {
+		if execErr != nil {
+			return recorder.RecordDistributedTaskNodeFailure(ctx, task.Namespace, task.ID, task.Version, execErr.Error())
+		}
+		return recorder.RecordDistributedTaskNodeCompletion(ctx, task.Namespace, task.ID, task.Version)
+	}
+	if err := backoff.Retry(record, backoff.WithContext(backoff.NewExponentialBackOff(), ctx)); err != nil {
+		logger.WithError(err).Error("record resharding task completion")
+	}
+}
+
+func (p *ReshardingProvider) execute(ctx context.Context, task *distributedtask.Task, payload api.ReshardingTaskPayload) error {
+	idx := p.db.GetIndex(schema.ClassName(payload.Class))
+	if idx == nil {
+		return fmt.Errorf("collection %q not found", payload.Class)
+	}
+	if !idx.shardState().IsLocalShard(payload.SourceShard) {
+		// nothing to move on this node
+		return nil
+	}
+
+	if err := idx.LoadLocalShard(ctx, payload.TargetShard); err != nil {
+		return fmt.Errorf("load target shard %q: %w", payload.TargetShard, err)
+	}
+	source, releaseSource, err := idx.getOrInitShard(ctx, payload.SourceShard)
+	if err != nil {
+		return fmt.Errorf("get source shard %q: %w", payload.SourceShard, err)
+	}
+	defer releaseSource()
+	target, releaseTarget, err := idx.getOrInitShard(ctx, payload.TargetShard)
+	if err != nil {
+		return fmt.Errorf("get target shard %q: %w", payload.TargetShard, err)
+	}
+	defer releaseTarget()
+
+	r := &resharder{
+		source:      source,
+		target:      target,
+		state:       idx.shardState(),
+		virtual:     payload.Virtual,
+		submittedAt: payload.SubmittedAtUnixMillis,
+	}
+
+	switch payload.Phase {
+	case sharding.ReshardingPhaseCopy:
+		return r.copyObjects(ctx, 0, 0)
+	case sharding.ReshardingPhaseCleanup:
+		// the cleanup task is scheduled when the routing is switched to the
+		// target shard, anything written to the target before is a copy
+		return r.cleanup(ctx, task.StartedAt.UnixMilli(), payload.Operation == sharding.ReshardingSplit)
+	default:
+		return fmt.Errorf("unknown resharding phase %q", payload.Phase)
+	}
+}
+
+type reshardingTaskHandle struct {
+	cancel context.CancelFunc
+}
+
+func (h *reshardingTaskHandle) Terminate() {
+	h.cancel()
+}
+
+// resharder moves the objects of a set of virtual shards from a local source
+// shard to a local target shard.
+type resharder struct {
+	source, target ShardLike
+	state          *sharding.State
+	virtual        []string
+	submittedAt    int64
+}
+
+func (r *resharder) moving(id strfmt.UUID) (bool, error) {
+	parsed, err := uuid.Parse(id.String())
+	if err != nil {
+		return false, fmt.Errorf("parse uuid: %q", id.String())
+	}
+	idBytes, _ := parsed.MarshalBinary() // cannot error
+	return slices.Contains(r.virtual, r.state.VirtualShard(idBytes)), nil
+}
+
+// copyObjects writes the moving objects of the source shard to the target
+// shard, unless the target already holds the same or a newer version, or
+// the object was deleted from the target after its last update. Objects
+// missing in the target shard are only written if they were updated at or
+// after since, as older ones have been copied and deleted from the target.
+// A positive until skips objects updated at or after it, those were written
+// to the target directly once the routing switched.
+func (r *resharder) copyObjects(ctx context.Context, since, until int64) error {
+	batch := make([]*storobj.Object, 0, reshardingBatchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		err := errors.Join(r.target.PutObjectBatch(ctx, batch)...)
+		batch = batch[:0]
+		return err
+	}
+
+	err := r.source.Store().Bucket(helpers.ObjectsBucketLSM).IterateObjects(ctx, func(obj *storobj.Object) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if until > 0 && obj.LastUpdateTimeUnix() >= until {
+			return nil
+		}
+		if ok, err := r.moving(obj.ID()); err != nil || !ok {
+			return err
+		}
+
+		deleted, deletionTime, err := r.target.WasDeleted(ctx, obj.ID())
+		if err != nil {
+			return fmt.Errorf("check deletion of object %s in target shard: %w", obj.ID(), err)
+		}
+		if deleted && deletionTime.UnixMilli() >= obj.LastUpdateTimeUnix() {
+			return nil
+		}
+
+		existing, err := r.target.ObjectByID(ctx, obj.ID(), search.SelectProperties{}, additional.Properties{})
+		if err != nil {
+			return fmt.Errorf("get object %s from target shard: %w", obj.ID(), err)
+		}
+		if existing == nil && obj.LastUpdateTimeUnix() < since {
+			return nil
+		}
+		if existing != nil && existing.LastUpdateTimeUnix() >= obj.LastUpdateTimeUnix() {
+			return nil
+		}
+
+		batch = append(batch, obj)
+		if len(batch) < reshardingBatchSize {
+			return nil
+		}
+		return flush()
+	})
+	if err != nil {
+		return fmt.Errorf("copy objects: %w", err)
+	}
+	return flush()
+}
+
+// cleanup catches up the target shard with the changes made to the source
+// shard while objects were copied. Moved objects are removed from the source
+// shard of a split, the source shard of a merge is dropped once the operation
+// is finished.
+func (r *resharder) cleanup(ctx context.Context, committedAt int64, deleteFromSource bool) error {
+	if err := r.copyObjects(ctx, r.submittedAt, committedAt); err != nil {
+		return err
+	}
+
+	// objects deleted from the source shard after they were copied, but before
+	// the routing switched to the target shard
+	var deleted []strfmt.UUID
+	err := r.target.Store().Bucket(helpers.ObjectsBucketLSM).IterateObjects(ctx, func(obj *storobj.Object) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if obj.LastUpdateTimeUnix() >= committedAt {
+			return nil
+		}
+		if ok, err := r.moving(obj.ID()); err != nil || !ok {
+			return err
+		}
+		exists, err := r.source.Exists(ctx, obj.ID())
+		if err != nil {
+			return fmt.Errorf("check object %s in source shard: %w", obj.ID(), err)
+		}
+		if !exists {
+			deleted = append(deleted, obj.ID())
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("find deleted objects: %w", err)
+	}
+	if err := deleteObjects(ctx, r.target, deleted); err != nil {
+		return err
+	}
+
+	if !deleteFromSource {
+		return nil
+	}
+
+	var moved []strfmt.UUID
+	err = r.source.Store().Bucket(helpers.ObjectsBucketLSM).IterateObjects(ctx, func(obj *storobj.Object) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		ok, err := r.moving(obj.ID())
+		if ok {
+			moved = append(moved, obj.ID())
+		}
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("find moved objects: %w", err)
+	}
+	return deleteObjects(ctx, r.source, moved)
+}
+
+// deleteObjects is called once iterating a shard's objects is done, as the
+// cursor blocks flushing the bucket while open.
+func deleteObjects(ctx context.Context, shard ShardLike, ids []strfmt.UUID) error {
+	deletionTime := time.Now()
+	for _, id := range ids {
+		if err := shard.DeleteObject(ctx, id, deletionTime); err != nil {
+			return fmt.Errorf("delete object %s from shard %q: %w", id, shard.Name(), err)
+		}
+	}
+	return nil
+}
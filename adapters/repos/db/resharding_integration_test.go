@@ -0,0 +1,130 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+func TestResharder(t *testing.T) {
+	ctx := context.Background()
+	className := "ReshardingTest"
+	source, idx := testShard(t, ctx, className)
+	target, err := idx.initShard(ctx, "target", &models.Class{Class: className}, nil, false)
+	require.NoError(t, err)
+	idx.shards.Store("target", target)
+
+	state := idx.shardState()
+	moving, err := state.PlanSplit(source.Name())
+	require.NoError(t, err)
+
+	submittedAt := time.Now().UnixMilli()
+	r := &resharder{
+		source:      source,
+		target:      target,
+		state:       state,
+		virtual:     moving,
+		submittedAt: submittedAt,
+	}
+
+	var movingIDs, stayingIDs []strfmt.UUID
+	objs := make([]*storobj.Object, 200)
+	for i := range objs {
+		objs[i] = testObject(className)
+		objs[i].Object.LastUpdateTimeUnix = submittedAt - 1000
+		ok, err := r.moving(objs[i].ID())
+		require.NoError(t, err)
+		if ok {
+			movingIDs = append(movingIDs, objs[i].ID())
+		} else {
+			stayingIDs = append(stayingIDs, objs[i].ID())
+		}
+	}
+	require.NotEmpty(t, movingIDs)
+	require.NotEmpty(t, stayingIDs)
+	for _, err := range source.PutObjectBatch(ctx, objs) {
+		require.NoError(t, err)
+	}
+
+	get := func(shard ShardLike, id strfmt.UUID) *storobj.Object {
+		obj, err := shard.ObjectByID(ctx, id, search.SelectProperties{}, additional.Properties{})
+		require.NoError(t, err)
+		return obj
+	}
+
+	t.Run("copy", func(t *testing.T) {
+		require.NoError(t, r.copyObjects(ctx, 0, 0))
+
+		for _, id := range movingIDs {
+			assert.NotNil(t, get(target, id))
+			assert.NotNil(t, get(source, id))
+		}
+		for _, id := range stayingIDs {
+			assert.Nil(t, get(target, id))
+		}
+	})
+
+	updatedID, deletedID := movingIDs[0], movingIDs[1]
+	deletedFromTargetID, lateID := movingIDs[2], movingIDs[3]
+	t.Run("cleanup", func(t *testing.T) {
+		// changes to the source shard during the copy phase
+		updated := get(source, updatedID)
+		updated.Object.LastUpdateTimeUnix = submittedAt + 1000
+		updated.Object.Properties = map[string]interface{}{"name": "updated"}
+		require.NoError(t, source.PutObject(ctx, updated))
+		require.NoError(t, source.DeleteObject(ctx, deletedID, time.Now()))
+		deletedFromTarget := get(source, deletedFromTargetID)
+		deletedFromTarget.Object.LastUpdateTimeUnix = submittedAt + 1000
+		require.NoError(t, source.PutObject(ctx, deletedFromTarget))
+		// a write that still reached the source after the routing switched
+		late := get(source, lateID)
+		late.Object.LastUpdateTimeUnix = submittedAt + 3000
+		late.Object.Properties = map[string]interface{}{"name": "late"}
+		require.NoError(t, source.PutObject(ctx, late))
+
+		// deleted by a client from the target after the routing switched
+		require.NoError(t, target.DeleteObject(ctx, deletedFromTargetID, time.UnixMilli(submittedAt+2500)))
+
+		require.NoError(t, r.cleanup(ctx, submittedAt+2000, true))
+
+		assert.Nil(t, get(target, deletedFromTargetID), "deleted object must not be copied again")
+		assert.Equal(t, submittedAt-1000, get(target, lateID).LastUpdateTimeUnix(),
+			"objects updated after the commit are not copied")
+
+		assert.Equal(t, submittedAt+1000, get(target, updatedID).LastUpdateTimeUnix())
+		assert.Equal(t, "updated", get(target, updatedID).Properties().(map[string]interface{})["name"])
+		assert.Nil(t, get(target, deletedID))
+		for _, id := range movingIDs {
+			assert.Nil(t, get(source, id))
+			if id != deletedID && id != deletedFromTargetID {
+				assert.NotNil(t, get(target, id))
+			}
+		}
+		for _, id := range stayingIDs {
+			assert.NotNil(t, get(source, id))
+			assert.Nil(t, get(target, id))
+		}
+	})
+}
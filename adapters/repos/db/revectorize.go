@@ -0,0 +1,682 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/cluster/distributedtask"
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+const (
+	revectorizeBatchSize = 100
+	// revectorizeDrainInterval is the time to wait for the vector index queue
+	// to add the vectors queued for the replaced vector index.
+	revectorizeDrainInterval = 100 * time.Millisecond
+)
+
+// objectsRevectorizer embeds objects with vectorizer settings differing from
+// the ones configured for their collection.
+type objectsRevectorizer interface {
+	RevectorizeBatch(ctx context.Context, class *models.Class, targetVector string,
+		vectorizer map[string]interface{}, objects []*models.Object) ([][]float32, error)
+}
+
+// RevectorizeProvider executes the distributed tasks re-embedding the objects
+// of a target vector with new vectorizer settings. Every node embeds the
+// objects of its local shards into a shadow vector index, which replaces the
+// serving vector index once all nodes are done, together with the collection
+// switching to the new settings.
+//
+// Progress is checkpointed in the local state of the shards, so the build
+// phase continues where it stopped when a node restarts.
+type RevectorizeProvider struct {
+	db         *DB
+	vectorizer objectsRevectorizer
+	logger     logrus.FieldLogger
+
+	mu       sync.Mutex
+	recorder distributedtask.TaskCompletionRecorder
+}
+
+func NewRevectorizeProvider(db *DB, vectorizer objectsRevectorizer, logger logrus.FieldLogger) *RevectorizeProvider {
+	return &RevectorizeProvider{
+		db:         db,
+		vectorizer: vectorizer,
+		logger:     logger.WithField("action", "revectorize"),
+	}
+}
+
+func (p *RevectorizeProvider) SetCompletionRecorder(recorder distributedtask.TaskCompletionRecorder) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.recorder = recorder
+}
+
+// GetLocalTasks returns the build tasks with shadow vector indexes on this
+// node. Once the indexes are swapped, the run can no longer be aborted.
+func (p *RevectorizeProvider) GetLocalTasks() []distributedtask.TaskDescriptor {
+	seen := map[distributedtask.TaskDescriptor]struct{}{}
+	var tasks []distributedtask.TaskDescriptor
+	p.forEachLoadedShard(func(className string, shard *Shard) {
+		shard.revectorizeLock.Lock()
+		defer shard.revectorizeLock.Unlock()
+
+		for targetVector, st := range shard.revectorizeStates {
+			if !st.active() || st.Phase != api.RevectorizePhaseBuild {
+				continue
+			}
+			desc := distributedtask.TaskDescriptor{
+				ID:      api.RevectorizeTaskID(className, targetVector, api.RevectorizePhaseBuild),
+				Version: st.TaskVersion,
+			}
+			if _, ok := seen[desc]; !ok {
+				seen[desc] = struct{}{}
+				tasks = append(tasks, desc)
+			}
+		}
+	})
+	return tasks
+}
+
+// CleanupTask drops the shadow vector indexes of an aborted build task. The
+// shadow indexes of a finished build task are kept for the swap task, which
+// is recognized by the collection using the new vectorizer settings.
+func (p *RevectorizeProvider) CleanupTask(desc distributedtask.TaskDescriptor) error {
+	parts := strings.Split(desc.ID, "/")
+	if len(parts) != 3 {
+		return fmt.Errorf("unexpected re-vectorization task id %q", desc.ID)
+	}
+	className, targetVector, phase := parts[0], parts[1], parts[2]
+	if phase != api.RevectorizePhaseBuild {
+		return nil
+	}
+
+	idx := p.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil
+	}
+	class := p.db.schemaGetter.ReadOnlyClass(className)
+
+	ctx := context.Background()
+	return idx.ForEachLoadedShard(func(_ string, shardLike ShardLike) error {
+		shard := loadedShard(shardLike)
+		if shard == nil {
+			return nil
+		}
+		st, ok := shard.getRevectorizeState(targetVector)
+		if !ok || !st.active() || st.swapped() || st.TaskVersion != desc.Version {
+			return nil
+		}
+		if st.Built && vectorizerApplied(class, targetVector, st.Vectorizer) {
+			return nil
+		}
+		return shard.dropShadowVectorIndex(ctx, targetVector)
+	})
+}
+
+func (p *RevectorizeProvider) StartTask(task *distributedtask.Task) (distributedtask.TaskHandle, error) {
+	var payload api.RevectorizeTaskPayload
+	if err := json.Unmarshal(task.Payload, &payload); err != nil {
+		return nil, fmt.Errorf("unmarshal re-vectorization task payload: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	enterrors.GoWrapper(func() { p.run(ctx, task, payload) }, p.logger)
+	return &revectorizeTaskHandle{cancel: cancel}, nil
+}
+
+func (p *RevectorizeProvider) run(ctx context.Context, task *distributedtask.Task, payload api.RevectorizeTaskPayload) {
+	logger := p.logger.WithFields(logrus.Fields{
+		"class":         payload.Class,
+		"target_vector": payload.TargetVector,
+		"phase":         payload.Phase,
+	})
+
+	execErr := p.execute(ctx, task, payload)
+	if ctx.Err() != nil {
+		logger.Info("re-vectorization task terminated")
+		return
+	}
+	if execErr != nil {
+		logger.WithError(execErr).Error("re-vectorization task failed")
+	}
+
+	p.mu.Lock()
+	recorder := p.recorder
+	p.mu.Unlock()
+
+	record := func() error {
+		if execErr != nil {
+			return recorder.RecordDistributedTaskNodeFailure(ctx, task.Namespace, task.ID, task.Version, execErr.Error())
+		}
+		return recorder.RecordDistributedTaskNodeCompletion(ctx, task.Namespace, task.ID, task.Version)
+	}
+	if err := backoff.Retry(record, backoff.WithContext(backoff.NewExponentialBackOff(), ctx)); err != nil {
+		logger.WithError(err).Error("record re-vectorization task completion")
+	}
+}
+
+func (p *RevectorizeProvider) execute(ctx context.Context, task *distributedtask.Task, payload api.RevectorizeTaskPayload) error {
+	idx := p.db.GetIndex(schema.ClassName(payload.Class))
+	if idx == nil {
+		return fmt.Errorf("collection %q not found", payload.Class)
+	}
+	class := p.db.schemaGetter.ReadOnlyClass(payload.Class)
+	if class == nil {
+		return fmt.Errorf("collection %q not found", payload.Class)
+	}
+
+	limiter := rate.NewLimiter(rate.Inf, revectorizeBatchSize)
+	if payload.ObjectsPerSecond > 0 {
+		limiter.SetLimit(rate.Limit(payload.ObjectsPerSecond))
+	}
+
+	return idx.ForEachShard(func(name string, _ ShardLike) error {
+		shard, release, err := loadShard(ctx, idx, name)
+		if err != nil {
+			return err
+		}
+		defer release()
+
+		r := &revectorizer{
+			shard:        shard,
+			class:        class,
+			targetVector: payload.TargetVector,
+			payload:      payload,
+			taskVersion:  task.Version,
+			vectorizer:   p.vectorizer,
+			limiter:      limiter,
+		}
+		switch payload.Phase {
+		case api.RevectorizePhaseBuild:
+			err = r.build(ctx)
+		case api.RevectorizePhaseSwap:
+			err = r.swap(ctx)
+		default:
+			return fmt.Errorf("unknown re-vectorization phase %q", payload.Phase)
+		}
+		if err != nil {
+			return fmt.Errorf("shard %q: %w", name, err)
+		}
+		return nil
+	})
+}
+
+func (p *RevectorizeProvider) forEachLoadedShard(f func(className string, shard *Shard)) {
+	p.db.indexLock.RLock()
+	defer p.db.indexLock.RUnlock()
+
+	for _, idx := range p.db.indices {
+		className := idx.Config.ClassName.String()
+		_ = idx.ForEachLoadedShard(func(_ string, shardLike ShardLike) error {
+			if shard := loadedShard(shardLike); shard != nil {
+				f(className, shard)
+			}
+			return nil
+		})
+	}
+}
+
+// SwapVectorIndex makes the shadow vector indexes built by the
+// re-vectorization submitted at the given time serve the target vector on the
+// local shards. It is applied together with the switch of the collection to
+// the new vectorizer settings.
+func (m *Migrator) SwapVectorIndex(ctx context.Context, class, targetVector string, submittedAt int64) error {
+	idx := m.db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return fmt.Errorf("could not find collection %s", class)
+	}
+
+	return idx.ForEachShard(func(name string, _ ShardLike) error {
+		shard, release, err := loadShard(ctx, idx, name)
+		if err != nil {
+			return err
+		}
+		defer release()
+
+		st, ok := shard.getRevectorizeState(targetVector)
+		if !ok || !st.active() || st.SubmittedAt != submittedAt || st.swapped() {
+			return nil
+		}
+		if !st.Built {
+			return fmt.Errorf("shard %q: shadow vector index of %q is not built", name, targetVector)
+		}
+		if err := shard.swapShadowVectorIndex(ctx, targetVector); err != nil {
+			return fmt.Errorf("shard %q: %w", name, err)
+		}
+		return nil
+	})
+}
+
+// loadShard returns the local shard with the given name, loading a lazy shard.
+func loadShard(ctx context.Context, idx *Index, name string) (*Shard, func(), error) {
+	shardLike, release, err := idx.getOrInitShard(ctx, name)
+	if err != nil {
+		return nil, nil, fmt.Errorf("get shard %q: %w", name, err)
+	}
+	if lazy, ok := shardLike.(*LazyLoadShard); ok {
+		if err := lazy.Load(ctx); err != nil {
+			release()
+			return nil, nil, fmt.Errorf("load shard %q: %w", name, err)
+		}
+	}
+	return loadedShard(shardLike), release, nil
+}
+
+// loadedShard returns the shard behind a loaded lazy shard.
+func loadedShard(shard ShardLike) *Shard {
+	switch s := shard.(type) {
+	case *Shard:
+		return s
+	case *LazyLoadShard:
+		if s.isLoaded() {
+			return s.shard
+		}
+	}
+	return nil
+}
+
+// vectorizerApplied returns whether the target vector of the class uses the
+// vectorizer module config.
+func vectorizerApplied(class *models.Class, targetVector string, vectorizer map[string]interface{}) bool {
+	if class == nil {
+		return false
+	}
+	vectorConfig, ok := class.VectorConfig[targetVector]
+	if !ok {
+		return false
+	}
+	applied, err := json.Marshal(vectorConfig.Vectorizer)
+	if err != nil {
+		return false
+	}
+	expected, err := json.Marshal(vectorizer)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(applied, expected)
+}
+
+type revectorizeTaskHandle struct {
+	cancel context.CancelFunc
+}
+
+func (h *revectorizeTaskHandle) Terminate() {
+	h.cancel()
+}
+
+// revectorizer re-embeds the objects of a local shard for a target vector.
+type revectorizer struct {
+	shard        *Shard
+	class        *models.Class
+	targetVector string
+	payload      api.RevectorizeTaskPayload
+	taskVersion  uint64
+	vectorizer   objectsRevectorizer
+	limiter      *rate.Limiter
+}
+
+// begin returns the shard's state of the run, initializing it unless the
+// run already started on the shard. The shadow index of an aborted earlier
+// run is dropped. It returns false if the run was completed before.
+func (r *revectorizer) begin(ctx context.Context) (revectorizeState, bool, error) {
+	st, _ := r.shard.getRevectorizeState(r.targetVector)
+	if st.Completed == r.payload.SubmittedAtUnixMillis {
+		return st, false, nil
+	}
+	if st.active() && st.SubmittedAt == r.payload.SubmittedAtUnixMillis {
+		return st, true, nil
+	}
+
+	if st.active() {
+		if st.swapped() {
+			return st, false, fmt.Errorf("re-vectorization of %q submitted at %d did not finish swapping",
+				r.targetVector, st.SubmittedAt)
+		}
+		if err := r.shard.dropShadowVectorIndex(ctx, r.targetVector); err != nil {
+			return st, false, fmt.Errorf("drop shadow vector index of aborted run: %w", err)
+		}
+	}
+
+	err := r.shard.updateRevectorizeState(r.targetVector, func(st *revectorizeState) {
+		st.SubmittedAt = r.payload.SubmittedAtUnixMillis
+		st.TaskVersion = r.taskVersion
+		st.Phase = api.RevectorizePhaseBuild
+		st.Vectorizer = r.payload.Vectorizer
+	})
+	if err != nil {
+		return st, false, err
+	}
+	st, _ = r.shard.getRevectorizeState(r.targetVector)
+	if err := r.shard.loadRevectorizeBucket(ctx, st.shadowName(r.targetVector)); err != nil {
+		return st, false, err
+	}
+	return st, true, nil
+}
+
+// build embeds all objects of the shard into the shadow vector index,
+// continuing from the last checkpoint.
+func (r *revectorizer) build(ctx context.Context) error {
+	st, ok, err := r.begin(ctx)
+	if err != nil || !ok || st.Built {
+		return err
+	}
+	shadow, err := r.shard.shadowVectorIndex(ctx, r.targetVector)
+	if err != nil {
+		return err
+	}
+
+	checkpoint, processed := st.Checkpoint, st.Processed
+	for {
+		keys, objs, err := nextObjects(r.shard, checkpoint, revectorizeBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(objs) == 0 {
+			break
+		}
+		if err := r.embed(ctx, shadow, st.shadowName(r.targetVector), objs); err != nil {
+			return err
+		}
+
+		checkpoint, processed = keys[len(keys)-1], processed+int64(len(objs))
+		err = r.shard.updateRevectorizeState(r.targetVector, func(st *revectorizeState) {
+			st.Checkpoint = checkpoint
+			st.Processed = processed
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// objects written with keys before the checkpoint in the meantime
+	if err := r.catchUp(ctx, shadow, st.shadowName(r.targetVector), 0); err != nil {
+		return err
+	}
+	return r.shard.updateRevectorizeState(r.targetVector, func(st *revectorizeState) {
+		st.Built = true
+	})
+}
+
+// swap completes the replacement of the serving vector index with the shadow
+// index. Objects written concurrently to the swap are embedded afterwards,
+// then the new vectors are written to the objects and the replaced index is
+// dropped.
+func (r *revectorizer) swap(ctx context.Context) error {
+	st, ok, err := r.begin(ctx)
+	if err != nil || !ok {
+		return err
+	}
+
+	if !st.swapped() {
+		// the indexes are swapped when the collection switches to the new
+		// vectorizer settings, unless that failed on the shard
+		if err := r.build(ctx); err != nil {
+			return err
+		}
+		if err := r.shard.swapShadowVectorIndex(ctx, r.targetVector); err != nil {
+			return err
+		}
+		st, _ = r.shard.getRevectorizeState(r.targetVector)
+	}
+
+	serving, ok := r.shard.GetVectorIndex(r.targetVector)
+	if !ok {
+		return fmt.Errorf("vector index of %q not found", r.targetVector)
+	}
+	if err := r.catchUp(ctx, serving, st.IndexName, st.SwappedAt); err != nil {
+		return err
+	}
+	if err := r.deleteOrphans(serving); err != nil {
+		return err
+	}
+	if err := r.rewriteObjects(ctx, st.IndexName, st.SwappedAt); err != nil {
+		return err
+	}
+	if err := r.drainReplacedIndex(ctx); err != nil {
+		return err
+	}
+	return r.shard.dropShadowVectorIndex(ctx, r.targetVector)
+}
+
+// drainReplacedIndex waits for the vector index queue to add the vectors
+// queued before the swap to the replaced index, so that it can be dropped.
+func (r *revectorizer) drainReplacedIndex(ctx context.Context) error {
+	queue, ok := r.shard.GetVectorIndexQueue(r.targetVector)
+	if !ok {
+		return fmt.Errorf("vector index queue of %q not found", r.targetVector)
+	}
+
+	for queue.ReplacedTasks() > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(revectorizeDrainInterval):
+		}
+	}
+	// the last dequeued tasks may still be executed
+	queue.Pause()
+	queue.Wait()
+	queue.Resume()
+	return nil
+}
+
+// catchUp embeds the objects missing in the vector index, which were last
+// updated before the given time, if set.
+func (r *revectorizer) catchUp(ctx context.Context, index VectorIndex, indexName string, before int64) error {
+	var after []byte
+	for {
+		keys, objs, err := nextObjects(r.shard, after, revectorizeBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(objs) == 0 {
+			return nil
+		}
+		after = keys[len(keys)-1]
+
+		missing := objs[:0]
+		for _, obj := range objs {
+			if before > 0 && obj.LastUpdateTimeUnix() >= before {
+				continue
+			}
+			if !index.ContainsDoc(obj.DocID) {
+				missing = append(missing, obj)
+			}
+		}
+		if err := r.embed(ctx, index, indexName, missing); err != nil {
+			return err
+		}
+	}
+}
+
+// deleteOrphans removes the documents of objects deleted or updated while
+// the shadow index was built.
+func (r *revectorizer) deleteOrphans(index VectorIndex) error {
+	bucket := r.shard.store.Bucket(helpers.ObjectsBucketLSM)
+
+	var (
+		orphans []uint64
+		iterErr error
+		key     = make([]byte, 8)
+	)
+	index.Iterate(func(docID uint64) bool {
+		binary.LittleEndian.PutUint64(key, docID)
+		obj, err := bucket.GetBySecondary(helpers.ObjectsBucketLSMDocIDSecondaryIndex, key)
+		if err != nil {
+			iterErr = fmt.Errorf("get object of doc id %d: %w", docID, err)
+			return false
+		}
+		if obj == nil {
+			orphans = append(orphans, docID)
+		}
+		return true
+	})
+	if iterErr != nil {
+		return iterErr
+	}
+	if len(orphans) == 0 {
+		return nil
+	}
+	return index.Delete(orphans...)
+}
+
+// rewriteObjects replaces the vectors stored in the objects last updated
+// before the swap with the embedded ones.
+func (r *revectorizer) rewriteObjects(ctx context.Context, indexName string, before int64) error {
+	var after []byte
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		keys, objs, err := nextObjects(r.shard, after, revectorizeBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(objs) == 0 {
+			return nil
+		}
+		after = keys[len(keys)-1]
+
+		for i, obj := range objs {
+			if obj.LastUpdateTimeUnix() >= before {
+				continue
+			}
+			if err := r.rewriteObject(keys[i], obj.DocID, indexName); err != nil {
+				return fmt.Errorf("rewrite object %s: %w", obj.ID(), err)
+			}
+		}
+	}
+}
+
+func (r *revectorizer) rewriteObject(idBytes []byte, docID uint64, indexName string) error {
+	key := make([]byte, 8)
+	binary.LittleEndian.PutUint64(key, docID)
+	vector, err := r.shard.revectorizedVector(indexName, key)
+	if err != nil || vector == nil {
+		return err
+	}
+
+	lock := &r.shard.docIdLock[r.shard.uuidToIdLockPoolId(idBytes)]
+	lock.Lock()
+	defer lock.Unlock()
+
+	bucket := r.shard.store.Bucket(helpers.ObjectsBucketLSM)
+	obj, err := fetchObject(bucket, idBytes)
+	if err != nil {
+		return err
+	}
+	if obj == nil || obj.DocID != docID {
+		// deleted or updated in the meantime
+		return nil
+	}
+
+	if obj.Vectors == nil {
+		obj.Vectors = map[string][]float32{}
+	}
+	obj.Vectors[r.targetVector] = vector
+	data, err := obj.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("marshal object: %w", err)
+	}
+	return r.shard.upsertObjectDataLSM(bucket, idBytes, data, docID)
+}
+
+// embed adds the objects to the vector index with the given name, their
+// vectors are stored until they are written to the objects.
+func (r *revectorizer) embed(ctx context.Context, index VectorIndex, indexName string, objs []*storobj.Object) error {
+	if len(objs) == 0 {
+		return nil
+	}
+	if err := r.limiter.WaitN(ctx, len(objs)); err != nil {
+		return err
+	}
+
+	objects := make([]*models.Object, len(objs))
+	docIDs := make([]uint64, len(objs))
+	for i, obj := range objs {
+		objects[i] = &obj.Object
+		docIDs[i] = obj.DocID
+	}
+	vectors, err := r.vectorizer.RevectorizeBatch(ctx, r.class, r.targetVector, r.payload.Vectorizer, objects)
+	if err != nil {
+		return fmt.Errorf("vectorize objects: %w", err)
+	}
+	if err := r.shard.putRevectorizedVectors(indexName, docIDs, vectors); err != nil {
+		return err
+	}
+
+	// a batch interrupted by a restart may have been added partially
+	ids := make([]uint64, 0, len(docIDs))
+	missing := make([][]float32, 0, len(docIDs))
+	for i, docID := range docIDs {
+		if !index.ContainsDoc(docID) {
+			ids = append(ids, docID)
+			missing = append(missing, vectors[i])
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	if err := index.AddBatch(ctx, ids, missing); err != nil {
+		return fmt.Errorf("add vectors to index %q: %w", indexName, err)
+	}
+	return nil
+}
+
+// nextObjects returns up to limit objects of the shard following the given
+// key in key order, and their keys. The cursor is closed before returning, as
+// it blocks flushing the bucket while open.
+func nextObjects(shard *Shard, after []byte, limit int) ([][]byte, []*storobj.Object, error) {
+	cursor := shard.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+	defer cursor.Close()
+
+	var k, v []byte
+	if after == nil {
+		k, v = cursor.First()
+	} else {
+		k, v = cursor.Seek(after)
+		if bytes.Equal(k, after) {
+			k, v = cursor.Next()
+		}
+	}
+
+	keys := make([][]byte, 0, limit)
+	objs := make([]*storobj.Object, 0, limit)
+	for ; k != nil && len(objs) < limit; k, v = cursor.Next() {
+		obj, err := storobj.FromBinary(v)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unmarshal object: %w", err)
+		}
+		keys = append(keys, bytes.Clone(k))
+		objs = append(objs, obj)
+	}
+	return keys, objs, nil
+}
@@ -0,0 +1,161 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+type fakeObjectsRevectorizer struct {
+	vector []float32
+}
+
+func (f *fakeObjectsRevectorizer) RevectorizeBatch(ctx context.Context, class *models.Class, targetVector string,
+	vectorizer map[string]interface{}, objects []*models.Object,
+) ([][]float32, error) {
+	vectors := make([][]float32, len(objects))
+	for i := range vectors {
+		vectors[i] = f.vector
+	}
+	return vectors, nil
+}
+
+func TestRevectorizer(t *testing.T) {
+	ctx := context.Background()
+	className := "RevectorizeTest"
+	shd, _ := testShardWithSettings(t, ctx, &models.Class{Class: className}, hnsw.UserConfig{}, false, true,
+		func(i *Index) {
+			i.vectorIndexUserConfigs = map[string]schemaConfig.VectorIndexConfig{"foo": hnsw.UserConfig{}}
+		})
+
+	objs := make([]*storobj.Object, 250)
+	for i := range objs {
+		objs[i] = testObject(className)
+		objs[i].Vectors = map[string][]float32{"foo": {1, 2, 3}}
+	}
+	for _, err := range shd.PutObjectBatch(ctx, objs) {
+		require.NoError(t, err)
+	}
+	shard := loadedShard(shd)
+	require.NotNil(t, shard)
+
+	submittedAt := time.Now().UnixMilli()
+	newRevectorizer := func(phase string) *revectorizer {
+		return &revectorizer{
+			shard:        shard,
+			class:        &models.Class{Class: className},
+			targetVector: "foo",
+			payload: api.RevectorizeTaskPayload{
+				Class:                 className,
+				TargetVector:          "foo",
+				Phase:                 phase,
+				Vectorizer:            map[string]interface{}{"my-module": map[string]interface{}{}},
+				SubmittedAtUnixMillis: submittedAt,
+			},
+			taskVersion: 1,
+			vectorizer:  &fakeObjectsRevectorizer{vector: []float32{1, 2, 3, 4}},
+			limiter:     rate.NewLimiter(rate.Inf, revectorizeBatchSize),
+		}
+	}
+
+	t.Run("build", func(t *testing.T) {
+		require.NoError(t, newRevectorizer(api.RevectorizePhaseBuild).build(ctx))
+
+		st, ok := shard.getRevectorizeState("foo")
+		require.True(t, ok)
+		assert.True(t, st.Built)
+		assert.Equal(t, int64(len(objs)), st.Processed)
+
+		shadow, err := shard.shadowVectorIndex(ctx, "foo")
+		require.NoError(t, err)
+		for _, obj := range objs {
+			stored, err := shard.ObjectByID(ctx, obj.ID(), nil, additional.Properties{Vectors: []string{"foo"}})
+			require.NoError(t, err)
+			assert.True(t, shadow.ContainsDoc(stored.DocID))
+			assert.Equal(t, []float32{1, 2, 3}, stored.Vectors["foo"])
+		}
+
+		progress := shard.revectorizationProgress()
+		require.Len(t, progress, 1)
+		assert.Equal(t, "foo", progress[0].TargetVector)
+		assert.Equal(t, api.RevectorizePhaseBuild, progress[0].Phase)
+	})
+
+	// written after the shadow index was built
+	added := testObject(className)
+	added.Vectors = map[string][]float32{"foo": {1, 2, 3}}
+	// written with the new vectorizer settings after the swap
+	late := testObject(className)
+	late.Vectors = map[string][]float32{"foo": {4, 3, 2, 1}}
+
+	t.Run("swap with the vectorizer switch", func(t *testing.T) {
+		require.NoError(t, shd.PutObject(ctx, added))
+
+		require.NoError(t, shard.swapShadowVectorIndex(ctx, "foo"))
+
+		st, ok := shard.getRevectorizeState("foo")
+		require.True(t, ok)
+		assert.True(t, st.swapped())
+		assert.Equal(t, api.RevectorizePhaseSwap, st.Phase)
+		assert.Equal(t, "foo~1", shard.vectorIndexName("foo"))
+
+		late.Object.LastUpdateTimeUnix = st.SwappedAt
+		require.NoError(t, shd.PutObject(ctx, late))
+	})
+
+	t.Run("swap", func(t *testing.T) {
+		require.NoError(t, newRevectorizer(api.RevectorizePhaseSwap).swap(ctx))
+
+		st, ok := shard.getRevectorizeState("foo")
+		require.True(t, ok)
+		assert.False(t, st.active())
+		assert.Equal(t, submittedAt, st.Completed)
+		assert.Equal(t, "foo~1", shard.vectorIndexName("foo"))
+		assert.Empty(t, shard.revectorizationProgress())
+		assert.Nil(t, shard.store.Bucket(revectorizeBucketName("foo~1")))
+
+		index, ok := shard.GetVectorIndex("foo")
+		require.True(t, ok)
+		for _, obj := range append(objs, added) {
+			stored, err := shard.ObjectByID(ctx, obj.ID(), nil, additional.Properties{Vectors: []string{"foo"}})
+			require.NoError(t, err)
+			assert.True(t, index.ContainsDoc(stored.DocID))
+			assert.Equal(t, []float32{1, 2, 3, 4}, stored.Vectors["foo"])
+		}
+
+		stored, err := shard.ObjectByID(ctx, late.ID(), nil, additional.Properties{Vectors: []string{"foo"}})
+		require.NoError(t, err)
+		assert.True(t, index.ContainsDoc(stored.DocID))
+		assert.Equal(t, []float32{4, 3, 2, 1}, stored.Vectors["foo"])
+	})
+
+	t.Run("completed runs are skipped", func(t *testing.T) {
+		require.NoError(t, newRevectorizer(api.RevectorizePhaseBuild).build(ctx))
+		assert.Empty(t, shard.revectorizationProgress())
+		assert.Equal(t, "foo~1", shard.vectorIndexName("foo"))
+	})
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/weaviate/weaviate/adapters/repos/db/refcache"
+	"github.com/weaviate/weaviate/adapters/repos/db/sorter"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/dto"
@@ -48,6 +49,32 @@ func (db *DB) Aggregate(ctx context.Context,
 	return idx.aggregate(ctx, params, modules)
 }
 
+// TargetVectorDimensions returns the length of the vectors of the target
+// vector indexed by the loaded local shards of the class, zero if none are
+// indexed yet.
+func (db *DB) TargetVectorDimensions(ctx context.Context, className, targetVector string) (int, error) {
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return 0, nil
+	}
+
+	dims := 0
+	err := idx.ForEachLoadedShard(func(name string, shard ShardLike) error {
+		if dims > 0 {
+			return nil
+		}
+		index, ok := shard.GetVectorIndex(targetVector)
+		if !ok {
+			return nil
+		}
+		if index, ok := index.(DimensionsVectorIndex); ok {
+			dims = index.Dimensions()
+		}
+		return ctx.Err()
+	})
+	return dims, err
+}
+
 func (db *DB) GetQueryMaximumResults() int {
 	return int(db.config.QueryMaximumResults)
 }
@@ -113,6 +140,16 @@ func (db *DB) VectorSearch(ctx context.Context,
 		return results, err
 	}
 
+	if batch := traverser.SearchBatchFromContext(ctx); batch != nil {
+		s := &traverser.BatchedVectorSearch{
+			Params:        params,
+			TargetVectors: targetVectors,
+			SearchVectors: searchVectors,
+		}
+		batch.Search(s, db.vectorSearchBatch)
+		return s.Results, s.Err
+	}
+
 	totalLimit, err := db.getTotalLimit(params.Pagination, params.AdditionalProperties)
 	if err != nil {
 		return nil, fmt.Errorf("invalid pagination params: %w", err)
@@ -131,6 +168,12 @@ func (db *DB) VectorSearch(ctx context.Context,
 		return nil, errors.Wrapf(err, "object vector search at index %s", idx.ID())
 	}
 
+	return db.vectorSearchResults(ctx, params, totalLimit, res, dists)
+}
+
+func (db *DB) vectorSearchResults(ctx context.Context, params dto.GetParams, totalLimit int,
+	res []*storobj.Object, dists []float32,
+) ([]search.Result, error) {
 	if totalLimit < 0 {
 		params.Pagination.Limit = len(res)
 	}
@@ -350,6 +393,16 @@ func (db *DB) ResolveReferences(ctx context.Context, objs search.Results,
 	return res, nil
 }
 
+// SortResults orders search results which were already retrieved, for
+// example the fused results of a hybrid search
+func (db *DB) SortResults(objs search.Results, sort []filters.Sort) (search.Results, error) {
+	res, err := sorter.NewSearchResultsSorter(db.schemaGetter.ReadOnlyClass).Sort(objs, sort)
+	if err != nil {
+		return nil, fmt.Errorf("sort search results: %w", err)
+	}
+	return res, nil
+}
+
 func (db *DB) validateSort(sort []filters.Sort) error {
 	if len(sort) > 0 {
 		var errorMsgs []string
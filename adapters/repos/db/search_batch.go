@@ -0,0 +1,523 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	entsentry "github.com/weaviate/weaviate/entities/sentry"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/sharding"
+	"github.com/weaviate/weaviate/usecases/traverser"
+)
+
+// vectorSearchBatch executes the vector searches collected by a search batch.
+// The searches of an index are fanned out together, see
+// objectVectorSearchBatch.
+func (db *DB) vectorSearchBatch(ctx context.Context, searches []*traverser.BatchedVectorSearch) {
+	type indexBatch struct {
+		idx      *Index
+		searches []*traverser.BatchedVectorSearch
+		limits   []int
+		queries  []vectorSearchQuery
+	}
+
+	var batches []*indexBatch
+	byClass := map[string]*indexBatch{}
+	for _, s := range searches {
+		totalLimit, err := db.getTotalLimit(s.Params.Pagination, s.Params.AdditionalProperties)
+		if err != nil {
+			s.Err = fmt.Errorf("invalid pagination params: %w", err)
+			continue
+		}
+
+		b, ok := byClass[s.Params.ClassName]
+		if !ok {
+			idx := db.GetIndex(schema.ClassName(s.Params.ClassName))
+			if idx == nil {
+				s.Err = fmt.Errorf("tried to browse non-existing index for %s", s.Params.ClassName)
+				continue
+			}
+			b = &indexBatch{idx: idx}
+			byClass[s.Params.ClassName] = b
+			batches = append(batches, b)
+		}
+
+		b.searches = append(b.searches, s)
+		b.limits = append(b.limits, totalLimit)
+		b.queries = append(b.queries, vectorSearchQuery{
+			searchVectors:     s.SearchVectors,
+			targetVectors:     s.TargetVectors,
+			dist:              extractDistanceFromParams(s.Params),
+			limit:             totalLimit,
+			filters:           s.Params.Filters,
+			sort:              s.Params.Sort,
+			groupBy:           s.Params.GroupBy,
+			additional:        s.Params.AdditionalProperties,
+			replProps:         s.Params.ReplicationProperties,
+			tenant:            s.Params.Tenant,
+			targetCombination: s.Params.TargetVectorCombination,
+			properties:        s.Params.Properties.GetPropertyNames(),
+		})
+	}
+
+	for _, b := range batches {
+		results := b.idx.objectVectorSearchBatch(ctx, b.queries)
+		for n, s := range b.searches {
+			if err := results[n].err; err != nil {
+				s.Err = errors.Wrapf(err, "object vector search at index %s", b.idx.ID())
+				continue
+			}
+			s.Results, s.Err = db.vectorSearchResults(ctx, s.Params, b.limits[n],
+				results[n].objects, results[n].dists)
+		}
+	}
+}
+
+// vectorSearchQuery holds the arguments of a vector search of an index
+type vectorSearchQuery struct {
+	searchVectors     []models.Vector
+	targetVectors     []string
+	dist              float32
+	limit             int
+	filters           *filters.LocalFilter
+	sort              []filters.Sort
+	groupBy           *searchparams.GroupBy
+	additional        additional.Properties
+	replProps         *additional.ReplicationProperties
+	tenant            string
+	targetCombination *dto.TargetCombination
+	properties        []string
+}
+
+func (q vectorSearchQuery) shardQuery() sharding.ShardSearchQuery {
+	return sharding.ShardSearchQuery{
+		Vectors:           q.searchVectors,
+		TargetVectors:     q.targetVectors,
+		Distance:          q.dist,
+		Limit:             q.limit,
+		Filters:           q.filters,
+		Sort:              q.sort,
+		GroupBy:           q.groupBy,
+		Additional:        q.additional,
+		TargetCombination: q.targetCombination,
+		Properties:        q.properties,
+	}
+}
+
+type vectorSearchResult struct {
+	objects []*storobj.Object
+	dists   []float32
+	err     error
+	// shards is the number of shards the query was executed on
+	shards int
+}
+
+// objectVectorSearchBatch executes many vector searches with a single fan-out
+// to the shards. Every local shard is acquired once and evaluates the queries
+// targeting it together, see Shard.ObjectVectorSearchBatch, a remote shard
+// gets all queries targeting it in a single request. The results of every
+// query are merged like the ones of objectVectorSearch.
+func (i *Index) objectVectorSearchBatch(ctx context.Context, queries []vectorSearchQuery) []vectorSearchResult {
+	results := make([]vectorSearchResult, len(queries))
+
+	var shardNames []string
+	shardQueries := map[string][]int{}
+	for n, q := range queries {
+		if err := i.validateMultiTenancy(q.tenant); err != nil {
+			results[n].err = err
+			continue
+		}
+		names, err := i.targetShardNames(ctx, q.tenant)
+		if err != nil {
+			results[n].err = err
+			continue
+		}
+		results[n].shards = len(names)
+		for _, name := range names {
+			if _, ok := shardQueries[name]; !ok {
+				shardNames = append(shardNames, name)
+			}
+			shardQueries[name] = append(shardQueries[name], n)
+		}
+	}
+
+	m := &sync.Mutex{}
+	collect := func(n int, objs []*storobj.Object, dists []float32, err error) {
+		m.Lock()
+		defer m.Unlock()
+		if err != nil {
+			if results[n].err == nil {
+				results[n].err = err
+			}
+			return
+		}
+		results[n].objects = append(results[n].objects, objs...)
+		results[n].dists = append(results[n].dists, dists...)
+	}
+
+	eg := enterrors.NewErrorGroupWrapper(i.logger)
+	eg.SetLimit(_NUMCPU * 2)
+
+	for _, sn := range shardNames {
+		shardName := sn
+		targeting := shardQueries[shardName]
+
+		shard, release, err := i.GetShard(ctx, shardName)
+		if err != nil {
+			for _, n := range targeting {
+				collect(n, nil, nil, err)
+			}
+			continue
+		}
+
+		if shard != nil {
+			eg.Go(func() error {
+				defer release()
+				i.localShardSearchBatch(ctx, shard, queries, targeting, collect)
+				return nil
+			})
+		}
+
+		switch {
+		case i.Config.ForceFullReplicasSearch:
+			// every replica is searched, which a batch request does not support
+			for _, n := range targeting {
+				n := n
+				eg.Go(func() error {
+					q := queries[n]
+					objs, dists, err := i.remoteShardSearch(ctx, q.searchVectors, q.targetVectors,
+						q.dist, q.limit, q.filters, q.sort, q.groupBy, q.additional, q.targetCombination, q.properties, shardName)
+					if err != nil {
+						err = fmt.Errorf("remote shard object search %s: %w", shardName, err)
+					}
+					collect(n, objs, dists, err)
+					return nil
+				})
+			}
+		case shard == nil:
+			eg.Go(func() error {
+				i.remoteShardSearchBatch(ctx, shardName, queries, targeting, collect)
+				return nil
+			})
+		}
+	}
+	eg.Wait()
+
+	for n, q := range queries {
+		r := &results[n]
+		if r.err != nil || r.shards == 0 {
+			continue
+		}
+		r.objects, r.dists, r.err = i.mergeShardVectorResults(ctx, r.objects, r.dists,
+			r.shards, q.limit, q.sort, q.groupBy, q.replProps)
+	}
+	return results
+}
+
+// localShardSearchBatch searches a local shard with all queries targeting it,
+// see Shard.ObjectVectorSearchBatch
+func (i *Index) localShardSearchBatch(ctx context.Context, shard ShardLike, queries []vectorSearchQuery,
+	targeting []int, collect func(n int, objs []*storobj.Object, dists []float32, err error),
+) {
+	batch := make([]sharding.ShardSearchQuery, len(targeting))
+	for k, n := range targeting {
+		batch[k] = queries[n].shardQuery()
+	}
+
+	localCtx := helpers.InitSlowQueryDetails(ctx)
+	helpers.AnnotateSlowQueryLog(localCtx, "is_coordinator", true)
+	start := time.Now()
+	results := shard.ObjectVectorSearchBatch(localCtx, batch)
+	i.profileShard(localCtx, shard.Name(), i.getSchema.NodeName(), false, start)
+
+	for k, n := range targeting {
+		r := results[k]
+		if r.Err != nil {
+			collect(n, nil, nil, fmt.Errorf("local shard object search %s: %w", shard.ID(), r.Err))
+			continue
+		}
+		if i.replicationEnabled() {
+			storobj.AddOwnership(r.Objects, i.getSchema.NodeName(), shard.Name())
+		}
+		collect(n, r.Objects, r.Scores, nil)
+	}
+}
+
+// remoteShardSearchBatch sends the queries targeting a remote shard in a
+// single request and collects the result of every query
+func (i *Index) remoteShardSearchBatch(ctx context.Context, shardName string, queries []vectorSearchQuery,
+	targeting []int, collect func(n int, objs []*storobj.Object, dists []float32, err error),
+) {
+	batch := make([]sharding.ShardSearchQuery, len(targeting))
+	for k, n := range targeting {
+		batch[k] = queries[n].shardQuery()
+	}
+
+	start := time.Now()
+	results, node, err := i.remote.SearchShardBatch(ctx, shardName, batch)
+	if err != nil {
+		err = fmt.Errorf("remote shard object search %s: %w", shardName, err)
+		for _, n := range targeting {
+			collect(n, nil, nil, err)
+		}
+		return
+	}
+	i.profileShard(ctx, shardName, node, true, start)
+
+	for k, n := range targeting {
+		r := results[k]
+		if r.Err != nil {
+			collect(n, nil, nil, fmt.Errorf("remote shard object search %s: %w", shardName, r.Err))
+			continue
+		}
+		if i.replicationEnabled() {
+			storobj.AddOwnership(r.Objects, node, shardName)
+		}
+		collect(n, r.Objects, r.Scores, nil)
+	}
+}
+
+// ObjectVectorSearchBatch runs many vector searches on the shard and returns
+// the result of every query. Queries with equal filters share their allow
+// list. The searches of queries which target the same vector with the same
+// allow list are evaluated together if the vector index implements
+// BatchVectorIndex, see its SearchByVectorBatch. Searches by distance and
+// multi vector searches run on their own.
+func (s *Shard) ObjectVectorSearchBatch(ctx context.Context, queries []sharding.ShardSearchQuery) []sharding.ShardSearchResult {
+	s.activityTracker.Add(1)
+	results := make([]sharding.ShardSearchResult, len(queries))
+
+	queryFilters, release := s.vectorSearchBatchFilters(ctx, queries)
+	defer release()
+
+	type vectorSearch struct {
+		query, target int
+	}
+	// vectorBatch holds the searches of a target vector sharing an allow list
+	type vectorBatch struct {
+		targetVector string
+		filter       *batchFilter
+		searches     []vectorSearch
+		vectors      [][]float32
+		limit        int
+	}
+
+	var batches []*vectorBatch
+	var single []vectorSearch
+	idss := make([][][]uint64, len(queries))
+	distss := make([][][]float32, len(queries))
+	for n, q := range queries {
+		if f := queryFilters[n]; f != nil && f.err != nil {
+			results[n].Err = f.err
+			continue
+		}
+		if len(q.Vectors) != len(q.TargetVectors) {
+			results[n].Err = fmt.Errorf("got %d search vectors for %d target vectors",
+				len(q.Vectors), len(q.TargetVectors))
+			continue
+		}
+		idss[n] = make([][]uint64, len(q.TargetVectors))
+		distss[n] = make([][]float32, len(q.TargetVectors))
+
+		for t, targetVector := range q.TargetVectors {
+			vector, ok := q.Vectors[t].([]float32)
+			if !ok || q.Limit < 0 {
+				single = append(single, vectorSearch{query: n, target: t})
+				continue
+			}
+
+			var batch *vectorBatch
+			for _, b := range batches {
+				if b.targetVector == targetVector && b.filter == queryFilters[n] {
+					batch = b
+					break
+				}
+			}
+			if batch == nil {
+				batch = &vectorBatch{targetVector: targetVector, filter: queryFilters[n]}
+				batches = append(batches, batch)
+			}
+			batch.searches = append(batch.searches, vectorSearch{query: n, target: t})
+			batch.vectors = append(batch.vectors, vector)
+			batch.limit = max(batch.limit, q.Limit)
+		}
+	}
+
+	m := &sync.Mutex{}
+	setResult := func(search vectorSearch, ids []uint64, dists []float32, err error) {
+		m.Lock()
+		defer m.Unlock()
+		if err != nil {
+			if results[search.query].Err == nil {
+				results[search.query].Err = err
+			}
+			return
+		}
+		idss[search.query][search.target] = ids
+		distss[search.query][search.target] = dists
+	}
+	searchSingle := func(search vectorSearch) {
+		q := queries[search.query]
+		ids, dists, err := s.searchTargetVector(ctx, q.TargetVectors[search.target],
+			q.Vectors[search.target], q.Distance, q.Limit, queryFilters[search.query].getAllowList())
+		setResult(search, ids, dists, err)
+	}
+
+	eg := enterrors.NewErrorGroupWrapper(s.index.logger)
+	eg.SetLimit(_NUMCPU)
+	beforeVector := time.Now()
+
+	for _, b := range batches {
+		b := b
+		vidx, ok := s.GetVectorIndex(b.targetVector)
+		batchIndex, canBatch := vidx.(BatchVectorIndex)
+		if !ok || !canBatch || len(b.searches) == 1 {
+			for _, search := range b.searches {
+				search := search
+				eg.Go(func() error {
+					searchSingle(search)
+					return nil
+				})
+			}
+			continue
+		}
+
+		eg.Go(func() error {
+			batchIDs, batchDists, err := batchIndex.SearchByVectorBatch(ctx, b.vectors, b.limit, b.filter.getAllowList())
+			if err != nil {
+				err = fmt.Errorf("vector search: %w", err)
+				entsentry.CaptureException(fmt.Errorf("collection %q shard %q: %w",
+					s.index.Config.ClassName, s.name, err))
+			}
+			for k, search := range b.searches {
+				if err != nil {
+					setResult(search, nil, nil, err)
+					continue
+				}
+				// the batch is searched with the largest limit of its queries
+				ids, dists := batchIDs[k], batchDists[k]
+				if limit := queries[search.query].Limit; len(ids) > limit {
+					ids, dists = ids[:limit], dists[:limit]
+				}
+				setResult(search, ids, dists, nil)
+			}
+			return nil
+		})
+	}
+	for _, search := range single {
+		search := search
+		eg.Go(func() error {
+			searchSingle(search)
+			return nil
+		})
+	}
+	eg.Wait()
+
+	eg = enterrors.NewErrorGroupWrapper(s.index.logger)
+	eg.SetLimit(_NUMCPU)
+	for n := range queries {
+		if results[n].Err != nil {
+			continue
+		}
+		n := n
+		eg.Go(func() error {
+			q := queries[n]
+			for t := range idss[n] {
+				if len(idss[n][t]) == 0 {
+					idss[n][t], distss[n][t] = nil, nil
+				}
+			}
+			results[n].Objects, results[n].Scores, results[n].Err = s.vectorSearchObjects(ctx,
+				idss[n], distss[n], beforeVector, q.Vectors, q.TargetVectors, q.Distance, q.Limit,
+				q.Filters != nil, q.Sort, q.GroupBy, q.Additional, q.TargetCombination, q.Properties)
+			return nil
+		})
+	}
+	eg.Wait()
+
+	return results
+}
+
+// batchFilter is the allow list of the queries of a batch with equal filters
+type batchFilter struct {
+	query     int
+	allowList helpers.AllowList
+	err       error
+}
+
+func (f *batchFilter) getAllowList() helpers.AllowList {
+	if f == nil {
+		return nil
+	}
+	return f.allowList
+}
+
+// vectorSearchBatchFilters builds the allow lists of the queries of a batch.
+// Queries with equal filters share the same batchFilter, so its allow list is
+// only built once. The filter of an unfiltered query is nil. release closes
+// all allow lists.
+func (s *Shard) vectorSearchBatchFilters(ctx context.Context, queries []sharding.ShardSearchQuery,
+) (queryFilters []*batchFilter, release func()) {
+	var distinct []*batchFilter
+	queryFilters = make([]*batchFilter, len(queries))
+	for n, q := range queries {
+		if q.Filters == nil {
+			continue
+		}
+		for _, f := range distinct {
+			other := queries[f.query]
+			if reflect.DeepEqual(other.Filters, q.Filters) && reflect.DeepEqual(other.Additional, q.Additional) {
+				queryFilters[n] = f
+				break
+			}
+		}
+		if queryFilters[n] == nil {
+			queryFilters[n] = &batchFilter{query: n}
+			distinct = append(distinct, queryFilters[n])
+		}
+	}
+
+	eg := enterrors.NewErrorGroupWrapper(s.index.logger)
+	eg.SetLimit(_NUMCPU)
+	for _, f := range distinct {
+		f := f
+		eg.Go(func() error {
+			q := queries[f.query]
+			f.allowList, f.err = s.vectorSearchAllowList(ctx, q.Filters, q.Additional)
+			return nil
+		})
+	}
+	eg.Wait()
+
+	return queryFilters, func() {
+		for _, f := range distinct {
+			if f.allowList != nil {
+				f.allowList.Close()
+			}
+		}
+	}
+}
@@ -0,0 +1,160 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+	"github.com/weaviate/weaviate/usecases/traverser"
+)
+
+func TestVectorSearchBatch(t *testing.T) {
+	className := "SearchBatchClass"
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Class:               className,
+		Properties: []*models.Property{
+			{
+				Name:         "parity",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWhitespace,
+			},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: nil}},
+		shardState: multiShardState(),
+	}
+	repo, err := New(logger, Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  t.TempDir(),
+		QueryMaximumResults:       100,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil, memwatch.NewDummyMonitor())
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+	schemaGetter.schema = schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}}
+
+	for i := 0; i < 30; i++ {
+		parity := "even"
+		if i%2 == 1 {
+			parity = "odd"
+		}
+		obj := &models.Object{
+			ID:         strfmt.UUID(fmt.Sprintf("7c8183ae-150d-433f-92b6-ed095b0000%02d", i)),
+			Class:      className,
+			Properties: map[string]interface{}{"parity": parity},
+		}
+		vec := []float32{float32(i), float32(30 - i), 1}
+		require.Nil(t, repo.PutObject(context.Background(), obj, vec, nil, nil, nil, 0))
+	}
+
+	oddFilter := func() *filters.LocalFilter {
+		return &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On:       &filters.Path{Class: schema.ClassName(className), Property: "parity"},
+			Value:    &filters.Value{Value: "odd", Type: schema.DataTypeText},
+		}}
+	}
+	queries := []dto.GetParams{
+		{ClassName: className, Pagination: &filters.Pagination{Limit: 5}},
+		{ClassName: className, Pagination: &filters.Pagination{Limit: 3, Offset: 2}},
+		{ClassName: className, Pagination: &filters.Pagination{Limit: 4}, Filters: oddFilter()},
+		{ClassName: className, Pagination: &filters.Pagination{Limit: 10}},
+		// shares the allow list of the other filtered query
+		{ClassName: className, Pagination: &filters.Pagination{Limit: 2}, Filters: oddFilter()},
+	}
+	// no vector is at the same distance to two objects, ties are merged in
+	// the order the shards answer in
+	vectors := [][]float32{{0, 30, 1}, {15.5, 14.5, 1}, {29, 1, 1}, {10, 20, 1}, {20.3, 9.7, 1}}
+
+	ids := func(res []search.Result) []strfmt.UUID {
+		out := make([]strfmt.UUID, len(res))
+		for i := range res {
+			out[i] = res[i].ID
+		}
+		return out
+	}
+
+	expected := make([][]strfmt.UUID, len(queries))
+	for i := range queries {
+		res, err := repo.VectorSearch(context.Background(), queries[i], []string{""}, []models.Vector{vectors[i]})
+		require.Nil(t, err)
+		require.NotEmpty(t, res)
+		expected[i] = ids(res)
+	}
+
+	ctx, batch := traverser.ContextWithSearchBatch(context.Background(), len(queries))
+	actual := make([][]strfmt.UUID, len(queries))
+	errs := make([]error, len(queries))
+	wg := sync.WaitGroup{}
+	for i := range queries {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer batch.Done()
+			res, err := repo.VectorSearch(ctx, queries[i], []string{""}, []models.Vector{vectors[i]})
+			actual[i], errs[i] = ids(res), err
+		}()
+	}
+	wg.Wait()
+
+	for i := range queries {
+		require.Nil(t, errs[i])
+		assert.Equal(t, expected[i], actual[i], "query %d", i)
+	}
+
+	t.Run("errors are reported per query", func(t *testing.T) {
+		ctx, batch := traverser.ContextWithSearchBatch(context.Background(), 2)
+		var validErr, invalidErr error
+		wg := sync.WaitGroup{}
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			defer batch.Done()
+			_, validErr = repo.VectorSearch(ctx, queries[0], []string{""}, []models.Vector{vectors[0]})
+		}()
+		go func() {
+			defer wg.Done()
+			defer batch.Done()
+			params := dto.GetParams{ClassName: className, Pagination: &filters.Pagination{Limit: 1000}}
+			_, invalidErr = repo.VectorSearch(ctx, params, []string{""}, []models.Vector{vectors[0]})
+		}()
+		wg.Wait()
+
+		assert.Nil(t, validErr)
+		assert.Error(t, invalidErr)
+	})
+}
@@ -54,6 +54,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/replica/hashtree"
+	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 const IdLockPoolSize = 128
@@ -83,6 +84,8 @@ type ShardLike interface {
 	Exists(ctx context.Context, id strfmt.UUID) (bool, error)
 	ObjectSearch(ctx context.Context, limit int, filters *filters.LocalFilter, keywordRanking *searchparams.KeywordRanking, sort []filters.Sort, cursor *filters.Cursor, additional additional.Properties, properties []string) ([]*storobj.Object, []float32, error)
 	ObjectVectorSearch(ctx context.Context, searchVectors []models.Vector, targetVectors []string, targetDist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties, targetCombination *dto.TargetCombination, properties []string) ([]*storobj.Object, []float32, error)
+	ObjectVectorSearchBatch(ctx context.Context, queries []sharding.ShardSearchQuery) []sharding.ShardSearchResult
+	ExportObjects(ctx context.Context, filter *filters.LocalFilter, after strfmt.UUID, limit int, addl additional.Properties, fn func([]*storobj.Object) error) error
 	UpdateVectorIndexConfig(ctx context.Context, updated schemaConfig.VectorIndexConfig) error
 	UpdateVectorIndexConfigs(ctx context.Context, updated map[string]schemaConfig.VectorIndexConfig) error
 	AddReferencesBatch(ctx context.Context, refs objects.BatchReferences) []error
@@ -168,6 +171,8 @@ type ShardLike interface {
 	addTargetNodeOverride(ctx context.Context, targetNodeOverride additional.AsyncReplicationTargetNodeOverride) error
 	// getAsyncReplicationStats returns all current sync replication stats for this node/shard
 	getAsyncReplicationStats(ctx context.Context) []*models.AsyncReplicationStatus
+	// revectorizationProgress returns the progress of the ongoing re-vectorizations of target vectors
+	revectorizationProgress() []*models.RevectorizationProgress
 
 	Metrics() *Metrics
 
@@ -208,6 +213,11 @@ type Shard struct {
 	vectorIndexes map[string]VectorIndex
 	queues        map[string]*VectorIndexQueue
 
+	// re-vectorization of target vectors, see shard_revectorize.go
+	revectorizeLock   sync.Mutex
+	revectorizeStates map[string]*revectorizeState
+	shadowIndexes     map[string]VectorIndex
+
 	// async replication
 	asyncReplicationRWMux      sync.RWMutex
 	asyncReplicationConfig     asyncReplicationConfig
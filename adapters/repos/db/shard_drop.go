@@ -114,6 +114,10 @@ func (s *Shard) drop() (err error) {
 		return err
 	}
 
+	if err = s.dropShadowVectorIndexes(ctx); err != nil {
+		return err
+	}
+
 	// delete property length tracker
 	err = s.GetPropertyLengthTracker().Drop()
 	if err != nil {
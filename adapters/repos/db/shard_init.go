@@ -129,6 +129,10 @@ func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
 		return nil, errors.Wrapf(err, "init shard %q", s.ID())
 	}
 
+	if err = s.loadRevectorizeStates(ctx); err != nil {
+		return nil, fmt.Errorf("load re-vectorization state: %w", err)
+	}
+
 	if err = s.initShardVectors(ctx); err != nil {
 		return nil, fmt.Errorf("init shard vectors: %w", err)
 	}
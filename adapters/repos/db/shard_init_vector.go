@@ -47,6 +47,14 @@ func (s *Shard) initShardVectors(ctx context.Context) error {
 
 func (s *Shard) initVectorIndex(ctx context.Context,
 	targetVector string, vectorIndexUserConfig schemaConfig.VectorIndexConfig,
+) (VectorIndex, error) {
+	return s.initNamedVectorIndex(ctx, s.vectorIndexName(targetVector), vectorIndexUserConfig)
+}
+
+// initNamedVectorIndex initializes the vector index with the given name. It
+// is the target vector, unless the target vector was re-vectorized.
+func (s *Shard) initNamedVectorIndex(ctx context.Context,
+	targetVector string, vectorIndexUserConfig schemaConfig.VectorIndexConfig,
 ) (VectorIndex, error) {
 	var distProv distancer.Provider
 
@@ -49,6 +49,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/objects"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/replica/hashtree"
+	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 type LazyLoadShard struct {
@@ -259,6 +260,26 @@ func (l *LazyLoadShard) ObjectVectorSearch(ctx context.Context, searchVectors []
 	return l.shard.ObjectVectorSearch(ctx, searchVectors, targetVectors, targetDist, limit, filters, sort, groupBy, additional, targetCombination, properties)
 }
 
+func (l *LazyLoadShard) ObjectVectorSearchBatch(ctx context.Context, queries []sharding.ShardSearchQuery) []sharding.ShardSearchResult {
+	if err := l.Load(ctx); err != nil {
+		results := make([]sharding.ShardSearchResult, len(queries))
+		for n := range results {
+			results[n].Err = err
+		}
+		return results
+	}
+	return l.shard.ObjectVectorSearchBatch(ctx, queries)
+}
+
+func (l *LazyLoadShard) ExportObjects(ctx context.Context, filter *filters.LocalFilter, after strfmt.UUID,
+	limit int, addl additional.Properties, fn func([]*storobj.Object) error,
+) error {
+	if err := l.Load(ctx); err != nil {
+		return err
+	}
+	return l.shard.ExportObjects(ctx, filter, after, limit, addl, fn)
+}
+
 func (l *LazyLoadShard) UpdateVectorIndexConfig(ctx context.Context, updated schemaConfig.VectorIndexConfig) error {
 	if err := l.Load(ctx); err != nil {
 		return err
@@ -295,6 +316,13 @@ func (l *LazyLoadShard) getAsyncReplicationStats(ctx context.Context) []*models.
 	return l.shard.getAsyncReplicationStats(ctx)
 }
 
+func (l *LazyLoadShard) revectorizationProgress() []*models.RevectorizationProgress {
+	if !l.isLoaded() {
+		return nil
+	}
+	return l.shard.revectorizationProgress()
+}
+
 func (l *LazyLoadShard) AddReferencesBatch(ctx context.Context, refs objects.BatchReferences) []error {
 	if err := l.Load(ctx); err != nil {
 		return []error{err}
@@ -224,6 +224,14 @@ func (s *Shard) vectorByIndexID(ctx context.Context, indexID uint64, targetVecto
 func (s *Shard) readVectorByIndexIDIntoSlice(ctx context.Context, indexID uint64, container *common.VectorSlice, targetVector string) ([]float32, error) {
 	binary.LittleEndian.PutUint64(container.Buff8, indexID)
 
+	if revectorized, ok := revectorizedTargetVector(targetVector); ok {
+		vector, err := s.revectorizedVector(targetVector, container.Buff8)
+		if err != nil || vector != nil {
+			return vector, err
+		}
+		targetVector = revectorized
+	}
+
 	bytes, newBuff, err := s.store.Bucket(helpers.ObjectsBucketLSM).
 		GetBySecondaryIntoMemory(0, container.Buff8, container.Buff)
 	if err != nil {
@@ -335,6 +343,16 @@ func (s *Shard) ObjectSearch(ctx context.Context, limit int, filters *filters.Lo
 			cursor, additional, s.index.Config.ClassName)
 		return objs, nil, err
 	}
+	if cursor != nil {
+		// only exports combine a cursor with filters, the cursor API rejects it
+		allowList, err := s.buildAllowList(ctx, filters, additional)
+		if err != nil {
+			return nil, nil, err
+		}
+		defer allowList.Close()
+		objs, err := s.cursorObjectList(ctx, cursor, allowList, additional, s.index.Config.ClassName)
+		return objs, nil, err
+	}
 	objs, err := inverted.NewSearcher(s.index.logger, s.store, s.index.getSchema.ReadOnlyClass,
 		s.propertyIndices, s.index.classSearcher, s.index.stopwords, s.versioner.Version(),
 		s.isFallbackToSearchable, s.tenant(), s.index.Config.QueryNestedRefLimit, s.bitmapFactory).
@@ -393,18 +411,12 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors []models.V
 
 	s.activityTracker.Add(1)
 
-	var allowList helpers.AllowList
-	if filters != nil {
-		beforeFilter := time.Now()
-		list, err := s.buildAllowList(ctx, filters, additional)
-		if err != nil {
-			return nil, nil, err
-		}
-		allowList = list
-		took := time.Since(beforeFilter)
-		s.metrics.FilteredVectorFilter(took)
-		helpers.AnnotateSlowQueryLog(ctx, "filters_build_allow_list_took", took)
-		helpers.AnnotateSlowQueryLog(ctx, "filters_ids_matched", allowList.Len())
+	allowList, err := s.vectorSearchAllowList(ctx, filters, additional)
+	if err != nil {
+		return nil, nil, err
+	}
+	if allowList != nil {
+		defer allowList.Close()
 	}
 
 	eg := enterrors.NewErrorGroupWrapper(s.index.logger)
@@ -417,73 +429,9 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors []models.V
 		i := i
 		targetVector := targetVector
 		eg.Go(func() error {
-			var (
-				ids   []uint64
-				dists []float32
-				err   error
-			)
-
-			vidx, ok := s.GetVectorIndex(targetVector)
-			if !ok {
-				return fmt.Errorf("index for target vector %q not found", targetVector)
-			}
-
-			if limit < 0 {
-				switch searchVector := searchVectors[i].(type) {
-				case []float32:
-					ids, dists, err = vidx.SearchByVectorDistance(
-						ctx, searchVector, targetDist, s.index.Config.QueryMaximumResults, allowList)
-					if err != nil {
-						// This should normally not fail. A failure here could indicate that more
-						// attention is required, for example because data is corrupted. That's
-						// why this error is explicitly pushed to sentry.
-						err = fmt.Errorf("vector search by distance: %w", err)
-						entsentry.CaptureException(err)
-						return err
-					}
-				case [][]float32:
-					ids, dists, err = vidx.SearchByMultiVectorDistance(
-						ctx, searchVector, targetDist, s.index.Config.QueryMaximumResults, allowList)
-					if err != nil {
-						// This should normally not fail. A failure here could indicate that more
-						// attention is required, for example because data is corrupted. That's
-						// why this error is explicitly pushed to sentry.
-						err = fmt.Errorf("multi vector search by distance: %w", err)
-						entsentry.CaptureException(err)
-						return err
-					}
-				default:
-					return fmt.Errorf("vector search by distance: unsupported type: %T", searchVectors[i])
-				}
-			} else {
-				switch searchVector := searchVectors[i].(type) {
-				case []float32:
-					ids, dists, err = vidx.SearchByVector(ctx, searchVector, limit, allowList)
-					if err != nil {
-						// This should normally not fail. A failure here could indicate that more
-						// attention is required, for example because data is corrupted. That's
-						// why this error is explicitly pushed to sentry.
-						err = fmt.Errorf("vector search: %w", err)
-						// annotate for sentry so we know which collection/shard this happened on
-						entsentry.CaptureException(fmt.Errorf("collection %q shard %q: %w",
-							s.index.Config.ClassName, s.name, err))
-						return err
-					}
-				case [][]float32:
-					ids, dists, err = vidx.SearchByMultiVector(ctx, searchVector, limit, allowList)
-					if err != nil {
-						// This should normally not fail. A failure here could indicate that more
-						// attention is required, for example because data is corrupted. That's
-						// why this error is explicitly pushed to sentry.
-						err = fmt.Errorf("multi vector search: %w", err)
-						// annotate for sentry so we know which collection/shard this happened on
-						entsentry.CaptureException(fmt.Errorf("collection %q shard %q: %w",
-							s.index.Config.ClassName, s.name, err))
-						return err
-					}
-				default:
-					return fmt.Errorf("vector search: unsupported type: %T", searchVectors[i])
-				}
+			ids, dists, err := s.searchTargetVector(ctx, targetVector, searchVectors[i], targetDist, limit, allowList)
+			if err != nil {
+				return err
 			}
 			if len(ids) == 0 {
 				return nil
@@ -498,16 +446,120 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors []models.V
 	if err := eg.Wait(); err != nil {
 		return nil, nil, err
 	}
-	if allowList != nil {
-		defer allowList.Close()
+
+	return s.vectorSearchObjects(ctx, idss, distss, beforeVector, searchVectors, targetVectors, targetDist, limit,
+		filters != nil, sort, groupBy, additional, targetCombination, properties)
+}
+
+// vectorSearchAllowList builds the allow list of a vector search, it is nil
+// if the search is not filtered
+func (s *Shard) vectorSearchAllowList(ctx context.Context, filters *filters.LocalFilter,
+	additional additional.Properties,
+) (helpers.AllowList, error) {
+	if filters == nil {
+		return nil, nil
 	}
 
+	beforeFilter := time.Now()
+	allowList, err := s.buildAllowList(ctx, filters, additional)
+	if err != nil {
+		return nil, err
+	}
+	took := time.Since(beforeFilter)
+	s.metrics.FilteredVectorFilter(took)
+	helpers.AnnotateSlowQueryLog(ctx, "filters_build_allow_list_took", took)
+	helpers.AnnotateSlowQueryLog(ctx, "filters_ids_matched", allowList.Len())
+	return allowList, nil
+}
+
+// searchTargetVector searches the vector index of a single target vector
+func (s *Shard) searchTargetVector(ctx context.Context, targetVector string, searchVector models.Vector,
+	targetDist float32, limit int, allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	var (
+		ids   []uint64
+		dists []float32
+		err   error
+	)
+
+	vidx, ok := s.GetVectorIndex(targetVector)
+	if !ok {
+		return nil, nil, fmt.Errorf("index for target vector %q not found", targetVector)
+	}
+
+	if limit < 0 {
+		switch searchVector := searchVector.(type) {
+		case []float32:
+			ids, dists, err = vidx.SearchByVectorDistance(
+				ctx, searchVector, targetDist, s.index.Config.QueryMaximumResults, allowList)
+			if err != nil {
+				// This should normally not fail. A failure here could indicate that more
+				// attention is required, for example because data is corrupted. That's
+				// why this error is explicitly pushed to sentry.
+				err = fmt.Errorf("vector search by distance: %w", err)
+				entsentry.CaptureException(err)
+				return nil, nil, err
+			}
+		case [][]float32:
+			ids, dists, err = vidx.SearchByMultiVectorDistance(
+				ctx, searchVector, targetDist, s.index.Config.QueryMaximumResults, allowList)
+			if err != nil {
+				// This should normally not fail. A failure here could indicate that more
+				// attention is required, for example because data is corrupted. That's
+				// why this error is explicitly pushed to sentry.
+				err = fmt.Errorf("multi vector search by distance: %w", err)
+				entsentry.CaptureException(err)
+				return nil, nil, err
+			}
+		default:
+			return nil, nil, fmt.Errorf("vector search by distance: unsupported type: %T", searchVector)
+		}
+	} else {
+		switch searchVector := searchVector.(type) {
+		case []float32:
+			ids, dists, err = vidx.SearchByVector(ctx, searchVector, limit, allowList)
+			if err != nil {
+				// This should normally not fail. A failure here could indicate that more
+				// attention is required, for example because data is corrupted. That's
+				// why this error is explicitly pushed to sentry.
+				err = fmt.Errorf("vector search: %w", err)
+				// annotate for sentry so we know which collection/shard this happened on
+				entsentry.CaptureException(fmt.Errorf("collection %q shard %q: %w",
+					s.index.Config.ClassName, s.name, err))
+				return nil, nil, err
+			}
+		case [][]float32:
+			ids, dists, err = vidx.SearchByMultiVector(ctx, searchVector, limit, allowList)
+			if err != nil {
+				// This should normally not fail. A failure here could indicate that more
+				// attention is required, for example because data is corrupted. That's
+				// why this error is explicitly pushed to sentry.
+				err = fmt.Errorf("multi vector search: %w", err)
+				// annotate for sentry so we know which collection/shard this happened on
+				entsentry.CaptureException(fmt.Errorf("collection %q shard %q: %w",
+					s.index.Config.ClassName, s.name, err))
+				return nil, nil, err
+			}
+		default:
+			return nil, nil, fmt.Errorf("vector search: unsupported type: %T", searchVector)
+		}
+	}
+	return ids, dists, nil
+}
+
+// vectorSearchObjects combines the results of the target vectors of a vector
+// search, groups or sorts them and resolves the objects
+func (s *Shard) vectorSearchObjects(ctx context.Context, idss [][]uint64, distss [][]float32,
+	beforeVector time.Time, searchVectors []models.Vector, targetVectors []string, targetDist float32,
+	limit int, filtered bool, sort []filters.Sort, groupBy *searchparams.GroupBy,
+	additional additional.Properties, targetCombination *dto.TargetCombination, properties []string,
+) ([]*storobj.Object, []float32, error) {
 	idsCombined, distCombined, err := CombineMultiTargetResults(ctx, s, s.index.logger, idss, distss, targetVectors, searchVectors, targetCombination, limit, targetDist)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	if filters != nil {
+	if filtered {
 		s.metrics.FilteredVectorVector(time.Since(beforeVector))
 	}
 	helpers.AnnotateSlowQueryLog(ctx, "vector_search_took", time.Since(beforeVector))
@@ -528,7 +580,7 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors []models.V
 			return nil, nil, errors.Wrap(err, "vector search sort")
 		}
 		took := time.Since(beforeSort)
-		if filters != nil {
+		if filtered {
 			s.metrics.FilteredVectorSort(took)
 		}
 		helpers.AnnotateSlowQueryLog(ctx, "sort_took", took)
@@ -543,7 +595,7 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors []models.V
 	}
 
 	took := time.Since(beforeObjects)
-	if filters != nil {
+	if filtered {
 		s.metrics.FilteredVectorObjects(took)
 	}
 
@@ -565,11 +617,13 @@ func (s *Shard) ObjectList(ctx context.Context, limit int, sort []filters.Sort,
 	if cursor == nil {
 		cursor = &filters.Cursor{After: "", Limit: limit}
 	}
-	return s.cursorObjectList(ctx, cursor, additional, className)
+	return s.cursorObjectList(ctx, cursor, nil, additional, className)
 }
 
+// cursorObjectList lists the objects after the cursor in the order of their
+// ids, skipping those not contained in allowList if it is set
 func (s *Shard) cursorObjectList(ctx context.Context, c *filters.Cursor,
-	additional additional.Properties,
+	allowList helpers.AllowList, additional additional.Properties,
 	className schema.ClassName,
 ) ([]*storobj.Object, error) {
 	cursor := s.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
@@ -594,6 +648,19 @@ func (s *Shard) cursorObjectList(ctx context.Context, c *filters.Cursor,
 	out := make([]*storobj.Object, c.Limit)
 
 	for ; key != nil && i < c.Limit; key, val = cursor.Next() {
+		if allowList != nil {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			docID, err := storobj.DocIDFromBinary(val)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unmarhsal doc id of item %d", i)
+			}
+			if !allowList.Contains(docID) {
+				continue
+			}
+		}
+
 		obj, err := storobj.FromBinary(val)
 		if err != nil {
 			return nil, errors.Wrapf(err, "unmarhsal item %d", i)
@@ -606,6 +673,91 @@ func (s *Shard) cursorObjectList(ctx context.Context, c *filters.Cursor,
 	return out[:i], nil
 }
 
+// ExportObjects passes the objects matching filter to fn page by page, in the
+// order of their ids starting after the given one. The allow list of the filter
+// is built once for the whole walk. The objects bucket cursor is reopened for
+// every page, so that a slow consumer of the pages does not keep it open.
+func (s *Shard) ExportObjects(ctx context.Context, filter *filters.LocalFilter, after strfmt.UUID,
+	limit int, addl additional.Properties, fn func([]*storobj.Object) error,
+) error {
+	s.activityTracker.Add(1)
+
+	var allowList helpers.AllowList
+	var exportList *exportAllowList
+	if filter != nil {
+		exportList = &exportAllowList{}
+		if err := exportList.build(ctx, s, filter, addl); err != nil {
+			return err
+		}
+		defer exportList.Close()
+		allowList = exportList
+	}
+
+	rebuilt := false
+	for {
+		objs, err := s.cursorObjectList(ctx, &filters.Cursor{After: after.String(), Limit: limit},
+			allowList, addl, schema.ClassName(s.index.Config.ClassName))
+		if err != nil {
+			return err
+		}
+		if exportList != nil && exportList.skippedNewer && !rebuilt {
+			// the page skipped an object written after the allow list was
+			// built, it is read again with a fresh allow list
+			if err := exportList.build(ctx, s, filter, addl); err != nil {
+				return err
+			}
+			rebuilt = true
+			continue
+		}
+		rebuilt = false
+
+		if len(objs) == 0 {
+			return nil
+		}
+		if err := fn(objs); err != nil {
+			return err
+		}
+		if len(objs) < limit {
+			return nil
+		}
+		after = objs[len(objs)-1].ID()
+	}
+}
+
+// exportAllowList is the allow list of an export. Objects written after it
+// was built are not contained in it, whether the walk skipped one of them is
+// recorded in skippedNewer.
+type exportAllowList struct {
+	helpers.AllowList
+	builtAt      uint64
+	skippedNewer bool
+}
+
+func (l *exportAllowList) build(ctx context.Context, s *Shard, filter *filters.LocalFilter,
+	addl additional.Properties,
+) error {
+	builtAt := s.counter.Get()
+	list, err := s.buildAllowList(ctx, filter, addl)
+	if err != nil {
+		return err
+	}
+	if l.AllowList != nil {
+		l.AllowList.Close()
+	}
+	l.AllowList, l.builtAt, l.skippedNewer = list, builtAt, false
+	return nil
+}
+
+func (l *exportAllowList) Contains(id uint64) bool {
+	if l.AllowList.Contains(id) {
+		return true
+	}
+	if id >= l.builtAt {
+		l.skippedNewer = true
+	}
+	return false
+}
+
 func (s *Shard) sortedObjectList(ctx context.Context, limit int, sort []filters.Sort, className schema.ClassName) ([]uint64, error) {
 	lsmSorter, err := sorter.NewLSMSorter(s.store, s.index.getSchema.ReadOnlyClass, className)
 	if err != nil {
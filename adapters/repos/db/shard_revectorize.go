@@ -0,0 +1,422 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+const (
+	revectorizeStateFile = "revectorize.json"
+
+	// shadowVectorSeparator separates the target vector from the generation in
+	// the name of a vector index built by a re-vectorization.
+	shadowVectorSeparator = "~"
+)
+
+// revectorizeState is the local state of the re-vectorization of a target
+// vector of the shard. It is persisted, so that an interrupted run continues
+// from its last checkpoint after a restart.
+type revectorizeState struct {
+	// IndexName is the name of the vector index serving the target vector. It
+	// is empty until the target vector is re-vectorized for the first time.
+	IndexName string `json:"indexName,omitempty"`
+	// Generation is the number of completed re-vectorizations.
+	Generation int `json:"generation"`
+	// Completed identifies the last completed run by its submission time.
+	Completed int64 `json:"completed,omitempty"`
+
+	// The fields below describe the ongoing run, if any.
+	SubmittedAt int64  `json:"submittedAt,omitempty"`
+	TaskVersion uint64 `json:"taskVersion,omitempty"`
+	Phase       string `json:"phase,omitempty"`
+	Built       bool   `json:"built,omitempty"`
+	// Vectorizer is the module config the objects are re-embedded with.
+	Vectorizer map[string]interface{} `json:"vectorizer,omitempty"`
+	// Checkpoint is the key of the last object embedded in the build phase.
+	Checkpoint []byte `json:"checkpoint,omitempty"`
+	Processed  int64  `json:"processed,omitempty"`
+	// SwappedAt is the time the shadow index replaced the serving one.
+	SwappedAt int64 `json:"swappedAt,omitempty"`
+	// Previous is the name of the vector index replaced by the swap.
+	Previous string `json:"previous,omitempty"`
+}
+
+func (st *revectorizeState) active() bool {
+	return st.SubmittedAt != 0
+}
+
+func (st *revectorizeState) swapped() bool {
+	return st.SwappedAt != 0
+}
+
+// shadowName returns the name of the vector index the ongoing run embeds the
+// objects into.
+func (st *revectorizeState) shadowName(targetVector string) string {
+	return targetVector + shadowVectorSeparator + strconv.Itoa(st.Generation+1)
+}
+
+func (st *revectorizeState) servingName(targetVector string) string {
+	if st.IndexName == "" {
+		return targetVector
+	}
+	return st.IndexName
+}
+
+func (st *revectorizeState) complete(targetVector string) {
+	*st = revectorizeState{
+		IndexName:  st.shadowName(targetVector),
+		Generation: st.Generation + 1,
+		Completed:  st.SubmittedAt,
+	}
+}
+
+// revectorizeBucketName is the bucket holding the vectors embedded for the
+// vector index with the given name, until they are written to the objects.
+func revectorizeBucketName(indexName string) string {
+	return "revectorize_" + indexName
+}
+
+func (s *Shard) revectorizeStatePath() string {
+	return filepath.Join(s.path(), revectorizeStateFile)
+}
+
+// loadRevectorizeStates has to be called before the vector indexes are
+// initialized, as re-vectorized target vectors are served by indexes named
+// differently.
+func (s *Shard) loadRevectorizeStates(ctx context.Context) error {
+	s.revectorizeLock.Lock()
+	defer s.revectorizeLock.Unlock()
+
+	s.revectorizeStates = map[string]*revectorizeState{}
+	s.shadowIndexes = map[string]VectorIndex{}
+
+	data, err := os.ReadFile(s.revectorizeStatePath())
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("read re-vectorization state: %w", err)
+	}
+	if err := json.Unmarshal(data, &s.revectorizeStates); err != nil {
+		return fmt.Errorf("unmarshal re-vectorization state: %w", err)
+	}
+
+	for targetVector, st := range s.revectorizeStates {
+		if !st.active() {
+			continue
+		}
+		if err := s.loadRevectorizeBucket(ctx, st.shadowName(targetVector)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Shard) persistRevectorizeStatesWithLock() error {
+	data, err := json.Marshal(s.revectorizeStates)
+	if err != nil {
+		return fmt.Errorf("marshal re-vectorization state: %w", err)
+	}
+
+	path := s.revectorizeStatePath()
+	if err := os.WriteFile(path+".tmp", data, 0o600); err != nil {
+		return fmt.Errorf("write re-vectorization state: %w", err)
+	}
+	if err := os.Rename(path+".tmp", path); err != nil {
+		return fmt.Errorf("rename re-vectorization state: %w", err)
+	}
+	return nil
+}
+
+// vectorIndexName returns the name of the vector index serving the target
+// vector.
+func (s *Shard) vectorIndexName(targetVector string) string {
+	s.revectorizeLock.Lock()
+	defer s.revectorizeLock.Unlock()
+
+	if st, ok := s.revectorizeStates[targetVector]; ok {
+		return st.servingName(targetVector)
+	}
+	return targetVector
+}
+
+func (s *Shard) getRevectorizeState(targetVector string) (revectorizeState, bool) {
+	s.revectorizeLock.Lock()
+	defer s.revectorizeLock.Unlock()
+
+	st, ok := s.revectorizeStates[targetVector]
+	if !ok {
+		return revectorizeState{}, false
+	}
+	return *st, true
+}
+
+func (s *Shard) updateRevectorizeState(targetVector string, update func(st *revectorizeState)) error {
+	s.revectorizeLock.Lock()
+	defer s.revectorizeLock.Unlock()
+
+	st, ok := s.revectorizeStates[targetVector]
+	if !ok {
+		st = &revectorizeState{}
+		s.revectorizeStates[targetVector] = st
+	}
+	update(st)
+	return s.persistRevectorizeStatesWithLock()
+}
+
+// shadowVectorIndex returns the vector index of the target vector that is
+// not serving queries during a re-vectorization. That is the index being
+// built until the swap, and the replaced one afterwards.
+func (s *Shard) shadowVectorIndex(ctx context.Context, targetVector string) (VectorIndex, error) {
+	s.revectorizeLock.Lock()
+	defer s.revectorizeLock.Unlock()
+
+	if index, ok := s.shadowIndexes[targetVector]; ok {
+		return index, nil
+	}
+
+	st, ok := s.revectorizeStates[targetVector]
+	if !ok || !st.active() {
+		return nil, fmt.Errorf("no re-vectorization of %q in progress", targetVector)
+	}
+	name := st.shadowName(targetVector)
+	if st.swapped() {
+		name = st.Previous
+	}
+
+	index, err := s.initNamedVectorIndex(ctx, name, s.index.GetVectorIndexConfig(targetVector))
+	if err != nil {
+		return nil, fmt.Errorf("init vector index %q: %w", name, err)
+	}
+	s.shadowIndexes[targetVector] = index
+	return index, nil
+}
+
+// swapShadowVectorIndex makes the shadow index serve the target vector, the
+// replaced index becomes the shadow index to be dropped. Vectors queued before
+// the swap were embedded for the replaced index, the queue still adds them to
+// it.
+func (s *Shard) swapShadowVectorIndex(ctx context.Context, targetVector string) error {
+	shadow, err := s.shadowVectorIndex(ctx, targetVector)
+	if err != nil {
+		return err
+	}
+	queue, ok := s.GetVectorIndexQueue(targetVector)
+	if !ok {
+		return fmt.Errorf("vector index queue of %q not found", targetVector)
+	}
+	queue.Pause()
+	defer queue.Resume()
+	queue.Wait()
+
+	s.vectorIndexMu.Lock()
+	defer s.vectorIndexMu.Unlock()
+	s.revectorizeLock.Lock()
+	defer s.revectorizeLock.Unlock()
+
+	st := s.revectorizeStates[targetVector]
+	if st == nil || !st.active() || st.swapped() {
+		return fmt.Errorf("no shadow vector index of %q to swap", targetVector)
+	}
+	serving, ok := s.vectorIndexes[targetVector]
+	if !ok {
+		return fmt.Errorf("vector index of %q not found", targetVector)
+	}
+
+	previous := *st
+	st.Phase = api.RevectorizePhaseSwap
+	st.Previous = st.servingName(targetVector)
+	st.IndexName = st.shadowName(targetVector)
+	st.SwappedAt = time.Now().UnixMilli()
+	if err := s.persistRevectorizeStatesWithLock(); err != nil {
+		*st = previous
+		return err
+	}
+
+	s.vectorIndexes[targetVector] = shadow
+	s.shadowIndexes[targetVector] = serving
+	queue.SwapIndex(shadow)
+	return nil
+}
+
+// dropShadowVectorIndex drops the shadow index and the embedded vectors of
+// the ongoing run. Once the indexes were swapped, the run is completed.
+// Otherwise it is aborted, and the target vector stays as it was.
+func (s *Shard) dropShadowVectorIndex(ctx context.Context, targetVector string) error {
+	index, err := s.shadowVectorIndex(ctx, targetVector)
+	if err != nil {
+		return err
+	}
+	if err := index.Drop(ctx); err != nil {
+		return fmt.Errorf("drop vector index: %w", err)
+	}
+
+	s.revectorizeLock.Lock()
+	defer s.revectorizeLock.Unlock()
+
+	delete(s.shadowIndexes, targetVector)
+	st := s.revectorizeStates[targetVector]
+	if err := s.dropRevectorizeBucket(ctx, st.shadowName(targetVector)); err != nil {
+		return err
+	}
+
+	if st.swapped() {
+		st.complete(targetVector)
+	} else {
+		*st = revectorizeState{
+			IndexName:  st.IndexName,
+			Generation: st.Generation,
+			Completed:  st.Completed,
+		}
+	}
+	return s.persistRevectorizeStatesWithLock()
+}
+
+func (s *Shard) loadRevectorizeBucket(ctx context.Context, indexName string) error {
+	err := s.store.CreateOrLoadBucket(ctx, revectorizeBucketName(indexName),
+		lsmkv.WithStrategy(lsmkv.StrategyReplace),
+		lsmkv.WithPread(s.index.Config.AvoidMMap),
+		s.dynamicMemtableSizing(),
+		s.memtableDirtyConfig(),
+		lsmkv.WithAllocChecker(s.index.allocChecker),
+		lsmkv.WithMaxSegmentSize(s.index.Config.MaxSegmentSize),
+		s.segmentCleanupConfig(),
+		lsmkv.WithMinMMapSize(s.index.Config.MinMMapSize),
+	)
+	if err != nil {
+		return fmt.Errorf("create re-vectorization bucket of %q: %w", indexName, err)
+	}
+	return nil
+}
+
+func (s *Shard) dropRevectorizeBucket(ctx context.Context, indexName string) error {
+	name := revectorizeBucketName(indexName)
+	if s.store.Bucket(name) != nil {
+		if err := s.store.ShutdownBucket(ctx, name); err != nil {
+			return err
+		}
+	}
+	if err := os.RemoveAll(filepath.Join(s.pathLSM(), name)); err != nil {
+		return fmt.Errorf("remove re-vectorization bucket of %q: %w", indexName, err)
+	}
+	return nil
+}
+
+func (s *Shard) putRevectorizedVectors(indexName string, docIDs []uint64, vectors [][]float32) error {
+	bucket := s.store.Bucket(revectorizeBucketName(indexName))
+	if bucket == nil {
+		return fmt.Errorf("re-vectorization bucket of %q not found", indexName)
+	}
+
+	for i, docID := range docIDs {
+		key := make([]byte, 8)
+		binary.LittleEndian.PutUint64(key, docID)
+		value := make([]byte, 4*len(vectors[i]))
+		for j, f := range vectors[i] {
+			binary.LittleEndian.PutUint32(value[4*j:], math.Float32bits(f))
+		}
+		if err := bucket.Put(key, value); err != nil {
+			return fmt.Errorf("put vector of doc id %d: %w", docID, err)
+		}
+	}
+	return nil
+}
+
+// revectorizedVector returns the vector embedded for the vector index with
+// the given name, nil if the objects hold the vector.
+func (s *Shard) revectorizedVector(indexName string, docIDKey []byte) ([]float32, error) {
+	bucket := s.store.Bucket(revectorizeBucketName(indexName))
+	if bucket == nil {
+		return nil, nil
+	}
+	value, err := bucket.Get(docIDKey)
+	if err != nil || len(value) == 0 {
+		return nil, err
+	}
+
+	vector := make([]float32, len(value)/4)
+	for i := range vector {
+		vector[i] = math.Float32frombits(binary.LittleEndian.Uint32(value[4*i:]))
+	}
+	return vector, nil
+}
+
+// revectorizedTargetVector returns the target vector the vector index with
+// the given name was built for.
+func revectorizedTargetVector(indexName string) (string, bool) {
+	targetVector, _, ok := strings.Cut(indexName, shadowVectorSeparator)
+	return targetVector, ok
+}
+
+func (s *Shard) revectorizationProgress() []*models.RevectorizationProgress {
+	s.revectorizeLock.Lock()
+	defer s.revectorizeLock.Unlock()
+
+	var progress []*models.RevectorizationProgress
+	for targetVector, st := range s.revectorizeStates {
+		if !st.active() {
+			continue
+		}
+		progress = append(progress, &models.RevectorizationProgress{
+			TargetVector:     targetVector,
+			Phase:            st.Phase,
+			ProcessedObjects: st.Processed,
+			TotalObjects:     int64(s.ObjectCountAsync()),
+		})
+	}
+	return progress
+}
+
+func (s *Shard) shutdownShadowVectorIndexes(ctx context.Context) error {
+	s.revectorizeLock.Lock()
+	defer s.revectorizeLock.Unlock()
+
+	var errs []error
+	for targetVector, index := range s.shadowIndexes {
+		if err := index.Flush(); err != nil {
+			errs = append(errs, fmt.Errorf("flush shadow vector index of vector %q: %w", targetVector, err))
+		}
+		if err := index.Shutdown(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("shut down shadow vector index of vector %q: %w", targetVector, err))
+		}
+	}
+	s.shadowIndexes = map[string]VectorIndex{}
+	return errors.Join(errs...)
+}
+
+func (s *Shard) dropShadowVectorIndexes(ctx context.Context) error {
+	s.revectorizeLock.Lock()
+	defer s.revectorizeLock.Unlock()
+
+	for targetVector, index := range s.shadowIndexes {
+		if err := index.Drop(ctx); err != nil {
+			return fmt.Errorf("remove shadow vector index of vector %q at %s: %w", targetVector, s.path(), err)
+		}
+	}
+	s.shadowIndexes = map[string]VectorIndex{}
+	return nil
+}
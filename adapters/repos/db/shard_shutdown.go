@@ -114,6 +114,10 @@ func (s *Shard) Shutdown(ctx context.Context) (err error) {
 		return nil
 	})
 
+	if err = s.shutdownShadowVectorIndexes(ctx); err != nil {
+		ec.Add(err)
+	}
+
 	if s.store != nil {
 		// store would be nil if loading the objects bucket failed, as we would
 		// only return the store on success from s.initLSMStore()
@@ -11,7 +11,11 @@
 
 package sorter
 
-import "github.com/weaviate/weaviate/entities/storobj"
+import (
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
 
 type comparable struct {
 	docID uint64
@@ -51,6 +55,34 @@ func (c *comparableCreator) createFromObjectWithPayload(object *storobj.Object,
 	return &comparable{object.DocID, values, payload}
 }
 
+func (c *comparableCreator) createFromSearchResult(result *search.Result, payload interface{}) *comparable {
+	values := make([]interface{}, len(c.propNames))
+	for level, propName := range c.propNames {
+		values[level] = c.extractor.extractFromSearchResult(result, propName)
+	}
+	return &comparable{0, values, payload}
+}
+
+func (c *comparableCreator) createFromGroupHit(hit map[string]interface{}, payload interface{}) *comparable {
+	values := make([]interface{}, len(c.propNames))
+	for level, propName := range c.propNames {
+		values[level] = c.extractor.extractFromGroupHit(hit, propName)
+	}
+	return &comparable{0, values, payload}
+}
+
+// withScore sets the values of the _distance and _score pseudo properties,
+// which are not stored on the object but are the result of the search
+func (c *comparableCreator) withScore(comparable *comparable, score float32) *comparable {
+	for level, propName := range c.propNames {
+		if filters.IsSearchSortProperty(propName) {
+			value := float64(score)
+			comparable.values[level] = &value
+		}
+	}
+	return comparable
+}
+
 func (c *comparableCreator) extractDocIDs(comparables []*comparable) []uint64 {
 	docIDs := make([]uint64, len(comparables))
 	for i, comparable := range comparables {
@@ -16,9 +16,11 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/storobj"
 )
 
@@ -93,6 +95,50 @@ func (e *comparableValueExtractor) extractFromObject(object *storobj.Object, pro
 	if !ok {
 		return nil
 	}
+	return e.extractFromProperties(propertiesMap, propName)
+}
+
+func (e *comparableValueExtractor) extractFromSearchResult(result *search.Result, propName string) interface{} {
+	switch propName {
+	case filters.InternalPropID, filters.InternalPropBackwardsCompatID:
+		id := result.ID.String()
+		return &id
+	case filters.InternalPropCreationTimeUnix:
+		ts := float64(result.Created)
+		return &ts
+	case filters.InternalPropLastUpdateTimeUnix:
+		ts := float64(result.Updated)
+		return &ts
+	case filters.SortPropDistance:
+		dist := float64(result.Dist)
+		return &dist
+	case filters.SortPropScore:
+		score := float64(result.Score)
+		return &score
+	}
+
+	propertiesMap, ok := result.Schema.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return e.extractFromProperties(propertiesMap, propName)
+}
+
+func (e *comparableValueExtractor) extractFromGroupHit(hit map[string]interface{}, propName string) interface{} {
+	if addl, ok := hit["_additional"].(*additional.GroupHitAdditional); ok {
+		switch propName {
+		case filters.InternalPropID, filters.InternalPropBackwardsCompatID:
+			id := addl.ID.String()
+			return &id
+		case filters.SortPropDistance:
+			dist := float64(addl.Distance)
+			return &dist
+		}
+	}
+	return e.extractFromProperties(hit, propName)
+}
+
+func (e *comparableValueExtractor) extractFromProperties(propertiesMap map[string]interface{}, propName string) interface{} {
 	value, ok := propertiesMap[propName]
 	if !ok {
 		return nil
@@ -159,7 +159,8 @@ func (h *lsmSorterHelper) getSortedDocIDsAndDistances(ctx context.Context, docID
 			continue
 		}
 
-		comparable := h.creator.createFromBytesWithPayload(docID, objData, distances[i])
+		comparable := h.creator.withScore(
+			h.creator.createFromBytesWithPayload(docID, objData, distances[i]), distances[i])
 		sorter.addComparable(comparable)
 	}
 
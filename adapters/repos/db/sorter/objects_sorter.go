@@ -75,6 +75,9 @@ func (h *objectsSorterHelper) sort(objects []*storobj.Object, distances []float3
 			payload.d = distances[i]
 		}
 		comparable := h.creator.createFromObjectWithPayload(objects[i], payload)
+		if withDistances {
+			comparable = h.creator.withScore(comparable, distances[i])
+		}
 		sorter.addComparable(comparable)
 	}
 
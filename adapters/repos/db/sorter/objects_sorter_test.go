@@ -377,6 +377,16 @@ func TestObjectsSorter(t *testing.T) {
 	}
 }
 
+func TestObjectsSorterBySearchDistance(t *testing.T) {
+	sorter := NewObjectsSorter(sorterCitySchema().GetClass)
+	gotObjs, gotDists, err := sorter.Sort(sorterCitySchemaObjects(), sorterCitySchemaDistances(),
+		4, sort2("isCapital", "desc", "_distance", "asc"))
+
+	require.Nil(t, err)
+	require.Equal(t, []string{"Berlin", "Amsterdam", "Wroclaw", "New York"}, extractCityNames(gotObjs))
+	require.Equal(t, []float32{0.2, 0.4, 0.1, 0.3}, gotDists)
+}
+
 func createSort(property, order string) filters.Sort {
 	return filters.Sort{Path: []string{property}, Order: order}
 }
@@ -0,0 +1,111 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sorter
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+// SearchResultsSorter orders results which were already retrieved, such as
+// the fused results of a hybrid search. Ties keep the order of the search.
+type SearchResultsSorter interface {
+	Sort(results []search.Result, sort []filters.Sort) ([]search.Result, error)
+}
+
+type searchResultsSorter struct {
+	readOnlyClass func(string) *models.Class
+}
+
+func NewSearchResultsSorter(fn func(string) *models.Class) *searchResultsSorter {
+	return &searchResultsSorter{readOnlyClass: fn}
+}
+
+// Sort orders the results by the given sort clauses. If the results are
+// groups, the hits of every group are sorted and the groups are ordered by
+// their first hit.
+func (s *searchResultsSorter) Sort(results []search.Result,
+	sort []filters.Sort,
+) ([]search.Result, error) {
+	if len(results) == 0 || len(sort) == 0 {
+		return results, nil
+	}
+
+	propNames, orders, err := extractPropNamesAndOrders(sort)
+	if err != nil {
+		return nil, err
+	}
+
+	class := s.readOnlyClass(results[0].ClassName)
+	if class == nil {
+		return nil, fmt.Errorf("search results sorter - class %s not found", results[0].ClassName)
+	}
+	dataTypesHelper := newDataTypesHelper(class)
+	comparator := newComparator(dataTypesHelper, propNames, orders)
+	creator := newComparableCreator(newComparableValueExtractor(dataTypesHelper), propNames)
+
+	comparables := make([]*comparable, len(results))
+	for i := range results {
+		group := resultGroup(&results[i])
+		if group == nil || len(group.Hits) == 0 {
+			comparables[i] = creator.createFromSearchResult(&results[i], i)
+			continue
+		}
+		group.Hits = sortGroupHits(group.Hits, comparator, creator)
+		comparables[i] = creator.createFromGroupHit(group.Hits[0], i)
+	}
+	sortStable(comparables, comparator)
+
+	out := make([]search.Result, len(results))
+	for i, comparable := range comparables {
+		out[i] = results[comparable.payload.(int)]
+		if group := resultGroup(&out[i]); group != nil {
+			group.ID = i
+		}
+	}
+	return out, nil
+}
+
+func sortGroupHits(hits []map[string]interface{}, comparator *comparator,
+	creator *comparableCreator,
+) []map[string]interface{} {
+	comparables := make([]*comparable, len(hits))
+	for i := range hits {
+		comparables[i] = creator.createFromGroupHit(hits[i], i)
+	}
+	sortStable(comparables, comparator)
+
+	sorted := make([]map[string]interface{}, len(hits))
+	for i, comparable := range comparables {
+		sorted[i] = hits[comparable.payload.(int)]
+	}
+	return sorted
+}
+
+func sortStable(comparables []*comparable, comparator *comparator) {
+	sort.SliceStable(comparables, func(i, j int) bool {
+		return comparator.compare(comparables[i], comparables[j]) == -1
+	})
+}
+
+func resultGroup(result *search.Result) *additional.Group {
+	if result.AdditionalProperties == nil {
+		return nil
+	}
+	group, _ := result.AdditionalProperties["group"].(*additional.Group)
+	return group
+}
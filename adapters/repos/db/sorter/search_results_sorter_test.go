@@ -0,0 +1,110 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sorter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+func TestSearchResultsSorter(t *testing.T) {
+	sorter := NewSearchResultsSorter(sorterCitySchema().GetClass)
+
+	t.Run("by property with the distance as tie-breaker", func(t *testing.T) {
+		results := citySearchResults(sorterCitySchemaObjects(), sorterCitySchemaDistances())
+
+		sorted, err := sorter.Sort(results, sort2("isCapital", "desc", "_distance", "asc"))
+		require.Nil(t, err)
+		assert.Equal(t, []string{"Berlin", "Amsterdam", "Wroclaw", "New York", "Nil2", "Nil"},
+			searchResultNames(sorted))
+	})
+
+	t.Run("by score", func(t *testing.T) {
+		results := citySearchResults(sorterCitySchemaObjects(), sorterCitySchemaDistances())
+		for i := range results {
+			results[i].Score = float32(len(results[i].Schema.(map[string]interface{})["name"].(string)))
+		}
+
+		sorted, err := sorter.Sort(results, sort1("_score", "desc"))
+		require.Nil(t, err)
+		assert.Equal(t, []string{"Amsterdam", "New York", "Wroclaw", "Berlin", "Nil2", "Nil"},
+			searchResultNames(sorted))
+	})
+
+	t.Run("groups and their hits", func(t *testing.T) {
+		results := []search.Result{
+			cityGroup(0, cityWroclaw, cityBerlin),
+			cityGroup(1, cityNewYork, cityAmsterdam),
+		}
+
+		sorted, err := sorter.Sort(results, sort1("name", "asc"))
+		require.Nil(t, err)
+		require.Len(t, sorted, 2)
+
+		first := sorted[0].AdditionalProperties["group"].(*additional.Group)
+		assert.Equal(t, 0, first.ID)
+		assert.Equal(t, "Amsterdam", first.Hits[0]["name"])
+		assert.Equal(t, "New York", first.Hits[1]["name"])
+
+		second := sorted[1].AdditionalProperties["group"].(*additional.Group)
+		assert.Equal(t, 1, second.ID)
+		assert.Equal(t, "Berlin", second.Hits[0]["name"])
+	})
+}
+
+func citySearchResults(objs []*storobj.Object, dists []float32) []search.Result {
+	results := make([]search.Result, len(objs))
+	for i, obj := range objs {
+		results[i] = search.Result{
+			ClassName: obj.Class().String(),
+			ID:        obj.ID(),
+			Schema:    obj.Properties(),
+			Dist:      dists[i],
+		}
+	}
+	return results
+}
+
+func cityGroup(id int, objs ...*storobj.Object) search.Result {
+	hits := make([]map[string]interface{}, len(objs))
+	for i, obj := range objs {
+		hit := map[string]interface{}{}
+		for k, v := range obj.Properties().(map[string]interface{}) {
+			hit[k] = v
+		}
+		hit["_additional"] = &additional.GroupHitAdditional{ID: obj.ID()}
+		hits[i] = hit
+	}
+
+	return search.Result{
+		ClassName: objs[0].Class().String(),
+		ID:        objs[0].ID(),
+		Schema:    objs[0].Properties(),
+		AdditionalProperties: models.AdditionalProperties{
+			"group": &additional.Group{ID: id, Count: len(hits), Hits: hits},
+		},
+	}
+}
+
+func searchResultNames(results []search.Result) []string {
+	names := make([]string, len(results))
+	for i := range results {
+		names[i] = results[i].Schema.(map[string]interface{})["name"].(string)
+	}
+	return names
+}
@@ -63,6 +63,7 @@ type VectorIndex interface {
 	AddBatch(ctx context.Context, id []uint64, vector [][]float32) error
 	Delete(id ...uint64) error
 	SearchByVector(ctx context.Context, vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error)
+	SearchByVectorBatch(ctx context.Context, vectors [][]float32, k int, allow helpers.AllowList) ([][]uint64, [][]float32, error)
 	SearchByVectorDistance(ctx context.Context, vector []float32, dist float32,
 		maxLimit int64, allow helpers.AllowList) ([]uint64, []float32, error)
 	UpdateUserConfig(updated schemaconfig.VectorIndexConfig, callback func()) error
@@ -258,6 +259,17 @@ func (dynamic *dynamic) Multivector() bool {
 	return dynamic.index.Multivector()
 }
 
+// Dimensions returns the length of the vectors indexed by the current
+// index, zero if there are none yet.
+func (dynamic *dynamic) Dimensions() int {
+	dynamic.RLock()
+	defer dynamic.RUnlock()
+	if index, ok := dynamic.index.(interface{ Dimensions() int }); ok {
+		return index.Dimensions()
+	}
+	return 0
+}
+
 func (dynamic *dynamic) AddBatch(ctx context.Context, ids []uint64, vectors [][]float32) error {
 	dynamic.RLock()
 	defer dynamic.RUnlock()
@@ -300,6 +312,12 @@ func (dynamic *dynamic) SearchByVector(ctx context.Context, vector []float32, k
 	return dynamic.index.SearchByVector(ctx, vector, k, allow)
 }
 
+func (dynamic *dynamic) SearchByVectorBatch(ctx context.Context, vectors [][]float32, k int, allow helpers.AllowList) ([][]uint64, [][]float32, error) {
+	dynamic.RLock()
+	defer dynamic.RUnlock()
+	return dynamic.index.SearchByVectorBatch(ctx, vectors, k, allow)
+}
+
 func (dynamic *dynamic) SearchByMultiVector(ctx context.Context, vectors [][]float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
 	dynamic.RLock()
 	defer dynamic.RUnlock()
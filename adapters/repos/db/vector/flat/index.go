@@ -192,6 +192,12 @@ func (index *flat) Multivector() bool {
 	return false
 }
 
+// Dimensions returns the length of the indexed vectors, zero if there are
+// none yet.
+func (index *flat) Dimensions() int {
+	return int(atomic.LoadInt32(&index.dims))
+}
+
 func (index *flat) getBucketName() string {
 	if index.targetVector != "" {
 		return fmt.Sprintf("%s_%s", helpers.VectorsBucketLSM, index.targetVector)
@@ -525,6 +531,21 @@ func (index *flat) vectorById(id uint64) ([]byte, error) {
 func (index *flat) findTopVectors(heap *priorityqueue.Queue[any],
 	allow helpers.AllowList, limit int, cursorFn func() *lsmkv.CursorReplace,
 	distanceCalc distanceCalc,
+) error {
+	return index.scanVectors(allow, cursorFn, func(id uint64, v []byte) error {
+		distance, err := distanceCalc(v)
+		if err != nil {
+			return err
+		}
+		index.insertToHeap(heap, limit, id, distance)
+		return nil
+	})
+}
+
+// scanVectors calls fn for every vector of the bucket opened by cursorFn
+// which is contained in allow
+func (index *flat) scanVectors(allow helpers.AllowList,
+	cursorFn func() *lsmkv.CursorReplace, fn func(id uint64, v []byte) error,
 ) error {
 	var key []byte
 	var v []byte
@@ -555,11 +576,9 @@ func (index *flat) findTopVectors(heap *priorityqueue.Queue[any],
 	for ; key != nil && (allow == nil || id <= allowMax); key, v = cursor.Next() {
 		id = binary.BigEndian.Uint64(key)
 		if allow == nil || allow.Contains(id) {
-			distance, err := distanceCalc(v)
-			if err != nil {
+			if err := fn(id, v); err != nil {
 				return err
 			}
-			index.insertToHeap(heap, limit, id, distance)
 		}
 	}
 	return nil
@@ -569,6 +588,21 @@ func (index *flat) findTopVectors(heap *priorityqueue.Queue[any],
 // distanceCalc
 func (index *flat) findTopVectorsCached(heap *priorityqueue.Queue[any],
 	allow helpers.AllowList, limit int, vectorBQ []uint64,
+) error {
+	return index.scanVectorsCached(allow, func(id uint64, vec []uint64) error {
+		distance, err := index.bq.DistanceBetweenCompressedVectors(vec, vectorBQ)
+		if err != nil {
+			return err
+		}
+		index.insertToHeap(heap, limit, id, distance)
+		return nil
+	})
+}
+
+// scanVectorsCached calls fn for every cached compressed vector which is
+// contained in allow
+func (index *flat) scanVectorsCached(allow helpers.AllowList,
+	fn func(id uint64, vec []uint64) error,
 ) error {
 	var id uint64
 	allowMax := uint64(0)
@@ -609,11 +643,9 @@ func (index *flat) findTopVectorsCached(heap *priorityqueue.Queue[any],
 					if len(vec) == 0 {
 						continue
 					}
-					distance, err := index.bq.DistanceBetweenCompressedVectors(vec, vectorBQ)
-					if err != nil {
+					if err := fn(currentId, vec); err != nil {
 						return err
 					}
-					index.insertToHeap(heap, limit, currentId, distance)
 
 				}
 			}
@@ -0,0 +1,181 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"context"
+	"sync"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+// SearchByVectorBatch evaluates many queries with a single scan of the
+// vectors. Every vector is read and decoded once and compared to all
+// queries, so a batch of queries costs about as much I/O as a single one.
+func (index *flat) SearchByVectorBatch(ctx context.Context, vectors [][]float32, k int,
+	allow helpers.AllowList,
+) ([][]uint64, [][]float32, error) {
+	switch index.compression {
+	case compressionBQ:
+		return index.searchByVectorBatchBQ(ctx, vectors, k, allow)
+	case compressionPQ:
+		// use uncompressed for now
+		fallthrough
+	default:
+		return index.searchByVectorBatch(ctx, vectors, k, allow)
+	}
+}
+
+func (index *flat) searchByVectorBatch(ctx context.Context, vectors [][]float32, k int,
+	allow helpers.AllowList,
+) ([][]uint64, [][]float32, error) {
+	queries := make([][]float32, len(vectors))
+	heaps := make([]*priorityqueue.Queue[any], len(vectors))
+	for n := range vectors {
+		queries[n] = index.normalized(vectors[n])
+		heaps[n] = index.pqResults.GetMax(k)
+		defer index.pqResults.Put(heaps[n])
+	}
+
+	if err := index.scanVectors(allow, index.store.Bucket(index.getBucketName()).Cursor,
+		func(id uint64, v []byte) error {
+			vecSlice := index.pool.float32SlicePool.Get(len(v) / 4)
+			defer index.pool.float32SlicePool.Put(vecSlice)
+
+			candidate := float32SliceFromByteSlice(v, vecSlice.slice)
+			for n, query := range queries {
+				distance, err := index.distancerProvider.SingleDist(query, candidate)
+				if err != nil {
+					return err
+				}
+				index.insertToHeap(heaps[n], k, id, distance)
+			}
+			return ctx.Err()
+		},
+	); err != nil {
+		return nil, nil, err
+	}
+
+	return index.extractHeaps(heaps)
+}
+
+func (index *flat) searchByVectorBatchBQ(ctx context.Context, vectors [][]float32, k int,
+	allow helpers.AllowList,
+) ([][]uint64, [][]float32, error) {
+	rescore := index.searchTimeRescore(k)
+	queries := make([][]float32, len(vectors))
+	queriesBQ := make([][]uint64, len(vectors))
+	heaps := make([]*priorityqueue.Queue[any], len(vectors))
+	for n := range vectors {
+		queries[n] = index.normalized(vectors[n])
+		queriesBQ[n] = index.bq.Encode(queries[n])
+		heaps[n] = index.pqResults.GetMax(rescore)
+		defer index.pqResults.Put(heaps[n])
+	}
+
+	addCompressed := func(id uint64, vec []uint64) error {
+		for n, queryBQ := range queriesBQ {
+			distance, err := index.bq.DistanceBetweenCompressedVectors(vec, queryBQ)
+			if err != nil {
+				return err
+			}
+			index.insertToHeap(heaps[n], rescore, id, distance)
+		}
+		return ctx.Err()
+	}
+
+	if index.isBQCached() {
+		if err := index.scanVectorsCached(allow, addCompressed); err != nil {
+			return nil, nil, err
+		}
+	} else {
+		if err := index.scanVectors(allow, index.store.Bucket(index.getCompressedBucketName()).Cursor,
+			func(id uint64, v []byte) error {
+				vecSliceBQ := index.pool.uint64SlicePool.Get(len(v) / 8)
+				defer index.pool.uint64SlicePool.Put(vecSliceBQ)
+
+				return addCompressed(id, uint64SliceFromByteSlice(v, vecSliceBQ.slice))
+			},
+		); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// the candidates of all queries are rescored together, so a vector which
+	// is a candidate of many queries is only read once
+	var candidates []uint64
+	candidateQueries := map[uint64][]int{}
+	for n, heap := range heaps {
+		for heap.Len() > 0 {
+			id := heap.Pop().ID
+			if _, ok := candidateQueries[id]; !ok {
+				candidates = append(candidates, id)
+			}
+			candidateQueries[id] = append(candidateQueries[id], n)
+		}
+	}
+
+	m := &sync.Mutex{}
+	eg := enterrors.NewErrorGroupWrapper(index.logger)
+	for workerID := 0; workerID < index.concurrentCacheReads; workerID++ {
+		workerID := workerID
+		eg.Go(func() error {
+			for idPos := workerID; idPos < len(candidates); idPos += index.concurrentCacheReads {
+				id := candidates[idPos]
+				candidateAsBytes, err := index.vectorById(id)
+				if err != nil {
+					return err
+				}
+				if len(candidateAsBytes) == 0 {
+					continue
+				}
+
+				vecSlice := index.pool.float32SlicePool.Get(len(candidateAsBytes) / 4)
+				candidate := float32SliceFromByteSlice(candidateAsBytes, vecSlice.slice)
+				targeting := candidateQueries[id]
+				distances := make([]float32, len(targeting))
+				for i, n := range targeting {
+					distances[i], err = index.distancerProvider.SingleDist(queries[n], candidate)
+					if err != nil {
+						index.pool.float32SlicePool.Put(vecSlice)
+						return err
+					}
+				}
+				index.pool.float32SlicePool.Put(vecSlice)
+
+				m.Lock()
+				for i, n := range targeting {
+					index.insertToHeap(heaps[n], k, id, distances[i])
+				}
+				m.Unlock()
+			}
+			return ctx.Err()
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return nil, nil, err
+	}
+
+	return index.extractHeaps(heaps)
+}
+
+func (index *flat) extractHeaps(heaps []*priorityqueue.Queue[any]) ([][]uint64, [][]float32, error) {
+	idss := make([][]uint64, len(heaps))
+	distss := make([][]float32, len(heaps))
+	for n, heap := range heaps {
+		idss[n], distss[n] = index.extractHeap(heap)
+	}
+	return idss, distss, nil
+}
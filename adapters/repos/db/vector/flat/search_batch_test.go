@@ -0,0 +1,85 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+)
+
+func TestFlat_SearchByVectorBatch(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	vectors, queries := testinghelpers.RandomVecsFixedSeed(500, 20, 16)
+
+	cases := []struct {
+		name string
+		bq   bool
+		// cache is only used with bq
+		cache bool
+	}{
+		{name: "uncompressed"},
+		{name: "bq", bq: true},
+		{name: "bq cached", bq: true, cache: true},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			dirName := t.TempDir()
+			store, err := lsmkv.New(dirName, dirName, logger, nil,
+				cyclemanager.NewCallbackGroupNoop(),
+				cyclemanager.NewCallbackGroupNoop(),
+				cyclemanager.NewCallbackGroupNoop())
+			require.Nil(t, err)
+			defer store.Shutdown(ctx)
+
+			index, err := New(Config{
+				ID:               "id",
+				RootPath:         dirName,
+				DistanceProvider: distancer.NewL2SquaredProvider(),
+			}, flatent.UserConfig{
+				BQ: flatent.CompressionUserConfig{Enabled: tt.bq, Cache: tt.cache, RescoreLimit: 50},
+			}, store)
+			require.Nil(t, err)
+			defer index.Shutdown(ctx)
+
+			for id, vector := range vectors {
+				require.Nil(t, index.Add(ctx, uint64(id), vector))
+			}
+
+			for _, allow := range []helpers.AllowList{nil, helpers.NewAllowList(1, 5, 17, 42, 100, 101, 250, 499)} {
+				idss, distss, err := index.SearchByVectorBatch(ctx, queries, 5, allow)
+				require.Nil(t, err)
+				require.Len(t, idss, len(queries))
+				require.Len(t, distss, len(queries))
+
+				// every query gets the same result as if it was searched on its own
+				for n, query := range queries {
+					ids, dists, err := index.SearchByVector(ctx, query, 5, allow)
+					require.Nil(t, err)
+					assert.Equal(t, ids, idss[n])
+					assert.Equal(t, dists, distss[n])
+				}
+			}
+		})
+	}
+}
@@ -793,6 +793,12 @@ func (h *hnsw) Multivector() bool {
 	return h.multivector.Load()
 }
 
+// Dimensions returns the length of the indexed vectors, zero if there are
+// none yet.
+func (h *hnsw) Dimensions() int {
+	return int(atomic.LoadInt32(&h.dims))
+}
+
 func (h *hnsw) Upgraded() bool {
 	return h.Compressed()
 }
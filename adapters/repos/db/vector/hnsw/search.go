@@ -41,6 +41,17 @@ const (
 	RRE
 )
 
+func (s FilterStrategy) String() string {
+	switch s {
+	case ACORN:
+		return "acorn"
+	case RRE:
+		return "rre"
+	default:
+		return "sweeping"
+	}
+}
+
 func (h *hnsw) searchTimeEF(k int) int {
 	// load atomically, so we can get away with concurrent updates of the
 	// userconfig without having to set a lock each time we try to read - which
@@ -190,9 +201,12 @@ func (h *hnsw) searchLayerByVectorWithDistancerWithStrategy(ctx context.Context,
 	strategy FilterStrategy) (*priorityqueue.Queue[any], error,
 ) {
 	start := time.Now()
+	// visitedNodes counts the candidates whose connections were explored
+	visitedNodes := 0
 	defer func() {
 		took := time.Since(start)
 		helpers.AnnotateSlowQueryLog(ctx, fmt.Sprintf("knn_search_layer_%d_took", level), took)
+		helpers.AnnotateSlowQueryLog(ctx, fmt.Sprintf("knn_search_layer_%d_visited_nodes", level), visitedNodes)
 	}()
 	h.pools.visitedListsLock.RLock()
 	visited := h.pools.visitedLists.Borrow()
@@ -277,6 +291,7 @@ func (h *hnsw) searchLayerByVectorWithDistancerWithStrategy(ctx context.Context,
 			candidateNode.Unlock()
 			continue
 		}
+		visitedNodes++
 
 		if strategy != ACORN {
 			if len(candidateNode.connections[level]) > h.maximumConnectionsLayerZero {
@@ -715,6 +730,9 @@ func (h *hnsw) knnSearchByVector(ctx context.Context, searchVec []float32, k int
 	} else {
 		strategy = SWEEPING
 	}
+	if allowList != nil {
+		helpers.AnnotateSlowQueryLog(ctx, "knn_search_filter_strategy", strategy.String())
+	}
 
 	if allowList != nil && useAcorn {
 		it := allowList.Iterator()
@@ -0,0 +1,216 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// SearchByVectorBatch evaluates many queries which share the same allow
+// list. If the allow list is small enough for a flat search, the queries
+// share a single pass over the allowed nodes and the vector of every node is
+// read once for all of them. Otherwise every query traverses the graph on its
+// own, as the paths of different queries through the graph diverge right
+// after the entrypoint.
+func (h *hnsw) SearchByVectorBatch(ctx context.Context, vectors [][]float32, k int,
+	allowList helpers.AllowList,
+) ([][]uint64, [][]float32, error) {
+	h.compressActionLock.RLock()
+	defer h.compressActionLock.RUnlock()
+
+	queries := make([][]float32, len(vectors))
+	for n := range vectors {
+		queries[n] = h.normalizeVec(vectors[n])
+	}
+
+	flatSearchCutoff := int(atomic.LoadInt64(&h.flatSearchCutoff))
+	if allowList != nil && !h.forbidFlat && allowList.Len() < flatSearchCutoff {
+		helpers.AnnotateSlowQueryLog(ctx, "hnsw_flat_search", true)
+		return h.flatSearchBatch(ctx, queries, k, h.searchTimeEF(k), allowList)
+	}
+	helpers.AnnotateSlowQueryLog(ctx, "hnsw_flat_search", false)
+
+	idss := make([][]uint64, len(queries))
+	distss := make([][]float32, len(queries))
+	eg := enterrors.NewErrorGroupWrapper(h.logger)
+	eg.SetLimit(runtime.GOMAXPROCS(0))
+	for n := range queries {
+		n := n
+		eg.Go(func() error {
+			var err error
+			idss[n], distss[n], err = h.knnSearchByVector(ctx, queries[n], k, h.searchTimeEF(k), allowList)
+			return err
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, nil, err
+	}
+	return idss, distss, nil
+}
+
+// flatSearchBatch is the batched flatSearch. The allow list is iterated once
+// and every candidate is compared to all queries.
+func (h *hnsw) flatSearchBatch(ctx context.Context, queryVectors [][]float32, k, limit int,
+	allowList helpers.AllowList,
+) ([][]uint64, [][]float32, error) {
+	if !h.shouldRescore() {
+		limit = k
+	}
+
+	h.RLock()
+	nodeSize := uint64(len(h.nodes))
+	h.RUnlock()
+
+	compressed := h.compressed.Load()
+	compressorDistancers := make([]compressionhelpers.CompressorDistancer, len(queryVectors))
+	if compressed {
+		for n, queryVector := range queryVectors {
+			distancer, returnFn := h.compressor.NewDistancer(queryVector)
+			defer returnFn()
+			compressorDistancers[n] = distancer
+		}
+	}
+
+	aggregateMu := &sync.Mutex{}
+	results := make([]*priorityqueue.Queue[any], len(queryVectors))
+	for n := range results {
+		results[n] = priorityqueue.NewMax[any](limit)
+	}
+
+	beforeIter := time.Now()
+	candidates := make([]uint64, 0, allowList.Len())
+	it := allowList.Iterator()
+	for candidate, ok := it.Next(); ok; candidate, ok = it.Next() {
+		candidates = append(candidates, candidate)
+	}
+
+	eg := enterrors.NewErrorGroupWrapper(h.logger)
+	for workerID := 0; workerID < h.flatSearchConcurrency; workerID++ {
+		workerID := workerID
+		eg.Go(func() error {
+			localResults := make([]*priorityqueue.Queue[any], len(queryVectors))
+			for n := range localResults {
+				localResults[n] = priorityqueue.NewMax[any](limit)
+			}
+			var e storobj.ErrNotFound
+			for idPos := workerID; idPos < len(candidates); idPos += h.flatSearchConcurrency {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+				candidate := candidates[idPos]
+				if candidate >= nodeSize {
+					continue
+				}
+
+				h.shardedNodeLocks.RLock(candidate)
+				c := h.nodes[candidate]
+				h.shardedNodeLocks.RUnlock(candidate)
+
+				if c == nil || h.hasTombstone(candidate) {
+					continue
+				}
+
+				if compressed {
+					for n, distancer := range compressorDistancers {
+						dist, err := distancer.DistanceToNode(candidate)
+						if errors.As(err, &e) {
+							h.handleDeletedNode(e.DocID, "flatSearchBatch")
+							break
+						}
+						if err != nil {
+							return err
+						}
+						addResult(localResults[n], candidate, dist, limit)
+					}
+					continue
+				}
+
+				// the vector of the candidate is read once for all queries
+				vec, err := h.vectorForID(ctx, candidate)
+				if errors.As(err, &e) {
+					h.handleDeletedNode(e.DocID, "flatSearchBatch")
+					continue
+				}
+				if err != nil {
+					return errors.Wrapf(err, "could not get vector of object at docID %d", candidate)
+				}
+				if len(vec) == 0 {
+					return fmt.Errorf("got a nil or zero-length vector at docID %d", candidate)
+				}
+				for n, queryVector := range queryVectors {
+					dist, err := h.distancerProvider.SingleDist(vec, queryVector)
+					if err != nil {
+						return err
+					}
+					addResult(localResults[n], candidate, dist, limit)
+				}
+			}
+
+			aggregateMu.Lock()
+			defer aggregateMu.Unlock()
+			for n, local := range localResults {
+				for local.Len() > 0 {
+					res := local.Pop()
+					addResult(results[n], res.ID, res.Dist, limit)
+				}
+			}
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return nil, nil, err
+	}
+	helpers.AnnotateSlowQueryLog(ctx, "flat_search_iteration_took", time.Since(beforeIter))
+
+	if h.shouldRescore() {
+		beforeRescore := time.Now()
+		for n, queryVector := range queryVectors {
+			compressorDistancer, fn := h.compressor.NewDistancer(queryVector)
+			err := h.rescore(ctx, results[n], k, compressorDistancer)
+			fn()
+			if err != nil {
+				helpers.AnnotateSlowQueryLog(ctx, "context_error", "flat_search_rescore")
+				return nil, nil, fmt.Errorf("flat search: %w", err)
+			}
+		}
+		helpers.AnnotateSlowQueryLog(ctx, "flat_search_rescore_took", time.Since(beforeRescore))
+	}
+
+	idss := make([][]uint64, len(results))
+	distss := make([][]float32, len(results))
+	for n, res := range results {
+		ids := make([]uint64, res.Len())
+		dists := make([]float32, res.Len())
+		// results are ordered in reverse, flip them before presenting them
+		for i := len(ids) - 1; res.Len() > 0; i-- {
+			item := res.Pop()
+			ids[i] = item.ID
+			dists[i] = item.Dist
+		}
+		idss[n], distss[n] = ids, dists
+	}
+	return idss, distss, nil
+}
@@ -0,0 +1,86 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/storobj"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestSearchByVectorBatch(t *testing.T) {
+	ctx := context.Background()
+	vectors, queries := testinghelpers.RandomVecsFixedSeed(1000, 20, 16)
+
+	index, err := New(Config{
+		RootPath:              t.TempDir(),
+		ID:                    "search-batch",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewL2SquaredProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			if int(id) >= len(vectors) {
+				return nil, storobj.NewErrNotFoundf(id, "out of range")
+			}
+			return vectors[int(id)], nil
+		},
+	}, ent.UserConfig{
+		MaxConnections:        16,
+		EFConstruction:        64,
+		EF:                    64,
+		VectorCacheMaxObjects: 10e12,
+	}, cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+	require.Nil(t, err)
+	defer index.Shutdown(ctx)
+
+	for id, vector := range vectors {
+		require.Nil(t, index.Add(ctx, uint64(id), vector))
+	}
+
+	filtered := make([]uint64, 800)
+	for i := range filtered {
+		filtered[i] = uint64(i)
+	}
+
+	tests := []struct {
+		name  string
+		allow helpers.AllowList
+	}{
+		{name: "graph search"},
+		{name: "graph search with filter", allow: helpers.NewAllowList(filtered...)},
+		{name: "flat search", allow: helpers.NewAllowList(1, 5, 17, 42, 100, 101, 250, 999)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idss, distss, err := index.SearchByVectorBatch(ctx, queries, 5, tt.allow)
+			require.Nil(t, err)
+			require.Len(t, idss, len(queries))
+			require.Len(t, distss, len(queries))
+
+			// every query gets the same result as if it was searched on its own
+			for n, query := range queries {
+				ids, dists, err := index.SearchByVector(ctx, query, 5, tt.allow)
+				require.Nil(t, err)
+				assert.Equal(t, ids, idss[n])
+				assert.Equal(t, dists, distss[n])
+			}
+		})
+	}
+}
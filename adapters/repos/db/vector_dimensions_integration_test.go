@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+func TestTargetVectorDimensions(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:               "TargetVectorDimensions",
+		InvertedIndexConfig: invertedConfig(),
+		VectorConfig: map[string]models.VectorConfig{
+			"hnsw":  {VectorIndexType: "hnsw", VectorIndexConfig: enthnsw.NewDefaultUserConfig()},
+			"flat":  {VectorIndexType: "flat", VectorIndexConfig: flat.NewDefaultUserConfig()},
+			"empty": {VectorIndexType: "hnsw", VectorIndexConfig: enthnsw.NewDefaultUserConfig()},
+		},
+	}
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: nil}},
+		shardState: singleShardState(),
+	}
+	repo, err := New(logger, Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  t.TempDir(),
+		QueryMaximumResults:       10,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil, memwatch.NewDummyMonitor())
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(ctx, class, schemaGetter.shardState))
+	schemaGetter.schema = schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}}
+
+	dimensions := func(targetVector string) int {
+		dims, err := repo.TargetVectorDimensions(ctx, class.Class, targetVector)
+		require.Nil(t, err)
+		return dims
+	}
+
+	assert.Equal(t, 0, dimensions("hnsw"), "no vectors indexed yet")
+	assert.Equal(t, 0, dimensions("flat"), "no vectors indexed yet")
+
+	for i := 0; i < 3; i++ {
+		obj := &models.Object{Class: class.Class, ID: intToUUID(i)}
+		vectors := map[string][]float32{"hnsw": randVector(3), "flat": randVector(5)}
+		require.Nil(t, repo.PutObject(ctx, obj, nil, vectors, nil, nil, 0))
+	}
+
+	assert.Equal(t, 3, dimensions("hnsw"))
+	assert.Equal(t, 5, dimensions("flat"))
+	assert.Equal(t, 0, dimensions("empty"))
+	assert.Equal(t, 0, dimensions("unknown"))
+
+	dims, err := repo.TargetVectorDimensions(ctx, "Unknown", "hnsw")
+	require.Nil(t, err)
+	assert.Equal(t, 0, dims)
+}
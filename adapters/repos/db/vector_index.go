@@ -62,3 +62,19 @@ type VectorIndex interface {
 	QueryMultiVectorDistancer(queryVector [][]float32) common.QueryVectorDistancer
 	Stats() (common.IndexStats, error)
 }
+
+// BatchVectorIndex is implemented by vector indexes which evaluate many
+// queries sharing the same allow list together, see
+// Shard.ObjectVectorSearchBatch
+type BatchVectorIndex interface {
+	SearchByVectorBatch(ctx context.Context, vectors [][]float32, k int,
+		allow helpers.AllowList) ([][]uint64, [][]float32, error)
+}
+
+// DimensionsVectorIndex is implemented by vector indexes which know the
+// length of the vectors they index, see DB.TargetVectorDimensions
+type DimensionsVectorIndex interface {
+	// Dimensions returns the length of the indexed vectors, zero if there
+	// are none yet.
+	Dimensions() int
+}
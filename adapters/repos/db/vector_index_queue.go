@@ -52,6 +52,11 @@ type VectorIndexQueue struct {
 	batchSize int
 
 	vectorIndex VectorIndex
+
+	// replaced is the vector index the tasks queued before the last swap of
+	// the vector index are executed against, see SwapIndex.
+	replaced      VectorIndex
+	replacedTasks atomic.Int64
 }
 
 func NewVectorIndexQueue(
@@ -291,6 +296,33 @@ func (iq *VectorIndexQueue) checkCompressionSettings() (skip bool) {
 // The queue must be paused before calling this method.
 func (iq *VectorIndexQueue) ResetWith(vidx VectorIndex) {
 	iq.vectorIndex = vidx
+	// the new index may hold vectors of different dimensions
+	iq.dims.Store(0)
+}
+
+// SwapIndex makes the queue index the vectors into the given vector index.
+// Unlike with ResetWith, the tasks queued before are still executed against
+// the replaced index, as their vectors were embedded for it.
+// The queue must be paused before calling this method.
+func (iq *VectorIndexQueue) SwapIndex(vidx VectorIndex) {
+	iq.replaced = iq.vectorIndex
+	iq.replacedTasks.Store(iq.Size())
+	iq.ResetWith(vidx)
+}
+
+// ReplacedTasks returns the number of tasks queued before the last swap of
+// the vector index which were not dequeued yet.
+func (iq *VectorIndexQueue) ReplacedTasks() int64 {
+	return max(iq.replacedTasks.Load(), 0)
+}
+
+// taskIndex returns the vector index the next decoded task is executed
+// against. Tasks are decoded in the order they were queued.
+func (iq *VectorIndexQueue) taskIndex() VectorIndex {
+	if iq.replacedTasks.Load() > 0 && iq.replacedTasks.Add(-1) >= 0 {
+		return iq.replaced
+	}
+	return iq.vectorIndex
 }
 
 type vectorIndexQueueDecoder struct {
@@ -323,7 +355,7 @@ func (v *vectorIndexQueueDecoder) DecodeTask(data []byte) (queue.Task, error) {
 			op:     op,
 			id:     uint64(id),
 			vector: vec,
-			idx:    v.q.vectorIndex,
+			idx:    v.q.taskIndex(),
 		}, nil
 	case vectorIndexQueueDeleteOp:
 		// decode id
@@ -332,7 +364,7 @@ func (v *vectorIndexQueueDecoder) DecodeTask(data []byte) (queue.Task, error) {
 		return &Task[[]float32]{
 			op:  op,
 			id:  uint64(id),
-			idx: v.q.vectorIndex,
+			idx: v.q.taskIndex(),
 		}, nil
 	case vectorIndexQueueMultiInsertOp:
 		// decode id
@@ -361,7 +393,7 @@ func (v *vectorIndexQueueDecoder) DecodeTask(data []byte) (queue.Task, error) {
 			op:     op,
 			id:     uint64(id),
 			vector: multiVec,
-			idx:    v.q.vectorIndex,
+			idx:    v.q.taskIndex(),
 		}, nil
 	case vectorIndexQueueMultiDeleteOp:
 		// decode id
@@ -370,7 +402,7 @@ func (v *vectorIndexQueueDecoder) DecodeTask(data []byte) (queue.Task, error) {
 		return &Task[[][]float32]{
 			op:  op,
 			id:  uint64(id),
-			idx: v.q.vectorIndex,
+			idx: v.q.taskIndex(),
 		}, nil
 	}
 
@@ -410,21 +442,48 @@ func (t *Task[T]) Execute(ctx context.Context) error {
 }
 
 func (t *Task[T]) NewGroup(op uint8, tasks ...queue.Task) queue.Task {
-	ids := make([]uint64, len(tasks))
-	vectors := make([]T, len(tasks))
+	// tasks queued before a swap of the vector index are executed against the
+	// replaced index, so consecutive tasks are grouped by their index
+	var groups taskGroups
+	var group *TaskGroup[T]
 
-	for i, task := range tasks {
+	for _, task := range tasks {
 		t := task.(*Task[T])
-		ids[i] = t.id
-		vectors[i] = t.vector
+		if group == nil || group.idx != t.idx {
+			group = &TaskGroup[T]{
+				op:  op,
+				idx: t.idx,
+			}
+			groups = append(groups, group)
+		}
+		group.ids = append(group.ids, t.id)
+		group.vectors = append(group.vectors, t.vector)
 	}
 
-	return &TaskGroup[T]{
-		op:      op,
-		ids:     ids,
-		vectors: vectors,
-		idx:     t.idx,
+	if len(groups) == 1 {
+		return groups[0]
 	}
+	return groups
+}
+
+// taskGroups executes task groups of different vector indexes in order.
+type taskGroups []queue.Task
+
+func (g taskGroups) Op() uint8 {
+	return g[0].Op()
+}
+
+func (g taskGroups) Key() uint64 {
+	return g[0].Key()
+}
+
+func (g taskGroups) Execute(ctx context.Context) error {
+	for _, group := range g {
+		if err := group.Execute(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 type TaskGroup[T dto.Embedding] struct {
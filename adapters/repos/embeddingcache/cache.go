@@ -0,0 +1,283 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package embeddingcache stores vectors returned by vectorizer modules in a
+// node-local lsmkv store, so that unchanged texts are not sent to the
+// embedding provider again.
+package embeddingcache
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+const bucketPrefix = "embeddings_"
+
+// Cache keeps its entries in two generations of buckets. Writes go to the
+// current generation; once it exceeds half of the size limit, the previous
+// generation is dropped and a new one started. Entries read from the previous
+// generation are copied to the current one, so that frequently used vectors
+// survive the rotation. Expired entries are treated as misses and disappear
+// with their generation.
+type Cache struct {
+	logger          logrus.FieldLogger
+	dir             string
+	store           *lsmkv.Store
+	flushCycle      cyclemanager.CycleManager
+	compactionCycle cyclemanager.CycleManager
+	generationSize  int64
+	ttl             time.Duration
+	now             func() time.Time
+
+	lock         sync.RWMutex
+	generation   int
+	current      *lsmkv.Bucket
+	previous     *lsmkv.Bucket
+	previousName string
+	previousSize int64
+	size         atomic.Int64
+	rotating     atomic.Bool
+}
+
+func New(cfg config.EmbeddingCacheConfig, logger logrus.FieldLogger) (*Cache, error) {
+	return newCache(cfg.Path, int64(cfg.MaxSizeMB)*1024*1024, cfg.TTL, logger)
+}
+
+func newCache(dir string, maxSize int64, ttl time.Duration, logger logrus.FieldLogger) (*Cache, error) {
+	logger = logger.WithField("action", "embedding_cache")
+
+	flushCallbacks := cyclemanager.NewCallbackGroup("embedding_cache/flush", logger, 1)
+	compactionCallbacks := cyclemanager.NewCallbackGroup("embedding_cache/compaction", logger, 1)
+	store, err := lsmkv.New(dir, dir, logger, nil, compactionCallbacks,
+		cyclemanager.NewCallbackGroupNoop(), flushCallbacks)
+	if err != nil {
+		return nil, fmt.Errorf("init embedding cache store: %w", err)
+	}
+
+	c := &Cache{
+		logger:          logger,
+		dir:             dir,
+		store:           store,
+		flushCycle:      cyclemanager.NewManager(cyclemanager.MemtableFlushCycleTicker(), flushCallbacks.CycleCallback, logger),
+		compactionCycle: cyclemanager.NewManager(cyclemanager.CompactionCycleTicker(), compactionCallbacks.CycleCallback, logger),
+		generationSize:  maxSize / 2,
+		ttl:             ttl,
+		now:             time.Now,
+	}
+	if err := c.load(); err != nil {
+		return nil, err
+	}
+	c.flushCycle.Start()
+	c.compactionCycle.Start()
+	return c, nil
+}
+
+// load opens the two most recent generations and removes older ones, which
+// may be left over from a crash during rotation.
+func (c *Cache) load() error {
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return fmt.Errorf("read embedding cache dir: %w", err)
+	}
+	var generations []int
+	for _, entry := range entries {
+		if gen, ok := parseGeneration(entry.Name()); ok && entry.IsDir() {
+			generations = append(generations, gen)
+		}
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(generations)))
+
+	for i, gen := range generations {
+		if i < 2 {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(c.dir, bucketName(gen))); err != nil {
+			return fmt.Errorf("remove embedding cache generation %d: %w", gen, err)
+		}
+	}
+
+	c.generation = 1
+	if len(generations) > 0 {
+		c.generation = generations[0]
+	}
+	if c.current, err = c.openBucket(c.generation); err != nil {
+		return err
+	}
+	c.size.Store(bucketSize(c.current))
+	if len(generations) > 1 {
+		if c.previous, err = c.openBucket(generations[1]); err != nil {
+			return err
+		}
+		c.previousName = bucketName(generations[1])
+		c.previousSize = bucketSize(c.previous)
+	}
+	c.reportSize()
+	return nil
+}
+
+func (c *Cache) openBucket(gen int) (*lsmkv.Bucket, error) {
+	name := bucketName(gen)
+	if err := c.store.CreateOrLoadBucket(context.Background(), name,
+		lsmkv.WithStrategy(lsmkv.StrategyReplace)); err != nil {
+		return nil, fmt.Errorf("open embedding cache generation %d: %w", gen, err)
+	}
+	return c.store.Bucket(name), nil
+}
+
+// Get returns the cached value of the key, unless it is missing or expired.
+func (c *Cache) Get(key []byte) ([]byte, bool) {
+	c.lock.RLock()
+	value, fromPrevious := c.get(c.current, key), false
+	if value == nil && c.previous != nil {
+		value, fromPrevious = c.get(c.previous, key), true
+	}
+	c.lock.RUnlock()
+
+	if value == nil {
+		return nil, false
+	}
+	if fromPrevious {
+		c.put(key, value)
+	}
+	return value[8:], true
+}
+
+func (c *Cache) get(bucket *lsmkv.Bucket, key []byte) []byte {
+	value, err := bucket.Get(key)
+	if err != nil {
+		c.logger.WithError(err).Debug("read embedding cache")
+		return nil
+	}
+	if len(value) < 8 {
+		return nil
+	}
+	if expiry := int64(binary.LittleEndian.Uint64(value)); expiry != 0 && c.now().UnixNano() > expiry {
+		return nil
+	}
+	return value
+}
+
+// Put stores the value with the configured TTL. Failures are logged, as the
+// cache must never fail an import.
+func (c *Cache) Put(key, value []byte) {
+	var expiry int64
+	if c.ttl > 0 {
+		expiry = c.now().Add(c.ttl).UnixNano()
+	}
+	entry := binary.LittleEndian.AppendUint64(make([]byte, 0, 8+len(value)), uint64(expiry))
+	c.put(key, append(entry, value...))
+}
+
+func (c *Cache) put(key, entry []byte) {
+	c.lock.RLock()
+	err := c.current.Put(key, entry)
+	c.lock.RUnlock()
+	if err != nil {
+		c.logger.WithError(err).Debug("write embedding cache")
+		return
+	}
+
+	if c.size.Add(int64(len(key)+len(entry))) > c.generationSize {
+		c.rotate()
+	}
+	c.reportSize()
+}
+
+func (c *Cache) rotate() {
+	if !c.rotating.CompareAndSwap(false, true) {
+		return
+	}
+	defer c.rotating.Store(false)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.size.Load() <= c.generationSize {
+		return
+	}
+
+	next, err := c.openBucket(c.generation + 1)
+	if err != nil {
+		c.logger.WithError(err).Warn("rotate embedding cache")
+		return
+	}
+	if c.previous != nil {
+		if err := c.store.ShutdownBucket(context.Background(), c.previousName); err != nil {
+			c.logger.WithError(err).Warn("shut down expired embedding cache generation")
+		} else if err := os.RemoveAll(filepath.Join(c.dir, c.previousName)); err != nil {
+			c.logger.WithError(err).Warn("remove expired embedding cache generation")
+		}
+	}
+
+	c.previous, c.previousName, c.previousSize = c.current, bucketName(c.generation), c.size.Load()
+	c.generation++
+	c.current = next
+	c.size.Store(0)
+}
+
+func (c *Cache) reportSize() {
+	c.lock.RLock()
+	size := c.size.Load() + c.previousSize
+	c.lock.RUnlock()
+	monitoring.GetMetrics().T2VEmbeddingCacheSize.Set(float64(size))
+}
+
+// Shutdown stops the background cycles and flushes the buckets. It is safe
+// to call on a nil cache.
+func (c *Cache) Shutdown(ctx context.Context) error {
+	if c == nil {
+		return nil
+	}
+	if err := c.flushCycle.StopAndWait(ctx); err != nil {
+		return fmt.Errorf("stop embedding cache flush cycle: %w", err)
+	}
+	if err := c.compactionCycle.StopAndWait(ctx); err != nil {
+		return fmt.Errorf("stop embedding cache compaction cycle: %w", err)
+	}
+	return c.store.Shutdown(ctx)
+}
+
+func bucketName(gen int) string {
+	return bucketPrefix + strconv.Itoa(gen)
+}
+
+func parseGeneration(name string) (int, bool) {
+	if !strings.HasPrefix(name, bucketPrefix) {
+		return 0, false
+	}
+	gen, err := strconv.Atoi(strings.TrimPrefix(name, bucketPrefix))
+	return gen, err == nil && gen > 0
+}
+
+func bucketSize(bucket *lsmkv.Bucket) int64 {
+	var size int64
+	cursor := bucket.Cursor()
+	defer cursor.Close()
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		size += int64(len(k) + len(v))
+	}
+	return size
+}
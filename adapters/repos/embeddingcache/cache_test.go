@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package embeddingcache
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+
+	t.Run("get and put", func(t *testing.T) {
+		c, err := newCache(t.TempDir(), 1<<20, 0, logger)
+		require.NoError(t, err)
+		defer c.Shutdown(context.Background())
+
+		_, ok := c.Get([]byte("key"))
+		assert.False(t, ok)
+
+		c.Put([]byte("key"), []byte("vector"))
+		value, ok := c.Get([]byte("key"))
+		require.True(t, ok)
+		assert.Equal(t, []byte("vector"), value)
+	})
+
+	t.Run("expired entries are misses", func(t *testing.T) {
+		c, err := newCache(t.TempDir(), 1<<20, time.Hour, logger)
+		require.NoError(t, err)
+		defer c.Shutdown(context.Background())
+
+		now := time.Now()
+		c.now = func() time.Time { return now }
+		c.Put([]byte("key"), []byte("vector"))
+
+		c.now = func() time.Time { return now.Add(59 * time.Minute) }
+		_, ok := c.Get([]byte("key"))
+		assert.True(t, ok)
+
+		c.now = func() time.Time { return now.Add(61 * time.Minute) }
+		_, ok = c.Get([]byte("key"))
+		assert.False(t, ok)
+	})
+
+	t.Run("size is bounded by dropping the oldest generation", func(t *testing.T) {
+		dir := t.TempDir()
+		// each entry takes 5 + 8 + 10 bytes, a generation holds 4 of them
+		c, err := newCache(dir, 200, 0, logger)
+		require.NoError(t, err)
+		defer c.Shutdown(context.Background())
+
+		for i := 0; i < 20; i++ {
+			c.Put([]byte(fmt.Sprintf("key%02d", i)), []byte("0123456789"))
+		}
+
+		_, ok := c.Get([]byte("key00"))
+		assert.False(t, ok, "the oldest entries were evicted")
+		_, ok = c.Get([]byte("key19"))
+		assert.True(t, ok, "the newest entries are kept")
+
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		generations := 0
+		for _, entry := range entries {
+			if _, ok := parseGeneration(entry.Name()); ok {
+				generations++
+			}
+		}
+		assert.Equal(t, 2, generations)
+	})
+
+	t.Run("hits in the previous generation are promoted", func(t *testing.T) {
+		c, err := newCache(t.TempDir(), 200, 0, logger)
+		require.NoError(t, err)
+		defer c.Shutdown(context.Background())
+
+		c.Put([]byte("hot"), []byte("0123456789"))
+		for i := 0; i < 20; i++ {
+			c.Put([]byte(fmt.Sprintf("key%02d", i)), []byte("0123456789"))
+			_, ok := c.Get([]byte("hot"))
+			require.True(t, ok, "iteration %d", i)
+		}
+	})
+
+	t.Run("entries survive a restart", func(t *testing.T) {
+		dir := t.TempDir()
+		c, err := newCache(dir, 1<<20, 0, logger)
+		require.NoError(t, err)
+		c.Put([]byte("key"), []byte("vector"))
+		require.NoError(t, c.Shutdown(context.Background()))
+
+		c, err = newCache(dir, 1<<20, 0, logger)
+		require.NoError(t, err)
+		defer c.Shutdown(context.Background())
+		value, ok := c.Get([]byte("key"))
+		require.True(t, ok)
+		assert.Equal(t, []byte("vector"), value)
+		assert.Positive(t, c.size.Load())
+	})
+}
@@ -41,11 +41,54 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	ClusterGetSlowQueries(params *ClusterGetSlowQueriesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ClusterGetSlowQueriesOK, error)
+
 	ClusterGetStatistics(params *ClusterGetStatisticsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ClusterGetStatisticsOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+ClusterGetSlowQueries lists slow queries
+
+Returns the queries which exceeded the slow query threshold on any node of the cluster, most recent first. Only the shape of a query is recorded, never the values searched for.
+*/
+func (a *Client) ClusterGetSlowQueries(params *ClusterGetSlowQueriesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ClusterGetSlowQueriesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewClusterGetSlowQueriesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "cluster.get.slowQueries",
+		Method:             "GET",
+		PathPattern:        "/cluster/slow-queries",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ClusterGetSlowQueriesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ClusterGetSlowQueriesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for cluster.get.slowQueries: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 ClusterGetStatistics sees raft cluster statistics
 
@@ -49,6 +49,8 @@ type ClientService interface {
 
 	ListReplication(params *ListReplicationParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListReplicationOK, error)
 
+	PromoteStandby(params *PromoteStandbyParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PromoteStandbyNoContent, error)
+
 	Replicate(params *ReplicateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicateOK, error)
 
 	ReplicationDetails(params *ReplicationDetailsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicationDetailsOK, error)
@@ -220,6 +222,47 @@ func (a *Client) ListReplication(params *ListReplicationParams, authInfo runtime
 	panic(msg)
 }
 
+/*
+PromoteStandby promotes a cross cluster replication standby
+
+Promotes this cluster from a standby receiving cross-cluster replication to a primary accepting client writes. Replicated writes from the former primary are rejected from then on. Promotion cannot be undone.
+*/
+func (a *Client) PromoteStandby(params *PromoteStandbyParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*PromoteStandbyNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPromoteStandbyParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "promoteStandby",
+		Method:             "POST",
+		PathPattern:        "/replication/cross-cluster/promote",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &PromoteStandbyReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PromoteStandbyNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for promoteStandby: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 Replicate starts the async operation to replicate a replica between two nodes
 */
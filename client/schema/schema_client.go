@@ -53,10 +53,14 @@ type ClientService interface {
 
 	SchemaObjectsShardsGet(params *SchemaObjectsShardsGetParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShardsGetOK, error)
 
+	SchemaObjectsShardsReshard(params *SchemaObjectsShardsReshardParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShardsReshardOK, error)
+
 	SchemaObjectsShardsUpdate(params *SchemaObjectsShardsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShardsUpdateOK, error)
 
 	SchemaObjectsUpdate(params *SchemaObjectsUpdateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsUpdateOK, error)
 
+	SchemaObjectsVectorsRevectorize(params *SchemaObjectsVectorsRevectorizeParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsVectorsRevectorizeOK, error)
+
 	TenantExists(params *TenantExistsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantExistsOK, error)
 
 	TenantsCreate(params *TenantsCreateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TenantsCreateOK, error)
@@ -314,6 +318,47 @@ func (a *Client) SchemaObjectsShardsGet(params *SchemaObjectsShardsGetParams, au
 	panic(msg)
 }
 
+/*
+SchemaObjectsShardsReshard splits or merges a shard
+
+Split a shard of a collection into two shards, or merge it into another shard of the same collection. The operation runs in the background, its progress can be followed with the distributed tasks API. Shards can only be merged if their replicas are hosted on the same nodes. Multi-tenant collections are not supported.
+*/
+func (a *Client) SchemaObjectsShardsReshard(params *SchemaObjectsShardsReshardParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsShardsReshardOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsShardsReshardParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.shards.reshard",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/shards/{shardName}/reshard",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsShardsReshardReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsShardsReshardOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.shards.reshard: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 SchemaObjectsShardsUpdate updates a shard status
 
@@ -396,6 +441,47 @@ func (a *Client) SchemaObjectsUpdate(params *SchemaObjectsUpdateParams, authInfo
 	panic(msg)
 }
 
+/*
+SchemaObjectsVectorsRevectorize re-vectorizes a named vector
+
+Re-embed all objects of a named vector with a different vectorizer module or module configuration. The objects are embedded into a new vector index in the background while queries keep being served from the existing index, which is replaced once every node has finished. The progress can be followed with the distributed tasks API and the verbose nodes API. Multi-tenant collections and multi-vectors are not supported.
+*/
+func (a *Client) SchemaObjectsVectorsRevectorize(params *SchemaObjectsVectorsRevectorizeParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SchemaObjectsVectorsRevectorizeOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsVectorsRevectorizeParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "schema.objects.vectors.revectorize",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/vectors/{vectorName}/revectorize",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsVectorsRevectorizeReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsVectorsRevectorizeOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.vectors.revectorize: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 TenantExists checks whether a tenant exists
 
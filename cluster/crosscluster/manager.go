@@ -0,0 +1,91 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package crosscluster
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+)
+
+// Manager holds the cross-cluster replication state shared by all nodes of
+// a cluster. A standby cluster is promoted once, the promotion cannot be
+// undone, as the former primary must not resume replicating to it.
+type Manager struct {
+	mu         sync.RWMutex
+	promotedAt time.Time
+}
+
+type snapshot struct {
+	PromotedAtUnixMillis int64 `json:"promotedAtUnixMillis,omitempty"`
+}
+
+func NewManager() *Manager {
+	return &Manager{}
+}
+
+func (m *Manager) Promote(c *api.ApplyRequest) error {
+	var r api.CrossClusterPromoteRequest
+	if err := json.Unmarshal(c.SubCommand, &r); err != nil {
+		return fmt.Errorf("unmarshal promote request: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.promotedAt.IsZero() {
+		m.promotedAt = time.UnixMilli(r.PromotedAtUnixMillis)
+	}
+	return nil
+}
+
+// Promoted returns whether the cluster has been promoted and when.
+func (m *Manager) Promoted() (bool, time.Time) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return !m.promotedAt.IsZero(), m.promotedAt
+}
+
+func (m *Manager) Snapshot() ([]byte, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var s snapshot
+	if !m.promotedAt.IsZero() {
+		s.PromotedAtUnixMillis = m.promotedAt.UnixMilli()
+	}
+	bytes, err := json.Marshal(&s)
+	if err != nil {
+		return nil, fmt.Errorf("marshal snapshot: %w", err)
+	}
+	return bytes, nil
+}
+
+func (m *Manager) Restore(bytes []byte) error {
+	var s snapshot
+	if err := json.Unmarshal(bytes, &s); err != nil {
+		return fmt.Errorf("unmarshal snapshot: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.promotedAt = time.Time{}
+	if s.PromotedAtUnixMillis != 0 {
+		m.promotedAt = time.UnixMilli(s.PromotedAtUnixMillis)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package crosscluster
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+)
+
+func promoteRequest(t *testing.T, at time.Time) *api.ApplyRequest {
+	subCommand, err := json.Marshal(&api.CrossClusterPromoteRequest{PromotedAtUnixMillis: at.UnixMilli()})
+	require.NoError(t, err)
+	return &api.ApplyRequest{Type: api.ApplyRequest_TYPE_CROSS_CLUSTER_PROMOTE, SubCommand: subCommand}
+}
+
+func TestManager(t *testing.T) {
+	m := NewManager()
+	promoted, _ := m.Promoted()
+	assert.False(t, promoted)
+
+	first := time.UnixMilli(1000)
+	require.NoError(t, m.Promote(promoteRequest(t, first)))
+	// promoting again keeps the time of the first promotion
+	require.NoError(t, m.Promote(promoteRequest(t, time.UnixMilli(2000))))
+	promoted, at := m.Promoted()
+	assert.True(t, promoted)
+	assert.Equal(t, first, at)
+
+	snap, err := m.Snapshot()
+	require.NoError(t, err)
+
+	restored := NewManager()
+	require.NoError(t, restored.Restore(snap))
+	promoted, at = restored.Promoted()
+	assert.True(t, promoted)
+	assert.Equal(t, first, at)
+
+	snap, err = NewManager().Snapshot()
+	require.NoError(t, err)
+	require.NoError(t, restored.Restore(snap))
+	promoted, _ = restored.Promoted()
+	assert.False(t, promoted)
+}
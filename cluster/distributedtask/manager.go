@@ -58,32 +58,51 @@ func (m *Manager) AddTask(c *api.ApplyRequest, seqNum uint64) error {
 		return fmt.Errorf("unmarshal add task request: %w", err)
 	}
 
+	return m.ScheduleTask(r.Namespace, r.Id, r.Payload, time.UnixMilli(r.SubmittedAtUnixMillis), seqNum)
+}
+
+// ScheduleTask adds a task on behalf of another FSM command, e.g. to chain a
+// task to the completion of a previous one. As with AddTask, it must only be
+// called while applying a RAFT log entry, with seqNum being the entry's index.
+func (m *Manager) ScheduleTask(namespace, taskID string, payload []byte, submittedAt time.Time, seqNum uint64) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	task := m.findTaskWithLock(r.Namespace, r.Id)
+	task := m.findTaskWithLock(namespace, taskID)
 	if task != nil {
 		if task.Status == TaskStatusStarted {
-			return fmt.Errorf("task %s/%s is already running with version %d", r.Namespace, r.Id, task.Version)
+			return fmt.Errorf("task %s/%s is already running with version %d", namespace, taskID, task.Version)
 		}
 
 		if seqNum <= task.Version {
-			return fmt.Errorf("task %s/%s is already finished with version %d", r.Namespace, r.Id, task.Version)
+			return fmt.Errorf("task %s/%s is already finished with version %d", namespace, taskID, task.Version)
 		}
 	}
 
 	m.setTaskWithLock(&Task{
-		Namespace:      r.Namespace,
-		TaskDescriptor: TaskDescriptor{ID: r.Id, Version: seqNum},
-		Payload:        r.Payload,
+		Namespace:      namespace,
+		TaskDescriptor: TaskDescriptor{ID: taskID, Version: seqNum},
+		Payload:        payload,
 		Status:         TaskStatusStarted,
-		StartedAt:      time.UnixMilli(r.SubmittedAtUnixMillis),
+		StartedAt:      submittedAt,
 		FinishedNodes:  map[string]bool{},
 	})
 
 	return nil
 }
 
+// GetTask returns a copy of the latest version of the given task.
+func (m *Manager) GetTask(namespace, taskID string) (*Task, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	task := m.findTaskWithLock(namespace, taskID)
+	if task == nil {
+		return nil, false
+	}
+	return task.Clone(), true
+}
+
 func (m *Manager) RecordNodeCompletion(c *api.ApplyRequest, numberOfNodesInTheCluster int) error {
 	var r api.RecordDistributedTaskNodeCompletionRequest
 	if err := json.Unmarshal(c.SubCommand, &r); err != nil {
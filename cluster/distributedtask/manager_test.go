@@ -372,6 +372,45 @@ func TestManager_CleanUpTask_Failures(t *testing.T) {
 	})
 }
 
+func TestManager_ScheduleTask(t *testing.T) {
+	var (
+		h   = newTestHarness(t).init(t)
+		now = h.clock.Now().Truncate(time.Millisecond)
+	)
+
+	_, ok := h.manager.GetTask("test", "1")
+	require.False(t, ok)
+
+	require.NoError(t, h.manager.ScheduleTask("test", "1", []byte("payload"), now, 10))
+	require.ErrorContains(t, h.manager.ScheduleTask("test", "1", nil, now, 11), "already running")
+
+	task, ok := h.manager.GetTask("test", "1")
+	require.True(t, ok)
+	assert.Equal(t, uint64(10), task.Version)
+	assert.Equal(t, TaskStatusStarted, task.Status)
+	assert.Equal(t, []byte("payload"), task.Payload)
+	assert.Equal(t, now, task.StartedAt)
+
+	// the returned task is a copy
+	task.FinishedNodes["local-node"] = true
+	task, _ = h.manager.GetTask("test", "1")
+	assert.Empty(t, task.FinishedNodes)
+
+	require.NoError(t, h.manager.RecordNodeCompletion(toCmd(t, &cmd.RecordDistributedTaskNodeCompletionRequest{
+		Namespace:            "test",
+		Id:                   "1",
+		Version:              10,
+		NodeId:               "local-node",
+		FinishedAtUnixMillis: now.UnixMilli(),
+	}), 1))
+	require.ErrorContains(t, h.manager.ScheduleTask("test", "1", nil, now, 10), "already finished")
+	require.NoError(t, h.manager.ScheduleTask("test", "1", nil, now, 12))
+
+	task, _ = h.manager.GetTask("test", "1")
+	assert.Equal(t, uint64(12), task.Version)
+	assert.Equal(t, TaskStatusStarted, task.Status)
+}
+
 func TestManager_ListDistributedTasksPayload(t *testing.T) {
 	var (
 		h   = newTestHarness(t).init(t)
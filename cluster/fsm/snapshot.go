@@ -31,6 +31,8 @@ type Snapshot struct {
 	DistributedTasks []byte `json:"distributed_tasks,omitempty"`
 	// ReplicationOps are the currently ongoing operation for replica replication
 	ReplicationOps []byte `json:"replication_ops,omitempty"`
+	// CrossCluster is the cross-cluster replication state, i.e. whether a standby cluster has been promoted
+	CrossCluster []byte `json:"cross_cluster,omitempty"`
 }
 
 // Snapshotter is used to snapshot and restore any (FSM) state
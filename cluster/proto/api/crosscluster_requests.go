@@ -0,0 +1,18 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package api
+
+// CrossClusterPromoteRequest promotes a standby cluster receiving
+// cross-cluster replication to a primary accepting client writes.
+type CrossClusterPromoteRequest struct {
+	PromotedAtUnixMillis int64 `json:"promotedAtUnixMillis"`
+}
@@ -35,6 +35,7 @@ const (
 	ApplyRequest_TYPE_UPDATE_SHARD_STATUS                         ApplyRequest_Type = 10
 	ApplyRequest_TYPE_ADD_REPLICA_TO_SHARD                        ApplyRequest_Type = 11
 	ApplyRequest_TYPE_DELETE_REPLICA_FROM_SHARD                   ApplyRequest_Type = 12
+	ApplyRequest_TYPE_START_RESHARDING                            ApplyRequest_Type = 13
 	ApplyRequest_TYPE_ADD_TENANT                                  ApplyRequest_Type = 16
 	ApplyRequest_TYPE_UPDATE_TENANT                               ApplyRequest_Type = 17
 	ApplyRequest_TYPE_DELETE_TENANT                               ApplyRequest_Type = 18
@@ -63,6 +64,7 @@ const (
 	ApplyRequest_TYPE_DISTRIBUTED_TASK_CANCEL                     ApplyRequest_Type = 301
 	ApplyRequest_TYPE_DISTRIBUTED_TASK_RECORD_NODE_COMPLETED      ApplyRequest_Type = 302
 	ApplyRequest_TYPE_DISTRIBUTED_TASK_CLEAN_UP                   ApplyRequest_Type = 303
+	ApplyRequest_TYPE_CROSS_CLUSTER_PROMOTE                       ApplyRequest_Type = 400
 )
 
 // Enum value maps for ApplyRequest_Type.
@@ -77,6 +79,7 @@ var (
 		10:  "TYPE_UPDATE_SHARD_STATUS",
 		11:  "TYPE_ADD_REPLICA_TO_SHARD",
 		12:  "TYPE_DELETE_REPLICA_FROM_SHARD",
+		13:  "TYPE_START_RESHARDING",
 		16:  "TYPE_ADD_TENANT",
 		17:  "TYPE_UPDATE_TENANT",
 		18:  "TYPE_DELETE_TENANT",
@@ -105,6 +108,7 @@ var (
 		301: "TYPE_DISTRIBUTED_TASK_CANCEL",
 		302: "TYPE_DISTRIBUTED_TASK_RECORD_NODE_COMPLETED",
 		303: "TYPE_DISTRIBUTED_TASK_CLEAN_UP",
+		400: "TYPE_CROSS_CLUSTER_PROMOTE",
 	}
 	ApplyRequest_Type_value = map[string]int32{
 		"TYPE_UNSPECIFIED":                                 0,
@@ -116,6 +120,7 @@ var (
 		"TYPE_UPDATE_SHARD_STATUS":                         10,
 		"TYPE_ADD_REPLICA_TO_SHARD":                        11,
 		"TYPE_DELETE_REPLICA_FROM_SHARD":                   12,
+		"TYPE_START_RESHARDING":                            13,
 		"TYPE_ADD_TENANT":                                  16,
 		"TYPE_UPDATE_TENANT":                               17,
 		"TYPE_DELETE_TENANT":                               18,
@@ -144,6 +149,7 @@ var (
 		"TYPE_DISTRIBUTED_TASK_CANCEL":                     301,
 		"TYPE_DISTRIBUTED_TASK_RECORD_NODE_COMPLETED":      302,
 		"TYPE_DISTRIBUTED_TASK_CLEAN_UP":                   303,
+		"TYPE_CROSS_CLUSTER_PROMOTE":                       400,
 	}
 )
 
@@ -1487,14 +1493,14 @@ const file_api_message_proto_rawDesc = "" +
 	"\x11NotifyPeerRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
 	"\aaddress\x18\x02 \x01(\tR\aaddress\"\x14\n" +
-	"\x12NotifyPeerResponse\"\xac\n" +
+	"\x12NotifyPeerResponse\"\xe8\n" +
 	"\n" +
 	"\fApplyRequest\x12@\n" +
 	"\x04type\x18\x01 \x01(\x0e2,.weaviate.internal.cluster.ApplyRequest.TypeR\x04type\x12\x14\n" +
 	"\x05class\x18\x02 \x01(\tR\x05class\x12\x18\n" +
 	"\aversion\x18\x03 \x01(\x04R\aversion\x12\x1f\n" +
 	"\vsub_command\x18\x04 \x01(\fR\n" +
-	"subCommand\"\x88\t\n" +
+	"subCommand\"\xc4\t\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x12\n" +
 	"\x0eTYPE_ADD_CLASS\x10\x01\x12\x15\n" +
@@ -1505,7 +1511,8 @@ const file_api_message_proto_rawDesc = "" +
 	"\x18TYPE_UPDATE_SHARD_STATUS\x10\n" +
 	"\x12\x1d\n" +
 	"\x19TYPE_ADD_REPLICA_TO_SHARD\x10\v\x12\"\n" +
-	"\x1eTYPE_DELETE_REPLICA_FROM_SHARD\x10\f\x12\x13\n" +
+	"\x1eTYPE_DELETE_REPLICA_FROM_SHARD\x10\f\x12\x19\n" +
+	"\x15TYPE_START_RESHARDING\x10\r\x12\x13\n" +
 	"\x0fTYPE_ADD_TENANT\x10\x10\x12\x16\n" +
 	"\x12TYPE_UPDATE_TENANT\x10\x11\x12\x16\n" +
 	"\x12TYPE_DELETE_TENANT\x10\x12\x12\x17\n" +
@@ -1533,7 +1540,8 @@ const file_api_message_proto_rawDesc = "" +
 	"\x19TYPE_DISTRIBUTED_TASK_ADD\x10\xac\x02\x12!\n" +
 	"\x1cTYPE_DISTRIBUTED_TASK_CANCEL\x10\xad\x02\x120\n" +
 	"+TYPE_DISTRIBUTED_TASK_RECORD_NODE_COMPLETED\x10\xae\x02\x12#\n" +
-	"\x1eTYPE_DISTRIBUTED_TASK_CLEAN_UP\x10\xaf\x02\"A\n" +
+	"\x1eTYPE_DISTRIBUTED_TASK_CLEAN_UP\x10\xaf\x02\x12\x1f\n" +
+	"\x1aTYPE_CROSS_CLUSTER_PROMOTE\x10\x90\x03\"A\n" +
 	"\rApplyResponse\x12\x18\n" +
 	"\aversion\x18\x01 \x01(\x04R\aversion\x12\x16\n" +
 	"\x06leader\x18\x02 \x01(\tR\x06leader\"\xc2\x06\n" +
@@ -0,0 +1,39 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package api
+
+import "fmt"
+
+// ReshardingTasksNamespace is the distributed tasks namespace of the tasks
+// moving objects between physical shards during a split or merge.
+const ReshardingTasksNamespace = "resharding"
+
+// ReshardingTaskPayload is the payload of a resharding distributed task. Each
+// phase of a split or merge is executed as a separate task.
+type ReshardingTaskPayload struct {
+	Class       string   `json:"class"`
+	Operation   string   `json:"operation"`
+	Phase       string   `json:"phase"`
+	SourceShard string   `json:"sourceShard"`
+	TargetShard string   `json:"targetShard"`
+	Virtual     []string `json:"virtual"`
+
+	// SubmittedAtUnixMillis is the time the operation was started. Objects
+	// written to the source shard afterwards are caught up during cleanup.
+	SubmittedAtUnixMillis int64 `json:"submittedAtUnixMillis"`
+}
+
+// ReshardingTaskID returns the ID of the distributed task executing the given
+// phase of the resharding operation started on the source shard.
+func ReshardingTaskID(class, sourceShard, phase string) string {
+	return fmt.Sprintf("%s/%s/%s", class, sourceShard, phase)
+}
@@ -0,0 +1,55 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package api
+
+import "fmt"
+
+// RevectorizeTasksNamespace is the distributed tasks namespace of the tasks
+// re-embedding the objects of a collection with new vectorizer settings.
+const RevectorizeTasksNamespace = "revectorize"
+
+const (
+	// RevectorizePhaseBuild embeds every object into a shadow vector index
+	// next to the one serving queries.
+	RevectorizePhaseBuild = "build"
+	// RevectorizePhaseSwap embeds the objects written while the serving
+	// vector index was replaced with the shadow index and drops the replaced
+	// index. The indexes are swapped when the collection switches to the new
+	// vectorizer settings, once the build phase finished on all nodes.
+	RevectorizePhaseSwap = "swap"
+)
+
+// RevectorizeTaskPayload is the payload of a re-vectorization distributed
+// task. Each phase is executed as a separate task.
+type RevectorizeTaskPayload struct {
+	Class        string `json:"class"`
+	TargetVector string `json:"targetVector"`
+	Phase        string `json:"phase"`
+
+	// Vectorizer is the new module config of the target vector, keyed by the
+	// name of the vectorizer module.
+	Vectorizer map[string]interface{} `json:"vectorizer"`
+
+	// ObjectsPerSecond limits the rate objects are embedded at on each node,
+	// zero means unlimited.
+	ObjectsPerSecond int `json:"objectsPerSecond,omitempty"`
+
+	// SubmittedAtUnixMillis is the time the re-vectorization was started. It
+	// identifies the run in the local state of the shards.
+	SubmittedAtUnixMillis int64 `json:"submittedAtUnixMillis"`
+}
+
+// RevectorizeTaskID returns the ID of the distributed task executing the
+// given phase of the re-vectorization of a target vector.
+func RevectorizeTaskID(class, targetVector, phase string) string {
+	return fmt.Sprintf("%s/%s/%s", class, targetVector, phase)
+}
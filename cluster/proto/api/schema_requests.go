@@ -50,6 +50,13 @@ type DeleteReplicaFromShard struct {
 	SchemaVersion            uint64
 }
 
+type StartReshardingRequest struct {
+	Class, Operation, SourceShard, TargetShard string
+	// Virtual holds the virtual shards moving to the target shard of a split
+	Virtual               []string
+	SubmittedAtUnixMillis int64
+}
+
 type QueryReadOnlyClassesRequest struct {
 	Classes []string
 }
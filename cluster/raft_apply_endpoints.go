@@ -152,6 +152,25 @@ func (s *Raft) DeleteReplicaFromShard(ctx context.Context, class, shard, targetN
 	return s.Execute(ctx, command)
 }
 
+func (s *Raft) StartResharding(ctx context.Context, req cmd.StartReshardingRequest) (uint64, error) {
+	if req.Class == "" || req.SourceShard == "" || req.TargetShard == "" {
+		return 0, fmt.Errorf("empty class or source shard or target shard : %w", schema.ErrBadRequest)
+	}
+	if req.SubmittedAtUnixMillis == 0 {
+		req.SubmittedAtUnixMillis = time.Now().UnixMilli()
+	}
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_START_RESHARDING,
+		Class:      req.Class,
+		SubCommand: subCommand,
+	}
+	return s.Execute(ctx, command)
+}
+
 func (s *Raft) UpdateShardStatus(ctx context.Context, class, shard, status string) (uint64, error) {
 	if class == "" || shard == "" {
 		return 0, fmt.Errorf("empty class or shard : %w", schema.ErrBadRequest)
@@ -0,0 +1,48 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	cmd "github.com/weaviate/weaviate/cluster/proto/api"
+)
+
+// PromoteStandby promotes the cluster from a standby receiving cross-cluster
+// replication to a primary accepting client writes.
+func (s *Raft) PromoteStandby(ctx context.Context) error {
+	req := cmd.CrossClusterPromoteRequest{
+		PromotedAtUnixMillis: time.Now().UnixMilli(),
+	}
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_CROSS_CLUSTER_PROMOTE,
+		SubCommand: subCommand,
+	}
+	if _, err = s.Execute(ctx, command); err != nil {
+		return fmt.Errorf("executing command: %w", err)
+	}
+	return nil
+}
+
+// StandbyPromoted returns whether the cluster has been promoted, as known to
+// the local node.
+func (s *Raft) StandbyPromoted() bool {
+	promoted, _ := s.store.crossClusterManager.Promoted()
+	return promoted
+}
@@ -0,0 +1,357 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replication
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/verbosity"
+	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/config/runtime"
+)
+
+const (
+	rebalancerLogAction = "replica_rebalancer"
+
+	// reasons for moving a replica, in the order they are evaluated
+	rebalanceReasonDiskUsage   = "disk_usage"
+	rebalanceReasonShardCount  = "shard_count"
+	rebalanceReasonObjectCount = "object_count"
+)
+
+// NodeStatusGetter returns the status of every node in the cluster, including the shards they host.
+type NodeStatusGetter interface {
+	GetNodeStatus(ctx context.Context, className, verbosity string) ([]*models.NodeStatus, error)
+}
+
+// NodeInfoGetter returns the disk usage a node broadcasts to the cluster.
+type NodeInfoGetter interface {
+	NodeInfo(node string) (cluster.NodeInfo, bool)
+}
+
+// ReplicaMover registers replica movements in the replication FSM.
+type ReplicaMover interface {
+	ReplicationReplicateReplica(uuid strfmt.UUID, sourceNode string, sourceCollection string, sourceShard string, targetNode string, transferType string) error
+}
+
+// LeaderChecker reports whether the local node is the leader of the cluster.
+type LeaderChecker interface {
+	IsLeader() bool
+}
+
+type RebalancerParams struct {
+	Logger     *logrus.Logger
+	FSM        *ShardReplicationFSM
+	Leader     LeaderChecker
+	NodeStatus NodeStatusGetter
+	NodeInfo   NodeInfoGetter
+	Mover      ReplicaMover
+
+	// Interval is the time between two rebalancing rounds
+	Interval time.Duration
+	// MaxConcurrentMoves is the number of unfinished replication operations above which no further replica is moved
+	MaxConcurrentMoves int
+	// ImbalanceThreshold is the difference in disk usage ratio, or in object count relative to the most loaded node,
+	// between two nodes above which replicas are moved from one to the other
+	ImbalanceThreshold float64
+	// DryRun only logs the replica movements that would be scheduled
+	DryRun *runtime.DynamicValue[bool]
+	// Paused skips rebalancing rounds until unset
+	Paused *runtime.DynamicValue[bool]
+}
+
+// Rebalancer periodically compares the disk usage, shard count and object count of the storage nodes and moves one
+// replica at a time from the most to the least loaded node through the replication FSM.
+//
+// It runs on every node but only acts while the local node is the leader. As movements take time to show in the node
+// statistics, no new replica is moved while MaxConcurrentMoves replication operations are still in progress.
+type Rebalancer struct {
+	logger     *logrus.Entry
+	fsm        *ShardReplicationFSM
+	leader     LeaderChecker
+	nodeStatus NodeStatusGetter
+	nodeInfo   NodeInfoGetter
+	mover      ReplicaMover
+
+	interval           time.Duration
+	maxConcurrentMoves int
+	imbalanceThreshold float64
+	dryRun             *runtime.DynamicValue[bool]
+	paused             *runtime.DynamicValue[bool]
+}
+
+func NewRebalancer(params RebalancerParams) *Rebalancer {
+	return &Rebalancer{
+		logger:             params.Logger.WithField("action", rebalancerLogAction),
+		fsm:                params.FSM,
+		leader:             params.Leader,
+		nodeStatus:         params.NodeStatus,
+		nodeInfo:           params.NodeInfo,
+		mover:              params.Mover,
+		interval:           params.Interval,
+		maxConcurrentMoves: params.MaxConcurrentMoves,
+		imbalanceThreshold: params.ImbalanceThreshold,
+		dryRun:             params.DryRun,
+		paused:             params.Paused,
+	}
+}
+
+// Start runs rebalancing rounds until ctx is cancelled.
+func (r *Rebalancer) Start(ctx context.Context) {
+	r.logger.WithFields(logrus.Fields{
+		"interval":             r.interval,
+		"max_concurrent_moves": r.maxConcurrentMoves,
+		"imbalance_threshold":  r.imbalanceThreshold,
+	}).Info("starting replica rebalancer")
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			r.logger.Info("stopping replica rebalancer")
+			return
+		case <-ticker.C:
+			if err := r.Rebalance(ctx); err != nil {
+				r.logger.WithError(err).Warn("rebalance replicas")
+			}
+		}
+	}
+}
+
+// Rebalance runs a single rebalancing round, moving at most one replica.
+func (r *Rebalancer) Rebalance(ctx context.Context) error {
+	if r.paused.Get() {
+		r.logger.Debug("replica rebalancer is paused")
+		return nil
+	}
+	if !r.leader.IsLeader() {
+		return nil
+	}
+
+	busy, inProgress := r.shardsInProgress()
+	if inProgress >= r.maxConcurrentMoves {
+		r.logger.WithField("ops_in_progress", inProgress).Debug("skip rebalancing, too many replication operations in progress")
+		return nil
+	}
+
+	loads, err := r.nodeLoads(ctx)
+	if err != nil {
+		return err
+	}
+	if loads == nil {
+		return nil
+	}
+
+	move, ok := planReplicaMove(loads, busy, r.imbalanceThreshold)
+	if !ok {
+		r.logger.Debug("replicas are balanced")
+		return nil
+	}
+
+	logger := r.logger.WithFields(logrus.Fields{
+		"reason":       move.reason,
+		"collection":   move.collection,
+		"shard":        move.shard,
+		"source_node":  move.source,
+		"target_node":  move.target,
+		"object_count": move.objectCount,
+	})
+	if r.dryRun.Get() {
+		logger.Info("dry run: would move replica")
+		return nil
+	}
+
+	id := strfmt.UUID(uuid.New().String())
+	if err := r.mover.ReplicationReplicateReplica(id, move.source, move.collection, move.shard, move.target, api.MOVE.String()); err != nil {
+		return fmt.Errorf("move replica of shard %q of collection %q from %q to %q: %w",
+			move.shard, move.collection, move.source, move.target, err)
+	}
+	logger.WithField("op_uuid", id).Info("moving replica")
+	return nil
+}
+
+// shardsInProgress returns the shards involved in unfinished replication operations and the number of these operations.
+func (r *Rebalancer) shardsInProgress() (map[shardKey]struct{}, int) {
+	ops := r.fsm.GetUnfinishedOps()
+	busy := make(map[shardKey]struct{}, len(ops))
+	for _, op := range ops {
+		busy[shardKey{collection: op.SourceShard.CollectionId, shard: op.SourceShard.ShardId}] = struct{}{}
+	}
+	return busy, len(ops)
+}
+
+// nodeLoads returns the load of every storage node, or nil if any node is not healthy as moving replicas would put
+// more pressure on an already degraded cluster. The load is what the nodes share with the cluster: the disk usage
+// they broadcast and the object counts of their shards. The query and write activity of the node-wide metrics stays
+// on each node and is not part of it.
+func (r *Rebalancer) nodeLoads(ctx context.Context) ([]nodeLoad, error) {
+	statuses, err := r.nodeStatus.GetNodeStatus(ctx, "", verbosity.OutputVerbose)
+	if err != nil {
+		return nil, fmt.Errorf("get nodes status: %w", err)
+	}
+
+	loads := make([]nodeLoad, 0, len(statuses))
+	for _, status := range statuses {
+		if status.Status == nil || *status.Status != models.NodeStatusStatusHEALTHY {
+			r.logger.WithField("node", status.Name).Debug("skip rebalancing, node is not healthy")
+			return nil, nil
+		}
+
+		load := nodeLoad{name: status.Name, diskUsage: -1, replicas: make(map[shardKey]int64, len(status.Shards))}
+		if info, ok := r.nodeInfo.NodeInfo(status.Name); ok && info.Total > 0 {
+			load.diskUsage = 1 - float64(info.Available)/float64(info.Total)
+		}
+		for _, shard := range status.Shards {
+			load.replicas[shardKey{collection: shard.Class, shard: shard.Name}] = shard.ObjectCount
+			load.objectCount += shard.ObjectCount
+		}
+		loads = append(loads, load)
+	}
+	return loads, nil
+}
+
+type shardKey struct {
+	collection, shard string
+}
+
+type nodeLoad struct {
+	name string
+	// diskUsage is the ratio of used disk space, or -1 if unknown
+	diskUsage   float64
+	objectCount int64
+	replicas    map[shardKey]int64
+}
+
+type replicaMove struct {
+	reason            string
+	collection, shard string
+	source, target    string
+	objectCount       int64
+}
+
+// planReplicaMove picks at most one replica to move between the two nodes furthest apart in disk usage, shard count
+// or object count, in this order. Replicas of busy shards and replicas whose move would not reduce the imbalance are
+// never picked.
+func planReplicaMove(loads []nodeLoad, busy map[shardKey]struct{}, threshold float64) (replicaMove, bool) {
+	if len(loads) < 2 {
+		return replicaMove{}, false
+	}
+
+	// disk usage is only compared if every node reported it
+	withDiskUsage := true
+	for _, l := range loads {
+		withDiskUsage = withDiskUsage && l.diskUsage >= 0
+	}
+	if withDiskUsage {
+		source, target := extremes(loads, func(l nodeLoad) float64 { return l.diskUsage })
+		if source.diskUsage-target.diskUsage > threshold {
+			// objects are the closest estimate of the data moving along with a replica, a replica larger than the
+			// difference would only swap the roles of both nodes
+			diff := source.objectCount - target.objectCount
+			if move, ok := pickReplica(source, target, busy, diff/2); ok && (diff <= 0 || move.objectCount < diff) {
+				move.reason = rebalanceReasonDiskUsage
+				return move, true
+			}
+		}
+	}
+
+	source, target := extremes(loads, func(l nodeLoad) float64 { return float64(len(l.replicas)) })
+	if len(source.replicas)-len(target.replicas) > 1 {
+		// the cheapest replica to move
+		if move, ok := pickReplica(source, target, busy, 0); ok {
+			move.reason = rebalanceReasonShardCount
+			return move, true
+		}
+	}
+
+	source, target = extremes(loads, func(l nodeLoad) float64 { return float64(l.objectCount) })
+	diff := source.objectCount - target.objectCount
+	if source.objectCount > 0 && float64(diff)/float64(source.objectCount) > threshold {
+		if move, ok := pickReplica(source, target, busy, diff/2); ok && move.objectCount < diff {
+			move.reason = rebalanceReasonObjectCount
+			return move, true
+		}
+	}
+
+	return replicaMove{}, false
+}
+
+// extremes returns the nodes with the highest and the lowest value. Ties are broken by node name to keep plans
+// deterministic.
+func extremes(loads []nodeLoad, value func(nodeLoad) float64) (highest, lowest nodeLoad) {
+	sorted := make([]nodeLoad, len(loads))
+	copy(sorted, loads)
+	sort.Slice(sorted, func(i, j int) bool {
+		vi, vj := value(sorted[i]), value(sorted[j])
+		if vi != vj {
+			return vi > vj
+		}
+		return sorted[i].name < sorted[j].name
+	})
+	return sorted[0], sorted[len(sorted)-1]
+}
+
+// pickReplica returns the replica of source, absent on target, whose object count is the largest not exceeding
+// objects, or the smallest one if all exceed it.
+func pickReplica(source, target nodeLoad, busy map[shardKey]struct{}, objects int64) (replicaMove, bool) {
+	candidates := make([]shardKey, 0, len(source.replicas))
+	for key := range source.replicas {
+		if _, ok := target.replicas[key]; ok {
+			continue
+		}
+		if _, ok := busy[key]; ok {
+			continue
+		}
+		candidates = append(candidates, key)
+	}
+	if len(candidates) == 0 {
+		return replicaMove{}, false
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		ci, cj := source.replicas[candidates[i]], source.replicas[candidates[j]]
+		if ci != cj {
+			return ci < cj
+		}
+		if candidates[i].collection != candidates[j].collection {
+			return candidates[i].collection < candidates[j].collection
+		}
+		return candidates[i].shard < candidates[j].shard
+	})
+
+	picked := candidates[0]
+	for _, key := range candidates[1:] {
+		if source.replicas[key] > objects {
+			break
+		}
+		picked = key
+	}
+
+	return replicaMove{
+		collection:  picked.collection,
+		shard:       picked.shard,
+		source:      source.name,
+		target:      target.name,
+		objectCount: source.replicas[picked],
+	}, true
+}
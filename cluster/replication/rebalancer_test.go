@@ -0,0 +1,232 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replication_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/cluster/replication"
+	"github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/config/runtime"
+	"github.com/weaviate/weaviate/usecases/fakes"
+)
+
+type fakeLeader bool
+
+func (l fakeLeader) IsLeader() bool { return bool(l) }
+
+type fakeNodeStatus []*models.NodeStatus
+
+func (s fakeNodeStatus) GetNodeStatus(context.Context, string, string) ([]*models.NodeStatus, error) {
+	return s, nil
+}
+
+type fakeNodeInfo map[string]cluster.NodeInfo
+
+func (i fakeNodeInfo) NodeInfo(node string) (cluster.NodeInfo, bool) {
+	info, ok := i[node]
+	return info, ok
+}
+
+type replicaMove struct {
+	source, collection, shard, target, transferType string
+}
+
+type fakeMover struct {
+	moves []replicaMove
+}
+
+func (m *fakeMover) ReplicationReplicateReplica(_ strfmt.UUID, sourceNode, sourceCollection, sourceShard, targetNode, transferType string) error {
+	m.moves = append(m.moves, replicaMove{sourceNode, sourceCollection, sourceShard, targetNode, transferType})
+	return nil
+}
+
+func newReplicationFSM() *replication.ShardReplicationFSM {
+	schemaManager := schema.NewSchemaManager("test-node", nil, fakes.NewMockParser(), prometheus.NewPedanticRegistry(), logrus.New())
+	return replication.NewManager(schemaManager.NewSchemaReader(), prometheus.NewPedanticRegistry()).GetReplicationFSM()
+}
+
+func nodeStatus(name string, shards map[string]int64) *models.NodeStatus {
+	healthy := models.NodeStatusStatusHEALTHY
+	status := &models.NodeStatus{Name: name, Status: &healthy}
+	for shard, count := range shards {
+		status.Shards = append(status.Shards, &models.NodeShardStatus{Class: "C", Name: shard, ObjectCount: count})
+	}
+	return status
+}
+
+func diskUsage(usedPercent uint64) cluster.NodeInfo {
+	return cluster.NodeInfo{DiskUsage: cluster.DiskUsage{Total: 100, Available: 100 - usedPercent}}
+}
+
+func TestRebalancer(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	newRebalancer := func(statuses fakeNodeStatus, infos fakeNodeInfo, fsm *replication.ShardReplicationFSM) (*replication.Rebalancer, *fakeMover, *runtime.DynamicValue[bool], *runtime.DynamicValue[bool]) {
+		if fsm == nil {
+			fsm = newReplicationFSM()
+		}
+		mover := &fakeMover{}
+		dryRun, paused := runtime.NewDynamicValue(false), runtime.NewDynamicValue(false)
+		return replication.NewRebalancer(replication.RebalancerParams{
+			Logger:             logger,
+			FSM:                fsm,
+			Leader:             fakeLeader(true),
+			NodeStatus:         statuses,
+			NodeInfo:           infos,
+			Mover:              mover,
+			Interval:           time.Minute,
+			MaxConcurrentMoves: 1,
+			ImbalanceThreshold: 0.2,
+			DryRun:             dryRun,
+			Paused:             paused,
+		}), mover, dryRun, paused
+	}
+
+	t.Run("disk usage", func(t *testing.T) {
+		r, mover, _, _ := newRebalancer(fakeNodeStatus{
+			nodeStatus("node1", map[string]int64{"S1": 100, "S2": 400, "S3": 1000}),
+			nodeStatus("node2", map[string]int64{"S4": 100, "S5": 100, "S6": 100}),
+		}, fakeNodeInfo{"node1": diskUsage(80), "node2": diskUsage(20)}, nil)
+
+		require.NoError(t, r.Rebalance(ctx))
+		// the largest replica not exceeding half the difference in object count
+		assert.Equal(t, []replicaMove{{"node1", "C", "S2", "node2", api.MOVE.String()}}, mover.moves)
+	})
+
+	t.Run("shard count", func(t *testing.T) {
+		r, mover, _, _ := newRebalancer(fakeNodeStatus{
+			nodeStatus("node1", map[string]int64{"S1": 10, "S2": 20, "S3": 30, "S4": 40}),
+			nodeStatus("node2", map[string]int64{"S1": 10, "S5": 80}),
+		}, fakeNodeInfo{"node1": diskUsage(50), "node2": diskUsage(50)}, nil)
+
+		require.NoError(t, r.Rebalance(ctx))
+		// the smallest replica not already on node2
+		assert.Equal(t, []replicaMove{{"node1", "C", "S2", "node2", api.MOVE.String()}}, mover.moves)
+	})
+
+	t.Run("object count", func(t *testing.T) {
+		r, mover, _, _ := newRebalancer(fakeNodeStatus{
+			nodeStatus("node1", map[string]int64{"S1": 100, "S2": 300}),
+			nodeStatus("node2", map[string]int64{"S3": 50, "S4": 50}),
+		}, fakeNodeInfo{}, nil)
+
+		require.NoError(t, r.Rebalance(ctx))
+		assert.Equal(t, []replicaMove{{"node1", "C", "S1", "node2", api.MOVE.String()}}, mover.moves)
+	})
+
+	t.Run("balanced", func(t *testing.T) {
+		r, mover, _, _ := newRebalancer(fakeNodeStatus{
+			nodeStatus("node1", map[string]int64{"S1": 100, "S2": 100}),
+			nodeStatus("node2", map[string]int64{"S3": 110, "S4": 100}),
+		}, fakeNodeInfo{"node1": diskUsage(40), "node2": diskUsage(45)}, nil)
+
+		require.NoError(t, r.Rebalance(ctx))
+		assert.Empty(t, mover.moves)
+	})
+
+	t.Run("move would not reduce imbalance", func(t *testing.T) {
+		r, mover, _, _ := newRebalancer(fakeNodeStatus{
+			nodeStatus("node1", map[string]int64{"S1": 1000}),
+			nodeStatus("node2", map[string]int64{"S2": 500}),
+		}, fakeNodeInfo{}, nil)
+
+		require.NoError(t, r.Rebalance(ctx))
+		assert.Empty(t, mover.moves)
+	})
+
+	t.Run("unhealthy node", func(t *testing.T) {
+		unavailable := models.NodeStatusStatusUNAVAILABLE
+		r, mover, _, _ := newRebalancer(fakeNodeStatus{
+			nodeStatus("node1", map[string]int64{"S1": 100, "S2": 300}),
+			{Name: "node2", Status: &unavailable},
+		}, fakeNodeInfo{}, nil)
+
+		require.NoError(t, r.Rebalance(ctx))
+		assert.Empty(t, mover.moves)
+	})
+
+	unbalanced := fakeNodeStatus{
+		nodeStatus("node1", map[string]int64{"S1": 100, "S2": 300}),
+		nodeStatus("node2", map[string]int64{"S3": 50, "S4": 50}),
+	}
+
+	t.Run("paused", func(t *testing.T) {
+		r, mover, _, paused := newRebalancer(unbalanced, fakeNodeInfo{}, nil)
+
+		paused.SetValue(true)
+		require.NoError(t, r.Rebalance(ctx))
+		assert.Empty(t, mover.moves)
+
+		paused.SetValue(false)
+		require.NoError(t, r.Rebalance(ctx))
+		assert.Len(t, mover.moves, 1)
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		r, mover, dryRun, _ := newRebalancer(unbalanced, fakeNodeInfo{}, nil)
+
+		dryRun.SetValue(true)
+		require.NoError(t, r.Rebalance(ctx))
+		assert.Empty(t, mover.moves)
+	})
+
+	t.Run("throttled by operations in progress", func(t *testing.T) {
+		fsm := newReplicationFSM()
+		r, mover, _, _ := newRebalancer(unbalanced, fakeNodeInfo{}, fsm)
+
+		require.NoError(t, fsm.Replicate(1, &api.ReplicationReplicateShardRequest{
+			Uuid:             strfmt.UUID("00000000-0000-0000-0000-000000000001"),
+			SourceNode:       "node1",
+			SourceCollection: "C",
+			SourceShard:      "S1",
+			TargetNode:       "node3",
+			TransferType:     api.MOVE.String(),
+		}))
+		require.NoError(t, r.Rebalance(ctx))
+		assert.Empty(t, mover.moves)
+
+		require.NoError(t, fsm.UpdateReplicationOpStatus(&api.ReplicationUpdateOpStateRequest{Id: 1, State: api.READY}))
+		require.NoError(t, r.Rebalance(ctx))
+		assert.Len(t, mover.moves, 1)
+	})
+
+	t.Run("not leader", func(t *testing.T) {
+		mover := &fakeMover{}
+		r := replication.NewRebalancer(replication.RebalancerParams{
+			Logger:             logger,
+			FSM:                newReplicationFSM(),
+			Leader:             fakeLeader(false),
+			NodeStatus:         unbalanced,
+			NodeInfo:           fakeNodeInfo{},
+			Mover:              mover,
+			MaxConcurrentMoves: 1,
+			ImbalanceThreshold: 0.2,
+		})
+
+		require.NoError(t, r.Rebalance(ctx))
+		assert.Empty(t, mover.moves)
+	})
+}
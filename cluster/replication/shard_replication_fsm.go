@@ -188,6 +188,19 @@ func (s *ShardReplicationFSM) GetOpsForTargetNode(node string) ([]ShardReplicati
 	return val, ok
 }
 
+// GetUnfinishedOps returns the operations that are neither ready nor cancelled
+func (s *ShardReplicationFSM) GetUnfinishedOps() []ShardReplicationOp {
+	s.opsLock.RLock()
+	defer s.opsLock.RUnlock()
+	ops := make([]ShardReplicationOp, 0, len(s.opsStatus))
+	for op, status := range s.opsStatus {
+		if state := status.GetCurrentState(); state != api.READY && state != api.CANCELLED {
+			ops = append(ops, op)
+		}
+	}
+	return ops
+}
+
 // ShouldConsumeOps returns true if the operation should be consumed by the consumer
 //
 // It checks the following two conditions:
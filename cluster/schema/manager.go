@@ -17,6 +17,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
@@ -24,6 +26,7 @@ import (
 
 	command "github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 var (
@@ -352,6 +355,122 @@ func (s *SchemaManager) DeleteReplicaFromShard(cmd *command.ApplyRequest, schema
 	)
 }
 
+func (s *SchemaManager) StartResharding(cmd *command.ApplyRequest, schemaOnly bool) error {
+	req := command.StartReshardingRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	return s.apply(
+		applyOp{
+			op:           cmd.GetType().String(),
+			updateSchema: func() error { return s.schema.startResharding(cmd.Class, cmd.Version, req) },
+			updateStore: func() error {
+				if req.Operation != sharding.ReshardingSplit {
+					return nil
+				}
+				// the target shard of a split lives on the same nodes as its source
+				replicas, _, err := s.schema.ShardReplicas(req.Class, req.TargetShard)
+				if err != nil || !slices.Contains(replicas, s.schema.nodeID) {
+					return err
+				}
+				return s.db.AddReplicaToShard(req.Class, req.TargetShard, s.schema.nodeID)
+			},
+			schemaOnly: schemaOnly,
+		},
+	)
+}
+
+// CommitResharding routes the virtual shards of the resharding operation
+// started on the source shard to the target shard.
+func (s *SchemaManager) CommitResharding(class, source string, v uint64, schemaOnly bool) error {
+	return s.apply(
+		applyOp{
+			op: "CommitResharding",
+			updateSchema: func() error {
+				_, err := s.schema.updateResharding(class, v, source, (*sharding.State).CommitResharding)
+				return err
+			},
+			updateStore: func() error { return nil },
+			schemaOnly:  schemaOnly,
+		},
+	)
+}
+
+// FinishResharding completes the resharding operation started on the source
+// shard and drops the local copy of a merged source shard.
+func (s *SchemaManager) FinishResharding(class, source string, v uint64, schemaOnly bool) error {
+	var r sharding.Resharding
+	return s.apply(
+		applyOp{
+			op: "FinishResharding",
+			updateSchema: func() (err error) {
+				r, err = s.schema.updateResharding(class, v, source, (*sharding.State).FinishResharding)
+				return err
+			},
+			updateStore: func() error {
+				if r.Operation != sharding.ReshardingMerge {
+					return nil
+				}
+				return s.db.DropShard(class, source)
+			},
+			schemaOnly: schemaOnly,
+		},
+	)
+}
+
+// AbortResharding cancels the resharding operation started on the source
+// shard and drops the local copy of the target shard of a split.
+func (s *SchemaManager) AbortResharding(class, source string, v uint64, schemaOnly bool) error {
+	var r sharding.Resharding
+	return s.apply(
+		applyOp{
+			op: "AbortResharding",
+			updateSchema: func() (err error) {
+				r, err = s.schema.updateResharding(class, v, source, (*sharding.State).AbortResharding)
+				return err
+			},
+			updateStore: func() error {
+				if r.Operation != sharding.ReshardingSplit {
+					return nil
+				}
+				return s.db.DropShard(class, r.TargetShard)
+			},
+			schemaOnly: schemaOnly,
+		},
+	)
+}
+
+// UpdateVectorizer replaces the vectorizer module config of a target vector
+// once its objects were re-embedded with it. The local shadow vector indexes
+// of the re-vectorization submitted at the given time are swapped in by the
+// same operation, so that vectors embedded with the new settings are never
+// added to or searched in the replaced indexes.
+func (s *SchemaManager) UpdateVectorizer(class, targetVector string, vectorizer map[string]interface{},
+	submittedAt int64, v uint64, schemaOnly bool,
+) error {
+	update := func(meta *metaClass) error {
+		vectorConfig, ok := meta.Class.VectorConfig[targetVector]
+		if !ok {
+			return fmt.Errorf("target vector %q not found in class %q", targetVector, class)
+		}
+		vectorConfig.Vectorizer = vectorizer
+		meta.Class.VectorConfig = maps.Clone(meta.Class.VectorConfig)
+		meta.Class.VectorConfig[targetVector] = vectorConfig
+		meta.ClassVersion = v
+		return nil
+	}
+
+	return s.apply(
+		applyOp{
+			op:           "UpdateVectorizer",
+			updateSchema: func() error { return s.schema.updateClass(class, update) },
+			updateStore:  func() error { return s.db.SwapVectorIndex(class, targetVector, submittedAt) },
+			schemaOnly:   schemaOnly,
+		},
+	)
+}
+
 func (s *SchemaManager) AddTenants(cmd *command.ApplyRequest, schemaOnly bool) error {
 	req := &command.AddTenantsRequest{}
 	if err := gproto.Unmarshal(cmd.SubCommand, req); err != nil {
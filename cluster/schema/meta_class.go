@@ -194,6 +194,44 @@ func (m *metaClass) DeleteReplicaFromShard(v uint64, shard string, replica strin
 	return nil
 }
 
+func (m *metaClass) StartResharding(v uint64, req command.StartReshardingRequest) error {
+	m.Lock()
+	defer m.Unlock()
+
+	var err error
+	switch req.Operation {
+	case sharding.ReshardingSplit:
+		err = m.Sharding.StartSplit(req.SourceShard, req.TargetShard, req.Virtual)
+	case sharding.ReshardingMerge:
+		err = m.Sharding.StartMerge(req.SourceShard, req.TargetShard)
+	default:
+		err = fmt.Errorf("unknown resharding operation %q", req.Operation)
+	}
+	if err != nil {
+		return err
+	}
+	m.ClassVersion = v
+	return nil
+}
+
+// UpdateResharding applies update to the resharding operation started on the
+// source shard and returns the operation as it was before the update.
+func (m *metaClass) UpdateResharding(v uint64, source string, update func(s *sharding.State, source string) error) (sharding.Resharding, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	r, ok := m.Sharding.Resharding[source]
+	if !ok {
+		return sharding.Resharding{}, fmt.Errorf("shard %q is not being resharded", source)
+	}
+	r = r.DeepCopy()
+	if err := update(&m.Sharding, source); err != nil {
+		return sharding.Resharding{}, err
+	}
+	m.ClassVersion = v
+	return r, nil
+}
+
 // MergeProps makes sure duplicates are not created by ignoring new props
 // with the same names as old props.
 // If property of nested type is present in both new and old slices,
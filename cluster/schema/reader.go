@@ -177,6 +177,15 @@ func (rs SchemaReader) CopyShardingState(class string) (ss *sharding.State) {
 	return res
 }
 
+// IsResharding returns true if a physical shard of the class is being split
+// or merged
+func (rs SchemaReader) IsResharding(class string) bool {
+	t := prometheus.NewTimer(monitoring.GetMetrics().SchemaReadsLocal.WithLabelValues("IsResharding"))
+	defer t.ObserveDuration()
+
+	return rs.schema.IsResharding(class)
+}
+
 func (rs SchemaReader) GetShardsStatus(class, tenant string) (models.ShardStatusList, error) {
 	t := prometheus.NewTimer(monitoring.GetMetrics().SchemaReadsLocal.WithLabelValues("GetShardsStatus"))
 	defer t.ObserveDuration()
@@ -130,6 +130,21 @@ func (s *schema) Read(class string, reader func(*models.Class, *sharding.State)
 	return meta.RLockGuard(reader)
 }
 
+// IsResharding returns true if a physical shard of the class is being split
+// or merged. Unlike CopyShardingState it does not copy the sharding state.
+func (s *schema) IsResharding(class string) bool {
+	meta := s.metaClass(class)
+	if meta == nil {
+		return false
+	}
+	resharding := false
+	meta.RLockGuard(func(_ *models.Class, state *sharding.State) error {
+		resharding = state.IsResharding()
+		return nil
+	})
+	return resharding
+}
+
 func (s *schema) metaClass(class string) *metaClass {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -424,6 +439,28 @@ func (s *schema) deleteReplicaFromShard(class string, v uint64, shard string, re
 	return meta.DeleteReplicaFromShard(v, shard, replica)
 }
 
+func (s *schema) startResharding(class string, v uint64, req command.StartReshardingRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	meta := s.classes[class]
+	if meta == nil {
+		return ErrClassNotFound
+	}
+	return meta.StartResharding(v, req)
+}
+
+func (s *schema) updateResharding(class string, v uint64, source string,
+	update func(s *sharding.State, source string) error,
+) (sharding.Resharding, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	meta := s.classes[class]
+	if meta == nil {
+		return sharding.Resharding{}, ErrClassNotFound
+	}
+	return meta.UpdateResharding(v, source, update)
+}
+
 func (s *schema) addTenants(class string, v uint64, req *command.AddTenantsRequest) error {
 	req.Tenants = removeNilTenants(req.Tenants)
 
@@ -40,6 +40,11 @@ type Indexer interface {
 	UpdateShardStatus(*api.UpdateShardStatusRequest) error
 	AddReplicaToShard(class, shard, targetNode string) error
 	DeleteReplicaFromShard(class, shard, targetNode string) error
+	// DropShard drops the local copy of a shard removed by a resharding operation
+	DropShard(class, shard string) error
+	// SwapVectorIndex makes the local shadow vector indexes built by the
+	// re-vectorization submitted at the given time serve the target vector
+	SwapVectorIndex(class, targetVector string, submittedAt int64) error
 	GetShardsStatus(class, tenant string) (models.ShardStatusList, error)
 	UpdateIndex(api.UpdateClassRequest) error
 
@@ -48,8 +48,10 @@ type Service struct {
 	*Raft
 
 	replicationEngine *replication.ShardReplicationEngine
-	raftAddr          string
-	config            *Config
+	// rebalancer is nil unless the replica rebalancer is enabled
+	rebalancer *replication.Rebalancer
+	raftAddr   string
+	config     *Config
 
 	rpcClient *rpc.Client
 	rpcServer *rpc.Server
@@ -101,11 +103,28 @@ func New(cfg Config, authZController authorization.Controller, snapshotter fsm.S
 		replicationEngineShutdownTimeout,
 		metrics.NewReplicationEngineCallbacks(prometheus.DefaultRegisterer),
 	)
+	var rebalancer *replication.Rebalancer
+	if cfg.ReplicaRebalancer.Enabled {
+		rebalancer = replication.NewRebalancer(replication.RebalancerParams{
+			Logger:             cfg.Logger,
+			FSM:                fsm.replicationManager.GetReplicationFSM(),
+			Leader:             &fsm,
+			NodeStatus:         cfg.NodeStatus,
+			NodeInfo:           cfg.NodeInfo,
+			Mover:              raft,
+			Interval:           cfg.ReplicaRebalancer.Interval,
+			MaxConcurrentMoves: cfg.ReplicaRebalancer.MaxConcurrentMoves,
+			ImbalanceThreshold: cfg.ReplicaRebalancer.ImbalanceThreshold,
+			DryRun:             cfg.ReplicaRebalancer.DryRun,
+			Paused:             cfg.ReplicaRebalancer.Paused,
+		})
+	}
 	svr := rpc.NewServer(&fsm, raft, rpcListenAddress, cfg.RaftRPCMessageMaxSize, cfg.SentryEnabled, svrMetrics, cfg.Logger)
 
 	return &Service{
 		Raft:               raft,
 		replicationEngine:  replicationEngine,
+		rebalancer:         rebalancer,
 		raftAddr:           raftAdvertisedAddress,
 		config:             &cfg,
 		rpcClient:          client,
@@ -134,6 +153,10 @@ func (c *Service) onFSMCaughtUp(ctx context.Context) {
 						c.logger.WithError(err).Error("replication engine failed to start after FSM caught up")
 					}
 				}, c.logger)
+				if c.rebalancer != nil {
+					// the rebalancer schedules operations for the replication engine, it stops along with it
+					enterrors.GoWrapper(func() { c.rebalancer.Start(replicationEngineCtx) }, c.logger)
+				}
 				return
 			}
 		}
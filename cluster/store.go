@@ -28,6 +28,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 
+	"github.com/weaviate/weaviate/cluster/crosscluster"
 	"github.com/weaviate/weaviate/cluster/distributedtask"
 	"github.com/weaviate/weaviate/cluster/dynusers"
 	"github.com/weaviate/weaviate/cluster/fsm"
@@ -170,6 +171,12 @@ type Config struct {
 
 	// ReplicaMovementMinimumFinalizingWait is the upper time bound duration for replica movement operations.
 	ReplicaMovementMinimumFinalizingWait *runtime.DynamicValue[time.Duration]
+
+	// ReplicaRebalancer is the configuration of the automatic replica rebalancer.
+	ReplicaRebalancer config.ReplicaRebalancerConfig
+	// NodeStatus and NodeInfo provide the shards and disk usage of every node to the replica rebalancer
+	NodeStatus replication.NodeStatusGetter
+	NodeInfo   replication.NodeInfoGetter
 }
 
 // Store is the implementation of RAFT on this local node. It will handle the local schema and RAFT operations (startup,
@@ -225,6 +232,9 @@ type Store struct {
 	// distributedTaskManager is responsible for applying/querying the distributed task FSM used to handle distributed tasks.
 	distributedTasksManager *distributedtask.Manager
 
+	// crossClusterManager is responsible for applying/querying the cross-cluster replication state, i.e. the promotion of a standby cluster
+	crossClusterManager *crosscluster.Manager
+
 	// lastAppliedIndexToDB represents the index of the last applied command when the store is opened.
 	lastAppliedIndexToDB atomic.Uint64
 	// / lastAppliedIndex index of latest update to the store
@@ -261,6 +271,7 @@ func NewFSM(cfg Config, authZController authorization.Controller, snapshotter fs
 			Clock:            clockwork.NewRealClock(),
 			CompletedTaskTTL: cfg.DistributedTasks.CompletedTaskTTL,
 		}),
+		crossClusterManager: crosscluster.NewManager(),
 	}
 }
 
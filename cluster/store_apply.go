@@ -173,6 +173,10 @@ func (st *Store) Apply(l *raft.Log) interface{} {
 		f = func() {
 			ret.Error = st.schemaManager.DeleteReplicaFromShard(&cmd, schemaOnly)
 		}
+	case api.ApplyRequest_TYPE_START_RESHARDING:
+		f = func() {
+			ret.Error = st.startResharding(&cmd, schemaOnly)
+		}
 
 	case api.ApplyRequest_TYPE_ADD_TENANT:
 		f = func() {
@@ -276,15 +280,28 @@ func (st *Store) Apply(l *raft.Log) interface{} {
 	case api.ApplyRequest_TYPE_DISTRIBUTED_TASK_RECORD_NODE_COMPLETED:
 		f = func() {
 			ret.Error = st.distributedTasksManager.RecordNodeCompletion(&cmd, st.numberOfNodesInTheCluster())
+			if ret.Error == nil {
+				st.advanceResharding(&cmd, schemaOnly)
+				st.advanceRevectorize(&cmd, schemaOnly)
+			}
 		}
 	case api.ApplyRequest_TYPE_DISTRIBUTED_TASK_CANCEL:
 		f = func() {
 			ret.Error = st.distributedTasksManager.CancelTask(&cmd)
+			if ret.Error == nil {
+				st.advanceResharding(&cmd, schemaOnly)
+				st.advanceRevectorize(&cmd, schemaOnly)
+			}
 		}
 	case api.ApplyRequest_TYPE_DISTRIBUTED_TASK_CLEAN_UP:
 		f = func() {
 			ret.Error = st.distributedTasksManager.CleanUpTask(&cmd)
 		}
+
+	case api.ApplyRequest_TYPE_CROSS_CLUSTER_PROMOTE:
+		f = func() {
+			ret.Error = st.crossClusterManager.Promote(&cmd)
+		}
 	default:
 		// This could occur when a new command has been introduced in a later app version
 		// At this point, we need to panic so that the app undergo an upgrade during restart
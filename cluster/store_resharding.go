@@ -0,0 +1,150 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/cluster/distributedtask"
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+// startResharding registers a shard split or merge in the schema and schedules
+// the distributed task copying the objects to the target shard. Both happen
+// as part of the same log entry, so every node ends up with the same state.
+func (st *Store) startResharding(cmd *api.ApplyRequest, schemaOnly bool) error {
+	req := api.StartReshardingRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("unmarshal start resharding request: %w", err)
+	}
+
+	if err := st.schemaManager.StartResharding(cmd, schemaOnly); err != nil {
+		return err
+	}
+
+	return st.scheduleReshardingTask(api.ReshardingTaskPayload{
+		Class:       req.Class,
+		Operation:   req.Operation,
+		Phase:       sharding.ReshardingPhaseCopy,
+		SourceShard: req.SourceShard,
+		TargetShard: req.TargetShard,
+		Virtual:     req.Virtual,
+
+		SubmittedAtUnixMillis: req.SubmittedAtUnixMillis,
+	}, time.UnixMilli(req.SubmittedAtUnixMillis), cmd.Version)
+}
+
+// advanceResharding moves a resharding operation to its next phase once the
+// distributed task of its current phase is no longer running:
+//   - a finished copy task commits the new routing and schedules the cleanup
+//   - a finished cleanup task completes the operation
+//   - a failed or cancelled copy task aborts the operation
+//
+// It is called after applying any change to a task's status. Errors are only
+// logged, as the change to the task itself has been applied successfully.
+func (st *Store) advanceResharding(cmd *api.ApplyRequest, schemaOnly bool) {
+	task, ok := st.stoppedDistributedTask(cmd, api.ReshardingTasksNamespace)
+	if !ok {
+		return
+	}
+
+	var payload api.ReshardingTaskPayload
+	if err := json.Unmarshal(task.Payload, &payload); err != nil {
+		st.log.WithError(err).WithField("task", task.ID).Error("unmarshal resharding task payload")
+		return
+	}
+
+	log := st.log.WithFields(logrus.Fields{
+		"action":       "resharding",
+		"class":        payload.Class,
+		"operation":    payload.Operation,
+		"phase":        payload.Phase,
+		"source_shard": payload.SourceShard,
+		"target_shard": payload.TargetShard,
+		"task_status":  task.Status,
+	})
+
+	var err error
+	switch {
+	case payload.Phase == sharding.ReshardingPhaseCopy && task.Status == distributedtask.TaskStatusFinished:
+		if err = st.schemaManager.CommitResharding(payload.Class, payload.SourceShard, cmd.Version, schemaOnly); err != nil {
+			break
+		}
+		payload.Phase = sharding.ReshardingPhaseCleanup
+		err = st.scheduleReshardingTask(payload, task.FinishedAt, cmd.Version)
+	case payload.Phase == sharding.ReshardingPhaseCopy:
+		err = st.schemaManager.AbortResharding(payload.Class, payload.SourceShard, cmd.Version, schemaOnly)
+	case payload.Phase == sharding.ReshardingPhaseCleanup && task.Status == distributedtask.TaskStatusFinished:
+		err = st.schemaManager.FinishResharding(payload.Class, payload.SourceShard, cmd.Version, schemaOnly)
+	default:
+		// routing has already been switched to the target shard, so the
+		// operation can neither be rolled back nor completed automatically
+		log.WithField("task_error", task.Error).Error("resharding cleanup did not finish")
+		return
+	}
+
+	if err != nil {
+		log.WithError(err).Error("advance resharding")
+		return
+	}
+	log.Info("advanced resharding")
+}
+
+func (st *Store) scheduleReshardingTask(payload api.ReshardingTaskPayload, submittedAt time.Time, seqNum uint64) error {
+	bytes, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("marshal resharding task payload: %w", err)
+	}
+
+	taskID := api.ReshardingTaskID(payload.Class, payload.SourceShard, payload.Phase)
+	return st.distributedTasksManager.ScheduleTask(api.ReshardingTasksNamespace, taskID, bytes, submittedAt, seqNum)
+}
+
+// stoppedDistributedTask returns the task of the namespace whose status was
+// changed by the command, if it is no longer running.
+func (st *Store) stoppedDistributedTask(cmd *api.ApplyRequest, namespace string) (*distributedtask.Task, bool) {
+	var (
+		taskNamespace, taskID string
+		taskVersion           uint64
+	)
+	switch cmd.Type {
+	case api.ApplyRequest_TYPE_DISTRIBUTED_TASK_RECORD_NODE_COMPLETED:
+		var r api.RecordDistributedTaskNodeCompletionRequest
+		if err := json.Unmarshal(cmd.SubCommand, &r); err != nil {
+			return nil, false
+		}
+		taskNamespace, taskID, taskVersion = r.Namespace, r.Id, r.Version
+	case api.ApplyRequest_TYPE_DISTRIBUTED_TASK_CANCEL:
+		var r api.CancelDistributedTaskRequest
+		if err := json.Unmarshal(cmd.SubCommand, &r); err != nil {
+			return nil, false
+		}
+		taskNamespace, taskID, taskVersion = r.Namespace, r.Id, r.Version
+	default:
+		return nil, false
+	}
+
+	if taskNamespace != namespace {
+		return nil, false
+	}
+
+	task, ok := st.distributedTasksManager.GetTask(namespace, taskID)
+	if !ok || task.Version != taskVersion || task.Status == distributedtask.TaskStatusStarted {
+		return nil, false
+	}
+	return task, true
+}
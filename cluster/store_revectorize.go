@@ -0,0 +1,90 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/cluster/distributedtask"
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/cluster/schema"
+)
+
+// advanceRevectorize switches a re-vectorized target vector to the new
+// vectorizer settings once all nodes finished building their shadow vector
+// indexes. The nodes swap their shadow indexes in when applying the switch,
+// the scheduled swap task embeds the objects written meanwhile and drops the
+// replaced indexes. Shadow indexes of a failed or cancelled build are dropped
+// by the nodes' local cleanup.
+//
+// It is called after applying any change to a task's status. Errors are only
+// logged, as the change to the task itself has been applied successfully.
+func (st *Store) advanceRevectorize(cmd *api.ApplyRequest, schemaOnly bool) {
+	task, ok := st.stoppedDistributedTask(cmd, api.RevectorizeTasksNamespace)
+	if !ok {
+		return
+	}
+
+	var payload api.RevectorizeTaskPayload
+	if err := json.Unmarshal(task.Payload, &payload); err != nil {
+		st.log.WithError(err).WithField("task", task.ID).Error("unmarshal re-vectorization task payload")
+		return
+	}
+
+	log := st.log.WithFields(logrus.Fields{
+		"action":        "revectorize",
+		"class":         payload.Class,
+		"target_vector": payload.TargetVector,
+		"phase":         payload.Phase,
+		"task_status":   task.Status,
+	})
+
+	if payload.Phase != api.RevectorizePhaseBuild || task.Status != distributedtask.TaskStatusFinished {
+		if task.Status == distributedtask.TaskStatusFailed {
+			log.WithField("task_error", task.Error).Error("re-vectorization did not finish")
+		}
+		return
+	}
+
+	err := st.schemaManager.UpdateVectorizer(payload.Class, payload.TargetVector, payload.Vectorizer,
+		payload.SubmittedAtUnixMillis, cmd.Version, schemaOnly)
+	if errors.Is(err, schema.ErrSchema) {
+		log.WithError(err).Error("advance re-vectorization")
+		return
+	}
+	if err != nil {
+		// the swap task swaps the indexes of the shards that failed to swap here
+		log.WithError(err).Warn("swap shadow vector indexes")
+	}
+
+	payload.Phase = api.RevectorizePhaseSwap
+	if err := st.scheduleRevectorizeTask(payload, task.FinishedAt, cmd.Version); err != nil {
+		log.WithError(err).Error("advance re-vectorization")
+		return
+	}
+	log.Info("advanced re-vectorization")
+}
+
+func (st *Store) scheduleRevectorizeTask(payload api.RevectorizeTaskPayload, submittedAt time.Time, seqNum uint64) error {
+	bytes, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("marshal re-vectorization task payload: %w", err)
+	}
+
+	taskID := api.RevectorizeTaskID(payload.Class, payload.TargetVector, payload.Phase)
+	return st.distributedTasksManager.ScheduleTask(api.RevectorizeTasksNamespace, taskID, bytes, submittedAt, seqNum)
+}
@@ -0,0 +1,141 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/raft"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/cluster/distributedtask"
+	cmd "github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/cluster/mocks"
+	"github.com/weaviate/weaviate/usecases/sharding"
+	shardingConfig "github.com/weaviate/weaviate/usecases/sharding/config"
+)
+
+func TestStoreApplyRevectorize(t *testing.T) {
+	oldVectorizer := map[string]interface{}{"text2vec-old": map[string]interface{}{}}
+	newVectorizer := map[string]interface{}{"text2vec-new": map[string]interface{}{"model": "large"}}
+
+	setup := func(t *testing.T) (MockStore, cmd.RevectorizeTaskPayload) {
+		m := NewMockStore(t, "Node-1", 9092)
+		m.parser.On("ParseClass", mock.Anything).Return(nil)
+		m.indexer.On("TriggerSchemaUpdateCallbacks").Return()
+		m.indexer.On("AddClass", mock.Anything).Return(nil)
+
+		cfg, err := shardingConfig.ParseConfig(nil, 1)
+		require.NoError(t, err)
+		nodes := mocks.NewMockNodeSelector("Node-1")
+		ss, err := sharding.InitState("C1", cfg, nodes.LocalName(), nodes.StorageCandidates(), 1, false)
+		require.NoError(t, err)
+
+		cls := &models.Class{
+			Class: "C1",
+			VectorConfig: map[string]models.VectorConfig{
+				"text": {Vectorizer: oldVectorizer, VectorIndexType: "hnsw"},
+			},
+		}
+		resp := m.store.Apply(&raft.Log{
+			Index: 1,
+			Data:  cmdAsBytes("C1", cmd.ApplyRequest_TYPE_ADD_CLASS, cmd.AddClassRequest{Class: cls, State: ss}, nil),
+		})
+		require.NoError(t, resp.(Response).Error)
+
+		payload := cmd.RevectorizeTaskPayload{
+			Class:                 "C1",
+			TargetVector:          "text",
+			Phase:                 cmd.RevectorizePhaseBuild,
+			Vectorizer:            newVectorizer,
+			SubmittedAtUnixMillis: time.Now().UnixMilli(),
+		}
+		payloadBytes, err := json.Marshal(payload)
+		require.NoError(t, err)
+		resp = m.store.Apply(&raft.Log{Index: 2, Data: cmdAsBytes("", cmd.ApplyRequest_TYPE_DISTRIBUTED_TASK_ADD, cmd.AddDistributedTaskRequest{
+			Namespace:             cmd.RevectorizeTasksNamespace,
+			Id:                    cmd.RevectorizeTaskID("C1", "text", cmd.RevectorizePhaseBuild),
+			Payload:               payloadBytes,
+			SubmittedAtUnixMillis: payload.SubmittedAtUnixMillis,
+		}, nil)})
+		require.NoError(t, resp.(Response).Error)
+
+		return m, payload
+	}
+
+	// completion is recorded on the manager directly, as counting the nodes
+	// of the cluster requires raft
+	complete := func(t *testing.T, m MockStore, version uint64, failure *string) {
+		c := &cmd.ApplyRequest{
+			Type: cmd.ApplyRequest_TYPE_DISTRIBUTED_TASK_RECORD_NODE_COMPLETED,
+			SubCommand: mustMarshal(t, cmd.RecordDistributedTaskNodeCompletionRequest{
+				Namespace:            cmd.RevectorizeTasksNamespace,
+				Id:                   cmd.RevectorizeTaskID("C1", "text", cmd.RevectorizePhaseBuild),
+				Version:              2,
+				NodeId:               "Node-1",
+				Error:                failure,
+				FinishedAtUnixMillis: time.Now().UnixMilli(),
+			}),
+			Version: version,
+		}
+		require.NoError(t, m.store.distributedTasksManager.RecordNodeCompletion(c, 1))
+		m.store.advanceRevectorize(c, false)
+	}
+
+	t.Run("finished build switches the vectorizer, swaps the indexes and schedules the swap", func(t *testing.T) {
+		m, payload := setup(t)
+		m.indexer.On("SwapVectorIndex", "C1", "text", payload.SubmittedAtUnixMillis).Return(nil).Once()
+		complete(t, m, 3, nil)
+		m.indexer.AssertExpectations(t)
+
+		cls := m.store.SchemaReader().ReadOnlyClass("C1")
+		require.NotNil(t, cls)
+		assert.Equal(t, newVectorizer, cls.VectorConfig["text"].Vectorizer)
+
+		task, ok := m.store.distributedTasksManager.GetTask(cmd.RevectorizeTasksNamespace,
+			cmd.RevectorizeTaskID("C1", "text", cmd.RevectorizePhaseSwap))
+		require.True(t, ok)
+		assert.Equal(t, uint64(3), task.Version)
+		assert.Equal(t, distributedtask.TaskStatusStarted, task.Status)
+
+		var swap cmd.RevectorizeTaskPayload
+		require.NoError(t, json.Unmarshal(task.Payload, &swap))
+		assert.Equal(t, cmd.RevectorizePhaseSwap, swap.Phase)
+		assert.Equal(t, payload.SubmittedAtUnixMillis, swap.SubmittedAtUnixMillis)
+	})
+
+	t.Run("failed build keeps the vectorizer", func(t *testing.T) {
+		m, _ := setup(t)
+		failure := "vectorizer unavailable"
+		complete(t, m, 3, &failure)
+
+		cls := m.store.SchemaReader().ReadOnlyClass("C1")
+		require.NotNil(t, cls)
+		assert.Equal(t, oldVectorizer, cls.VectorConfig["text"].Vectorizer)
+
+		_, ok := m.store.distributedTasksManager.GetTask(cmd.RevectorizeTasksNamespace,
+			cmd.RevectorizeTaskID("C1", "text", cmd.RevectorizePhaseSwap))
+		assert.False(t, ok)
+	})
+}
+
+func mustMarshal(t *testing.T, v any) []byte {
+	t.Helper()
+	b, err := json.Marshal(v)
+	require.NoError(t, err)
+	return b
+}
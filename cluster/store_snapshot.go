@@ -48,6 +48,11 @@ func (s *Store) Persist(sink raft.SnapshotSink) (err error) {
 		return fmt.Errorf("replication snapshot: %w", err)
 	}
 
+	crossClusterSnapshot, err := s.crossClusterManager.Snapshot()
+	if err != nil {
+		return fmt.Errorf("cross-cluster snapshot: %w", err)
+	}
+
 	snap := fsm.Snapshot{
 		NodeID:           s.cfg.NodeID,
 		SnapshotID:       sink.ID(),
@@ -55,6 +60,7 @@ func (s *Store) Persist(sink raft.SnapshotSink) (err error) {
 		RBAC:             rbacSnapshot,
 		DistributedTasks: tasksSnapshot,
 		ReplicationOps:   replicationSnapshot,
+		CrossCluster:     crossClusterSnapshot,
 	}
 	if err := json.NewEncoder(sink).Encode(&snap); err != nil {
 		return fmt.Errorf("encode: %w", err)
@@ -143,6 +149,13 @@ func (st *Store) Restore(rc io.ReadCloser) error {
 			}
 		}
 
+		if snap.CrossCluster != nil {
+			if err := st.crossClusterManager.Restore(snap.CrossCluster); err != nil {
+				st.log.WithError(err).Error("restoring cross-cluster state from snapshot")
+				return fmt.Errorf("restore cross-cluster state from snapshot: %w", err)
+			}
+		}
+
 		if st.cfg.MetadataOnlyVoters {
 			return nil
 		}
@@ -24,16 +24,20 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 	logrustest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	gproto "google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 
+	"github.com/weaviate/weaviate/cluster/distributedtask"
 	cmd "github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/cluster/schema"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/cluster/mocks"
 	"github.com/weaviate/weaviate/usecases/fakes"
 	"github.com/weaviate/weaviate/usecases/sharding"
+	shardingConfig "github.com/weaviate/weaviate/usecases/sharding/config"
 )
 
 var (
@@ -758,6 +762,96 @@ func TestStoreApply(t *testing.T) {
 	}
 }
 
+func TestStoreApplyResharding(t *testing.T) {
+	cls := &models.Class{Class: "C2"}
+	newState := func(t *testing.T) *sharding.State {
+		cfg, err := shardingConfig.ParseConfig(nil, 1)
+		require.NoError(t, err)
+		nodes := mocks.NewMockNodeSelector("Node-1")
+		ss, err := sharding.InitState("C2", cfg, nodes.LocalName(), nodes.StorageCandidates(), 1, false)
+		require.NoError(t, err)
+		return ss
+	}
+
+	setup := func(t *testing.T) (MockStore, string, cmd.StartReshardingRequest) {
+		m := NewMockStore(t, "Node-1", 9091)
+		m.parser.On("ParseClass", mock.Anything).Return(nil)
+		m.indexer.On("TriggerSchemaUpdateCallbacks").Return()
+		m.indexer.On("AddClass", mock.Anything).Return(nil)
+
+		ss := newState(t)
+		source := ss.AllPhysicalShards()[0]
+		moving, err := ss.PlanSplit(source)
+		require.NoError(t, err)
+
+		resp := m.store.Apply(&raft.Log{
+			Index: 1,
+			Data:  cmdAsBytes("C2", cmd.ApplyRequest_TYPE_ADD_CLASS, cmd.AddClassRequest{Class: cls, State: ss}, nil),
+		})
+		require.NoError(t, resp.(Response).Error)
+
+		return m, source, cmd.StartReshardingRequest{
+			Class:                 "C2",
+			Operation:             sharding.ReshardingSplit,
+			SourceShard:           source,
+			TargetShard:           "target",
+			Virtual:               moving,
+			SubmittedAtUnixMillis: time.Now().UnixMilli(),
+		}
+	}
+
+	t.Run("start", func(t *testing.T) {
+		m, source, req := setup(t)
+		m.indexer.On("AddReplicaToShard", "C2", "target", "Node-1").Return(nil)
+
+		resp := m.store.Apply(&raft.Log{Index: 2, Data: cmdAsBytes("C2", cmd.ApplyRequest_TYPE_START_RESHARDING, req, nil)})
+		require.NoError(t, resp.(Response).Error)
+
+		ss := m.store.SchemaReader().CopyShardingState("C2")
+		require.Contains(t, ss.Resharding, source)
+		assert.Equal(t, sharding.ReshardingPhaseCopy, ss.Resharding[source].Phase)
+		assert.Contains(t, ss.Physical, "target")
+
+		task, ok := m.store.distributedTasksManager.GetTask(cmd.ReshardingTasksNamespace,
+			cmd.ReshardingTaskID("C2", source, sharding.ReshardingPhaseCopy))
+		require.True(t, ok)
+		assert.Equal(t, uint64(2), task.Version)
+		assert.Equal(t, distributedtask.TaskStatusStarted, task.Status)
+
+		var payload cmd.ReshardingTaskPayload
+		require.NoError(t, json.Unmarshal(task.Payload, &payload))
+		assert.Equal(t, req.Virtual, payload.Virtual)
+		assert.Equal(t, "target", payload.TargetShard)
+
+		// the source shard is already being resharded
+		resp = m.store.Apply(&raft.Log{Index: 3, Data: cmdAsBytes("C2", cmd.ApplyRequest_TYPE_START_RESHARDING, req, nil)})
+		assert.ErrorIs(t, resp.(Response).Error, schema.ErrSchema)
+		m.indexer.AssertExpectations(t)
+	})
+
+	t.Run("cancel copy phase", func(t *testing.T) {
+		m, source, req := setup(t)
+		m.indexer.On("AddReplicaToShard", "C2", "target", "Node-1").Return(nil)
+		m.indexer.On("DropShard", "C2", "target").Return(nil)
+
+		resp := m.store.Apply(&raft.Log{Index: 2, Data: cmdAsBytes("C2", cmd.ApplyRequest_TYPE_START_RESHARDING, req, nil)})
+		require.NoError(t, resp.(Response).Error)
+
+		resp = m.store.Apply(&raft.Log{Index: 3, Data: cmdAsBytes("", cmd.ApplyRequest_TYPE_DISTRIBUTED_TASK_CANCEL, cmd.CancelDistributedTaskRequest{
+			Namespace:             cmd.ReshardingTasksNamespace,
+			Id:                    cmd.ReshardingTaskID("C2", source, sharding.ReshardingPhaseCopy),
+			Version:               2,
+			CancelledAtUnixMillis: time.Now().UnixMilli(),
+		}, nil)})
+		require.NoError(t, resp.(Response).Error)
+
+		ss := m.store.SchemaReader().CopyShardingState("C2")
+		assert.False(t, ss.IsResharding())
+		assert.NotContains(t, ss.Physical, "target")
+		m.indexer.AssertExpectations(t)
+	})
+}
+
 type MockStore struct {
 	indexer *fakes.MockSchemaExecutor
 	parser  *fakes.MockParser
@@ -37,6 +37,7 @@ type Params struct {
 	NearVector       *searchparams.NearVector   `json:"nearVector"`
 	NearObject       *searchparams.NearObject   `json:"nearObject"`
 	Hybrid           *searchparams.HybridSearch `json:"hybrid"`
+	SubAggregation   *SubAggregation            `json:"subAggregation"`
 }
 
 func (p *Params) UnmarshalJSON(data []byte) error {
@@ -83,8 +84,9 @@ type ParamProperty struct {
 }
 
 type Aggregator struct {
-	Type  string `json:"type"`
-	Limit *int   `json:"limit"` // used on TopOccurrence Agg
+	Type       string   `json:"type"`
+	Limit      *int     `json:"limit"`      // used on TopOccurrence Agg
+	Percentile *float64 `json:"percentile"` // used on Percentile Agg
 }
 
 func (a Aggregator) String() string {
@@ -123,6 +125,39 @@ func NewTopOccurrencesAggregator(limit *int) Aggregator {
 	return Aggregator{Type: TopOccurrencesType, Limit: limit}
 }
 
+// CardinalityAggregator approximates the number of distinct values of
+// numerical, date and text props
+var CardinalityAggregator = Aggregator{Type: "cardinality"}
+
+const PercentileType = "percentile"
+
+// DefaultPercentiles are used if percentiles are requested without
+// specifying which ones
+var DefaultPercentiles = []float64{50, 90, 99}
+
+// NewPercentileAggregator creates a PercentileAggregator for numerical and
+// date props. Percentiles are given in the range [0, 100].
+func NewPercentileAggregator(percentile float64) Aggregator {
+	return Aggregator{Type: PercentileType, Percentile: &percentile}
+}
+
+// ValidateAggregators checks the arguments of the aggregators of every
+// property
+func ValidateAggregators(props []ParamProperty) error {
+	for _, prop := range props {
+		for _, agg := range prop.Aggregators {
+			if agg.Type != PercentileType {
+				continue
+			}
+			if agg.Percentile == nil || *agg.Percentile < 0 || *agg.Percentile > 100 {
+				return fmt.Errorf("property %q: percentiles must be between 0 and 100", prop.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
 // Aggregators used in ref props
 var (
 	PointingToAggregator = Aggregator{Type: "pointingTo"}
@@ -164,6 +199,10 @@ func ParseAggregatorProp(name string) (Aggregator, error) {
 	case TopOccurrencesType:
 		return NewTopOccurrencesAggregator(ptInt(5)), nil // default to limit 5, can be overwritten
 
+	// sketches
+	case CardinalityAggregator.String():
+		return CardinalityAggregator, nil
+
 	// ref
 	case PointingToAggregator.String():
 		return PointingToAggregator, nil
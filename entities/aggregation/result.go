@@ -11,6 +11,8 @@
 
 package aggregation
 
+import "github.com/weaviate/weaviate/entities/sketch"
+
 type Result struct {
 	Groups []Group `json:"groups"`
 }
@@ -19,6 +21,7 @@ type Group struct {
 	Properties map[string]Property `json:"properties"`
 	GroupedBy  *GroupedBy          `json:"groupedBy"` // optional to support ungrouped aggregations (formerly meta)
 	Count      int                 `json:"count"`
+	SubGroups  []Group             `json:"subGroups,omitempty"`
 }
 
 type Property struct {
@@ -29,6 +32,22 @@ type Property struct {
 	SchemaType            string                 `json:"schemaType"`
 	ReferenceAggregation  Reference              `json:"referenceAggregation"`
 	DateAggregations      map[string]interface{} `json:"dateAggregation"`
+	Percentiles           []Percentile           `json:"percentiles,omitempty"`
+	Cardinality           *int64                 `json:"cardinality,omitempty"`
+
+	// The sketches the approximate aggregations are computed from. They are
+	// needed to combine the results of multiple shards and are removed before
+	// the result is returned to the user.
+	TDigest     *sketch.TDigest     `json:"tdigest,omitempty"`
+	HyperLogLog *sketch.HyperLogLog `json:"hyperLogLog,omitempty"`
+}
+
+// Percentile is the approximate value at the given percentile. Value is a
+// float64 for numerical props and an RFC3339 formatted string for dates. It
+// is nil if there were no values.
+type Percentile struct {
+	Percentile float64     `json:"percentile"`
+	Value      interface{} `json:"value"`
 }
 
 type Text struct {
@@ -0,0 +1,155 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// SubAggregation splits every group of the parent aggregation into buckets
+// and aggregates the requested properties per bucket. Buckets are formed by
+// exactly one of GroupBy, Histogram or DateHistogram. SubAggregations can be
+// nested to build multi-level breakdowns, e.g. group by category, then by
+// author, then by month.
+type SubAggregation struct {
+	GroupBy        *filters.Path   `json:"groupBy"`
+	Histogram      *Histogram      `json:"histogram"`
+	DateHistogram  *DateHistogram  `json:"dateHistogram"`
+	Limit          *int            `json:"limit"`
+	Properties     []ParamProperty `json:"properties"`
+	SubAggregation *SubAggregation `json:"subAggregation"`
+}
+
+// Histogram buckets numeric values into fixed-width intervals. The key of a
+// bucket is the lower bound of its interval.
+type Histogram struct {
+	Property schema.PropertyName `json:"property"`
+	Interval float64             `json:"interval"`
+}
+
+// DateHistogram buckets date values by calendar interval. The key of a bucket
+// is the start of its interval in UTC, formatted as RFC3339.
+type DateHistogram struct {
+	Property schema.PropertyName `json:"property"`
+	Interval CalendarInterval    `json:"interval"`
+}
+
+type CalendarInterval string
+
+const (
+	CalendarIntervalMinute  CalendarInterval = "minute"
+	CalendarIntervalHour    CalendarInterval = "hour"
+	CalendarIntervalDay     CalendarInterval = "day"
+	CalendarIntervalWeek    CalendarInterval = "week"
+	CalendarIntervalMonth   CalendarInterval = "month"
+	CalendarIntervalQuarter CalendarInterval = "quarter"
+	CalendarIntervalYear    CalendarInterval = "year"
+)
+
+func ParseCalendarInterval(in string) (CalendarInterval, error) {
+	switch i := CalendarInterval(in); i {
+	case CalendarIntervalMinute, CalendarIntervalHour, CalendarIntervalDay,
+		CalendarIntervalWeek, CalendarIntervalMonth, CalendarIntervalQuarter,
+		CalendarIntervalYear:
+		return i, nil
+	default:
+		return "", fmt.Errorf("unrecognized calendar interval '%s'", in)
+	}
+}
+
+// Truncate returns the start of the interval t falls into. Weeks start on
+// Monday, all calculations are done in UTC.
+func (i CalendarInterval) Truncate(t time.Time) time.Time {
+	t = t.UTC()
+	switch i {
+	case CalendarIntervalMinute:
+		return t.Truncate(time.Minute)
+	case CalendarIntervalHour:
+		return t.Truncate(time.Hour)
+	case CalendarIntervalDay:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	case CalendarIntervalWeek:
+		daysSinceMonday := (int(t.Weekday()) + 6) % 7
+		return time.Date(t.Year(), t.Month(), t.Day()-daysSinceMonday, 0, 0, 0, 0, time.UTC)
+	case CalendarIntervalMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	case CalendarIntervalQuarter:
+		month := time.Month((int(t.Month())-1)/3*3 + 1)
+		return time.Date(t.Year(), month, 1, 0, 0, 0, 0, time.UTC)
+	case CalendarIntervalYear:
+		return time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return t
+	}
+}
+
+// Property returns the name of the property the buckets are built from
+func (s *SubAggregation) Property() schema.PropertyName {
+	switch {
+	case s.GroupBy != nil:
+		return s.GroupBy.Property
+	case s.Histogram != nil:
+		return s.Histogram.Property
+	case s.DateHistogram != nil:
+		return s.DateHistogram.Property
+	default:
+		return ""
+	}
+}
+
+// IsHistogram indicates whether buckets are ordered by their key rather
+// than by the number of objects they contain.
+func (s *SubAggregation) IsHistogram() bool {
+	return s.Histogram != nil || s.DateHistogram != nil
+}
+
+func (s *SubAggregation) Validate() error {
+	for level := s; level != nil; level = level.SubAggregation {
+		set := 0
+		if level.GroupBy != nil {
+			if len(level.GroupBy.Slice()) > 1 {
+				return fmt.Errorf("sub-aggregation: grouping by cross-refs not supported")
+			}
+			set++
+		}
+		if level.Histogram != nil {
+			if level.Histogram.Interval <= 0 {
+				return fmt.Errorf("sub-aggregation: histogram interval must be a positive number")
+			}
+			set++
+		}
+		if level.DateHistogram != nil {
+			if _, err := ParseCalendarInterval(string(level.DateHistogram.Interval)); err != nil {
+				return fmt.Errorf("sub-aggregation: date histogram: %w", err)
+			}
+			set++
+		}
+		if set != 1 {
+			return fmt.Errorf("sub-aggregation: exactly one of groupBy, histogram or dateHistogram must be set")
+		}
+		if level.Property() == "" {
+			return fmt.Errorf("sub-aggregation: property must be set")
+		}
+		if level.Limit != nil && *level.Limit <= 0 {
+			return fmt.Errorf("sub-aggregation: limit must be a positive integer")
+		}
+		if err := ValidateAggregators(level.Properties); err != nil {
+			return fmt.Errorf("sub-aggregation: %w", err)
+		}
+	}
+
+	return nil
+}
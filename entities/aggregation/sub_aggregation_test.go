@@ -0,0 +1,120 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/filters"
+)
+
+func TestCalendarIntervalTruncate(t *testing.T) {
+	// a Thursday
+	in := time.Date(2024, time.August, 15, 13, 47, 12, 500, time.FixedZone("CEST", 2*60*60))
+
+	tests := []struct {
+		interval CalendarInterval
+		expected time.Time
+	}{
+		{CalendarIntervalMinute, time.Date(2024, time.August, 15, 11, 47, 0, 0, time.UTC)},
+		{CalendarIntervalHour, time.Date(2024, time.August, 15, 11, 0, 0, 0, time.UTC)},
+		{CalendarIntervalDay, time.Date(2024, time.August, 15, 0, 0, 0, 0, time.UTC)},
+		{CalendarIntervalWeek, time.Date(2024, time.August, 12, 0, 0, 0, 0, time.UTC)},
+		{CalendarIntervalMonth, time.Date(2024, time.August, 1, 0, 0, 0, 0, time.UTC)},
+		{CalendarIntervalQuarter, time.Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC)},
+		{CalendarIntervalYear, time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.interval), func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.interval.Truncate(in))
+		})
+	}
+
+	t.Run("week starting on sunday belongs to previous monday", func(t *testing.T) {
+		sunday := time.Date(2024, time.September, 1, 8, 0, 0, 0, time.UTC)
+		assert.Equal(t, time.Date(2024, time.August, 26, 0, 0, 0, 0, time.UTC),
+			CalendarIntervalWeek.Truncate(sunday))
+	})
+}
+
+func TestSubAggregationValidate(t *testing.T) {
+	limit := 0
+
+	tests := []struct {
+		name    string
+		sub     *SubAggregation
+		wantErr bool
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "nested",
+			sub: &SubAggregation{
+				GroupBy: &filters.Path{Class: "Article", Property: "category"},
+				SubAggregation: &SubAggregation{
+					DateHistogram: &DateHistogram{Property: "published", Interval: CalendarIntervalMonth},
+				},
+			},
+		},
+		{
+			name:    "no bucket",
+			sub:     &SubAggregation{},
+			wantErr: true,
+		},
+		{
+			name: "more than one bucket",
+			sub: &SubAggregation{
+				GroupBy:   &filters.Path{Class: "Article", Property: "category"},
+				Histogram: &Histogram{Property: "wordCount", Interval: 10},
+			},
+			wantErr: true,
+		},
+		{
+			name: "non-positive interval in nested level",
+			sub: &SubAggregation{
+				GroupBy:        &filters.Path{Class: "Article", Property: "category"},
+				SubAggregation: &SubAggregation{Histogram: &Histogram{Property: "wordCount"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown calendar interval",
+			sub: &SubAggregation{
+				DateHistogram: &DateHistogram{Property: "published", Interval: "fortnight"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "non-positive limit",
+			sub: &SubAggregation{
+				Histogram: &Histogram{Property: "wordCount", Interval: 10},
+				Limit:     &limit,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.sub.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -66,6 +66,23 @@ type GetParams struct {
 	ReplicationProperties   *additional.ReplicationProperties
 	Tenant                  string
 	IsRefOrigin             bool // is created by ref filter
+	QueryPipeline           *QueryPipeline
+	// CollapseChunks searches the chunks of a chunked class and returns
+	// their parents instead
+	CollapseChunks bool
+}
+
+// QueryPipeline invokes a query pipeline declared on the class by name.
+// Parameters replace the `${name}` placeholders of its stages.
+type QueryPipeline struct {
+	Name       string
+	Parameters map[string]string
+	// Resolved is set once the stages have been expanded into the
+	// surrounding GetParams
+	Resolved bool
+	// RerankLimit cuts the results after the rerank stage, before the
+	// generate stage runs
+	RerankLimit int
 }
 
 type Embedding interface {
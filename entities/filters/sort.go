@@ -11,6 +11,31 @@
 
 package filters
 
+// The pseudo properties _distance and _score can only be sorted by when the
+// query is a vector, bm25 or hybrid search. They order the search results by
+// the vector distance or the search score respectively.
+const (
+	SortPropDistance = "_distance"
+	SortPropScore    = "_score"
+)
+
+// IsSearchSortProperty returns whether propName refers to the distance or
+// score of a search result rather than to a property of the object
+func IsSearchSortProperty(propName string) bool {
+	return propName == SortPropDistance || propName == SortPropScore
+}
+
+// UsesSearchSortProperty returns whether any of the sort clauses sorts by
+// the distance or score of a search result
+func UsesSearchSortProperty(sort []Sort) bool {
+	for i := range sort {
+		if len(sort[i].Path) == 1 && IsSearchSortProperty(sort[i].Path[0]) {
+			return true
+		}
+	}
+	return false
+}
+
 // Sort contains path and order (asc, desc) information
 type Sort struct {
 	Path  []string `json:"path"`
@@ -56,7 +56,7 @@ func validateSortClause(getClass func(string) *models.Class, className schema.Cl
 			return errors.Errorf("class %q does not exist in schema", className)
 		}
 		propName := schema.PropertyName(path[0])
-		if IsInternalProperty(propName) {
+		if IsInternalProperty(propName) || IsSearchSortProperty(path[0]) {
 			// handle internal properties
 			return nil
 		}
@@ -50,6 +50,16 @@ func TestSortValidation(t *testing.T) {
 			valid: false,
 			prop:  "my_idz",
 		},
+		{
+			name:  "distance of search results",
+			valid: true,
+			prop:  "_distance",
+		},
+		{
+			name:  "score of search results",
+			valid: true,
+			prop:  "_score",
+		},
 	}
 
 	for _, tt := range tests {
@@ -49,6 +49,9 @@ type Class struct {
 	// Define properties of the collection.
 	Properties []*Property `json:"properties"`
 
+	// Named, server-side query pipelines that can be invoked by name at query time.
+	QueryPipelines []*QueryPipeline `json:"queryPipelines,omitempty"`
+
 	// replication config
 	ReplicationConfig *ReplicationConfig `json:"replicationConfig,omitempty"`
 
@@ -84,6 +87,10 @@ func (m *Class) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateQueryPipelines(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateReplicationConfig(formats); err != nil {
 		res = append(res, err)
 	}
@@ -162,6 +169,32 @@ func (m *Class) validateProperties(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Class) validateQueryPipelines(formats strfmt.Registry) error {
+	if swag.IsZero(m.QueryPipelines) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.QueryPipelines); i++ {
+		if swag.IsZero(m.QueryPipelines[i]) { // not required
+			continue
+		}
+
+		if m.QueryPipelines[i] != nil {
+			if err := m.QueryPipelines[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("queryPipelines" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("queryPipelines" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Class) validateReplicationConfig(formats strfmt.Registry) error {
 	if swag.IsZero(m.ReplicationConfig) { // not required
 		return nil
@@ -223,6 +256,10 @@ func (m *Class) ContextValidate(ctx context.Context, formats strfmt.Registry) er
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateQueryPipelines(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateReplicationConfig(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -289,6 +326,26 @@ func (m *Class) contextValidateProperties(ctx context.Context, formats strfmt.Re
 	return nil
 }
 
+func (m *Class) contextValidateQueryPipelines(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.QueryPipelines); i++ {
+
+		if m.QueryPipelines[i] != nil {
+			if err := m.QueryPipelines[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("queryPipelines" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("queryPipelines" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Class) contextValidateReplicationConfig(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.ReplicationConfig != nil {
@@ -35,6 +35,9 @@ type GraphQLResponse struct {
 
 	// Array with errors.
 	Errors []*GraphQLError `json:"errors,omitempty"`
+
+	// GraphQL extensions, e.g. the profiles of queries run with the profile argument.
+	Extensions map[string]JSONObject `json:"extensions,omitempty"`
 }
 
 // Validate validates this graph q l response
@@ -48,6 +48,9 @@ type NodeShardStatus struct {
 	// The number of objects in shard.
 	ObjectCount int64 `json:"objectCount"`
 
+	// The progress of re-vectorizing named vectors of the shard.
+	Revectorization []*RevectorizationProgress `json:"revectorization"`
+
 	// The status of the vector indexing process.
 	VectorIndexingStatus string `json:"vectorIndexingStatus"`
 
@@ -63,6 +66,10 @@ func (m *NodeShardStatus) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateRevectorization(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -95,6 +102,32 @@ func (m *NodeShardStatus) validateAsyncReplicationStatus(formats strfmt.Registry
 	return nil
 }
 
+func (m *NodeShardStatus) validateRevectorization(formats strfmt.Registry) error {
+	if swag.IsZero(m.Revectorization) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Revectorization); i++ {
+		if swag.IsZero(m.Revectorization[i]) { // not required
+			continue
+		}
+
+		if m.Revectorization[i] != nil {
+			if err := m.Revectorization[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("revectorization" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("revectorization" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // ContextValidate validate this node shard status based on the context it is used
 func (m *NodeShardStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -103,6 +136,10 @@ func (m *NodeShardStatus) ContextValidate(ctx context.Context, formats strfmt.Re
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateRevectorization(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -129,6 +166,26 @@ func (m *NodeShardStatus) contextValidateAsyncReplicationStatus(ctx context.Cont
 	return nil
 }
 
+func (m *NodeShardStatus) contextValidateRevectorization(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Revectorization); i++ {
+
+		if m.Revectorization[i] != nil {
+			if err := m.Revectorization[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("revectorization" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("revectorization" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *NodeShardStatus) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -38,6 +38,9 @@ type NodeStatus struct {
 	// The gitHash of Weaviate.
 	GitHash string `json:"gitHash,omitempty"`
 
+	// The health of the providers called by the modules of the node.
+	Modules []*NodeModuleStatus `json:"modules"`
+
 	// The name of the node.
 	Name string `json:"name,omitempty"`
 
@@ -63,6 +66,10 @@ func (m *NodeStatus) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateModules(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateShards(formats); err != nil {
 		res = append(res, err)
 	}
@@ -100,6 +107,32 @@ func (m *NodeStatus) validateBatchStats(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *NodeStatus) validateModules(formats strfmt.Registry) error {
+	if swag.IsZero(m.Modules) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Modules); i++ {
+		if swag.IsZero(m.Modules[i]) { // not required
+			continue
+		}
+
+		if m.Modules[i] != nil {
+			if err := m.Modules[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("modules" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("modules" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *NodeStatus) validateShards(formats strfmt.Registry) error {
 	if swag.IsZero(m.Shards) { // not required
 		return nil
@@ -201,6 +234,10 @@ func (m *NodeStatus) ContextValidate(ctx context.Context, formats strfmt.Registr
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateModules(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateShards(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -231,6 +268,26 @@ func (m *NodeStatus) contextValidateBatchStats(ctx context.Context, formats strf
 	return nil
 }
 
+func (m *NodeStatus) contextValidateModules(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Modules); i++ {
+
+		if m.Modules[i] != nil {
+			if err := m.Modules[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("modules" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("modules" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *NodeStatus) contextValidateShards(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.Shards); i++ {
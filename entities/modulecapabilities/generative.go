@@ -55,6 +55,83 @@ type GenerativeClient interface {
 	) (*GenerateResponse, error)
 }
 
+// GenerateStreamFn receives the text of a generative response piece by piece
+// while it is being generated. Returning an error aborts the generation.
+type GenerateStreamFn = func(delta string) error
+
+// GenerativeStreamingClient is implemented by generative clients that can
+// forward a response while the provider is still generating it. The returned
+// response holds the complete result, the same as the non-streaming methods.
+type GenerativeStreamingClient interface {
+	GenerativeClient
+	GenerateSingleResultStream(ctx context.Context,
+		properties *GenerateProperties, prompt string, requestParams interface{}, debug bool, cfg moduletools.ClassConfig,
+		onDelta GenerateStreamFn,
+	) (*GenerateResponse, error)
+	GenerateAllResultsStream(ctx context.Context,
+		properties []*GenerateProperties, task string, requestParams interface{}, debug bool, cfg moduletools.ClassConfig,
+		onDelta GenerateStreamFn,
+	) (*GenerateResponse, error)
+}
+
+// Roles of the messages of a tool calling conversation
+const (
+	GenerativeRoleUser      = "user"
+	GenerativeRoleAssistant = "assistant"
+	GenerativeRoleTool      = "tool"
+)
+
+// GenerativeTool describes a function the model may ask to call. Parameters
+// holds the JSON schema of its arguments.
+type GenerativeTool struct {
+	Name        string
+	Description string
+	Parameters  map[string]interface{}
+}
+
+// GenerativeToolCall is a tool call requested by the model, Arguments holds
+// the JSON encoded arguments
+type GenerativeToolCall struct {
+	ID        string
+	Name      string
+	Arguments string
+}
+
+// GenerativeMessage is a message of a tool calling conversation. Assistant
+// messages may request tool calls, tool messages answer one of them.
+type GenerativeMessage struct {
+	Role       string
+	Content    string
+	ToolCalls  []GenerativeToolCall
+	ToolCallID string
+}
+
+// GenerateToolsRequest is a conversation in which the model may call tools
+type GenerateToolsRequest struct {
+	System   string
+	Messages []GenerativeMessage
+	Tools    []GenerativeTool
+	// DisableTools asks for an answer without further tool calls. The tools
+	// stay declared, as earlier messages of the conversation refer to them.
+	DisableTools bool
+}
+
+// GenerateToolsResponse holds either the tool calls requested by the model
+// or its final answer
+type GenerateToolsResponse struct {
+	Result    *string
+	ToolCalls []GenerativeToolCall
+	Params    map[string]interface{}
+}
+
+// GenerativeToolsClient is implemented by generative clients whose models
+// can call tools
+type GenerativeToolsClient interface {
+	GenerateWithTools(ctx context.Context, request *GenerateToolsRequest,
+		requestParams interface{}, cfg moduletools.ClassConfig,
+	) (*GenerateToolsResponse, error)
+}
+
 // GenerativeProperty defines all needed additional request / response parameters
 // only client setting is manadatory as we can have generative modules
 // that don't expose any additional request / response params.
@@ -36,6 +36,12 @@ type NearParam interface {
 	SimilarityMetricProvided() bool
 }
 
+// VectorizerParam defines params which may override the vectorizer module
+// config of the target vector for a single query
+type VectorizerParam interface {
+	GetVectorizer() map[string]interface{}
+}
+
 // ValidateFn validates a given module param
 type ValidateFn = func(param interface{}) error
 
@@ -47,6 +47,22 @@ type ReferenceVectorizer[T dto.Embedding] interface {
 		cfg moduletools.ClassConfig, findObjectFn FindObjectFn) (T, error)
 }
 
+// ReferenceVectorizerProperties can optionally be implemented by ref2vec
+// modules to report which reference properties the object's vector is
+// calculated from. Modules which don't implement it are assumed to depend on
+// all reference properties of the class.
+type ReferenceVectorizerProperties interface {
+	VectorizableReferenceProperties(cfg moduletools.ClassConfig) ([]string, error)
+}
+
+// ReferenceVectorizerWeightProperties can optionally be implemented by ref2vec
+// modules which weight the referenced objects by some of their properties.
+// The vectors of the referencing objects are recalculated whenever one of
+// these properties of a referenced object changes.
+type ReferenceVectorizerWeightProperties interface {
+	ReferenceWeightProperties(cfg moduletools.ClassConfig) ([]string, error)
+}
+
 type InputVectorizer[T dto.Embedding] interface {
 	VectorizeInput(ctx context.Context, input string,
 		cfg moduletools.ClassConfig) (T, error)
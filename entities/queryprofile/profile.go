@@ -0,0 +1,193 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package queryprofile collects where the time of a single query was spent.
+// A profile is carried by the context of the query, the layers executing the
+// query record into it if present.
+package queryprofile
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+type ctxKey struct{}
+
+// ContextWithProfile returns a copy of ctx carrying the profile
+func ContextWithProfile(ctx context.Context, p *Profile) context.Context {
+	return context.WithValue(ctx, ctxKey{}, p)
+}
+
+// FromContext returns the profile carried by ctx, or nil
+func FromContext(ctx context.Context) *Profile {
+	p, _ := ctx.Value(ctxKey{}).(*Profile)
+	return p
+}
+
+// Shard is the profile of the search in a single shard
+type Shard struct {
+	Name string
+	Node string
+	// Remote is set if the shard was searched on another node, only the
+	// latency of the request is known then
+	Remote bool
+	Took   time.Duration
+	// Details are the timings, filter cardinalities and decisions annotated
+	// while searching the shard
+	Details map[string]any
+}
+
+// Profile of a query
+type Profile struct {
+	mu      sync.Mutex
+	shards  []Shard
+	details map[string]any
+}
+
+func New() *Profile {
+	return &Profile{details: map[string]any{}}
+}
+
+// AddShard records the search of a shard. A shard searched multiple times,
+// e.g. by the vector and keyword part of a hybrid query, is recorded once per
+// search.
+func (p *Profile) AddShard(shard Shard) {
+	shard.Details = withoutDurationStrings(shard.Details)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.shards = append(p.shards, shard)
+}
+
+// Annotate records a detail of the query outside of the shards, e.g. the
+// time spent vectorizing the query
+func (p *Profile) Annotate(key string, value any) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.details[key] = value
+}
+
+// Shards returns the recorded shards ordered by name
+func (p *Profile) Shards() []Shard {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	shards := make([]Shard, len(p.shards))
+	copy(shards, p.shards)
+	sort.SliceStable(shards, func(i, j int) bool {
+		return shards[i].Name < shards[j].Name
+	})
+	return shards
+}
+
+// Details returns the details recorded outside of the shards
+func (p *Profile) Details() map[string]any {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return maps.Clone(p.details)
+}
+
+// MarshalJSON renders durations human readable
+func (p *Profile) MarshalJSON() ([]byte, error) {
+	type shard struct {
+		Name    string         `json:"name"`
+		Node    string         `json:"node,omitempty"`
+		Remote  bool           `json:"remote"`
+		Took    string         `json:"took"`
+		Details map[string]any `json:"details,omitempty"`
+	}
+	type profile struct {
+		Shards  []shard        `json:"shards"`
+		Details map[string]any `json:"details,omitempty"`
+	}
+
+	shards := p.Shards()
+	out := profile{
+		Shards:  make([]shard, len(shards)),
+		Details: readableDurations(p.Details()),
+	}
+	for i, s := range shards {
+		out.Shards[i] = shard{
+			Name:    s.Name,
+			Node:    s.Node,
+			Remote:  s.Remote,
+			Took:    s.Took.String(),
+			Details: readableDurations(s.Details),
+		}
+	}
+	return json.Marshal(out)
+}
+
+// withoutDurationStrings drops the human readable copies the slow query log
+// annotates along with every duration
+func withoutDurationStrings(details map[string]any) map[string]any {
+	for key := range details {
+		if base, ok := strings.CutSuffix(key, "_string"); ok {
+			if _, ok := details[base].(time.Duration); ok {
+				delete(details, key)
+			}
+		}
+	}
+	return details
+}
+
+func readableDurations(details map[string]any) map[string]any {
+	if len(details) == 0 {
+		return nil
+	}
+	out := make(map[string]any, len(details))
+	for key, val := range details {
+		if d, ok := val.(time.Duration); ok {
+			out[key] = d.String()
+			continue
+		}
+		out[key] = val
+	}
+	return out
+}
+
+type profilesCtxKey struct{}
+
+// Profiles collects the profiles of all queries of a request, e.g. of all
+// classes queried by a single GraphQL request
+type Profiles struct {
+	mu       sync.Mutex
+	profiles map[string]*Profile
+}
+
+// ContextWithProfiles returns a copy of ctx carrying an empty collection
+func ContextWithProfiles(ctx context.Context) (context.Context, *Profiles) {
+	ps := &Profiles{profiles: map[string]*Profile{}}
+	return context.WithValue(ctx, profilesCtxKey{}, ps), ps
+}
+
+// ProfilesFromContext returns the collection carried by ctx, or nil
+func ProfilesFromContext(ctx context.Context) *Profiles {
+	ps, _ := ctx.Value(profilesCtxKey{}).(*Profiles)
+	return ps
+}
+
+// Add the profile of the query with the given name
+func (ps *Profiles) Add(name string, p *Profile) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ps.profiles[name] = p
+}
+
+// All returns the collected profiles by the name of their query
+func (ps *Profiles) All() map[string]*Profile {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	return maps.Clone(ps.profiles)
+}
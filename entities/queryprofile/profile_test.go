@@ -0,0 +1,85 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package queryprofile
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfile(t *testing.T) {
+	assert.Nil(t, FromContext(context.Background()))
+
+	p := New()
+	ctx := ContextWithProfile(context.Background(), p)
+	require.Same(t, p, FromContext(ctx))
+
+	p.AddShard(Shard{Name: "shard2", Remote: true, Took: 3 * time.Millisecond})
+	p.AddShard(Shard{
+		Name: "shard1",
+		Node: "node1",
+		Took: 2 * time.Millisecond,
+		Details: map[string]any{
+			"objects_took":        time.Millisecond,
+			"objects_took_string": "1ms",
+			"filters_ids_matched": 7,
+			"unrelated_string":    "kept",
+		},
+	})
+	p.Annotate("vectorize_took", 5*time.Millisecond)
+
+	shards := p.Shards()
+	require.Len(t, shards, 2)
+	assert.Equal(t, "shard1", shards[0].Name)
+	assert.Equal(t, map[string]any{
+		"objects_took":        time.Millisecond,
+		"filters_ids_matched": 7,
+		"unrelated_string":    "kept",
+	}, shards[0].Details)
+	assert.Equal(t, "shard2", shards[1].Name)
+	assert.Equal(t, map[string]any{"vectorize_took": 5 * time.Millisecond}, p.Details())
+
+	t.Run("json", func(t *testing.T) {
+		b, err := json.Marshal(p)
+		require.Nil(t, err)
+		assert.JSONEq(t, `{
+			"shards": [
+				{
+					"name": "shard1",
+					"node": "node1",
+					"remote": false,
+					"took": "2ms",
+					"details": {"objects_took": "1ms", "filters_ids_matched": 7, "unrelated_string": "kept"}
+				},
+				{"name": "shard2", "remote": true, "took": "3ms"}
+			],
+			"details": {"vectorize_took": "5ms"}
+		}`, string(b))
+	})
+}
+
+func TestProfiles(t *testing.T) {
+	assert.Nil(t, ProfilesFromContext(context.Background()))
+
+	ctx, profiles := ContextWithProfiles(context.Background())
+	require.Same(t, profiles, ProfilesFromContext(ctx))
+	assert.Empty(t, profiles.All())
+
+	p := New()
+	profiles.Add("Get.Article", p)
+	assert.Equal(t, map[string]*Profile{"Get.Article": p}, profiles.All())
+}
@@ -110,6 +110,9 @@ type HybridSearch struct {
 	WithDistance     bool          `json:"withDistance"`
 	NearTextParams   *NearTextParams
 	NearVectorParams *NearVector
+	// Vectorizer overrides the vectorizer module config of the target
+	// vectors when vectorizing the query
+	Vectorizer map[string]interface{} `json:"vectorizer"`
 }
 
 type NearObject struct {
@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sketch
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/bits"
+
+	"github.com/spaolacci/murmur3"
+)
+
+// DefaultPrecision results in 2^14 registers, i.e. 16KiB per sketch and a
+// standard error of about 0.8%
+const DefaultPrecision = 14
+
+// HyperLogLog estimates the number of distinct values added to it. Sketches
+// of the same precision can be merged, the result is the same as if all
+// values had been added to a single sketch.
+type HyperLogLog struct {
+	precision uint8
+	registers []uint8
+}
+
+func NewHyperLogLog(precision uint8) *HyperLogLog {
+	if precision < 4 || precision > 18 {
+		precision = DefaultPrecision
+	}
+
+	return &HyperLogLog{
+		precision: precision,
+		registers: make([]uint8, 1<<precision),
+	}
+}
+
+func (h *HyperLogLog) AddString(value string) {
+	h.AddHash(murmur3.Sum64([]byte(value)))
+}
+
+func (h *HyperLogLog) AddFloat64(value float64) {
+	h.AddInt64(int64(math.Float64bits(value)))
+}
+
+func (h *HyperLogLog) AddInt64(value int64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], uint64(value))
+	h.AddHash(murmur3.Sum64(buf[:]))
+}
+
+// AddHash adds an already hashed value. The hash must be uniformly
+// distributed over all 64 bits.
+func (h *HyperLogLog) AddHash(hash uint64) {
+	index := hash >> (64 - h.precision)
+	// the sentinel bit caps the rank in case all remaining bits are zero
+	rank := uint8(bits.LeadingZeros64(hash<<h.precision|1<<(h.precision-1))) + 1
+	if rank > h.registers[index] {
+		h.registers[index] = rank
+	}
+}
+
+func (h *HyperLogLog) Merge(other *HyperLogLog) error {
+	if other == nil {
+		return nil
+	}
+	if other.precision != h.precision {
+		return fmt.Errorf("cannot merge hyperloglog of precision %d into %d",
+			other.precision, h.precision)
+	}
+
+	for i, r := range other.registers {
+		if r > h.registers[i] {
+			h.registers[i] = r
+		}
+	}
+	return nil
+}
+
+// Count returns the estimated number of distinct values
+func (h *HyperLogLog) Count() uint64 {
+	m := float64(len(h.registers))
+
+	sum := 0.0
+	zeros := 0
+	for _, r := range h.registers {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+
+	alpha := 0.7213 / (1 + 1.079/m)
+	estimate := alpha * m * m / sum
+
+	// for small cardinalities linear counting is far more accurate
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+
+	return uint64(math.Round(estimate))
+}
+
+type hyperLogLogJSON struct {
+	Precision uint8  `json:"precision"`
+	Registers []byte `json:"registers"`
+}
+
+func (h *HyperLogLog) MarshalJSON() ([]byte, error) {
+	return json.Marshal(hyperLogLogJSON{
+		Precision: h.precision,
+		Registers: h.registers,
+	})
+}
+
+func (h *HyperLogLog) UnmarshalJSON(data []byte) error {
+	var in hyperLogLogJSON
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+
+	if len(in.Registers) != 1<<in.Precision {
+		return fmt.Errorf("hyperloglog: expected %d registers for precision %d, got %d",
+			1<<in.Precision, in.Precision, len(in.Registers))
+	}
+
+	h.precision = in.Precision
+	h.registers = in.Registers
+	return nil
+}
@@ -0,0 +1,140 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sketch
+
+import (
+	"encoding/json"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTDigest(t *testing.T) {
+	t.Run("empty", func(t *testing.T) {
+		td := NewTDigest(DefaultCompression)
+		assert.True(t, math.IsNaN(td.Quantile(0.5)))
+	})
+
+	t.Run("small exact set", func(t *testing.T) {
+		td := NewTDigest(DefaultCompression)
+		for i := 1; i <= 100; i++ {
+			td.Add(float64(i), 1)
+		}
+
+		assert.Equal(t, 1.0, td.Quantile(0))
+		assert.Equal(t, 100.0, td.Quantile(1))
+		assert.InDelta(t, 50.5, td.Quantile(0.5), 0.01)
+		assert.InDelta(t, 90.5, td.Quantile(0.9), 0.01)
+	})
+
+	t.Run("weighted values", func(t *testing.T) {
+		td := NewTDigest(DefaultCompression)
+		td.Add(7, 1000)
+		assert.Equal(t, 7.0, td.Quantile(0.5))
+		assert.Equal(t, 1000.0, td.Count())
+	})
+
+	values := make([]float64, 100_000)
+	r := rand.New(rand.NewSource(7))
+	for i := range values {
+		values[i] = r.NormFloat64()*100 + 1000
+	}
+	sorted := append([]float64{}, values...)
+	sort.Float64s(sorted)
+	exact := func(q float64) float64 {
+		return sorted[int(q*float64(len(sorted)-1))]
+	}
+
+	t.Run("large set", func(t *testing.T) {
+		td := NewTDigest(DefaultCompression)
+		for _, v := range values {
+			td.Add(v, 1)
+		}
+
+		for _, q := range []float64{0.01, 0.5, 0.9, 0.99} {
+			assert.InDelta(t, exact(q), td.Quantile(q), 2, "quantile %v", q)
+		}
+		assert.Less(t, len(td.centroids), 300)
+	})
+
+	t.Run("merged across shards and serialized", func(t *testing.T) {
+		merged := NewTDigest(DefaultCompression)
+		for shard := 0; shard < 4; shard++ {
+			td := NewTDigest(DefaultCompression)
+			for i := shard; i < len(values); i += 4 {
+				td.Add(values[i], 1)
+			}
+
+			// shard results are sent to other nodes as JSON
+			raw, err := json.Marshal(td)
+			require.NoError(t, err)
+			var decoded TDigest
+			require.NoError(t, json.Unmarshal(raw, &decoded))
+
+			merged.Merge(&decoded)
+		}
+
+		assert.Equal(t, float64(len(values)), merged.Count())
+		assert.Equal(t, sorted[0], merged.Quantile(0))
+		for _, q := range []float64{0.01, 0.5, 0.9, 0.99} {
+			assert.InDelta(t, exact(q), merged.Quantile(q), 2, "quantile %v", q)
+		}
+	})
+}
+
+func TestHyperLogLog(t *testing.T) {
+	t.Run("small cardinalities are exact", func(t *testing.T) {
+		h := NewHyperLogLog(DefaultPrecision)
+		for i := 0; i < 10; i++ {
+			h.AddString("a")
+			h.AddString("b")
+			h.AddFloat64(1.5)
+		}
+		assert.Equal(t, uint64(3), h.Count())
+	})
+
+	t.Run("large cardinality within error bounds", func(t *testing.T) {
+		h := NewHyperLogLog(DefaultPrecision)
+		for i := int64(0); i < 1_000_000; i++ {
+			h.AddInt64(i)
+		}
+		assert.InEpsilon(t, 1_000_000, float64(h.Count()), 0.03)
+	})
+
+	t.Run("merged across shards and serialized", func(t *testing.T) {
+		merged := NewHyperLogLog(DefaultPrecision)
+		for shard := int64(0); shard < 3; shard++ {
+			h := NewHyperLogLog(DefaultPrecision)
+			// overlapping ranges, 0..200k distinct values in total
+			for i := shard * 50_000; i < shard*50_000+100_000; i++ {
+				h.AddInt64(i)
+			}
+
+			raw, err := json.Marshal(h)
+			require.NoError(t, err)
+			var decoded HyperLogLog
+			require.NoError(t, json.Unmarshal(raw, &decoded))
+
+			require.NoError(t, merged.Merge(&decoded))
+		}
+		assert.InEpsilon(t, 200_000, float64(merged.Count()), 0.03)
+	})
+
+	t.Run("precision mismatch", func(t *testing.T) {
+		err := NewHyperLogLog(12).Merge(NewHyperLogLog(14))
+		assert.Error(t, err)
+	})
+}
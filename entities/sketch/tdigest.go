@@ -0,0 +1,206 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package sketch contains mergeable data structures which summarize large
+// sets of values with bounded memory, so that approximate statistics can be
+// computed per shard and combined across shards and nodes.
+package sketch
+
+import (
+	"encoding/json"
+	"math"
+	"sort"
+)
+
+// DefaultCompression trades accuracy for size. With the default at most about
+// 100 centroids are kept, independent of the number of values added.
+const DefaultCompression = 100
+
+// Centroid is the mean of a number of adjacent values together with the
+// number of values it represents
+type Centroid struct {
+	Mean   float64 `json:"mean"`
+	Weight float64 `json:"weight"`
+}
+
+// TDigest estimates quantiles of a distribution. It is accurate at the tails
+// (e.g. p1 or p99) and can be merged with other digests without losing more
+// accuracy than it would have if all values had been added to a single one.
+type TDigest struct {
+	compression float64
+	centroids   []Centroid // sorted by mean
+	buffer      []Centroid // not yet merged into centroids
+	count       float64
+	min         float64
+	max         float64
+}
+
+func NewTDigest(compression float64) *TDigest {
+	if compression <= 0 {
+		compression = DefaultCompression
+	}
+
+	return &TDigest{
+		compression: compression,
+		min:         math.Inf(1),
+		max:         math.Inf(-1),
+	}
+}
+
+// Add adds value with the given weight, i.e. the number of times it occurred
+func (t *TDigest) Add(value, weight float64) {
+	if weight <= 0 || math.IsNaN(value) {
+		return
+	}
+
+	t.buffer = append(t.buffer, Centroid{Mean: value, Weight: weight})
+	t.count += weight
+	t.min = math.Min(t.min, value)
+	t.max = math.Max(t.max, value)
+
+	if len(t.buffer) > int(5*t.compression) {
+		t.compress()
+	}
+}
+
+// Merge adds all values represented by other to t
+func (t *TDigest) Merge(other *TDigest) {
+	if other == nil || other.count == 0 {
+		return
+	}
+
+	other.compress()
+	t.buffer = append(t.buffer, other.centroids...)
+	t.count += other.count
+	t.min = math.Min(t.min, other.min)
+	t.max = math.Max(t.max, other.max)
+	t.compress()
+}
+
+// Count returns the total weight of all values added
+func (t *TDigest) Count() float64 {
+	return t.count
+}
+
+// Quantile returns the estimated value at quantile q, with q in [0, 1]. It
+// returns NaN if the digest is empty.
+func (t *TDigest) Quantile(q float64) float64 {
+	t.compress()
+
+	if len(t.centroids) == 0 {
+		return math.NaN()
+	}
+	if q <= 0 {
+		return t.min
+	}
+	if q >= 1 {
+		return t.max
+	}
+	if len(t.centroids) == 1 {
+		return t.centroids[0].Mean
+	}
+
+	// every centroid is assumed to be centered at its mean, values between
+	// two centroids are interpolated linearly
+	target := q * t.count
+	cumulative := 0.0
+	for i, c := range t.centroids {
+		mid := cumulative + c.Weight/2
+		if target < mid {
+			if i == 0 {
+				return t.min + (c.Mean-t.min)*(target/mid)
+			}
+			prev := t.centroids[i-1]
+			prevMid := cumulative - prev.Weight/2
+			return prev.Mean + (c.Mean-prev.Mean)*(target-prevMid)/(mid-prevMid)
+		}
+		cumulative += c.Weight
+	}
+
+	last := t.centroids[len(t.centroids)-1]
+	lastMid := t.count - last.Weight/2
+	return last.Mean + (t.max-last.Mean)*(target-lastMid)/(t.count-lastMid)
+}
+
+// compress merges the buffer into the centroids. Adjacent centroids are
+// combined as long as they span at most one unit of the scale function,
+// which keeps centroids small towards the tails of the distribution.
+func (t *TDigest) compress() {
+	if len(t.buffer) == 0 {
+		return
+	}
+
+	all := append(t.centroids, t.buffer...)
+	t.buffer = t.buffer[:0]
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].Mean < all[j].Mean
+	})
+
+	out := make([]Centroid, 0, len(all))
+	cur := all[0]
+	cumulative := 0.0
+	for _, next := range all[1:] {
+		proposed := cur.Weight + next.Weight
+		if t.scale((cumulative+proposed)/t.count)-t.scale(cumulative/t.count) <= 1 {
+			cur.Mean += (next.Mean - cur.Mean) * next.Weight / proposed
+			cur.Weight = proposed
+			continue
+		}
+
+		out = append(out, cur)
+		cumulative += cur.Weight
+		cur = next
+	}
+	t.centroids = append(out, cur)
+}
+
+// scale maps a quantile onto [-compression/4, compression/4], the gradient
+// is steepest at the tails
+func (t *TDigest) scale(q float64) float64 {
+	return t.compression / (2 * math.Pi) * math.Asin(2*math.Min(q, 1)-1)
+}
+
+type tDigestJSON struct {
+	Compression float64    `json:"compression"`
+	Centroids   []Centroid `json:"centroids"`
+	Min         float64    `json:"min"`
+	Max         float64    `json:"max"`
+}
+
+func (t *TDigest) MarshalJSON() ([]byte, error) {
+	t.compress()
+	out := tDigestJSON{
+		Compression: t.compression,
+		Centroids:   t.centroids,
+	}
+	if t.count > 0 {
+		// infinity can't be represented in JSON
+		out.Min, out.Max = t.min, t.max
+	}
+	return json.Marshal(out)
+}
+
+func (t *TDigest) UnmarshalJSON(data []byte) error {
+	var in tDigestJSON
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+
+	*t = *NewTDigest(in.Compression)
+	t.centroids = in.Centroids
+	for _, c := range in.Centroids {
+		t.count += c.Weight
+	}
+	if t.count > 0 {
+		t.min, t.max = in.Min, in.Max
+	}
+	return nil
+}
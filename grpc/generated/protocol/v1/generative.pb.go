@@ -2585,6 +2585,64 @@ func (x *GenerativeResult) GetValues() []*GenerativeReply {
 	return nil
 }
 
+// GenerativeDelta is a piece of a generative response sent while the
+// response is still being generated
+type GenerativeDelta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// uuid of the object the single result belongs to, unset for the grouped result
+	ObjectId *string `protobuf:"bytes,1,opt,name=object_id,json=objectId,proto3,oneof" json:"object_id,omitempty"`
+	Text     string  `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *GenerativeDelta) Reset() {
+	*x = GenerativeDelta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_generative_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerativeDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerativeDelta) ProtoMessage() {}
+
+func (x *GenerativeDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_generative_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerativeDelta.ProtoReflect.Descriptor instead.
+func (*GenerativeDelta) Descriptor() ([]byte, []int) {
+	return file_v1_generative_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GenerativeDelta) GetObjectId() string {
+	if x != nil && x.ObjectId != nil {
+		return *x.ObjectId
+	}
+	return ""
+}
+
+func (x *GenerativeDelta) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
 type GenerativeDebug struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2596,7 +2654,7 @@ type GenerativeDebug struct {
 func (x *GenerativeDebug) Reset() {
 	*x = GenerativeDebug{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_generative_proto_msgTypes[31]
+		mi := &file_v1_generative_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2609,7 +2667,7 @@ func (x *GenerativeDebug) String() string {
 func (*GenerativeDebug) ProtoMessage() {}
 
 func (x *GenerativeDebug) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_generative_proto_msgTypes[31]
+	mi := &file_v1_generative_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2622,7 +2680,7 @@ func (x *GenerativeDebug) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerativeDebug.ProtoReflect.Descriptor instead.
 func (*GenerativeDebug) Descriptor() ([]byte, []int) {
-	return file_v1_generative_proto_rawDescGZIP(), []int{31}
+	return file_v1_generative_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *GenerativeDebug) GetFullPrompt() string {
@@ -2646,7 +2704,7 @@ type GenerativeSearch_Single struct {
 func (x *GenerativeSearch_Single) Reset() {
 	*x = GenerativeSearch_Single{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_generative_proto_msgTypes[32]
+		mi := &file_v1_generative_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2659,7 +2717,7 @@ func (x *GenerativeSearch_Single) String() string {
 func (*GenerativeSearch_Single) ProtoMessage() {}
 
 func (x *GenerativeSearch_Single) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_generative_proto_msgTypes[32]
+	mi := &file_v1_generative_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2710,7 +2768,7 @@ type GenerativeSearch_Grouped struct {
 func (x *GenerativeSearch_Grouped) Reset() {
 	*x = GenerativeSearch_Grouped{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_generative_proto_msgTypes[33]
+		mi := &file_v1_generative_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2723,7 +2781,7 @@ func (x *GenerativeSearch_Grouped) String() string {
 func (*GenerativeSearch_Grouped) ProtoMessage() {}
 
 func (x *GenerativeSearch_Grouped) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_generative_proto_msgTypes[33]
+	mi := &file_v1_generative_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2772,7 +2830,7 @@ type GenerativeAnthropicMetadata_Usage struct {
 func (x *GenerativeAnthropicMetadata_Usage) Reset() {
 	*x = GenerativeAnthropicMetadata_Usage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_generative_proto_msgTypes[34]
+		mi := &file_v1_generative_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2785,7 +2843,7 @@ func (x *GenerativeAnthropicMetadata_Usage) String() string {
 func (*GenerativeAnthropicMetadata_Usage) ProtoMessage() {}
 
 func (x *GenerativeAnthropicMetadata_Usage) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_generative_proto_msgTypes[34]
+	mi := &file_v1_generative_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2828,7 +2886,7 @@ type GenerativeCohereMetadata_ApiVersion struct {
 func (x *GenerativeCohereMetadata_ApiVersion) Reset() {
 	*x = GenerativeCohereMetadata_ApiVersion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_generative_proto_msgTypes[35]
+		mi := &file_v1_generative_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2841,7 +2899,7 @@ func (x *GenerativeCohereMetadata_ApiVersion) String() string {
 func (*GenerativeCohereMetadata_ApiVersion) ProtoMessage() {}
 
 func (x *GenerativeCohereMetadata_ApiVersion) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_generative_proto_msgTypes[35]
+	mi := &file_v1_generative_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2892,7 +2950,7 @@ type GenerativeCohereMetadata_BilledUnits struct {
 func (x *GenerativeCohereMetadata_BilledUnits) Reset() {
 	*x = GenerativeCohereMetadata_BilledUnits{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_generative_proto_msgTypes[36]
+		mi := &file_v1_generative_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2905,7 +2963,7 @@ func (x *GenerativeCohereMetadata_BilledUnits) String() string {
 func (*GenerativeCohereMetadata_BilledUnits) ProtoMessage() {}
 
 func (x *GenerativeCohereMetadata_BilledUnits) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_generative_proto_msgTypes[36]
+	mi := &file_v1_generative_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2961,7 +3019,7 @@ type GenerativeCohereMetadata_Tokens struct {
 func (x *GenerativeCohereMetadata_Tokens) Reset() {
 	*x = GenerativeCohereMetadata_Tokens{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_generative_proto_msgTypes[37]
+		mi := &file_v1_generative_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2974,7 +3032,7 @@ func (x *GenerativeCohereMetadata_Tokens) String() string {
 func (*GenerativeCohereMetadata_Tokens) ProtoMessage() {}
 
 func (x *GenerativeCohereMetadata_Tokens) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_generative_proto_msgTypes[37]
+	mi := &file_v1_generative_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3017,7 +3075,7 @@ type GenerativeMistralMetadata_Usage struct {
 func (x *GenerativeMistralMetadata_Usage) Reset() {
 	*x = GenerativeMistralMetadata_Usage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_generative_proto_msgTypes[38]
+		mi := &file_v1_generative_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3030,7 +3088,7 @@ func (x *GenerativeMistralMetadata_Usage) String() string {
 func (*GenerativeMistralMetadata_Usage) ProtoMessage() {}
 
 func (x *GenerativeMistralMetadata_Usage) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_generative_proto_msgTypes[38]
+	mi := &file_v1_generative_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3080,7 +3138,7 @@ type GenerativeOpenAIMetadata_Usage struct {
 func (x *GenerativeOpenAIMetadata_Usage) Reset() {
 	*x = GenerativeOpenAIMetadata_Usage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_generative_proto_msgTypes[39]
+		mi := &file_v1_generative_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3093,7 +3151,7 @@ func (x *GenerativeOpenAIMetadata_Usage) String() string {
 func (*GenerativeOpenAIMetadata_Usage) ProtoMessage() {}
 
 func (x *GenerativeOpenAIMetadata_Usage) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_generative_proto_msgTypes[39]
+	mi := &file_v1_generative_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3142,7 +3200,7 @@ type GenerativeGoogleMetadata_TokenCount struct {
 func (x *GenerativeGoogleMetadata_TokenCount) Reset() {
 	*x = GenerativeGoogleMetadata_TokenCount{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_generative_proto_msgTypes[40]
+		mi := &file_v1_generative_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3155,7 +3213,7 @@ func (x *GenerativeGoogleMetadata_TokenCount) String() string {
 func (*GenerativeGoogleMetadata_TokenCount) ProtoMessage() {}
 
 func (x *GenerativeGoogleMetadata_TokenCount) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_generative_proto_msgTypes[40]
+	mi := &file_v1_generative_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3197,7 +3255,7 @@ type GenerativeGoogleMetadata_TokenMetadata struct {
 func (x *GenerativeGoogleMetadata_TokenMetadata) Reset() {
 	*x = GenerativeGoogleMetadata_TokenMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_generative_proto_msgTypes[41]
+		mi := &file_v1_generative_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3210,7 +3268,7 @@ func (x *GenerativeGoogleMetadata_TokenMetadata) String() string {
 func (*GenerativeGoogleMetadata_TokenMetadata) ProtoMessage() {}
 
 func (x *GenerativeGoogleMetadata_TokenMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_generative_proto_msgTypes[41]
+	mi := &file_v1_generative_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3251,7 +3309,7 @@ type GenerativeGoogleMetadata_Metadata struct {
 func (x *GenerativeGoogleMetadata_Metadata) Reset() {
 	*x = GenerativeGoogleMetadata_Metadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_generative_proto_msgTypes[42]
+		mi := &file_v1_generative_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3264,7 +3322,7 @@ func (x *GenerativeGoogleMetadata_Metadata) String() string {
 func (*GenerativeGoogleMetadata_Metadata) ProtoMessage() {}
 
 func (x *GenerativeGoogleMetadata_Metadata) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_generative_proto_msgTypes[42]
+	mi := &file_v1_generative_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3300,7 +3358,7 @@ type GenerativeGoogleMetadata_UsageMetadata struct {
 func (x *GenerativeGoogleMetadata_UsageMetadata) Reset() {
 	*x = GenerativeGoogleMetadata_UsageMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_generative_proto_msgTypes[43]
+		mi := &file_v1_generative_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3313,7 +3371,7 @@ func (x *GenerativeGoogleMetadata_UsageMetadata) String() string {
 func (*GenerativeGoogleMetadata_UsageMetadata) ProtoMessage() {}
 
 func (x *GenerativeGoogleMetadata_UsageMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_generative_proto_msgTypes[43]
+	mi := &file_v1_generative_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3363,7 +3421,7 @@ type GenerativeDatabricksMetadata_Usage struct {
 func (x *GenerativeDatabricksMetadata_Usage) Reset() {
 	*x = GenerativeDatabricksMetadata_Usage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_generative_proto_msgTypes[44]
+		mi := &file_v1_generative_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3376,7 +3434,7 @@ func (x *GenerativeDatabricksMetadata_Usage) String() string {
 func (*GenerativeDatabricksMetadata_Usage) ProtoMessage() {}
 
 func (x *GenerativeDatabricksMetadata_Usage) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_generative_proto_msgTypes[44]
+	mi := &file_v1_generative_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3426,7 +3484,7 @@ type GenerativeFriendliAIMetadata_Usage struct {
 func (x *GenerativeFriendliAIMetadata_Usage) Reset() {
 	*x = GenerativeFriendliAIMetadata_Usage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_generative_proto_msgTypes[45]
+		mi := &file_v1_generative_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3439,7 +3497,7 @@ func (x *GenerativeFriendliAIMetadata_Usage) String() string {
 func (*GenerativeFriendliAIMetadata_Usage) ProtoMessage() {}
 
 func (x *GenerativeFriendliAIMetadata_Usage) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_generative_proto_msgTypes[45]
+	mi := &file_v1_generative_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3489,7 +3547,7 @@ type GenerativeNvidiaMetadata_Usage struct {
 func (x *GenerativeNvidiaMetadata_Usage) Reset() {
 	*x = GenerativeNvidiaMetadata_Usage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_generative_proto_msgTypes[46]
+		mi := &file_v1_generative_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3502,7 +3560,7 @@ func (x *GenerativeNvidiaMetadata_Usage) String() string {
 func (*GenerativeNvidiaMetadata_Usage) ProtoMessage() {}
 
 func (x *GenerativeNvidiaMetadata_Usage) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_generative_proto_msgTypes[46]
+	mi := &file_v1_generative_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3552,7 +3610,7 @@ type GenerativeXAIMetadata_Usage struct {
 func (x *GenerativeXAIMetadata_Usage) Reset() {
 	*x = GenerativeXAIMetadata_Usage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_generative_proto_msgTypes[47]
+		mi := &file_v1_generative_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3565,7 +3623,7 @@ func (x *GenerativeXAIMetadata_Usage) String() string {
 func (*GenerativeXAIMetadata_Usage) ProtoMessage() {}
 
 func (x *GenerativeXAIMetadata_Usage) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_generative_proto_msgTypes[47]
+	mi := &file_v1_generative_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4371,19 +4429,25 @@ var file_v1_generative_proto_rawDesc = []byte{
 	0x75, 0x6c, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20,
 	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76,
 	0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x76, 0x65, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x47, 0x0a, 0x0f, 0x47, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x62, 0x75, 0x67, 0x12, 0x24, 0x0a, 0x0b,
-	0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x88,
-	0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x6d,
-	0x70, 0x74, 0x42, 0x74, 0x0a, 0x23, 0x69, 0x6f, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74,
-	0x65, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x42, 0x17, 0x57, 0x65, 0x61, 0x76, 0x69,
-	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x76, 0x65, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77,
-	0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65,
-	0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x3b,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x79, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x55, 0x0a, 0x0f, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x09,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x08, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64,
+	0x22, 0x47, 0x0a, 0x0f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x76, 0x65, 0x44, 0x65,
+	0x62, 0x75, 0x67, 0x12, 0x24, 0x0a, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x6d,
+	0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x75, 0x6c, 0x6c,
+	0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x66, 0x75,
+	0x6c, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x42, 0x74, 0x0a, 0x23, 0x69, 0x6f, 0x2e,
+	0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x76, 0x31,
+	0x42, 0x17, 0x57, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2f, 0x77,
+	0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4398,7 +4462,7 @@ func file_v1_generative_proto_rawDescGZIP() []byte {
 	return file_v1_generative_proto_rawDescData
 }
 
-var file_v1_generative_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
+var file_v1_generative_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
 var file_v1_generative_proto_goTypes = []interface{}{
 	(*GenerativeSearch)(nil),                       // 0: weaviate.v1.GenerativeSearch
 	(*GenerativeProvider)(nil),                     // 1: weaviate.v1.GenerativeProvider
@@ -4431,28 +4495,29 @@ var file_v1_generative_proto_goTypes = []interface{}{
 	(*GenerativeMetadata)(nil),                     // 28: weaviate.v1.GenerativeMetadata
 	(*GenerativeReply)(nil),                        // 29: weaviate.v1.GenerativeReply
 	(*GenerativeResult)(nil),                       // 30: weaviate.v1.GenerativeResult
-	(*GenerativeDebug)(nil),                        // 31: weaviate.v1.GenerativeDebug
-	(*GenerativeSearch_Single)(nil),                // 32: weaviate.v1.GenerativeSearch.Single
-	(*GenerativeSearch_Grouped)(nil),               // 33: weaviate.v1.GenerativeSearch.Grouped
-	(*GenerativeAnthropicMetadata_Usage)(nil),      // 34: weaviate.v1.GenerativeAnthropicMetadata.Usage
-	(*GenerativeCohereMetadata_ApiVersion)(nil),    // 35: weaviate.v1.GenerativeCohereMetadata.ApiVersion
-	(*GenerativeCohereMetadata_BilledUnits)(nil),   // 36: weaviate.v1.GenerativeCohereMetadata.BilledUnits
-	(*GenerativeCohereMetadata_Tokens)(nil),        // 37: weaviate.v1.GenerativeCohereMetadata.Tokens
-	(*GenerativeMistralMetadata_Usage)(nil),        // 38: weaviate.v1.GenerativeMistralMetadata.Usage
-	(*GenerativeOpenAIMetadata_Usage)(nil),         // 39: weaviate.v1.GenerativeOpenAIMetadata.Usage
-	(*GenerativeGoogleMetadata_TokenCount)(nil),    // 40: weaviate.v1.GenerativeGoogleMetadata.TokenCount
-	(*GenerativeGoogleMetadata_TokenMetadata)(nil), // 41: weaviate.v1.GenerativeGoogleMetadata.TokenMetadata
-	(*GenerativeGoogleMetadata_Metadata)(nil),      // 42: weaviate.v1.GenerativeGoogleMetadata.Metadata
-	(*GenerativeGoogleMetadata_UsageMetadata)(nil), // 43: weaviate.v1.GenerativeGoogleMetadata.UsageMetadata
-	(*GenerativeDatabricksMetadata_Usage)(nil),     // 44: weaviate.v1.GenerativeDatabricksMetadata.Usage
-	(*GenerativeFriendliAIMetadata_Usage)(nil),     // 45: weaviate.v1.GenerativeFriendliAIMetadata.Usage
-	(*GenerativeNvidiaMetadata_Usage)(nil),         // 46: weaviate.v1.GenerativeNvidiaMetadata.Usage
-	(*GenerativeXAIMetadata_Usage)(nil),            // 47: weaviate.v1.GenerativeXAIMetadata.Usage
-	(*TextArray)(nil),                              // 48: weaviate.v1.TextArray
+	(*GenerativeDelta)(nil),                        // 31: weaviate.v1.GenerativeDelta
+	(*GenerativeDebug)(nil),                        // 32: weaviate.v1.GenerativeDebug
+	(*GenerativeSearch_Single)(nil),                // 33: weaviate.v1.GenerativeSearch.Single
+	(*GenerativeSearch_Grouped)(nil),               // 34: weaviate.v1.GenerativeSearch.Grouped
+	(*GenerativeAnthropicMetadata_Usage)(nil),      // 35: weaviate.v1.GenerativeAnthropicMetadata.Usage
+	(*GenerativeCohereMetadata_ApiVersion)(nil),    // 36: weaviate.v1.GenerativeCohereMetadata.ApiVersion
+	(*GenerativeCohereMetadata_BilledUnits)(nil),   // 37: weaviate.v1.GenerativeCohereMetadata.BilledUnits
+	(*GenerativeCohereMetadata_Tokens)(nil),        // 38: weaviate.v1.GenerativeCohereMetadata.Tokens
+	(*GenerativeMistralMetadata_Usage)(nil),        // 39: weaviate.v1.GenerativeMistralMetadata.Usage
+	(*GenerativeOpenAIMetadata_Usage)(nil),         // 40: weaviate.v1.GenerativeOpenAIMetadata.Usage
+	(*GenerativeGoogleMetadata_TokenCount)(nil),    // 41: weaviate.v1.GenerativeGoogleMetadata.TokenCount
+	(*GenerativeGoogleMetadata_TokenMetadata)(nil), // 42: weaviate.v1.GenerativeGoogleMetadata.TokenMetadata
+	(*GenerativeGoogleMetadata_Metadata)(nil),      // 43: weaviate.v1.GenerativeGoogleMetadata.Metadata
+	(*GenerativeGoogleMetadata_UsageMetadata)(nil), // 44: weaviate.v1.GenerativeGoogleMetadata.UsageMetadata
+	(*GenerativeDatabricksMetadata_Usage)(nil),     // 45: weaviate.v1.GenerativeDatabricksMetadata.Usage
+	(*GenerativeFriendliAIMetadata_Usage)(nil),     // 46: weaviate.v1.GenerativeFriendliAIMetadata.Usage
+	(*GenerativeNvidiaMetadata_Usage)(nil),         // 47: weaviate.v1.GenerativeNvidiaMetadata.Usage
+	(*GenerativeXAIMetadata_Usage)(nil),            // 48: weaviate.v1.GenerativeXAIMetadata.Usage
+	(*TextArray)(nil),                              // 49: weaviate.v1.TextArray
 }
 var file_v1_generative_proto_depIdxs = []int32{
-	32, // 0: weaviate.v1.GenerativeSearch.single:type_name -> weaviate.v1.GenerativeSearch.Single
-	33, // 1: weaviate.v1.GenerativeSearch.grouped:type_name -> weaviate.v1.GenerativeSearch.Grouped
+	33, // 0: weaviate.v1.GenerativeSearch.single:type_name -> weaviate.v1.GenerativeSearch.Single
+	34, // 1: weaviate.v1.GenerativeSearch.grouped:type_name -> weaviate.v1.GenerativeSearch.Grouped
 	2,  // 2: weaviate.v1.GenerativeProvider.anthropic:type_name -> weaviate.v1.GenerativeAnthropic
 	3,  // 3: weaviate.v1.GenerativeProvider.anyscale:type_name -> weaviate.v1.GenerativeAnyscale
 	4,  // 4: weaviate.v1.GenerativeProvider.aws:type_name -> weaviate.v1.GenerativeAWS
@@ -4466,36 +4531,36 @@ var file_v1_generative_proto_depIdxs = []int32{
 	12, // 12: weaviate.v1.GenerativeProvider.friendliai:type_name -> weaviate.v1.GenerativeFriendliAI
 	13, // 13: weaviate.v1.GenerativeProvider.nvidia:type_name -> weaviate.v1.GenerativeNvidia
 	14, // 14: weaviate.v1.GenerativeProvider.xai:type_name -> weaviate.v1.GenerativeXAI
-	48, // 15: weaviate.v1.GenerativeAnthropic.stop_sequences:type_name -> weaviate.v1.TextArray
-	48, // 16: weaviate.v1.GenerativeAnthropic.images:type_name -> weaviate.v1.TextArray
-	48, // 17: weaviate.v1.GenerativeAnthropic.image_properties:type_name -> weaviate.v1.TextArray
-	48, // 18: weaviate.v1.GenerativeAWS.images:type_name -> weaviate.v1.TextArray
-	48, // 19: weaviate.v1.GenerativeAWS.image_properties:type_name -> weaviate.v1.TextArray
-	48, // 20: weaviate.v1.GenerativeCohere.stop_sequences:type_name -> weaviate.v1.TextArray
-	48, // 21: weaviate.v1.GenerativeOllama.images:type_name -> weaviate.v1.TextArray
-	48, // 22: weaviate.v1.GenerativeOllama.image_properties:type_name -> weaviate.v1.TextArray
-	48, // 23: weaviate.v1.GenerativeOpenAI.stop:type_name -> weaviate.v1.TextArray
-	48, // 24: weaviate.v1.GenerativeOpenAI.images:type_name -> weaviate.v1.TextArray
-	48, // 25: weaviate.v1.GenerativeOpenAI.image_properties:type_name -> weaviate.v1.TextArray
-	48, // 26: weaviate.v1.GenerativeGoogle.stop_sequences:type_name -> weaviate.v1.TextArray
-	48, // 27: weaviate.v1.GenerativeGoogle.images:type_name -> weaviate.v1.TextArray
-	48, // 28: weaviate.v1.GenerativeGoogle.image_properties:type_name -> weaviate.v1.TextArray
-	48, // 29: weaviate.v1.GenerativeDatabricks.stop:type_name -> weaviate.v1.TextArray
-	48, // 30: weaviate.v1.GenerativeXAI.images:type_name -> weaviate.v1.TextArray
-	48, // 31: weaviate.v1.GenerativeXAI.image_properties:type_name -> weaviate.v1.TextArray
-	34, // 32: weaviate.v1.GenerativeAnthropicMetadata.usage:type_name -> weaviate.v1.GenerativeAnthropicMetadata.Usage
-	35, // 33: weaviate.v1.GenerativeCohereMetadata.api_version:type_name -> weaviate.v1.GenerativeCohereMetadata.ApiVersion
-	36, // 34: weaviate.v1.GenerativeCohereMetadata.billed_units:type_name -> weaviate.v1.GenerativeCohereMetadata.BilledUnits
-	37, // 35: weaviate.v1.GenerativeCohereMetadata.tokens:type_name -> weaviate.v1.GenerativeCohereMetadata.Tokens
-	48, // 36: weaviate.v1.GenerativeCohereMetadata.warnings:type_name -> weaviate.v1.TextArray
-	38, // 37: weaviate.v1.GenerativeMistralMetadata.usage:type_name -> weaviate.v1.GenerativeMistralMetadata.Usage
-	39, // 38: weaviate.v1.GenerativeOpenAIMetadata.usage:type_name -> weaviate.v1.GenerativeOpenAIMetadata.Usage
-	42, // 39: weaviate.v1.GenerativeGoogleMetadata.metadata:type_name -> weaviate.v1.GenerativeGoogleMetadata.Metadata
-	43, // 40: weaviate.v1.GenerativeGoogleMetadata.usage_metadata:type_name -> weaviate.v1.GenerativeGoogleMetadata.UsageMetadata
-	44, // 41: weaviate.v1.GenerativeDatabricksMetadata.usage:type_name -> weaviate.v1.GenerativeDatabricksMetadata.Usage
-	45, // 42: weaviate.v1.GenerativeFriendliAIMetadata.usage:type_name -> weaviate.v1.GenerativeFriendliAIMetadata.Usage
-	46, // 43: weaviate.v1.GenerativeNvidiaMetadata.usage:type_name -> weaviate.v1.GenerativeNvidiaMetadata.Usage
-	47, // 44: weaviate.v1.GenerativeXAIMetadata.usage:type_name -> weaviate.v1.GenerativeXAIMetadata.Usage
+	49, // 15: weaviate.v1.GenerativeAnthropic.stop_sequences:type_name -> weaviate.v1.TextArray
+	49, // 16: weaviate.v1.GenerativeAnthropic.images:type_name -> weaviate.v1.TextArray
+	49, // 17: weaviate.v1.GenerativeAnthropic.image_properties:type_name -> weaviate.v1.TextArray
+	49, // 18: weaviate.v1.GenerativeAWS.images:type_name -> weaviate.v1.TextArray
+	49, // 19: weaviate.v1.GenerativeAWS.image_properties:type_name -> weaviate.v1.TextArray
+	49, // 20: weaviate.v1.GenerativeCohere.stop_sequences:type_name -> weaviate.v1.TextArray
+	49, // 21: weaviate.v1.GenerativeOllama.images:type_name -> weaviate.v1.TextArray
+	49, // 22: weaviate.v1.GenerativeOllama.image_properties:type_name -> weaviate.v1.TextArray
+	49, // 23: weaviate.v1.GenerativeOpenAI.stop:type_name -> weaviate.v1.TextArray
+	49, // 24: weaviate.v1.GenerativeOpenAI.images:type_name -> weaviate.v1.TextArray
+	49, // 25: weaviate.v1.GenerativeOpenAI.image_properties:type_name -> weaviate.v1.TextArray
+	49, // 26: weaviate.v1.GenerativeGoogle.stop_sequences:type_name -> weaviate.v1.TextArray
+	49, // 27: weaviate.v1.GenerativeGoogle.images:type_name -> weaviate.v1.TextArray
+	49, // 28: weaviate.v1.GenerativeGoogle.image_properties:type_name -> weaviate.v1.TextArray
+	49, // 29: weaviate.v1.GenerativeDatabricks.stop:type_name -> weaviate.v1.TextArray
+	49, // 30: weaviate.v1.GenerativeXAI.images:type_name -> weaviate.v1.TextArray
+	49, // 31: weaviate.v1.GenerativeXAI.image_properties:type_name -> weaviate.v1.TextArray
+	35, // 32: weaviate.v1.GenerativeAnthropicMetadata.usage:type_name -> weaviate.v1.GenerativeAnthropicMetadata.Usage
+	36, // 33: weaviate.v1.GenerativeCohereMetadata.api_version:type_name -> weaviate.v1.GenerativeCohereMetadata.ApiVersion
+	37, // 34: weaviate.v1.GenerativeCohereMetadata.billed_units:type_name -> weaviate.v1.GenerativeCohereMetadata.BilledUnits
+	38, // 35: weaviate.v1.GenerativeCohereMetadata.tokens:type_name -> weaviate.v1.GenerativeCohereMetadata.Tokens
+	49, // 36: weaviate.v1.GenerativeCohereMetadata.warnings:type_name -> weaviate.v1.TextArray
+	39, // 37: weaviate.v1.GenerativeMistralMetadata.usage:type_name -> weaviate.v1.GenerativeMistralMetadata.Usage
+	40, // 38: weaviate.v1.GenerativeOpenAIMetadata.usage:type_name -> weaviate.v1.GenerativeOpenAIMetadata.Usage
+	43, // 39: weaviate.v1.GenerativeGoogleMetadata.metadata:type_name -> weaviate.v1.GenerativeGoogleMetadata.Metadata
+	44, // 40: weaviate.v1.GenerativeGoogleMetadata.usage_metadata:type_name -> weaviate.v1.GenerativeGoogleMetadata.UsageMetadata
+	45, // 41: weaviate.v1.GenerativeDatabricksMetadata.usage:type_name -> weaviate.v1.GenerativeDatabricksMetadata.Usage
+	46, // 42: weaviate.v1.GenerativeFriendliAIMetadata.usage:type_name -> weaviate.v1.GenerativeFriendliAIMetadata.Usage
+	47, // 43: weaviate.v1.GenerativeNvidiaMetadata.usage:type_name -> weaviate.v1.GenerativeNvidiaMetadata.Usage
+	48, // 44: weaviate.v1.GenerativeXAIMetadata.usage:type_name -> weaviate.v1.GenerativeXAIMetadata.Usage
 	15, // 45: weaviate.v1.GenerativeMetadata.anthropic:type_name -> weaviate.v1.GenerativeAnthropicMetadata
 	16, // 46: weaviate.v1.GenerativeMetadata.anyscale:type_name -> weaviate.v1.GenerativeAnyscaleMetadata
 	17, // 47: weaviate.v1.GenerativeMetadata.aws:type_name -> weaviate.v1.GenerativeAWSMetadata
@@ -4509,15 +4574,15 @@ var file_v1_generative_proto_depIdxs = []int32{
 	25, // 55: weaviate.v1.GenerativeMetadata.friendliai:type_name -> weaviate.v1.GenerativeFriendliAIMetadata
 	26, // 56: weaviate.v1.GenerativeMetadata.nvidia:type_name -> weaviate.v1.GenerativeNvidiaMetadata
 	27, // 57: weaviate.v1.GenerativeMetadata.xai:type_name -> weaviate.v1.GenerativeXAIMetadata
-	31, // 58: weaviate.v1.GenerativeReply.debug:type_name -> weaviate.v1.GenerativeDebug
+	32, // 58: weaviate.v1.GenerativeReply.debug:type_name -> weaviate.v1.GenerativeDebug
 	28, // 59: weaviate.v1.GenerativeReply.metadata:type_name -> weaviate.v1.GenerativeMetadata
 	29, // 60: weaviate.v1.GenerativeResult.values:type_name -> weaviate.v1.GenerativeReply
 	1,  // 61: weaviate.v1.GenerativeSearch.Single.queries:type_name -> weaviate.v1.GenerativeProvider
-	48, // 62: weaviate.v1.GenerativeSearch.Grouped.properties:type_name -> weaviate.v1.TextArray
+	49, // 62: weaviate.v1.GenerativeSearch.Grouped.properties:type_name -> weaviate.v1.TextArray
 	1,  // 63: weaviate.v1.GenerativeSearch.Grouped.queries:type_name -> weaviate.v1.GenerativeProvider
-	40, // 64: weaviate.v1.GenerativeGoogleMetadata.TokenMetadata.input_token_count:type_name -> weaviate.v1.GenerativeGoogleMetadata.TokenCount
-	40, // 65: weaviate.v1.GenerativeGoogleMetadata.TokenMetadata.output_token_count:type_name -> weaviate.v1.GenerativeGoogleMetadata.TokenCount
-	41, // 66: weaviate.v1.GenerativeGoogleMetadata.Metadata.token_metadata:type_name -> weaviate.v1.GenerativeGoogleMetadata.TokenMetadata
+	41, // 64: weaviate.v1.GenerativeGoogleMetadata.TokenMetadata.input_token_count:type_name -> weaviate.v1.GenerativeGoogleMetadata.TokenCount
+	41, // 65: weaviate.v1.GenerativeGoogleMetadata.TokenMetadata.output_token_count:type_name -> weaviate.v1.GenerativeGoogleMetadata.TokenCount
+	42, // 66: weaviate.v1.GenerativeGoogleMetadata.Metadata.token_metadata:type_name -> weaviate.v1.GenerativeGoogleMetadata.TokenMetadata
 	67, // [67:67] is the sub-list for method output_type
 	67, // [67:67] is the sub-list for method input_type
 	67, // [67:67] is the sub-list for extension type_name
@@ -4905,7 +4970,7 @@ func file_v1_generative_proto_init() {
 			}
 		}
 		file_v1_generative_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerativeDebug); i {
+			switch v := v.(*GenerativeDelta); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4917,7 +4982,7 @@ func file_v1_generative_proto_init() {
 			}
 		}
 		file_v1_generative_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerativeSearch_Single); i {
+			switch v := v.(*GenerativeDebug); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4929,7 +4994,7 @@ func file_v1_generative_proto_init() {
 			}
 		}
 		file_v1_generative_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerativeSearch_Grouped); i {
+			switch v := v.(*GenerativeSearch_Single); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4941,7 +5006,7 @@ func file_v1_generative_proto_init() {
 			}
 		}
 		file_v1_generative_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerativeAnthropicMetadata_Usage); i {
+			switch v := v.(*GenerativeSearch_Grouped); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4953,7 +5018,7 @@ func file_v1_generative_proto_init() {
 			}
 		}
 		file_v1_generative_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerativeCohereMetadata_ApiVersion); i {
+			switch v := v.(*GenerativeAnthropicMetadata_Usage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4965,7 +5030,7 @@ func file_v1_generative_proto_init() {
 			}
 		}
 		file_v1_generative_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerativeCohereMetadata_BilledUnits); i {
+			switch v := v.(*GenerativeCohereMetadata_ApiVersion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4977,7 +5042,7 @@ func file_v1_generative_proto_init() {
 			}
 		}
 		file_v1_generative_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerativeCohereMetadata_Tokens); i {
+			switch v := v.(*GenerativeCohereMetadata_BilledUnits); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4989,7 +5054,7 @@ func file_v1_generative_proto_init() {
 			}
 		}
 		file_v1_generative_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerativeMistralMetadata_Usage); i {
+			switch v := v.(*GenerativeCohereMetadata_Tokens); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5001,7 +5066,7 @@ func file_v1_generative_proto_init() {
 			}
 		}
 		file_v1_generative_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerativeOpenAIMetadata_Usage); i {
+			switch v := v.(*GenerativeMistralMetadata_Usage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5013,7 +5078,7 @@ func file_v1_generative_proto_init() {
 			}
 		}
 		file_v1_generative_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerativeGoogleMetadata_TokenCount); i {
+			switch v := v.(*GenerativeOpenAIMetadata_Usage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5025,7 +5090,7 @@ func file_v1_generative_proto_init() {
 			}
 		}
 		file_v1_generative_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerativeGoogleMetadata_TokenMetadata); i {
+			switch v := v.(*GenerativeGoogleMetadata_TokenCount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5037,7 +5102,7 @@ func file_v1_generative_proto_init() {
 			}
 		}
 		file_v1_generative_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerativeGoogleMetadata_Metadata); i {
+			switch v := v.(*GenerativeGoogleMetadata_TokenMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5049,7 +5114,7 @@ func file_v1_generative_proto_init() {
 			}
 		}
 		file_v1_generative_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerativeGoogleMetadata_UsageMetadata); i {
+			switch v := v.(*GenerativeGoogleMetadata_Metadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5061,7 +5126,7 @@ func file_v1_generative_proto_init() {
 			}
 		}
 		file_v1_generative_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerativeDatabricksMetadata_Usage); i {
+			switch v := v.(*GenerativeGoogleMetadata_UsageMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5073,7 +5138,7 @@ func file_v1_generative_proto_init() {
 			}
 		}
 		file_v1_generative_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerativeFriendliAIMetadata_Usage); i {
+			switch v := v.(*GenerativeDatabricksMetadata_Usage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5085,7 +5150,7 @@ func file_v1_generative_proto_init() {
 			}
 		}
 		file_v1_generative_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerativeNvidiaMetadata_Usage); i {
+			switch v := v.(*GenerativeFriendliAIMetadata_Usage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5097,6 +5162,18 @@ func file_v1_generative_proto_init() {
 			}
 		}
 		file_v1_generative_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerativeNvidiaMetadata_Usage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_generative_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenerativeXAIMetadata_Usage); i {
 			case 0:
 				return &v.state
@@ -5161,8 +5238,8 @@ func file_v1_generative_proto_init() {
 	}
 	file_v1_generative_proto_msgTypes[29].OneofWrappers = []interface{}{}
 	file_v1_generative_proto_msgTypes[31].OneofWrappers = []interface{}{}
-	file_v1_generative_proto_msgTypes[33].OneofWrappers = []interface{}{}
-	file_v1_generative_proto_msgTypes[35].OneofWrappers = []interface{}{}
+	file_v1_generative_proto_msgTypes[32].OneofWrappers = []interface{}{}
+	file_v1_generative_proto_msgTypes[34].OneofWrappers = []interface{}{}
 	file_v1_generative_proto_msgTypes[36].OneofWrappers = []interface{}{}
 	file_v1_generative_proto_msgTypes[37].OneofWrappers = []interface{}{}
 	file_v1_generative_proto_msgTypes[38].OneofWrappers = []interface{}{}
@@ -5175,13 +5252,14 @@ func file_v1_generative_proto_init() {
 	file_v1_generative_proto_msgTypes[45].OneofWrappers = []interface{}{}
 	file_v1_generative_proto_msgTypes[46].OneofWrappers = []interface{}{}
 	file_v1_generative_proto_msgTypes[47].OneofWrappers = []interface{}{}
+	file_v1_generative_proto_msgTypes[48].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_v1_generative_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   48,
+			NumMessages:   49,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -20,10 +20,31 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type WeaviateClient interface {
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchReply, error)
+	SearchStream(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (Weaviate_SearchStreamClient, error)
+	AgentSearch(ctx context.Context, in *AgentSearchRequest, opts ...grpc.CallOption) (*AgentSearchReply, error)
+	SearchBatch(ctx context.Context, in *SearchBatchRequest, opts ...grpc.CallOption) (*SearchBatchReply, error)
 	BatchObjects(ctx context.Context, in *BatchObjectsRequest, opts ...grpc.CallOption) (*BatchObjectsReply, error)
+	BatchStream(ctx context.Context, opts ...grpc.CallOption) (Weaviate_BatchStreamClient, error)
 	BatchDelete(ctx context.Context, in *BatchDeleteRequest, opts ...grpc.CallOption) (*BatchDeleteReply, error)
 	TenantsGet(ctx context.Context, in *TenantsGetRequest, opts ...grpc.CallOption) (*TenantsGetReply, error)
 	Aggregate(ctx context.Context, in *AggregateRequest, opts ...grpc.CallOption) (*AggregateReply, error)
+	CollectionsGet(ctx context.Context, in *CollectionsGetRequest, opts ...grpc.CallOption) (*CollectionsGetReply, error)
+	CollectionCreate(ctx context.Context, in *CollectionCreateRequest, opts ...grpc.CallOption) (*CollectionCreateReply, error)
+	CollectionUpdate(ctx context.Context, in *CollectionUpdateRequest, opts ...grpc.CallOption) (*CollectionUpdateReply, error)
+	CollectionDelete(ctx context.Context, in *CollectionDeleteRequest, opts ...grpc.CallOption) (*CollectionDeleteReply, error)
+	PropertyAdd(ctx context.Context, in *PropertyAddRequest, opts ...grpc.CallOption) (*PropertyAddReply, error)
+	VectorIndexConfigUpdate(ctx context.Context, in *VectorIndexConfigUpdateRequest, opts ...grpc.CallOption) (*VectorIndexConfigUpdateReply, error)
+	TenantsCreate(ctx context.Context, in *TenantsCreateRequest, opts ...grpc.CallOption) (*TenantsCreateReply, error)
+	TenantsUpdate(ctx context.Context, in *TenantsUpdateRequest, opts ...grpc.CallOption) (*TenantsUpdateReply, error)
+	TenantsDelete(ctx context.Context, in *TenantsDeleteRequest, opts ...grpc.CallOption) (*TenantsDeleteReply, error)
+	ObjectsGet(ctx context.Context, in *ObjectsGetRequest, opts ...grpc.CallOption) (*ObjectsGetReply, error)
+	ObjectsExists(ctx context.Context, in *ObjectsExistsRequest, opts ...grpc.CallOption) (*ObjectsExistsReply, error)
+	ObjectsReplace(ctx context.Context, in *ObjectsReplaceRequest, opts ...grpc.CallOption) (*ObjectsReplaceReply, error)
+	ObjectsMerge(ctx context.Context, in *ObjectsMergeRequest, opts ...grpc.CallOption) (*ObjectsMergeReply, error)
+	ObjectsDelete(ctx context.Context, in *ObjectsDeleteRequest, opts ...grpc.CallOption) (*ObjectsDeleteReply, error)
+	ReferenceAdd(ctx context.Context, in *ReferenceAddRequest, opts ...grpc.CallOption) (*ReferenceAddReply, error)
+	ReferenceDelete(ctx context.Context, in *ReferenceDeleteRequest, opts ...grpc.CallOption) (*ReferenceDeleteReply, error)
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (Weaviate_ExportClient, error)
 }
 
 type weaviateClient struct {
@@ -43,6 +64,56 @@ func (c *weaviateClient) Search(ctx context.Context, in *SearchRequest, opts ...
 	return out, nil
 }
 
+func (c *weaviateClient) SearchStream(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (Weaviate_SearchStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Weaviate_ServiceDesc.Streams[0], "/weaviate.v1.Weaviate/SearchStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &weaviateSearchStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Weaviate_SearchStreamClient interface {
+	Recv() (*SearchStreamReply, error)
+	grpc.ClientStream
+}
+
+type weaviateSearchStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *weaviateSearchStreamClient) Recv() (*SearchStreamReply, error) {
+	m := new(SearchStreamReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *weaviateClient) AgentSearch(ctx context.Context, in *AgentSearchRequest, opts ...grpc.CallOption) (*AgentSearchReply, error) {
+	out := new(AgentSearchReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/AgentSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) SearchBatch(ctx context.Context, in *SearchBatchRequest, opts ...grpc.CallOption) (*SearchBatchReply, error) {
+	out := new(SearchBatchReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/SearchBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *weaviateClient) BatchObjects(ctx context.Context, in *BatchObjectsRequest, opts ...grpc.CallOption) (*BatchObjectsReply, error) {
 	out := new(BatchObjectsReply)
 	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/BatchObjects", in, out, opts...)
@@ -52,6 +123,37 @@ func (c *weaviateClient) BatchObjects(ctx context.Context, in *BatchObjectsReque
 	return out, nil
 }
 
+func (c *weaviateClient) BatchStream(ctx context.Context, opts ...grpc.CallOption) (Weaviate_BatchStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Weaviate_ServiceDesc.Streams[1], "/weaviate.v1.Weaviate/BatchStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &weaviateBatchStreamClient{stream}
+	return x, nil
+}
+
+type Weaviate_BatchStreamClient interface {
+	Send(*BatchStreamRequest) error
+	Recv() (*BatchStreamReply, error)
+	grpc.ClientStream
+}
+
+type weaviateBatchStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *weaviateBatchStreamClient) Send(m *BatchStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *weaviateBatchStreamClient) Recv() (*BatchStreamReply, error) {
+	m := new(BatchStreamReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *weaviateClient) BatchDelete(ctx context.Context, in *BatchDeleteRequest, opts ...grpc.CallOption) (*BatchDeleteReply, error) {
 	out := new(BatchDeleteReply)
 	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/BatchDelete", in, out, opts...)
@@ -79,15 +181,212 @@ func (c *weaviateClient) Aggregate(ctx context.Context, in *AggregateRequest, op
 	return out, nil
 }
 
+func (c *weaviateClient) CollectionsGet(ctx context.Context, in *CollectionsGetRequest, opts ...grpc.CallOption) (*CollectionsGetReply, error) {
+	out := new(CollectionsGetReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/CollectionsGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) CollectionCreate(ctx context.Context, in *CollectionCreateRequest, opts ...grpc.CallOption) (*CollectionCreateReply, error) {
+	out := new(CollectionCreateReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/CollectionCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) CollectionUpdate(ctx context.Context, in *CollectionUpdateRequest, opts ...grpc.CallOption) (*CollectionUpdateReply, error) {
+	out := new(CollectionUpdateReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/CollectionUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) CollectionDelete(ctx context.Context, in *CollectionDeleteRequest, opts ...grpc.CallOption) (*CollectionDeleteReply, error) {
+	out := new(CollectionDeleteReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/CollectionDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) PropertyAdd(ctx context.Context, in *PropertyAddRequest, opts ...grpc.CallOption) (*PropertyAddReply, error) {
+	out := new(PropertyAddReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/PropertyAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) VectorIndexConfigUpdate(ctx context.Context, in *VectorIndexConfigUpdateRequest, opts ...grpc.CallOption) (*VectorIndexConfigUpdateReply, error) {
+	out := new(VectorIndexConfigUpdateReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/VectorIndexConfigUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) TenantsCreate(ctx context.Context, in *TenantsCreateRequest, opts ...grpc.CallOption) (*TenantsCreateReply, error) {
+	out := new(TenantsCreateReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/TenantsCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) TenantsUpdate(ctx context.Context, in *TenantsUpdateRequest, opts ...grpc.CallOption) (*TenantsUpdateReply, error) {
+	out := new(TenantsUpdateReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/TenantsUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) TenantsDelete(ctx context.Context, in *TenantsDeleteRequest, opts ...grpc.CallOption) (*TenantsDeleteReply, error) {
+	out := new(TenantsDeleteReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/TenantsDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) ObjectsGet(ctx context.Context, in *ObjectsGetRequest, opts ...grpc.CallOption) (*ObjectsGetReply, error) {
+	out := new(ObjectsGetReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/ObjectsGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) ObjectsExists(ctx context.Context, in *ObjectsExistsRequest, opts ...grpc.CallOption) (*ObjectsExistsReply, error) {
+	out := new(ObjectsExistsReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/ObjectsExists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) ObjectsReplace(ctx context.Context, in *ObjectsReplaceRequest, opts ...grpc.CallOption) (*ObjectsReplaceReply, error) {
+	out := new(ObjectsReplaceReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/ObjectsReplace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) ObjectsMerge(ctx context.Context, in *ObjectsMergeRequest, opts ...grpc.CallOption) (*ObjectsMergeReply, error) {
+	out := new(ObjectsMergeReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/ObjectsMerge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) ObjectsDelete(ctx context.Context, in *ObjectsDeleteRequest, opts ...grpc.CallOption) (*ObjectsDeleteReply, error) {
+	out := new(ObjectsDeleteReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/ObjectsDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) ReferenceAdd(ctx context.Context, in *ReferenceAddRequest, opts ...grpc.CallOption) (*ReferenceAddReply, error) {
+	out := new(ReferenceAddReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/ReferenceAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) ReferenceDelete(ctx context.Context, in *ReferenceDeleteRequest, opts ...grpc.CallOption) (*ReferenceDeleteReply, error) {
+	out := new(ReferenceDeleteReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/ReferenceDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (Weaviate_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Weaviate_ServiceDesc.Streams[2], "/weaviate.v1.Weaviate/Export", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &weaviateExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Weaviate_ExportClient interface {
+	Recv() (*ExportReply, error)
+	grpc.ClientStream
+}
+
+type weaviateExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *weaviateExportClient) Recv() (*ExportReply, error) {
+	m := new(ExportReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // WeaviateServer is the server API for Weaviate service.
 // All implementations must embed UnimplementedWeaviateServer
 // for forward compatibility
 type WeaviateServer interface {
 	Search(context.Context, *SearchRequest) (*SearchReply, error)
+	SearchStream(*SearchRequest, Weaviate_SearchStreamServer) error
+	AgentSearch(context.Context, *AgentSearchRequest) (*AgentSearchReply, error)
+	SearchBatch(context.Context, *SearchBatchRequest) (*SearchBatchReply, error)
 	BatchObjects(context.Context, *BatchObjectsRequest) (*BatchObjectsReply, error)
+	BatchStream(Weaviate_BatchStreamServer) error
 	BatchDelete(context.Context, *BatchDeleteRequest) (*BatchDeleteReply, error)
 	TenantsGet(context.Context, *TenantsGetRequest) (*TenantsGetReply, error)
 	Aggregate(context.Context, *AggregateRequest) (*AggregateReply, error)
+	CollectionsGet(context.Context, *CollectionsGetRequest) (*CollectionsGetReply, error)
+	CollectionCreate(context.Context, *CollectionCreateRequest) (*CollectionCreateReply, error)
+	CollectionUpdate(context.Context, *CollectionUpdateRequest) (*CollectionUpdateReply, error)
+	CollectionDelete(context.Context, *CollectionDeleteRequest) (*CollectionDeleteReply, error)
+	PropertyAdd(context.Context, *PropertyAddRequest) (*PropertyAddReply, error)
+	VectorIndexConfigUpdate(context.Context, *VectorIndexConfigUpdateRequest) (*VectorIndexConfigUpdateReply, error)
+	TenantsCreate(context.Context, *TenantsCreateRequest) (*TenantsCreateReply, error)
+	TenantsUpdate(context.Context, *TenantsUpdateRequest) (*TenantsUpdateReply, error)
+	TenantsDelete(context.Context, *TenantsDeleteRequest) (*TenantsDeleteReply, error)
+	ObjectsGet(context.Context, *ObjectsGetRequest) (*ObjectsGetReply, error)
+	ObjectsExists(context.Context, *ObjectsExistsRequest) (*ObjectsExistsReply, error)
+	ObjectsReplace(context.Context, *ObjectsReplaceRequest) (*ObjectsReplaceReply, error)
+	ObjectsMerge(context.Context, *ObjectsMergeRequest) (*ObjectsMergeReply, error)
+	ObjectsDelete(context.Context, *ObjectsDeleteRequest) (*ObjectsDeleteReply, error)
+	ReferenceAdd(context.Context, *ReferenceAddRequest) (*ReferenceAddReply, error)
+	ReferenceDelete(context.Context, *ReferenceDeleteRequest) (*ReferenceDeleteReply, error)
+	Export(*ExportRequest, Weaviate_ExportServer) error
 	mustEmbedUnimplementedWeaviateServer()
 }
 
@@ -98,9 +397,21 @@ type UnimplementedWeaviateServer struct {
 func (UnimplementedWeaviateServer) Search(context.Context, *SearchRequest) (*SearchReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
 }
+func (UnimplementedWeaviateServer) SearchStream(*SearchRequest, Weaviate_SearchStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method SearchStream not implemented")
+}
+func (UnimplementedWeaviateServer) AgentSearch(context.Context, *AgentSearchRequest) (*AgentSearchReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AgentSearch not implemented")
+}
+func (UnimplementedWeaviateServer) SearchBatch(context.Context, *SearchBatchRequest) (*SearchBatchReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchBatch not implemented")
+}
 func (UnimplementedWeaviateServer) BatchObjects(context.Context, *BatchObjectsRequest) (*BatchObjectsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchObjects not implemented")
 }
+func (UnimplementedWeaviateServer) BatchStream(Weaviate_BatchStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method BatchStream not implemented")
+}
 func (UnimplementedWeaviateServer) BatchDelete(context.Context, *BatchDeleteRequest) (*BatchDeleteReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchDelete not implemented")
 }
@@ -110,6 +421,57 @@ func (UnimplementedWeaviateServer) TenantsGet(context.Context, *TenantsGetReques
 func (UnimplementedWeaviateServer) Aggregate(context.Context, *AggregateRequest) (*AggregateReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Aggregate not implemented")
 }
+func (UnimplementedWeaviateServer) CollectionsGet(context.Context, *CollectionsGetRequest) (*CollectionsGetReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectionsGet not implemented")
+}
+func (UnimplementedWeaviateServer) CollectionCreate(context.Context, *CollectionCreateRequest) (*CollectionCreateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectionCreate not implemented")
+}
+func (UnimplementedWeaviateServer) CollectionUpdate(context.Context, *CollectionUpdateRequest) (*CollectionUpdateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectionUpdate not implemented")
+}
+func (UnimplementedWeaviateServer) CollectionDelete(context.Context, *CollectionDeleteRequest) (*CollectionDeleteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectionDelete not implemented")
+}
+func (UnimplementedWeaviateServer) PropertyAdd(context.Context, *PropertyAddRequest) (*PropertyAddReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PropertyAdd not implemented")
+}
+func (UnimplementedWeaviateServer) VectorIndexConfigUpdate(context.Context, *VectorIndexConfigUpdateRequest) (*VectorIndexConfigUpdateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VectorIndexConfigUpdate not implemented")
+}
+func (UnimplementedWeaviateServer) TenantsCreate(context.Context, *TenantsCreateRequest) (*TenantsCreateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TenantsCreate not implemented")
+}
+func (UnimplementedWeaviateServer) TenantsUpdate(context.Context, *TenantsUpdateRequest) (*TenantsUpdateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TenantsUpdate not implemented")
+}
+func (UnimplementedWeaviateServer) TenantsDelete(context.Context, *TenantsDeleteRequest) (*TenantsDeleteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TenantsDelete not implemented")
+}
+func (UnimplementedWeaviateServer) ObjectsGet(context.Context, *ObjectsGetRequest) (*ObjectsGetReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObjectsGet not implemented")
+}
+func (UnimplementedWeaviateServer) ObjectsExists(context.Context, *ObjectsExistsRequest) (*ObjectsExistsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObjectsExists not implemented")
+}
+func (UnimplementedWeaviateServer) ObjectsReplace(context.Context, *ObjectsReplaceRequest) (*ObjectsReplaceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObjectsReplace not implemented")
+}
+func (UnimplementedWeaviateServer) ObjectsMerge(context.Context, *ObjectsMergeRequest) (*ObjectsMergeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObjectsMerge not implemented")
+}
+func (UnimplementedWeaviateServer) ObjectsDelete(context.Context, *ObjectsDeleteRequest) (*ObjectsDeleteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObjectsDelete not implemented")
+}
+func (UnimplementedWeaviateServer) ReferenceAdd(context.Context, *ReferenceAddRequest) (*ReferenceAddReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReferenceAdd not implemented")
+}
+func (UnimplementedWeaviateServer) ReferenceDelete(context.Context, *ReferenceDeleteRequest) (*ReferenceDeleteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReferenceDelete not implemented")
+}
+func (UnimplementedWeaviateServer) Export(*ExportRequest, Weaviate_ExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
+}
 func (UnimplementedWeaviateServer) mustEmbedUnimplementedWeaviateServer() {}
 
 // UnsafeWeaviateServer may be embedded to opt out of forward compatibility for this service.
@@ -141,6 +503,63 @@ func _Weaviate_Search_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Weaviate_SearchStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SearchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WeaviateServer).SearchStream(m, &weaviateSearchStreamServer{stream})
+}
+
+type Weaviate_SearchStreamServer interface {
+	Send(*SearchStreamReply) error
+	grpc.ServerStream
+}
+
+type weaviateSearchStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *weaviateSearchStreamServer) Send(m *SearchStreamReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Weaviate_AgentSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).AgentSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/AgentSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).AgentSearch(ctx, req.(*AgentSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_SearchBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).SearchBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/SearchBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).SearchBatch(ctx, req.(*SearchBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Weaviate_BatchObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BatchObjectsRequest)
 	if err := dec(in); err != nil {
@@ -159,6 +578,32 @@ func _Weaviate_BatchObjects_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Weaviate_BatchStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(WeaviateServer).BatchStream(&weaviateBatchStreamServer{stream})
+}
+
+type Weaviate_BatchStreamServer interface {
+	Send(*BatchStreamReply) error
+	Recv() (*BatchStreamRequest, error)
+	grpc.ServerStream
+}
+
+type weaviateBatchStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *weaviateBatchStreamServer) Send(m *BatchStreamReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *weaviateBatchStreamServer) Recv() (*BatchStreamRequest, error) {
+	m := new(BatchStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _Weaviate_BatchDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BatchDeleteRequest)
 	if err := dec(in); err != nil {
@@ -213,6 +658,315 @@ func _Weaviate_Aggregate_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Weaviate_CollectionsGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionsGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).CollectionsGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/CollectionsGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).CollectionsGet(ctx, req.(*CollectionsGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_CollectionCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).CollectionCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/CollectionCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).CollectionCreate(ctx, req.(*CollectionCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_CollectionUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).CollectionUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/CollectionUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).CollectionUpdate(ctx, req.(*CollectionUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_CollectionDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).CollectionDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/CollectionDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).CollectionDelete(ctx, req.(*CollectionDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_PropertyAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PropertyAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).PropertyAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/PropertyAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).PropertyAdd(ctx, req.(*PropertyAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_VectorIndexConfigUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VectorIndexConfigUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).VectorIndexConfigUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/VectorIndexConfigUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).VectorIndexConfigUpdate(ctx, req.(*VectorIndexConfigUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_TenantsCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantsCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).TenantsCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/TenantsCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).TenantsCreate(ctx, req.(*TenantsCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_TenantsUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantsUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).TenantsUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/TenantsUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).TenantsUpdate(ctx, req.(*TenantsUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_TenantsDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantsDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).TenantsDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/TenantsDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).TenantsDelete(ctx, req.(*TenantsDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_ObjectsGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectsGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).ObjectsGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/ObjectsGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).ObjectsGet(ctx, req.(*ObjectsGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_ObjectsExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectsExistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).ObjectsExists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/ObjectsExists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).ObjectsExists(ctx, req.(*ObjectsExistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_ObjectsReplace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectsReplaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).ObjectsReplace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/ObjectsReplace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).ObjectsReplace(ctx, req.(*ObjectsReplaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_ObjectsMerge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectsMergeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).ObjectsMerge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/ObjectsMerge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).ObjectsMerge(ctx, req.(*ObjectsMergeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_ObjectsDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectsDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).ObjectsDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/ObjectsDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).ObjectsDelete(ctx, req.(*ObjectsDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_ReferenceAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReferenceAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).ReferenceAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/ReferenceAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).ReferenceAdd(ctx, req.(*ReferenceAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_ReferenceDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReferenceDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).ReferenceDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/ReferenceDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).ReferenceDelete(ctx, req.(*ReferenceDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WeaviateServer).Export(m, &weaviateExportServer{stream})
+}
+
+type Weaviate_ExportServer interface {
+	Send(*ExportReply) error
+	grpc.ServerStream
+}
+
+type weaviateExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *weaviateExportServer) Send(m *ExportReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Weaviate_ServiceDesc is the grpc.ServiceDesc for Weaviate service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -224,6 +978,14 @@ var Weaviate_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Search",
 			Handler:    _Weaviate_Search_Handler,
 		},
+		{
+			MethodName: "AgentSearch",
+			Handler:    _Weaviate_AgentSearch_Handler,
+		},
+		{
+			MethodName: "SearchBatch",
+			Handler:    _Weaviate_SearchBatch_Handler,
+		},
 		{
 			MethodName: "BatchObjects",
 			Handler:    _Weaviate_BatchObjects_Handler,
@@ -240,7 +1002,88 @@ var Weaviate_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Aggregate",
 			Handler:    _Weaviate_Aggregate_Handler,
 		},
+		{
+			MethodName: "CollectionsGet",
+			Handler:    _Weaviate_CollectionsGet_Handler,
+		},
+		{
+			MethodName: "CollectionCreate",
+			Handler:    _Weaviate_CollectionCreate_Handler,
+		},
+		{
+			MethodName: "CollectionUpdate",
+			Handler:    _Weaviate_CollectionUpdate_Handler,
+		},
+		{
+			MethodName: "CollectionDelete",
+			Handler:    _Weaviate_CollectionDelete_Handler,
+		},
+		{
+			MethodName: "PropertyAdd",
+			Handler:    _Weaviate_PropertyAdd_Handler,
+		},
+		{
+			MethodName: "VectorIndexConfigUpdate",
+			Handler:    _Weaviate_VectorIndexConfigUpdate_Handler,
+		},
+		{
+			MethodName: "TenantsCreate",
+			Handler:    _Weaviate_TenantsCreate_Handler,
+		},
+		{
+			MethodName: "TenantsUpdate",
+			Handler:    _Weaviate_TenantsUpdate_Handler,
+		},
+		{
+			MethodName: "TenantsDelete",
+			Handler:    _Weaviate_TenantsDelete_Handler,
+		},
+		{
+			MethodName: "ObjectsGet",
+			Handler:    _Weaviate_ObjectsGet_Handler,
+		},
+		{
+			MethodName: "ObjectsExists",
+			Handler:    _Weaviate_ObjectsExists_Handler,
+		},
+		{
+			MethodName: "ObjectsReplace",
+			Handler:    _Weaviate_ObjectsReplace_Handler,
+		},
+		{
+			MethodName: "ObjectsMerge",
+			Handler:    _Weaviate_ObjectsMerge_Handler,
+		},
+		{
+			MethodName: "ObjectsDelete",
+			Handler:    _Weaviate_ObjectsDelete_Handler,
+		},
+		{
+			MethodName: "ReferenceAdd",
+			Handler:    _Weaviate_ReferenceAdd_Handler,
+		},
+		{
+			MethodName: "ReferenceDelete",
+			Handler:    _Weaviate_ReferenceDelete_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SearchStream",
+			Handler:       _Weaviate_SearchStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "BatchStream",
+			Handler:       _Weaviate_BatchStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Export",
+			Handler:       _Weaviate_Export_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/weaviate.proto",
 }
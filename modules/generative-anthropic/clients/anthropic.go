@@ -19,10 +19,12 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/weaviate/weaviate/usecases/modulecomponents"
 	"github.com/weaviate/weaviate/usecases/modulecomponents/generative"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -40,11 +42,9 @@ type anthropic struct {
 
 func New(apiKey string, timeout time.Duration, logger logrus.FieldLogger) *anthropic {
 	return &anthropic{
-		apiKey: apiKey,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
-		logger: logger,
+		apiKey:     apiKey,
+		httpClient: httpclient.New("anthropic", timeout, httpclient.WithRateLimitRetries()),
+		logger:     logger,
 	}
 }
 
@@ -53,7 +53,7 @@ func (a *anthropic) GenerateSingleResult(ctx context.Context, properties *module
 	if err != nil {
 		return nil, err
 	}
-	return a.generate(ctx, cfg, forPrompt, generative.Blobs([]*modulecapabilities.GenerateProperties{properties}), options, debug)
+	return a.generate(ctx, cfg, forPrompt, generative.Blobs([]*modulecapabilities.GenerateProperties{properties}), options, debug, nil)
 }
 
 func (a *anthropic) GenerateAllResults(ctx context.Context, properties []*modulecapabilities.GenerateProperties, task string, options interface{}, debug bool, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateResponse, error) {
@@ -62,10 +62,26 @@ func (a *anthropic) GenerateAllResults(ctx context.Context, properties []*module
 	if err != nil {
 		return nil, err
 	}
-	return a.generate(ctx, cfg, forTask, generative.Blobs(properties), options, debug)
+	return a.generate(ctx, cfg, forTask, generative.Blobs(properties), options, debug, nil)
+}
+
+func (a *anthropic) GenerateSingleResultStream(ctx context.Context, properties *modulecapabilities.GenerateProperties, prompt string, options interface{}, debug bool, cfg moduletools.ClassConfig, onDelta modulecapabilities.GenerateStreamFn) (*modulecapabilities.GenerateResponse, error) {
+	forPrompt, err := generative.MakeSinglePrompt(generative.Text(properties), prompt)
+	if err != nil {
+		return nil, err
+	}
+	return a.generate(ctx, cfg, forPrompt, generative.Blobs([]*modulecapabilities.GenerateProperties{properties}), options, debug, onDelta)
+}
+
+func (a *anthropic) GenerateAllResultsStream(ctx context.Context, properties []*modulecapabilities.GenerateProperties, task string, options interface{}, debug bool, cfg moduletools.ClassConfig, onDelta modulecapabilities.GenerateStreamFn) (*modulecapabilities.GenerateResponse, error) {
+	forTask, err := generative.MakeTaskPrompt(generative.Texts(properties), task)
+	if err != nil {
+		return nil, err
+	}
+	return a.generate(ctx, cfg, forTask, generative.Blobs(properties), options, debug, onDelta)
 }
 
-func (a *anthropic) generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string, imageProperties []map[string]*string, options interface{}, debug bool) (*modulecapabilities.GenerateResponse, error) {
+func (a *anthropic) generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string, imageProperties []map[string]*string, options interface{}, debug bool, onDelta modulecapabilities.GenerateStreamFn) (*modulecapabilities.GenerateResponse, error) {
 	params := a.getParameters(cfg, options, imageProperties)
 	debugInformation := a.getDebugInformation(debug, prompt)
 
@@ -113,6 +129,7 @@ func (a *anthropic) generate(ctx context.Context, cfg moduletools.ClassConfig, p
 		Temperature:   params.Temperature,
 		TopK:          params.TopK,
 		TopP:          params.TopP,
+		Stream:        onDelta != nil,
 	}
 
 	body, err := json.Marshal(input)
@@ -142,6 +159,10 @@ func (a *anthropic) generate(ctx context.Context, cfg moduletools.ClassConfig, p
 
 	defer res.Body.Close()
 
+	if onDelta != nil && res.StatusCode == http.StatusOK {
+		return a.readStream(res.Body, onDelta, debugInformation)
+	}
+
 	bodyBytes, err := io.ReadAll(res.Body)
 	if err != nil {
 		return nil, errors.Wrap(err, "read response body")
@@ -165,6 +186,54 @@ func (a *anthropic) generate(ctx context.Context, cfg moduletools.ClassConfig, p
 	}, nil
 }
 
+// readStream forwards the text deltas of a streamed message and assembles the
+// complete result and usage from the stream events
+func (a *anthropic) readStream(body io.Reader, onDelta modulecapabilities.GenerateStreamFn,
+	debugInformation *modulecapabilities.GenerateDebugInformation,
+) (*modulecapabilities.GenerateResponse, error) {
+	var text strings.Builder
+	var streamUsage *usage
+	err := generative.ReadServerSentEvents(body, func(_, data string) error {
+		var event streamEvent
+		if err := json.Unmarshal([]byte(data), &event); err != nil {
+			return errors.Wrapf(err, "unmarshal stream event. Got: %v", data)
+		}
+		switch event.Type {
+		case "message_start":
+			if event.Message != nil && event.Message.Usage != nil {
+				streamUsage = event.Message.Usage
+			}
+		case "content_block_delta":
+			if event.Delta == nil || event.Delta.Text == "" {
+				return nil
+			}
+			text.WriteString(event.Delta.Text)
+			return onDelta(event.Delta.Text)
+		case "message_delta":
+			if event.Usage != nil {
+				if streamUsage == nil {
+					streamUsage = &usage{}
+				}
+				streamUsage.OutputTokens = event.Usage.OutputTokens
+			}
+		case "error":
+			return fmt.Errorf("Anthropic API error: %s - %s", event.Error.Type, event.Error.Message)
+		default:
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "read response stream")
+	}
+
+	textResponse := text.String()
+	return &modulecapabilities.GenerateResponse{
+		Result: &textResponse,
+		Debug:  debugInformation,
+		Params: a.getResponseParams(streamUsage),
+	}, nil
+}
+
 func (a *anthropic) getParameters(cfg moduletools.ClassConfig, options interface{}, imagePropertiesArray []map[string]*string) anthropicparams.Params {
 	settings := config.NewClassSettings(cfg)
 
@@ -255,13 +324,17 @@ func (a *anthropic) getAPIKey(ctx context.Context) (string, error) {
 }
 
 type generateInput struct {
-	Messages      []message `json:"messages,omitempty"`
-	Model         string    `json:"model,omitempty"`
-	MaxTokens     *int      `json:"max_tokens,omitempty"`
-	StopSequences []string  `json:"stop_sequences,omitempty"`
-	Temperature   *float64  `json:"temperature,omitempty"`
-	TopK          *int      `json:"top_k,omitempty"`
-	TopP          *float64  `json:"top_p,omitempty"`
+	System        string      `json:"system,omitempty"`
+	Messages      []message   `json:"messages,omitempty"`
+	Model         string      `json:"model,omitempty"`
+	MaxTokens     *int        `json:"max_tokens,omitempty"`
+	StopSequences []string    `json:"stop_sequences,omitempty"`
+	Temperature   *float64    `json:"temperature,omitempty"`
+	TopK          *int        `json:"top_k,omitempty"`
+	TopP          *float64    `json:"top_p,omitempty"`
+	Stream        bool        `json:"stream,omitempty"`
+	Tools         []tool      `json:"tools,omitempty"`
+	ToolChoice    *toolChoice `json:"tool_choice,omitempty"`
 }
 
 type message struct {
@@ -299,9 +372,25 @@ type generateResponse struct {
 	Usage        *usage       `json:"usage,omitempty"`
 }
 
-type content struct {
+type streamEvent struct {
+	Type    string            `json:"type"`
+	Message *generateResponse `json:"message,omitempty"`
+	Delta   *streamDelta      `json:"delta,omitempty"`
+	Usage   *usage            `json:"usage,omitempty"`
+	Error   errorMessage      `json:"error,omitempty"`
+}
+
+type streamDelta struct {
 	Type string `json:"type"`
-	Text string `json:"text"`
+	Text string `json:"text,omitempty"`
+}
+
+type content struct {
+	Type  string          `json:"type"`
+	Text  string          `json:"text"`
+	ID    string          `json:"id,omitempty"`
+	Name  string          `json:"name,omitempty"`
+	Input json.RawMessage `json:"input,omitempty"`
 }
 
 type StopReason string
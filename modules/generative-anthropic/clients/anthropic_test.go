@@ -14,6 +14,7 @@ package clients
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -104,6 +105,60 @@ func TestGenerate(t *testing.T) {
 	})
 }
 
+func TestGenerateStream(t *testing.T) {
+	properties := []*modulecapabilities.GenerateProperties{{Text: map[string]string{"prop": "My name is John"}}}
+
+	events := []string{
+		"event: message_start\ndata: {\"type\":\"message_start\",\"message\":{\"usage\":{\"input_tokens\":10,\"output_tokens\":1}}}",
+		"event: content_block_start\ndata: {\"type\":\"content_block_start\",\"index\":0}",
+		"event: ping\ndata: {\"type\":\"ping\"}",
+		"event: content_block_delta\ndata: {\"type\":\"content_block_delta\",\"delta\":{\"type\":\"text_delta\",\"text\":\"Jo\"}}",
+		"event: content_block_delta\ndata: {\"type\":\"content_block_delta\",\"delta\":{\"type\":\"text_delta\",\"text\":\"hn\"}}",
+		"event: message_delta\ndata: {\"type\":\"message_delta\",\"delta\":{\"stop_reason\":\"end_turn\"},\"usage\":{\"output_tokens\":2}}",
+		"event: message_stop\ndata: {\"type\":\"message_stop\"}",
+	}
+
+	t.Run("when the server streams the answer", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var b generateInput
+			require.Nil(t, json.NewDecoder(r.Body).Decode(&b))
+			assert.True(t, b.Stream)
+			for _, event := range events {
+				fmt.Fprintf(w, "%s\n\n", event)
+			}
+		}))
+		defer server.Close()
+
+		a := New("apiKey", 0, nullLogger())
+		var deltas []string
+		res, err := a.GenerateAllResultsStream(context.Background(), properties, "What is my name?", nil, false,
+			&fakeClassConfig{baseURL: server.URL}, func(delta string) error {
+				deltas = append(deltas, delta)
+				return nil
+			})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Jo", "hn"}, deltas)
+		assert.Equal(t, "John", *res.Result)
+		assert.Equal(t, &usage{InputTokens: 10, OutputTokens: 2}, GetResponseParams(res.Params).Usage)
+	})
+
+	t.Run("when the stream reports an error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, "%s\n\n", events[0])
+			fmt.Fprint(w, "event: error\ndata: {\"type\":\"error\",\"error\":{\"type\":\"overloaded_error\",\"message\":\"Overloaded\"}}\n\n")
+		}))
+		defer server.Close()
+
+		a := New("apiKey", 0, nullLogger())
+		_, err := a.GenerateAllResultsStream(context.Background(), properties, "What is my name?", nil, false,
+			&fakeClassConfig{baseURL: server.URL}, func(delta string) error { return nil })
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "overloaded_error - Overloaded")
+	})
+}
+
 type testAnthropicHandler struct {
 	t       *testing.T
 	answer  generateResponse
@@ -0,0 +1,177 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+// GenerateWithTools sends the conversation of an agent run as a message and
+// returns either the tool_use blocks or the text answer of the model
+func (a *anthropic) GenerateWithTools(ctx context.Context, request *modulecapabilities.GenerateToolsRequest, options interface{}, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateToolsResponse, error) {
+	params := a.getParameters(cfg, options, nil)
+
+	anthropicURL, err := a.getAnthropicURL(ctx, params.BaseURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "get anthropic url")
+	}
+
+	input := generateInput{
+		System:        request.System,
+		Messages:      toolMessages(request),
+		Model:         params.Model,
+		MaxTokens:     params.MaxTokens,
+		StopSequences: params.StopSequences,
+		Temperature:   params.Temperature,
+		TopK:          params.TopK,
+		TopP:          params.TopP,
+	}
+	for _, t := range request.Tools {
+		input.Tools = append(input.Tools, tool{Name: t.Name, Description: t.Description, InputSchema: t.Parameters})
+	}
+	if request.DisableTools && len(input.Tools) > 0 {
+		// the tools stay declared, the history references them
+		input.ToolChoice = &toolChoice{Type: "none"}
+	}
+
+	body, err := json.Marshal(input)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal body")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", anthropicURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "create POST request")
+	}
+	apiKey, err := a.getAPIKey(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "Anthropic API key")
+	}
+
+	req.Header.Add("x-api-key", apiKey)
+	req.Header.Add("Content-Type", "application/json")
+	req.Header.Add("Request-Source", "unspecified:weaviate")
+	req.Header.Add("anthropic-version", "2023-06-01")
+
+	res, err := a.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "do POST request")
+	}
+	defer res.Body.Close()
+
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read response body")
+	}
+
+	var resBody generateResponse
+	if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("unmarshal response body. Got: %v", string(bodyBytes)))
+	}
+	if res.StatusCode != 200 {
+		return nil, fmt.Errorf("Anthropic API error: %s - %s", resBody.Error.Type, resBody.Error.Message)
+	}
+
+	response := &modulecapabilities.GenerateToolsResponse{Params: a.getResponseParams(resBody.Usage)}
+	var text string
+	for _, block := range resBody.Content {
+		switch block.Type {
+		case "text":
+			text += block.Text
+		case "tool_use":
+			response.ToolCalls = append(response.ToolCalls, modulecapabilities.GenerativeToolCall{
+				ID:        block.ID,
+				Name:      block.Name,
+				Arguments: string(block.Input),
+			})
+		}
+	}
+	if text != "" {
+		response.Result = &text
+	}
+	return response, nil
+}
+
+// toolMessages converts the conversation into content blocks. The results of
+// the tool calls of one assistant turn are sent as a single user message.
+func toolMessages(request *modulecapabilities.GenerateToolsRequest) []message {
+	messages := make([]message, 0, len(request.Messages))
+	var results contentImageInput
+	flushResults := func() {
+		if len(results) > 0 {
+			messages = append(messages, message{Role: "user", Content: results})
+			results = nil
+		}
+	}
+	for _, msg := range request.Messages {
+		switch msg.Role {
+		case modulecapabilities.GenerativeRoleTool:
+			results = append(results, contentToolResult{
+				Type:      "tool_result",
+				ToolUseID: msg.ToolCallID,
+				Content:   msg.Content,
+			})
+		case modulecapabilities.GenerativeRoleAssistant:
+			flushResults()
+			var blocks contentImageInput
+			if msg.Content != "" {
+				blocks = append(blocks, contentText{Type: "text", Text: msg.Content})
+			}
+			for _, call := range msg.ToolCalls {
+				arguments := json.RawMessage(call.Arguments)
+				if len(arguments) == 0 {
+					arguments = json.RawMessage("{}")
+				}
+				blocks = append(blocks, contentToolUse{Type: "tool_use", ID: call.ID, Name: call.Name, Input: arguments})
+			}
+			messages = append(messages, message{Role: msg.Role, Content: blocks})
+		default:
+			flushResults()
+			messages = append(messages, message{Role: msg.Role, Content: msg.Content})
+		}
+	}
+	flushResults()
+	return messages
+}
+
+type tool struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	InputSchema map[string]interface{} `json:"input_schema"`
+}
+
+type toolChoice struct {
+	Type string `json:"type"`
+}
+
+type contentToolUse struct {
+	Type  string          `json:"type"`
+	ID    string          `json:"id"`
+	Name  string          `json:"name"`
+	Input json.RawMessage `json:"input"`
+}
+
+type contentToolResult struct {
+	Type      string `json:"type"`
+	ToolUseID string `json:"tool_use_id"`
+	Content   string `json:"content"`
+}
@@ -0,0 +1,85 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+)
+
+func TestGenerateWithTools(t *testing.T) {
+	request := &modulecapabilities.GenerateToolsRequest{
+		System: "You answer questions",
+		Messages: []modulecapabilities.GenerativeMessage{
+			{Role: modulecapabilities.GenerativeRoleUser, Content: "Which planets are red or blue?"},
+			{Role: modulecapabilities.GenerativeRoleAssistant, Content: "Let me search", ToolCalls: []modulecapabilities.GenerativeToolCall{
+				{ID: "toolu_1", Name: "search", Arguments: `{"query":"red planet"}`},
+				{ID: "toolu_2", Name: "search", Arguments: `{"query":"blue planet"}`},
+			}},
+			{Role: modulecapabilities.GenerativeRoleTool, Content: `[{"name":"Mars"}]`, ToolCallID: "toolu_1"},
+			{Role: modulecapabilities.GenerativeRoleTool, Content: `[{"name":"Neptune"}]`, ToolCallID: "toolu_2"},
+		},
+		Tools: []modulecapabilities.GenerativeTool{{
+			Name:        "search",
+			Description: "Search planets",
+			Parameters:  map[string]interface{}{"type": "object"},
+		}},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var b struct {
+			System   string `json:"system"`
+			Messages []struct {
+				Role    string          `json:"role"`
+				Content json.RawMessage `json:"content"`
+			} `json:"messages"`
+			Tools      []map[string]interface{} `json:"tools"`
+			ToolChoice interface{}              `json:"tool_choice"`
+		}
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&b))
+		assert.Equal(t, "You answer questions", b.System)
+		require.Len(t, b.Messages, 3)
+		assert.JSONEq(t, `[{"type":"text","text":"Let me search"},`+
+			`{"type":"tool_use","id":"toolu_1","name":"search","input":{"query":"red planet"}},`+
+			`{"type":"tool_use","id":"toolu_2","name":"search","input":{"query":"blue planet"}}]`, string(b.Messages[1].Content))
+		assert.Equal(t, "user", b.Messages[2].Role)
+		assert.JSONEq(t, `[{"type":"tool_result","tool_use_id":"toolu_1","content":"[{\"name\":\"Mars\"}]"},`+
+			`{"type":"tool_result","tool_use_id":"toolu_2","content":"[{\"name\":\"Neptune\"}]"}]`, string(b.Messages[2].Content))
+		require.Len(t, b.Tools, 1)
+		assert.Equal(t, map[string]interface{}{"type": "object"}, b.Tools[0]["input_schema"])
+		assert.Nil(t, b.ToolChoice)
+
+		w.Write([]byte(`{"type":"message","role":"assistant","stop_reason":"tool_use","content":[` +
+			`{"type":"text","text":"One more search"},` +
+			`{"type":"tool_use","id":"toolu_3","name":"aggregate","input":{"property":"color"}}]}`))
+	}))
+	defer server.Close()
+
+	a := New("apiKey", 5*time.Second, nullLogger())
+	res, err := a.GenerateWithTools(context.Background(), request, nil, &fakeClassConfig{baseURL: server.URL})
+
+	require.Nil(t, err)
+	require.NotNil(t, res.Result)
+	assert.Equal(t, "One more search", *res.Result)
+	require.Len(t, res.ToolCalls, 1)
+	assert.Equal(t, "toolu_3", res.ToolCalls[0].ID)
+	assert.Equal(t, "aggregate", res.ToolCalls[0].Name)
+	assert.JSONEq(t, `{"property":"color"}`, res.ToolCalls[0].Arguments)
+}
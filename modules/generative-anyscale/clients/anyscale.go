@@ -22,6 +22,7 @@ import (
 
 	"github.com/weaviate/weaviate/usecases/modulecomponents"
 	"github.com/weaviate/weaviate/usecases/modulecomponents/generative"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -39,11 +40,9 @@ type anyscale struct {
 
 func New(apiKey string, timeout time.Duration, logger logrus.FieldLogger) *anyscale {
 	return &anyscale{
-		apiKey: apiKey,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
-		logger: logger,
+		apiKey:     apiKey,
+		httpClient: httpclient.New("anyscale", timeout, httpclient.WithRateLimitRetries()),
+		logger:     logger,
 	}
 }
 
@@ -33,6 +33,7 @@ import (
 	awsparams "github.com/weaviate/weaviate/modules/generative-aws/parameters"
 	"github.com/weaviate/weaviate/usecases/modulecomponents"
 	generativecomponents "github.com/weaviate/weaviate/usecases/modulecomponents/generative"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 )
 
 func buildBedrockUrl(service, region, model string) string {
@@ -57,12 +58,10 @@ type awsClient struct {
 
 func New(awsAccessKey, awsSecretKey, awsSessionToken string, timeout time.Duration, logger logrus.FieldLogger) *awsClient {
 	return &awsClient{
-		awsAccessKey:    awsAccessKey,
-		awsSecretKey:    awsSecretKey,
-		awsSessionToken: awsSessionToken,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
+		awsAccessKey:        awsAccessKey,
+		awsSecretKey:        awsSecretKey,
+		awsSessionToken:     awsSessionToken,
+		httpClient:          httpclient.New("aws", timeout, httpclient.WithRateLimitRetries()),
 		buildBedrockUrlFn:   buildBedrockUrl,
 		buildSagemakerUrlFn: buildSagemakerUrl,
 		logger:              logger,
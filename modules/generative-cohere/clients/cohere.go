@@ -23,6 +23,7 @@ import (
 
 	"github.com/weaviate/weaviate/usecases/modulecomponents"
 	"github.com/weaviate/weaviate/usecases/modulecomponents/generative"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -40,11 +41,9 @@ type cohere struct {
 
 func New(apiKey string, timeout time.Duration, logger logrus.FieldLogger) *cohere {
 	return &cohere{
-		apiKey: apiKey,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
-		logger: logger,
+		apiKey:     apiKey,
+		httpClient: httpclient.New("cohere", timeout, httpclient.WithRateLimitRetries()),
+		logger:     logger,
 	}
 }
 
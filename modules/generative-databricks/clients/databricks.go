@@ -24,6 +24,7 @@ import (
 
 	"github.com/weaviate/weaviate/usecases/modulecomponents"
 	generativecomponents "github.com/weaviate/weaviate/usecases/modulecomponents/generative"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -50,11 +51,9 @@ type databricks struct {
 func New(databricksToken string, timeout time.Duration, logger logrus.FieldLogger) *databricks {
 	return &databricks{
 		databricksToken: databricksToken,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
-		buildEndpoint: buildEndpointFn,
-		logger:        logger,
+		httpClient:      httpclient.New("databricks", timeout, httpclient.WithRateLimitRetries()),
+		buildEndpoint:   buildEndpointFn,
+		logger:          logger,
 	}
 }
 
@@ -13,7 +13,9 @@ package clients
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
@@ -58,3 +60,74 @@ func (v *dummy) Generate(ctx context.Context, cfg moduletools.ClassConfig, promp
 		Result: &result,
 	}, nil
 }
+
+func (v *dummy) GenerateSingleResultStream(ctx context.Context, properties *modulecapabilities.GenerateProperties, prompt string, options interface{}, debug bool, cfg moduletools.ClassConfig, onDelta modulecapabilities.GenerateStreamFn) (*modulecapabilities.GenerateResponse, error) {
+	res, err := v.GenerateSingleResult(ctx, properties, prompt, options, debug, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return res, v.stream(ctx, *res.Result, onDelta)
+}
+
+func (v *dummy) GenerateAllResultsStream(ctx context.Context, properties []*modulecapabilities.GenerateProperties, task string, options interface{}, debug bool, cfg moduletools.ClassConfig, onDelta modulecapabilities.GenerateStreamFn) (*modulecapabilities.GenerateResponse, error) {
+	res, err := v.GenerateAllResults(ctx, properties, task, options, debug, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return res, v.stream(ctx, *res.Result, onDelta)
+}
+
+// stream sends the result word by word, the way a model emits tokens
+func (v *dummy) stream(ctx context.Context, result string, onDelta modulecapabilities.GenerateStreamFn) error {
+	for len(result) > 0 {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		end := strings.IndexByte(result[1:], ' ') + 1
+		if end == 0 {
+			end = len(result)
+		}
+		if err := onDelta(result[:end]); err != nil {
+			return err
+		}
+		result = result[end:]
+	}
+	return nil
+}
+
+// GenerateWithTools searches for the user prompt once and then answers with
+// what the search returned, which makes agent runs deterministic in tests
+func (v *dummy) GenerateWithTools(ctx context.Context, request *modulecapabilities.GenerateToolsRequest, options interface{}, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateToolsResponse, error) {
+	var prompt string
+	var outputs []string
+	for _, msg := range request.Messages {
+		switch msg.Role {
+		case modulecapabilities.GenerativeRoleUser:
+			prompt = msg.Content
+		case modulecapabilities.GenerativeRoleTool:
+			outputs = append(outputs, msg.Content)
+		}
+	}
+	if !request.DisableTools && len(outputs) == 0 && hasTool(request.Tools, "search") {
+		arguments, err := json.Marshal(map[string]string{"query": prompt})
+		if err != nil {
+			return nil, err
+		}
+		return &modulecapabilities.GenerateToolsResponse{
+			ToolCalls: []modulecapabilities.GenerativeToolCall{
+				{ID: "call_0", Name: "search", Arguments: string(arguments)},
+			},
+		}, nil
+	}
+	result := "You asked: " + prompt + ". I found: " + strings.Join(outputs, " ") + ". I'm sorry, I'm just a dummy and can't answer anything."
+	return &modulecapabilities.GenerateToolsResponse{Result: &result}, nil
+}
+
+func hasTool(tools []modulecapabilities.GenerativeTool, name string) bool {
+	for _, tool := range tools {
+		if tool.Name == name {
+			return true
+		}
+	}
+	return false
+}
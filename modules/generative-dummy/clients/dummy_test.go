@@ -0,0 +1,97 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestGenerateStream(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	c := New(logger)
+	props := &modulecapabilities.GenerateProperties{Text: map[string]string{"name": "Mars"}}
+
+	expected, err := c.GenerateSingleResult(context.Background(), props, "Describe {name}", nil, false, &fakeClassConfig{})
+	require.NoError(t, err)
+
+	var deltas []string
+	res, err := c.GenerateSingleResultStream(context.Background(), props, "Describe {name}", nil, false, &fakeClassConfig{},
+		func(delta string) error {
+			deltas = append(deltas, delta)
+			return nil
+		})
+	require.NoError(t, err)
+	assert.Equal(t, *expected.Result, *res.Result)
+	assert.Greater(t, len(deltas), 1)
+	assert.Equal(t, *res.Result, strings.Join(deltas, ""))
+}
+
+func TestGenerateWithTools(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	c := New(logger)
+	request := &modulecapabilities.GenerateToolsRequest{
+		Messages: []modulecapabilities.GenerativeMessage{
+			{Role: modulecapabilities.GenerativeRoleUser, Content: "red planets"},
+		},
+		Tools: []modulecapabilities.GenerativeTool{{Name: "search"}},
+	}
+
+	res, err := c.GenerateWithTools(context.Background(), request, nil, &fakeClassConfig{})
+	require.NoError(t, err)
+	require.Len(t, res.ToolCalls, 1)
+	assert.Equal(t, "search", res.ToolCalls[0].Name)
+	assert.JSONEq(t, `{"query":"red planets"}`, res.ToolCalls[0].Arguments)
+
+	request.Messages = append(request.Messages,
+		modulecapabilities.GenerativeMessage{Role: modulecapabilities.GenerativeRoleAssistant, ToolCalls: res.ToolCalls},
+		modulecapabilities.GenerativeMessage{Role: modulecapabilities.GenerativeRoleTool, Content: `[{"name":"Mars"}]`, ToolCallID: "call_0"},
+	)
+	res, err = c.GenerateWithTools(context.Background(), request, nil, &fakeClassConfig{})
+	require.NoError(t, err)
+	assert.Empty(t, res.ToolCalls)
+	require.NotNil(t, res.Result)
+	assert.Contains(t, *res.Result, "Mars")
+}
+
+type fakeClassConfig struct{}
+
+func (cfg *fakeClassConfig) Tenant() string {
+	return ""
+}
+
+func (cfg *fakeClassConfig) Class() map[string]interface{} {
+	return nil
+}
+
+func (cfg *fakeClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return nil
+}
+
+func (cfg *fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
+
+func (cfg *fakeClassConfig) TargetVector() string {
+	return ""
+}
+
+func (cfg *fakeClassConfig) PropertiesDataTypes() map[string]schema.DataType {
+	return nil
+}
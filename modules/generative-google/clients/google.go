@@ -29,6 +29,7 @@ import (
 	googleparams "github.com/weaviate/weaviate/modules/generative-google/parameters"
 	"github.com/weaviate/weaviate/usecases/modulecomponents/apikey"
 	"github.com/weaviate/weaviate/usecases/modulecomponents/generative"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 )
 
 type harmCategory string
@@ -143,11 +144,9 @@ func New(apiKey string, useGoogleAuth bool, timeout time.Duration, logger logrus
 		apiKey:        apiKey,
 		useGoogleAuth: useGoogleAuth,
 		googleApiKey:  apikey.NewGoogleApiKey(),
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
-		buildUrlFn: buildURL,
-		logger:     logger,
+		httpClient:    httpclient.New("google", timeout, httpclient.WithRateLimitRetries()),
+		buildUrlFn:    buildURL,
+		logger:        logger,
 	}
 }
 
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/weaviate/weaviate/usecases/modulecomponents"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -39,11 +40,9 @@ type nvidia struct {
 
 func New(apiKey string, timeout time.Duration, logger logrus.FieldLogger) *nvidia {
 	return &nvidia{
-		apiKey: apiKey,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
-		logger: logger,
+		apiKey:     apiKey,
+		httpClient: httpclient.New("nvidia", timeout, httpclient.WithRateLimitRetries()),
+		logger:     logger,
 	}
 }
 
@@ -18,12 +18,14 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/weaviate/weaviate/modules/generative-ollama/config"
 	ollamaparams "github.com/weaviate/weaviate/modules/generative-ollama/parameters"
 	"github.com/weaviate/weaviate/usecases/modulecomponents"
 	"github.com/weaviate/weaviate/usecases/modulecomponents/generative"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -38,10 +40,8 @@ type ollama struct {
 
 func New(timeout time.Duration, logger logrus.FieldLogger) *ollama {
 	return &ollama{
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
-		logger: logger,
+		httpClient: httpclient.New("ollama", timeout, httpclient.WithRateLimitRetries()),
+		logger:     logger,
 	}
 }
 
@@ -50,7 +50,7 @@ func (v *ollama) GenerateSingleResult(ctx context.Context, properties *modulecap
 	if err != nil {
 		return nil, err
 	}
-	return v.generate(ctx, cfg, forPrompt, generative.Blobs([]*modulecapabilities.GenerateProperties{properties}), options, debug)
+	return v.generate(ctx, cfg, forPrompt, generative.Blobs([]*modulecapabilities.GenerateProperties{properties}), options, debug, nil)
 }
 
 func (v *ollama) GenerateAllResults(ctx context.Context, properties []*modulecapabilities.GenerateProperties, task string, options interface{}, debug bool, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateResponse, error) {
@@ -58,10 +58,26 @@ func (v *ollama) GenerateAllResults(ctx context.Context, properties []*modulecap
 	if err != nil {
 		return nil, err
 	}
-	return v.generate(ctx, cfg, forTask, generative.Blobs(properties), options, debug)
+	return v.generate(ctx, cfg, forTask, generative.Blobs(properties), options, debug, nil)
 }
 
-func (v *ollama) generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string, imageProperties []map[string]*string, options interface{}, debug bool) (*modulecapabilities.GenerateResponse, error) {
+func (v *ollama) GenerateSingleResultStream(ctx context.Context, properties *modulecapabilities.GenerateProperties, prompt string, options interface{}, debug bool, cfg moduletools.ClassConfig, onDelta modulecapabilities.GenerateStreamFn) (*modulecapabilities.GenerateResponse, error) {
+	forPrompt, err := generative.MakeSinglePrompt(generative.Text(properties), prompt)
+	if err != nil {
+		return nil, err
+	}
+	return v.generate(ctx, cfg, forPrompt, generative.Blobs([]*modulecapabilities.GenerateProperties{properties}), options, debug, onDelta)
+}
+
+func (v *ollama) GenerateAllResultsStream(ctx context.Context, properties []*modulecapabilities.GenerateProperties, task string, options interface{}, debug bool, cfg moduletools.ClassConfig, onDelta modulecapabilities.GenerateStreamFn) (*modulecapabilities.GenerateResponse, error) {
+	forTask, err := generative.MakeTaskPrompt(generative.Texts(properties), task)
+	if err != nil {
+		return nil, err
+	}
+	return v.generate(ctx, cfg, forTask, generative.Blobs(properties), options, debug, onDelta)
+}
+
+func (v *ollama) generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string, imageProperties []map[string]*string, options interface{}, debug bool, onDelta modulecapabilities.GenerateStreamFn) (*modulecapabilities.GenerateResponse, error) {
 	params := v.getParameters(cfg, options, imageProperties)
 	debugInformation := v.getDebugInformation(debug, prompt)
 
@@ -69,7 +85,7 @@ func (v *ollama) generate(ctx context.Context, cfg moduletools.ClassConfig, prom
 	input := generateInput{
 		Model:  params.Model,
 		Prompt: prompt,
-		Stream: false,
+		Stream: onDelta != nil,
 	}
 	if params.Temperature != nil {
 		input.Options = &generateOptions{Temperature: params.Temperature}
@@ -96,6 +112,10 @@ func (v *ollama) generate(ctx context.Context, cfg moduletools.ClassConfig, prom
 	}
 	defer res.Body.Close()
 
+	if onDelta != nil && res.StatusCode == http.StatusOK {
+		return v.readStream(res.Body, onDelta, debugInformation)
+	}
+
 	bodyBytes, err := io.ReadAll(res.Body)
 	if err != nil {
 		return nil, errors.Wrap(err, "read response body")
@@ -122,6 +142,37 @@ func (v *ollama) generate(ctx context.Context, cfg moduletools.ClassConfig, prom
 	}, nil
 }
 
+// readStream forwards the response of every streamed line, the last line is
+// marked as done
+func (v *ollama) readStream(body io.Reader, onDelta modulecapabilities.GenerateStreamFn,
+	debugInformation *modulecapabilities.GenerateDebugInformation,
+) (*modulecapabilities.GenerateResponse, error) {
+	var text strings.Builder
+	err := generative.ReadJSONLines(body, func(line []byte) error {
+		var chunk generateResponse
+		if err := json.Unmarshal(line, &chunk); err != nil {
+			return errors.Wrapf(err, "unmarshal stream chunk. Got: %v", string(line))
+		}
+		if chunk.Error != "" {
+			return errors.Errorf("connection to Ollama API failed with error: %s", chunk.Error)
+		}
+		if chunk.Response == "" {
+			return nil
+		}
+		text.WriteString(chunk.Response)
+		return onDelta(chunk.Response)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "read response stream")
+	}
+
+	textResponse := text.String()
+	return &modulecapabilities.GenerateResponse{
+		Result: &textResponse,
+		Debug:  debugInformation,
+	}, nil
+}
+
 func (v *ollama) getParameters(cfg moduletools.ClassConfig, options interface{}, imagePropertiesArray []map[string]*string) ollamaparams.Params {
 	settings := config.NewClassSettings(cfg)
 
@@ -85,6 +85,47 @@ func TestGetAnswer(t *testing.T) {
 	}
 }
 
+func TestGetAnswerStream(t *testing.T) {
+	props := []*modulecapabilities.GenerateProperties{{Text: map[string]string{"prop": "My name is john"}}}
+
+	t.Run("when the server streams the answer", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var b generateInput
+			require.Nil(t, json.NewDecoder(r.Body).Decode(&b))
+			assert.True(t, b.Stream)
+			w.Write([]byte(`{"response":"Test"}` + "\n" + `{"response":" test"}` + "\n" + `{"response":"","done":true}` + "\n"))
+		}))
+		defer server.Close()
+
+		c := New(0, nullLogger())
+		var deltas []string
+		res, err := c.GenerateAllResultsStream(context.Background(), props, "What is my name?", nil, false,
+			&fakeClassConfig{apiEndpoint: server.URL}, func(delta string) error {
+				deltas = append(deltas, delta)
+				return nil
+			})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Test", " test"}, deltas)
+		assert.Equal(t, "Test test", *res.Result)
+	})
+
+	t.Run("when the server has an error", func(t *testing.T) {
+		server := httptest.NewServer(&testAnswerHandler{
+			t:      t,
+			answer: generateResponse{Error: "some error from the server"},
+		})
+		defer server.Close()
+
+		c := New(0, nullLogger())
+		_, err := c.GenerateAllResultsStream(context.Background(), props, "What is my name?", nil, false,
+			&fakeClassConfig{apiEndpoint: server.URL}, func(delta string) error { return nil })
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "some error from the server")
+	})
+}
+
 type testAnswerHandler struct {
 	t *testing.T
 	// the test handler will report as not ready before the time has passed
@@ -25,6 +25,7 @@ import (
 
 	"github.com/weaviate/weaviate/usecases/modulecomponents"
 	"github.com/weaviate/weaviate/usecases/modulecomponents/generative"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 
 	"github.com/pkg/errors"
@@ -67,11 +68,9 @@ func New(openAIApiKey, openAIOrganization, azureApiKey string, timeout time.Dura
 		openAIApiKey:       openAIApiKey,
 		openAIOrganization: openAIOrganization,
 		azureApiKey:        azureApiKey,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
-		buildUrl: buildUrlFn,
-		logger:   logger,
+		httpClient:         httpclient.New("openai", timeout, httpclient.WithRateLimitRetries()),
+		buildUrl:           buildUrlFn,
+		logger:             logger,
 	}
 }
 
@@ -81,7 +80,7 @@ func (v *openai) GenerateSingleResult(ctx context.Context, properties *modulecap
 	if err != nil {
 		return nil, err
 	}
-	return v.generate(ctx, cfg, forPrompt, generative.Blobs([]*modulecapabilities.GenerateProperties{properties}), options, debug)
+	return v.generate(ctx, cfg, forPrompt, generative.Blobs([]*modulecapabilities.GenerateProperties{properties}), options, debug, nil)
 }
 
 func (v *openai) GenerateAllResults(ctx context.Context, properties []*modulecapabilities.GenerateProperties, task string, options interface{}, debug bool, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateResponse, error) {
@@ -90,10 +89,28 @@ func (v *openai) GenerateAllResults(ctx context.Context, properties []*modulecap
 	if err != nil {
 		return nil, err
 	}
-	return v.generate(ctx, cfg, forTask, generative.Blobs(properties), options, debug)
+	return v.generate(ctx, cfg, forTask, generative.Blobs(properties), options, debug, nil)
 }
 
-func (v *openai) generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string, imageProperties []map[string]*string, options interface{}, debug bool) (*modulecapabilities.GenerateResponse, error) {
+func (v *openai) GenerateSingleResultStream(ctx context.Context, properties *modulecapabilities.GenerateProperties, prompt string, options interface{}, debug bool, cfg moduletools.ClassConfig, onDelta modulecapabilities.GenerateStreamFn) (*modulecapabilities.GenerateResponse, error) {
+	monitoring.GetMetrics().ModuleExternalRequestSingleCount.WithLabelValues("generate", "openai").Inc()
+	forPrompt, err := generative.MakeSinglePrompt(generative.Text(properties), prompt)
+	if err != nil {
+		return nil, err
+	}
+	return v.generate(ctx, cfg, forPrompt, generative.Blobs([]*modulecapabilities.GenerateProperties{properties}), options, debug, onDelta)
+}
+
+func (v *openai) GenerateAllResultsStream(ctx context.Context, properties []*modulecapabilities.GenerateProperties, task string, options interface{}, debug bool, cfg moduletools.ClassConfig, onDelta modulecapabilities.GenerateStreamFn) (*modulecapabilities.GenerateResponse, error) {
+	monitoring.GetMetrics().ModuleExternalRequestBatchCount.WithLabelValues("generate", "openai").Inc()
+	forTask, err := generative.MakeTaskPrompt(generative.Texts(properties), task)
+	if err != nil {
+		return nil, err
+	}
+	return v.generate(ctx, cfg, forTask, generative.Blobs(properties), options, debug, onDelta)
+}
+
+func (v *openai) generate(ctx context.Context, cfg moduletools.ClassConfig, prompt string, imageProperties []map[string]*string, options interface{}, debug bool, onDelta modulecapabilities.GenerateStreamFn) (*modulecapabilities.GenerateResponse, error) {
 	monitoring.GetMetrics().ModuleExternalRequests.WithLabelValues("generate", "openai").Inc()
 	startTime := time.Now()
 	params := v.getParameters(cfg, options, imageProperties)
@@ -109,6 +126,13 @@ func (v *openai) generate(ctx context.Context, cfg moduletools.ClassConfig, prom
 	if err != nil {
 		return nil, errors.Wrap(err, "generate input")
 	}
+	if onDelta != nil {
+		input.Stream = true
+		if !isAzure {
+			// usage is only part of a stream when asked for
+			input.StreamOptions = &streamOptions{IncludeUsage: true}
+		}
+	}
 
 	defer func() {
 		monitoring.GetMetrics().ModuleExternalRequestDuration.WithLabelValues("generate", oaiUrl).Observe(time.Since(startTime).Seconds())
@@ -148,6 +172,10 @@ func (v *openai) generate(ctx context.Context, cfg moduletools.ClassConfig, prom
 	defer res.Body.Close()
 
 	requestID := res.Header.Get("x-request-id")
+	if onDelta != nil && res.StatusCode == http.StatusOK {
+		return v.readStream(res.Body, onDelta, debugInformation)
+	}
+
 	bodyBytes, err := io.ReadAll(res.Body)
 	if err != nil {
 		return nil, errors.Wrap(err, "read response body")
@@ -194,6 +222,54 @@ func (v *openai) generate(ctx context.Context, cfg moduletools.ClassConfig, prom
 	}, nil
 }
 
+// readStream forwards the deltas of a streamed completion and assembles the
+// complete result from them
+func (v *openai) readStream(body io.Reader, onDelta modulecapabilities.GenerateStreamFn,
+	debugInformation *modulecapabilities.GenerateDebugInformation,
+) (*modulecapabilities.GenerateResponse, error) {
+	var text strings.Builder
+	var streamUsage *usage
+	err := generative.ReadServerSentEvents(body, func(_, data string) error {
+		if data == "[DONE]" {
+			return nil
+		}
+		var chunk generateResponse
+		if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+			return errors.Wrapf(err, "unmarshal stream chunk. Got: %v", data)
+		}
+		if chunk.Error != nil {
+			return errors.New(chunk.Error.Message)
+		}
+		if chunk.Usage != nil {
+			streamUsage = chunk.Usage
+		}
+		for _, c := range chunk.Choices {
+			delta := c.Text
+			if c.Delta != nil {
+				delta = c.Delta.Content
+			}
+			if c.Index != 0 || delta == "" {
+				continue
+			}
+			text.WriteString(delta)
+			if err := onDelta(delta); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "read response stream")
+	}
+
+	trimmedResponse := strings.Trim(text.String(), "\n")
+	return &modulecapabilities.GenerateResponse{
+		Result: &trimmedResponse,
+		Debug:  debugInformation,
+		Params: v.getResponseParams(streamUsage),
+	}, nil
+}
+
 func (v *openai) getParameters(cfg moduletools.ClassConfig, options interface{}, imagePropertiesArray []map[string]*string) openaiparams.Params {
 	settings := config.NewClassSettings(cfg)
 
@@ -438,31 +514,37 @@ func (v *openai) getOpenAIOrganization(ctx context.Context) string {
 }
 
 type generateInput struct {
-	Prompt           string    `json:"prompt,omitempty"`
-	Messages         []message `json:"messages,omitempty"`
-	Stream           bool      `json:"stream,omitempty"`
-	Model            string    `json:"model,omitempty"`
-	FrequencyPenalty *float64  `json:"frequency_penalty,omitempty"`
-	Logprobs         *bool     `json:"logprobs,omitempty"`
-	TopLogprobs      *int      `json:"top_logprobs,omitempty"`
-	MaxTokens        *int      `json:"max_tokens,omitempty"`
-	N                *int      `json:"n,omitempty"`
-	PresencePenalty  *float64  `json:"presence_penalty,omitempty"`
-	Stop             []string  `json:"stop,omitempty"`
-	Temperature      *float64  `json:"temperature,omitempty"`
-	TopP             *float64  `json:"top_p,omitempty"`
+	Prompt           string         `json:"prompt,omitempty"`
+	Messages         []message      `json:"messages,omitempty"`
+	Stream           bool           `json:"stream,omitempty"`
+	StreamOptions    *streamOptions `json:"stream_options,omitempty"`
+	Model            string         `json:"model,omitempty"`
+	FrequencyPenalty *float64       `json:"frequency_penalty,omitempty"`
+	Logprobs         *bool          `json:"logprobs,omitempty"`
+	TopLogprobs      *int           `json:"top_logprobs,omitempty"`
+	MaxTokens        *int           `json:"max_tokens,omitempty"`
+	N                *int           `json:"n,omitempty"`
+	PresencePenalty  *float64       `json:"presence_penalty,omitempty"`
+	Stop             []string       `json:"stop,omitempty"`
+	Temperature      *float64       `json:"temperature,omitempty"`
+	TopP             *float64       `json:"top_p,omitempty"`
+	Tools            []tool         `json:"tools,omitempty"`
+	ToolChoice       interface{}    `json:"tool_choice,omitempty"`
 }
 
 type responseMessage struct {
-	Role    string `json:"role"`
-	Content string `json:"content"`
-	Name    string `json:"name,omitempty"`
+	Role      string     `json:"role"`
+	Content   string     `json:"content"`
+	Name      string     `json:"name,omitempty"`
+	ToolCalls []toolCall `json:"tool_calls,omitempty"`
 }
 
 type message struct {
-	Role    string      `json:"role"`
-	Content interface{} `json:"content"` // string or array of contentText and contentImage
-	Name    string      `json:"name,omitempty"`
+	Role       string      `json:"role"`
+	Content    interface{} `json:"content"` // string or array of contentText and contentImage
+	Name       string      `json:"name,omitempty"`
+	ToolCalls  []toolCall  `json:"tool_calls,omitempty"`
+	ToolCallID string      `json:"tool_call_id,omitempty"`
 }
 
 type contentImageInput []interface{}
@@ -481,6 +563,10 @@ type contentImageURL struct {
 	URL *string `json:"url"`
 }
 
+type streamOptions struct {
+	IncludeUsage bool `json:"include_usage"`
+}
+
 type generateResponse struct {
 	Choices []choice
 	Usage   *usage          `json:"usage,omitempty"`
@@ -492,6 +578,7 @@ type choice struct {
 	Index        float32
 	Text         string           `json:"text,omitempty"`
 	Message      *responseMessage `json:"message,omitempty"`
+	Delta        *responseMessage `json:"delta,omitempty"`
 }
 
 type openAIApiError struct {
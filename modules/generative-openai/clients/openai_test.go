@@ -14,6 +14,7 @@ package clients
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -183,6 +184,70 @@ func TestGetAnswer(t *testing.T) {
 	})
 }
 
+func TestGetAnswerStream(t *testing.T) {
+	props := []*modulecapabilities.GenerateProperties{{Text: map[string]string{"prop": "My name is john"}}}
+	t.Run("when the server streams the answer", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var b map[string]interface{}
+			require.Nil(t, json.NewDecoder(r.Body).Decode(&b))
+			assert.Equal(t, true, b["stream"])
+			assert.Equal(t, map[string]interface{}{"include_usage": true}, b["stream_options"])
+
+			w.Header().Set("Content-Type", "text/event-stream")
+			for _, chunk := range []string{
+				`{"choices":[{"index":0,"delta":{"role":"assistant","content":""}}]}`,
+				`{"choices":[{"index":0,"delta":{"content":"Jo"}}]}`,
+				`{"choices":[{"index":0,"delta":{"content":"hn"}}]}`,
+				`{"choices":[],"usage":{"prompt_tokens":5,"completion_tokens":2,"total_tokens":7}}`,
+				`[DONE]`,
+			} {
+				fmt.Fprintf(w, "data: %s\n\n", chunk)
+			}
+		}))
+		defer server.Close()
+
+		c := New("openAIApiKey", "", "", 0, nullLogger())
+		c.buildUrl = func(isLegacy, isAzure bool, resourceName, deploymentID, baseURL, apiVersion string) (string, error) {
+			return fakeBuildUrl(server.URL, isAzure, isLegacy, resourceName, deploymentID, baseURL, apiVersion)
+		}
+
+		var deltas []string
+		res, err := c.GenerateAllResultsStream(context.Background(), props, "What is my name?", nil, false, nil,
+			func(delta string) error {
+				deltas = append(deltas, delta)
+				return nil
+			})
+
+		require.Nil(t, err)
+		assert.Equal(t, []string{"Jo", "hn"}, deltas)
+		assert.Equal(t, "John", *res.Result)
+		usage := GetResponseParams(res.Params).Usage
+		require.NotNil(t, usage)
+		assert.Equal(t, 7, *usage.TotalTokens)
+	})
+
+	t.Run("when the server rejects the request", func(t *testing.T) {
+		server := httptest.NewServer(&testAnswerHandler{
+			t: t,
+			answer: generateResponse{
+				Error: &openAIApiError{Message: "some error from the server"},
+			},
+		})
+		defer server.Close()
+
+		c := New("openAIApiKey", "", "", 0, nullLogger())
+		c.buildUrl = func(isLegacy, isAzure bool, resourceName, deploymentID, baseURL, apiVersion string) (string, error) {
+			return fakeBuildUrl(server.URL, isAzure, isLegacy, resourceName, deploymentID, baseURL, apiVersion)
+		}
+
+		_, err := c.GenerateAllResultsStream(context.Background(), props, "What is my name?", nil, false, nil,
+			func(delta string) error { return nil })
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "some error from the server")
+	})
+}
+
 type testAnswerHandler struct {
 	t *testing.T
 	// the test handler will report as not ready before the time has passed
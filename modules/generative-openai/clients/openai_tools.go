@@ -0,0 +1,166 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/modules/generative-openai/config"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+// GenerateWithTools sends the conversation of an agent run as a chat
+// completion and returns either the tool calls or the answer of the model
+func (v *openai) GenerateWithTools(ctx context.Context, request *modulecapabilities.GenerateToolsRequest, options interface{}, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateToolsResponse, error) {
+	monitoring.GetMetrics().ModuleExternalRequests.WithLabelValues("generate", "openai").Inc()
+	params := v.getParameters(cfg, options, nil)
+	if config.IsLegacy(params.Model) {
+		return nil, errors.Errorf("model %s does not support tool calls", params.Model)
+	}
+	isAzure := config.IsAzure(params.IsAzure, params.ResourceName, params.DeploymentID)
+
+	oaiUrl, err := v.buildOpenAIUrl(ctx, params)
+	if err != nil {
+		return nil, errors.Wrap(err, "url join path")
+	}
+
+	input := generateInput{
+		Messages:         toolMessages(request),
+		FrequencyPenalty: params.FrequencyPenalty,
+		MaxTokens:        params.MaxTokens,
+		PresencePenalty:  params.PresencePenalty,
+		Stop:             params.Stop,
+		Temperature:      params.Temperature,
+		TopP:             params.TopP,
+	}
+	if !isAzure {
+		input.Model = params.Model
+	}
+	for _, t := range request.Tools {
+		input.Tools = append(input.Tools, tool{
+			Type:     "function",
+			Function: function{Name: t.Name, Description: t.Description, Parameters: t.Parameters},
+		})
+	}
+	if request.DisableTools && len(input.Tools) > 0 {
+		input.ToolChoice = "none"
+	}
+
+	body, err := json.Marshal(input)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal body")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", oaiUrl, bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "create POST request")
+	}
+	apiKey, err := v.getApiKey(ctx, isAzure)
+	if err != nil {
+		return nil, errors.Wrapf(err, "OpenAI API Key")
+	}
+	req.Header.Add(v.getApiKeyHeaderAndValue(apiKey, isAzure))
+	if openAIOrganization := v.getOpenAIOrganization(ctx); openAIOrganization != "" {
+		req.Header.Add("OpenAI-Organization", openAIOrganization)
+	}
+	req.Header.Add("Content-Type", "application/json")
+
+	res, err := v.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send POST request")
+	}
+	defer res.Body.Close()
+
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read response body")
+	}
+	var resBody generateResponse
+	if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("unmarshal response body. Got: %v", string(bodyBytes)))
+	}
+	if res.StatusCode != 200 || resBody.Error != nil {
+		return nil, v.getError(res.StatusCode, res.Header.Get("x-request-id"), resBody.Error, params.IsAzure)
+	}
+
+	response := &modulecapabilities.GenerateToolsResponse{Params: v.getResponseParams(resBody.Usage)}
+	if len(resBody.Choices) == 0 || resBody.Choices[0].Message == nil {
+		return response, nil
+	}
+	message := resBody.Choices[0].Message
+	if message.Content != "" {
+		result := strings.Trim(message.Content, "\n")
+		response.Result = &result
+	}
+	for _, call := range message.ToolCalls {
+		response.ToolCalls = append(response.ToolCalls, modulecapabilities.GenerativeToolCall{
+			ID:        call.ID,
+			Name:      call.Function.Name,
+			Arguments: call.Function.Arguments,
+		})
+	}
+	return response, nil
+}
+
+func toolMessages(request *modulecapabilities.GenerateToolsRequest) []message {
+	messages := make([]message, 0, len(request.Messages)+1)
+	if request.System != "" {
+		messages = append(messages, message{Role: "system", Content: request.System})
+	}
+	for _, msg := range request.Messages {
+		m := message{Role: msg.Role, ToolCallID: msg.ToolCallID}
+		if msg.Content != "" || len(msg.ToolCalls) == 0 {
+			m.Content = msg.Content
+		}
+		for _, call := range msg.ToolCalls {
+			m.ToolCalls = append(m.ToolCalls, toolCall{
+				ID:       call.ID,
+				Type:     "function",
+				Function: toolCallFunction{Name: call.Name, Arguments: call.Arguments},
+			})
+		}
+		messages = append(messages, m)
+	}
+	return messages
+}
+
+type tool struct {
+	Type     string   `json:"type"`
+	Function function `json:"function"`
+}
+
+type function struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	Parameters  map[string]interface{} `json:"parameters,omitempty"`
+}
+
+type toolCall struct {
+	ID       string           `json:"id"`
+	Type     string           `json:"type"`
+	Function toolCallFunction `json:"function"`
+}
+
+type toolCallFunction struct {
+	Name      string `json:"name"`
+	Arguments string `json:"arguments"`
+}
@@ -0,0 +1,108 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+)
+
+func TestGenerateWithTools(t *testing.T) {
+	request := func() *modulecapabilities.GenerateToolsRequest {
+		return &modulecapabilities.GenerateToolsRequest{
+			System: "You answer questions",
+			Messages: []modulecapabilities.GenerativeMessage{
+				{Role: modulecapabilities.GenerativeRoleUser, Content: "Which planet is red?"},
+				{Role: modulecapabilities.GenerativeRoleAssistant, ToolCalls: []modulecapabilities.GenerativeToolCall{
+					{ID: "call_1", Name: "search", Arguments: `{"query":"red planet"}`},
+				}},
+				{Role: modulecapabilities.GenerativeRoleTool, Content: `[{"name":"Mars"}]`, ToolCallID: "call_1"},
+			},
+			Tools: []modulecapabilities.GenerativeTool{{
+				Name:        "search",
+				Description: "Search planets",
+				Parameters:  map[string]interface{}{"type": "object"},
+			}},
+		}
+	}
+
+	t.Run("when the model calls a tool", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var b struct {
+				Messages   []map[string]interface{} `json:"messages"`
+				Tools      []map[string]interface{} `json:"tools"`
+				ToolChoice interface{}              `json:"tool_choice"`
+			}
+			require.Nil(t, json.NewDecoder(r.Body).Decode(&b))
+			require.Len(t, b.Messages, 4)
+			assert.Equal(t, "system", b.Messages[0]["role"])
+			assert.Nil(t, b.Messages[2]["content"])
+			assert.Equal(t, []interface{}{map[string]interface{}{
+				"id": "call_1", "type": "function",
+				"function": map[string]interface{}{"name": "search", "arguments": `{"query":"red planet"}`},
+			}}, b.Messages[2]["tool_calls"])
+			assert.Equal(t, "call_1", b.Messages[3]["tool_call_id"])
+			require.Len(t, b.Tools, 1)
+			assert.Equal(t, "function", b.Tools[0]["type"])
+			assert.Nil(t, b.ToolChoice)
+
+			w.Write([]byte(`{"choices":[{"index":0,"message":{"role":"assistant","content":null,"tool_calls":[` +
+				`{"id":"call_2","type":"function","function":{"name":"search","arguments":"{\"query\":\"mars\"}"}}]}}]}`))
+		}))
+		defer server.Close()
+
+		c := New("openAIApiKey", "", "", 0, nullLogger())
+		c.buildUrl = func(isLegacy, isAzure bool, resourceName, deploymentID, baseURL, apiVersion string) (string, error) {
+			return fakeBuildUrl(server.URL, isAzure, isLegacy, resourceName, deploymentID, baseURL, apiVersion)
+		}
+
+		res, err := c.GenerateWithTools(context.Background(), request(), nil, nil)
+
+		require.Nil(t, err)
+		assert.Nil(t, res.Result)
+		assert.Equal(t, []modulecapabilities.GenerativeToolCall{
+			{ID: "call_2", Name: "search", Arguments: `{"query":"mars"}`},
+		}, res.ToolCalls)
+	})
+
+	t.Run("when tools are disabled", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var b map[string]interface{}
+			require.Nil(t, json.NewDecoder(r.Body).Decode(&b))
+			assert.Equal(t, "none", b["tool_choice"])
+
+			w.Write([]byte(`{"choices":[{"index":0,"message":{"role":"assistant","content":"Mars"}}]}`))
+		}))
+		defer server.Close()
+
+		c := New("openAIApiKey", "", "", 0, nullLogger())
+		c.buildUrl = func(isLegacy, isAzure bool, resourceName, deploymentID, baseURL, apiVersion string) (string, error) {
+			return fakeBuildUrl(server.URL, isAzure, isLegacy, resourceName, deploymentID, baseURL, apiVersion)
+		}
+		req := request()
+		req.DisableTools = true
+
+		res, err := c.GenerateWithTools(context.Background(), req, nil, nil)
+
+		require.Nil(t, err)
+		assert.Empty(t, res.ToolCalls)
+		require.NotNil(t, res.Result)
+		assert.Equal(t, "Mars", *res.Result)
+	})
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/weaviate/weaviate/modules/qna-openai/config"
 	"github.com/weaviate/weaviate/modules/qna-openai/ent"
 	"github.com/weaviate/weaviate/usecases/modulecomponents"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 )
 
@@ -60,7 +61,7 @@ func New(openAIApiKey, openAIOrganization, azureApiKey string, timeout time.Dura
 		openAIApiKey:       openAIApiKey,
 		openAIOrganization: openAIOrganization,
 		azureApiKey:        azureApiKey,
-		httpClient:         &http.Client{Timeout: timeout},
+		httpClient:         httpclient.New("openai", timeout, httpclient.WithRateLimitRetries()),
 		buildUrlFn:         buildUrl,
 		logger:             logger,
 	}
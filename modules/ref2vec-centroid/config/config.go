@@ -13,18 +13,30 @@ package config
 
 import (
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/weaviate/weaviate/entities/moduletools"
 )
 
 const (
-	MethodMean    = "mean"
-	MethodDefault = MethodMean
+	MethodMean         = "mean"
+	MethodWeightedMean = "weightedMean"
+	MethodRecencyMean  = "recencyMean"
+	MethodMedoid       = "medoid"
+	MethodDefault      = MethodMean
 )
 
+// DefaultHalfLife is the age at which a reference contributes half as much
+// as a brand-new one when using MethodRecencyMean
+const DefaultHalfLife = 30 * 24 * time.Hour
+
 const (
 	calculationMethodField   = "method"
 	referencePropertiesField = "referenceProperties"
+	weightPropertyField      = "weightProperty"
+	datePropertyField        = "dateProperty"
+	halfLifeField            = "halfLife"
 )
 
 func Default() map[string]interface{} {
@@ -53,6 +65,18 @@ func (c *Config) ReferenceProperties() map[string]struct{} {
 	return refProps
 }
 
+// ReferencePropertyNames returns the configured reference properties in a
+// stable order
+func (c *Config) ReferencePropertyNames() []string {
+	refProps := c.ReferenceProperties()
+	names := make([]string, 0, len(refProps))
+	for name := range refProps {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 func (c *Config) CalculationMethod() (string, error) {
 	props := c.class.Class()
 	calcMethod, ok := props[calculationMethodField].(string)
@@ -61,3 +85,44 @@ func (c *Config) CalculationMethod() (string, error) {
 	}
 	return calcMethod, nil
 }
+
+// WeightProperty is the numeric property of the referenced objects which is
+// used as the weight of their vectors by MethodWeightedMean
+func (c *Config) WeightProperty() (string, error) {
+	return c.stringField(weightPropertyField)
+}
+
+// DateProperty is the date property of the referenced objects which is used
+// to decay their weight by MethodRecencyMean
+func (c *Config) DateProperty() (string, error) {
+	return c.stringField(datePropertyField)
+}
+
+func (c *Config) HalfLife() (time.Duration, error) {
+	props := c.class.Class()
+	iHalfLife, ok := props[halfLifeField]
+	if !ok || iHalfLife == nil {
+		return DefaultHalfLife, nil
+	}
+	str, ok := iHalfLife.(string)
+	if !ok {
+		return 0, fmt.Errorf("could not parse %q. Expected a duration string, got: %v", halfLifeField, iHalfLife)
+	}
+	halfLife, err := time.ParseDuration(str)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse %q: %w", halfLifeField, err)
+	}
+	if halfLife <= 0 {
+		return 0, fmt.Errorf("%q must be positive, got: %v", halfLifeField, halfLife)
+	}
+	return halfLife, nil
+}
+
+func (c *Config) stringField(field string) (string, error) {
+	props := c.class.Class()
+	val, ok := props[field].(string)
+	if !ok || val == "" {
+		return "", fmt.Errorf("expected a non-empty string for field %q, got: %v", field, props[field])
+	}
+	return val, nil
+}
@@ -46,10 +46,28 @@ func Validate(cfg *Config) error {
 		}
 	}
 
-	_, err := cfg.CalculationMethod()
+	method, err := cfg.CalculationMethod()
 	if err != nil {
 		return err
 	}
 
+	switch method {
+	case "", MethodMean, MethodMedoid:
+	case MethodWeightedMean:
+		if _, err := cfg.WeightProperty(); err != nil {
+			return fmt.Errorf("%w: method %q: %w", errInvalidConfig, method, err)
+		}
+	case MethodRecencyMean:
+		if _, err := cfg.DateProperty(); err != nil {
+			return fmt.Errorf("%w: method %q: %w", errInvalidConfig, method, err)
+		}
+		if _, err := cfg.HalfLife(); err != nil {
+			return fmt.Errorf("%w: method %q: %w", errInvalidConfig, method, err)
+		}
+	default:
+		return fmt.Errorf("%w: unknown method %q, must be one of %q, %q, %q or %q",
+			errInvalidConfig, method, MethodMean, MethodWeightedMean, MethodRecencyMean, MethodMedoid)
+	}
+
 	return nil
 }
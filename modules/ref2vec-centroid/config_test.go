@@ -88,6 +88,68 @@ func TestConfigValidator(t *testing.T) {
 				"one value in the \"referenceProperties\" field",
 				class.Class),
 		},
+		{
+			name:  "valid config - weighted mean",
+			class: class,
+			classConfig: fakeClassConfig{
+				"referenceProperties": []interface{}{"someRef"},
+				"method":              "weightedMean",
+				"weightProperty":      "rating",
+			},
+		},
+		{
+			name:  "valid config - recency mean",
+			class: class,
+			classConfig: fakeClassConfig{
+				"referenceProperties": []interface{}{"someRef"},
+				"method":              "recencyMean",
+				"dateProperty":        "publishedAt",
+				"halfLife":            "168h",
+			},
+		},
+		{
+			name:  "valid config - medoid",
+			class: class,
+			classConfig: fakeClassConfig{
+				"referenceProperties": []interface{}{"someRef"},
+				"method":              "medoid",
+			},
+		},
+		{
+			name:  "invalid config - unknown method",
+			class: class,
+			classConfig: fakeClassConfig{
+				"referenceProperties": []interface{}{"someRef"},
+				"method":              "median",
+			},
+			expectedErr: fmt.Errorf("validate %q: invalid config: unknown method \"median\", "+
+				"must be one of \"mean\", \"weightedMean\", \"recencyMean\" or \"medoid\"",
+				class.Class),
+		},
+		{
+			name:  "invalid config - weighted mean without weight property",
+			class: class,
+			classConfig: fakeClassConfig{
+				"referenceProperties": []interface{}{"someRef"},
+				"method":              "weightedMean",
+			},
+			expectedErr: fmt.Errorf("validate %q: invalid config: method \"weightedMean\": "+
+				"expected a non-empty string for field \"weightProperty\", got: <nil>",
+				class.Class),
+		},
+		{
+			name:  "invalid config - recency mean with invalid half life",
+			class: class,
+			classConfig: fakeClassConfig{
+				"referenceProperties": []interface{}{"someRef"},
+				"method":              "recencyMean",
+				"dateProperty":        "publishedAt",
+				"halfLife":            "-1h",
+			},
+			expectedErr: fmt.Errorf("validate %q: invalid config: method \"recencyMean\": "+
+				"\"halfLife\" must be positive, got: -1h0m0s",
+				class.Class),
+		},
 		{
 			name:  "invalid config - non-string value in referenceProperties array",
 			class: class,
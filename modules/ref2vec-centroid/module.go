@@ -19,6 +19,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/modules/ref2vec-centroid/config"
 	"github.com/weaviate/weaviate/modules/ref2vec-centroid/vectorizer"
 )
 
@@ -67,9 +68,46 @@ func (m *CentroidModule) VectorizeObject(ctx context.Context,
 	return vzr.Object(ctx, obj)
 }
 
+func (m *CentroidModule) VectorizableReferenceProperties(cfg moduletools.ClassConfig) ([]string, error) {
+	c := config.New(cfg)
+	if err := config.Validate(c); err != nil {
+		return nil, err
+	}
+	return c.ReferencePropertyNames(), nil
+}
+
+func (m *CentroidModule) ReferenceWeightProperties(cfg moduletools.ClassConfig) ([]string, error) {
+	c := config.New(cfg)
+	if err := config.Validate(c); err != nil {
+		return nil, err
+	}
+	method, err := c.CalculationMethod()
+	if err != nil {
+		return nil, err
+	}
+	switch method {
+	case config.MethodWeightedMean:
+		prop, err := c.WeightProperty()
+		if err != nil {
+			return nil, err
+		}
+		return []string{prop}, nil
+	case config.MethodRecencyMean:
+		prop, err := c.DateProperty()
+		if err != nil {
+			return nil, err
+		}
+		return []string{prop}, nil
+	default:
+		return nil, nil
+	}
+}
+
 // verify we implement the modules.Module interface
 var (
 	_ = modulecapabilities.Module(New())
 	_ = modulecapabilities.ReferenceVectorizer[[]float32](New())
+	_ = modulecapabilities.ReferenceVectorizerProperties(New())
+	_ = modulecapabilities.ReferenceVectorizerWeightProperties(New())
 	_ = modulecapabilities.MetaProvider(New())
 )
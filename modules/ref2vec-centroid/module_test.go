@@ -22,6 +22,7 @@ import (
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
@@ -97,6 +98,30 @@ func TestRef2VecCentroid(t *testing.T) {
 		})
 	})
 
+	t.Run("ReferenceWeightProperties", func(t *testing.T) {
+		props, err := mod.ReferenceWeightProperties(classConfig)
+		require.Nil(t, err)
+		assert.Empty(t, props)
+
+		weighted := fakeClassConfig{
+			"referenceProperties": []interface{}{refProp},
+			"method":              "weightedMean",
+			"weightProperty":      "rating",
+		}
+		props, err = mod.ReferenceWeightProperties(weighted)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"rating"}, props)
+
+		recency := fakeClassConfig{
+			"referenceProperties": []interface{}{refProp},
+			"method":              "recencyMean",
+			"dateProperty":        "publishedAt",
+		}
+		props, err = mod.ReferenceWeightProperties(recency)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"publishedAt"}, props)
+	})
+
 	t.Run("VectorizeObject", func(t *testing.T) {
 		t.Run("expected success", func(t *testing.T) {
 			t.Run("one refVec", func(t *testing.T) {
@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorizer
+
+import (
+	"fmt"
+	"math"
+)
+
+// calculateMedoid returns the reference vector with the smallest sum of
+// euclidean distances to all other reference vectors. Unlike the
+// mean, the result is always one of the actual references, which makes it
+// robust against outliers.
+func calculateMedoid(refVecs ...[]float32) ([]float32, error) {
+	if len(refVecs) == 0 || len(refVecs[0]) == 0 {
+		return nil, nil
+	}
+
+	targetVecLen := len(refVecs[0])
+	for _, vec := range refVecs {
+		if len(vec) != targetVecLen {
+			return nil, fmt.Errorf("calculate medoid: found vectors of different length: %d and %d",
+				targetVecLen, len(vec))
+		}
+	}
+
+	sums := make([]float64, len(refVecs))
+	for i := range refVecs {
+		for j := i + 1; j < len(refVecs); j++ {
+			dist := euclideanDistance(refVecs[i], refVecs[j])
+			sums[i] += dist
+			sums[j] += dist
+		}
+	}
+
+	medoid, minSum := 0, math.Inf(1)
+	for i, sum := range sums {
+		if sum < minSum {
+			medoid, minSum = i, sum
+		}
+	}
+
+	out := make([]float32, targetVecLen)
+	copy(out, refVecs[medoid])
+	return out, nil
+}
+
+func euclideanDistance(a, b []float32) float64 {
+	var sum float64
+	for i := range a {
+		diff := float64(a[i] - b[i])
+		sum += diff * diff
+	}
+	return math.Sqrt(sum)
+}
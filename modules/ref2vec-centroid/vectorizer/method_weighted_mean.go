@@ -0,0 +1,121 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vectorizer
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+)
+
+func calculateWeightedMean(refVecs [][]float32, weights []float32) ([]float32, error) {
+	if len(refVecs) == 0 || len(refVecs[0]) == 0 {
+		return nil, nil
+	}
+
+	targetVecLen := len(refVecs[0])
+	meanVec := make([]float32, targetVecLen)
+
+	var totalWeight float32
+	for i, vec := range refVecs {
+		if len(vec) != targetVecLen {
+			return nil, fmt.Errorf("calculate weighted mean: found vectors of different length: %d and %d",
+				targetVecLen, len(vec))
+		}
+
+		totalWeight += weights[i]
+		for j, val := range vec {
+			meanVec[j] += weights[i] * val
+		}
+	}
+
+	// none of the references carries any weight, which is treated the
+	// same way as not having any references at all
+	if totalWeight == 0 {
+		return nil, nil
+	}
+
+	for i := range meanVec {
+		meanVec[i] /= totalWeight
+	}
+
+	return meanVec, nil
+}
+
+// propertyWeight uses the numeric property prop of a referenced object as
+// its weight. Objects without the property are ignored.
+func propertyWeight(prop string) weightFn {
+	return func(props map[string]interface{}) (float32, bool, error) {
+		val, ok := props[prop]
+		if !ok || val == nil {
+			return 0, false, nil
+		}
+
+		var weight float64
+		switch v := val.(type) {
+		case float64:
+			weight = v
+		case float32:
+			weight = float64(v)
+		case int64:
+			weight = float64(v)
+		case int:
+			weight = float64(v)
+		case json.Number:
+			f, err := v.Float64()
+			if err != nil {
+				return 0, false, fmt.Errorf("property %q: %w", prop, err)
+			}
+			weight = f
+		default:
+			return 0, false, fmt.Errorf("property %q: expected a number, got %T", prop, val)
+		}
+
+		if weight < 0 || math.IsNaN(weight) || math.IsInf(weight, 0) {
+			return 0, false, fmt.Errorf("property %q: weight must be a non-negative number, got %v", prop, weight)
+		}
+		return float32(weight), true, nil
+	}
+}
+
+// recencyWeight decays the weight of a referenced object exponentially with
+// the age of its date property prop, halving it every halfLife. Dates in the
+// future count as brand-new. Objects without the property are ignored.
+func recencyWeight(prop string, halfLife time.Duration, now time.Time) weightFn {
+	return func(props map[string]interface{}) (float32, bool, error) {
+		val, ok := props[prop]
+		if !ok || val == nil {
+			return 0, false, nil
+		}
+
+		var date time.Time
+		switch v := val.(type) {
+		case time.Time:
+			date = v
+		case string:
+			parsed, err := time.Parse(time.RFC3339Nano, v)
+			if err != nil {
+				return 0, false, fmt.Errorf("property %q: %w", prop, err)
+			}
+			date = parsed
+		default:
+			return 0, false, fmt.Errorf("property %q: expected a date, got %T", prop, val)
+		}
+
+		age := now.Sub(date)
+		if age < 0 {
+			age = 0
+		}
+		return float32(math.Pow(0.5, float64(age)/float64(halfLife))), true, nil
+	}
+}
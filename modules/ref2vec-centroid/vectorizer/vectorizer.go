@@ -14,6 +14,7 @@ package vectorizer
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
@@ -27,9 +28,15 @@ import (
 
 type calcFn func(vecs ...[]float32) ([]float32, error)
 
+// weightFn determines the weight of a referenced object's vector based on
+// the object's properties. References for which ok is false are left out of
+// the calculation.
+type weightFn func(props map[string]interface{}) (weight float32, ok bool, err error)
+
 type Vectorizer struct {
 	config       *config.Config
 	calcFn       calcFn
+	weightFn     weightFn
 	findObjectFn modulecapabilities.FindObjectFn
 }
 
@@ -46,6 +53,26 @@ func New(cfg moduletools.ClassConfig, findFn modulecapabilities.FindObjectFn) (*
 	switch method {
 	case config.MethodMean:
 		v.calcFn = calculateMean
+	case config.MethodMedoid:
+		v.calcFn = calculateMedoid
+	case config.MethodWeightedMean:
+		prop, err := v.config.WeightProperty()
+		if err != nil {
+			return nil, err
+		}
+		v.calcFn = calculateMean
+		v.weightFn = propertyWeight(prop)
+	case config.MethodRecencyMean:
+		prop, err := v.config.DateProperty()
+		if err != nil {
+			return nil, err
+		}
+		halfLife, err := v.config.HalfLife()
+		if err != nil {
+			return nil, err
+		}
+		v.calcFn = calculateMean
+		v.weightFn = recencyWeight(prop, halfLife, time.Now())
 	default:
 		v.calcFn = calculateMean
 	}
@@ -56,17 +83,17 @@ func New(cfg moduletools.ClassConfig, findFn modulecapabilities.FindObjectFn) (*
 func (v *Vectorizer) Object(ctx context.Context, obj *models.Object) ([]float32, error) {
 	props := v.config.ReferenceProperties()
 
-	refVecs, err := v.referenceVectorSearch(ctx, obj, props)
+	refs, err := v.referenceVectorSearch(ctx, obj, props)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(refVecs) == 0 {
+	if len(refs) == 0 {
 		obj.Vector = nil
 		return nil, nil
 	}
 
-	vec, err := v.calcFn(refVecs...)
+	vec, err := v.calculate(refs)
 	if err != nil {
 		return nil, fmt.Errorf("calculate vector: %w", err)
 	}
@@ -74,10 +101,37 @@ func (v *Vectorizer) Object(ctx context.Context, obj *models.Object) ([]float32,
 	return vec, nil
 }
 
+func (v *Vectorizer) calculate(refs []*search.Result) ([]float32, error) {
+	if v.weightFn == nil {
+		refVecs := make([][]float32, len(refs))
+		for i, ref := range refs {
+			refVecs[i] = ref.Vector
+		}
+		return v.calcFn(refVecs...)
+	}
+
+	refVecs := make([][]float32, 0, len(refs))
+	weights := make([]float32, 0, len(refs))
+	for _, ref := range refs {
+		props, _ := ref.Schema.(map[string]interface{})
+		weight, ok, err := v.weightFn(props)
+		if err != nil {
+			return nil, fmt.Errorf("weight of reference %s/%s: %w", ref.ClassName, ref.ID, err)
+		}
+		if !ok {
+			continue
+		}
+		refVecs = append(refVecs, ref.Vector)
+		weights = append(weights, weight)
+	}
+	return calculateWeightedMean(refVecs, weights)
+}
+
+// referenceVectorSearch returns the referenced objects which have a vector
 func (v *Vectorizer) referenceVectorSearch(ctx context.Context,
 	obj *models.Object, refProps map[string]struct{},
-) ([][]float32, error) {
-	var refVecs [][]float32
+) ([]*search.Result, error) {
+	var refs []*search.Result
 	props := obj.Properties.(map[string]interface{})
 
 	// use the ids from parent's beacons to find the referenced objects
@@ -92,11 +146,11 @@ func (v *Vectorizer) referenceVectorSearch(ctx context.Context,
 		// these will be used to compute the parent's
 		// vector eventually
 		if res.Vector != nil {
-			refVecs = append(refVecs, res.Vector)
+			refs = append(refs, res)
 		}
 	}
 
-	return refVecs, nil
+	return refs, nil
 }
 
 func (v *Vectorizer) findReferenceObject(ctx context.Context, beacon strfmt.URI, tenant string) (res *search.Result, err error) {
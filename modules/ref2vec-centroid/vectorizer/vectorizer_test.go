@@ -16,10 +16,12 @@ import (
 	"errors"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
 	"github.com/weaviate/weaviate/entities/search"
@@ -48,6 +50,160 @@ func TestVectorizer_New(t *testing.T) {
 
 		assert.EqualValues(t, expected, received)
 	})
+
+	t.Run("medoid calcFn is used", func(t *testing.T) {
+		cfg := fakeClassConfig{"method": config.MethodMedoid}
+		vzr, err := New(cfg, repo.Object)
+		assert.Nil(t, err)
+
+		expected := reflect.ValueOf(calculateMedoid).Pointer()
+		received := reflect.ValueOf(vzr.calcFn).Pointer()
+
+		assert.EqualValues(t, expected, received)
+		assert.Nil(t, vzr.weightFn)
+	})
+
+	t.Run("weighted methods require their property", func(t *testing.T) {
+		_, err := New(fakeClassConfig{"method": config.MethodWeightedMean}, repo.Object)
+		assert.EqualError(t, err, "expected a non-empty string for field \"weightProperty\", got: <nil>")
+
+		_, err = New(fakeClassConfig{"method": config.MethodRecencyMean}, repo.Object)
+		assert.EqualError(t, err, "expected a non-empty string for field \"dateProperty\", got: <nil>")
+	})
+}
+
+func TestVectorizer_WeightedObject(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name           string
+		cfg            fakeClassConfig
+		refs           []*search.Result
+		expectedResult []float32
+		expectedErr    string
+	}{
+		{
+			name: "weighted mean",
+			cfg:  fakeClassConfig{"method": config.MethodWeightedMean, "weightProperty": "rating"},
+			refs: []*search.Result{
+				{Vector: []float32{1, 1}, Schema: map[string]interface{}{"rating": float64(3)}},
+				{Vector: []float32{5, 9}, Schema: map[string]interface{}{"rating": float64(1)}},
+			},
+			expectedResult: []float32{2, 3},
+		},
+		{
+			name: "weighted mean skips refs without weight",
+			cfg:  fakeClassConfig{"method": config.MethodWeightedMean, "weightProperty": "rating"},
+			refs: []*search.Result{
+				{Vector: []float32{1, 1}, Schema: map[string]interface{}{"rating": float64(2)}},
+				{Vector: []float32{5, 9}, Schema: map[string]interface{}{}},
+			},
+			expectedResult: []float32{1, 1},
+		},
+		{
+			name: "weighted mean with zero total weight",
+			cfg:  fakeClassConfig{"method": config.MethodWeightedMean, "weightProperty": "rating"},
+			refs: []*search.Result{
+				{Vector: []float32{1, 1}, Schema: map[string]interface{}{"rating": float64(0)}},
+			},
+			expectedResult: nil,
+		},
+		{
+			name: "weighted mean with negative weight",
+			cfg:  fakeClassConfig{"method": config.MethodWeightedMean, "weightProperty": "rating"},
+			refs: []*search.Result{
+				{Vector: []float32{1, 1}, Schema: map[string]interface{}{"rating": float64(-1)}},
+			},
+			expectedErr: "weight must be a non-negative number",
+		},
+		{
+			name: "weighted mean with non-numeric weight",
+			cfg:  fakeClassConfig{"method": config.MethodWeightedMean, "weightProperty": "rating"},
+			refs: []*search.Result{
+				{Vector: []float32{1, 1}, Schema: map[string]interface{}{"rating": "high"}},
+			},
+			expectedErr: "expected a number, got string",
+		},
+		{
+			name: "recency mean",
+			cfg: fakeClassConfig{
+				"method": config.MethodRecencyMean, "dateProperty": "publishedAt", "halfLife": "24h",
+			},
+			refs: []*search.Result{
+				{Vector: []float32{0, 0}, Schema: map[string]interface{}{
+					"publishedAt": now.Add(-24 * time.Hour).Format(time.RFC3339Nano),
+				}},
+				{Vector: []float32{3, 6}, Schema: map[string]interface{}{
+					"publishedAt": now.Add(time.Hour).Format(time.RFC3339Nano),
+				}},
+			},
+			expectedResult: []float32{2, 4},
+		},
+		{
+			name: "recency mean with unparseable date",
+			cfg:  fakeClassConfig{"method": config.MethodRecencyMean, "dateProperty": "publishedAt"},
+			refs: []*search.Result{
+				{Vector: []float32{0, 0}, Schema: map[string]interface{}{"publishedAt": "yesterday"}},
+			},
+			expectedErr: "property \"publishedAt\"",
+		},
+		{
+			name: "medoid",
+			cfg:  fakeClassConfig{"method": config.MethodMedoid},
+			refs: []*search.Result{
+				{Vector: []float32{0, 0}},
+				{Vector: []float32{1, 1}},
+				{Vector: []float32{2, 2}},
+				{Vector: []float32{3, 3}},
+				{Vector: []float32{100, 100}},
+			},
+			expectedResult: []float32{2, 2},
+		},
+		{
+			name: "medoid with mismatched vector dimensions",
+			cfg:  fakeClassConfig{"method": config.MethodMedoid},
+			refs: []*search.Result{
+				{Vector: []float32{0, 0}},
+				{Vector: []float32{1, 1, 1}},
+			},
+			expectedErr: "calculate vector: calculate medoid: found vectors of different length: 2 and 3",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := &fakeObjectsRepo{}
+			test.cfg["referenceProperties"] = []interface{}{"toRef"}
+
+			modelRefs := make(models.MultipleRef, len(test.refs))
+			for i, res := range test.refs {
+				crossRef := crossref.New("localhost", "SomeClass",
+					strfmt.UUID(uuid.NewString()))
+				modelRefs[i] = crossRef.SingleRef()
+
+				repo.On("Object", ctx, crossRef.Class, crossRef.TargetID, "").
+					Return(res, nil)
+			}
+
+			obj := &models.Object{
+				Properties: map[string]interface{}{"toRef": modelRefs},
+			}
+			vectorizer, err := New(test.cfg, repo.Object)
+			require.Nil(t, err)
+			vec, err := vectorizer.Object(ctx, obj)
+			if test.expectedErr != "" {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.expectedErr)
+				return
+			}
+			require.Nil(t, err)
+			require.Len(t, vec, len(test.expectedResult))
+			for i := range vec {
+				assert.InDelta(t, test.expectedResult[i], vec[i], 1e-4)
+			}
+		})
+	}
 }
 
 func TestVectorizer_Object(t *testing.T) {
@@ -0,0 +1,157 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"math"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/modules/reranker-bm25/config"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/ent"
+)
+
+// client scores the documents locally with BM25, using the documents passed
+// to a single request as the corpus, and boosts documents in which the query
+// terms appear close to each other
+type client struct {
+	logger logrus.FieldLogger
+}
+
+func New(logger logrus.FieldLogger) *client {
+	return &client{
+		logger: logger,
+	}
+}
+
+func (c *client) Rank(ctx context.Context, query string, documents []string,
+	cfg moduletools.ClassConfig,
+) (*ent.RankResult, error) {
+	settings := config.NewClassSettings(cfg)
+	detector, err := stopwords.NewDetectorFromPreset(settings.Stopwords())
+	if err != nil {
+		return nil, err
+	}
+
+	tokenization := settings.Tokenization()
+	queryTerms := queryTerms(helpers.Tokenize(tokenization, query), detector)
+
+	docTerms := make([][]string, len(documents))
+	docFreqs := make(map[string]int, len(queryTerms))
+	var totalLen int
+	for i, doc := range documents {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		docTerms[i] = helpers.Tokenize(tokenization, doc)
+		totalLen += len(docTerms[i])
+
+		seen := make(map[string]struct{}, len(queryTerms))
+		for _, term := range docTerms[i] {
+			if _, ok := queryTerms[term]; !ok {
+				continue
+			}
+			if _, ok := seen[term]; !ok {
+				seen[term] = struct{}{}
+				docFreqs[term]++
+			}
+		}
+	}
+
+	avgLen := 1.0
+	if totalLen > 0 {
+		avgLen = float64(totalLen) / float64(len(documents))
+	}
+
+	k1, b, proximityWeight := settings.K1(), settings.B(), settings.ProximityWeight()
+	n := float64(len(documents))
+	documentScores := make([]ent.DocumentScore, len(documents))
+	for i, doc := range documents {
+		termFreqs := make(map[string]int, len(queryTerms))
+		for _, term := range docTerms[i] {
+			if _, ok := queryTerms[term]; ok {
+				termFreqs[term]++
+			}
+		}
+
+		var score float64
+		lenNorm := k1 * (1 - b + b*float64(len(docTerms[i]))/avgLen)
+		for term, tf := range termFreqs {
+			df := float64(docFreqs[term])
+			idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+			score += idf * float64(tf) * (k1 + 1) / (float64(tf) + lenNorm)
+		}
+		if proximityWeight > 0 && len(termFreqs) > 1 {
+			score *= 1 + proximityWeight*proximity(docTerms[i], queryTerms, len(termFreqs))
+		}
+
+		documentScores[i] = ent.DocumentScore{
+			Document: doc,
+			Score:    score,
+		}
+	}
+
+	return &ent.RankResult{
+		Query: query, DocumentScores: documentScores,
+	}, nil
+}
+
+func queryTerms(tokens []string, detector *stopwords.Detector) map[string]struct{} {
+	terms := make(map[string]struct{}, len(tokens))
+	for _, token := range tokens {
+		if !detector.IsStopword(token) {
+			terms[token] = struct{}{}
+		}
+	}
+	// a query consisting of stopwords only would otherwise match nothing
+	if len(terms) == 0 {
+		for _, token := range tokens {
+			terms[token] = struct{}{}
+		}
+	}
+	return terms
+}
+
+// proximity is 1 if the matched distinct query terms appear right next to
+// each other in the document and approaches 0 the further apart they are. It
+// is based on the smallest window of tokens containing all matched terms.
+func proximity(tokens []string, queryTerms map[string]struct{}, matched int) float64 {
+	window := math.MaxInt
+	counts := make(map[string]int, matched)
+	start := 0
+	for end, token := range tokens {
+		if _, ok := queryTerms[token]; !ok {
+			continue
+		}
+		counts[token]++
+		for len(counts) == matched {
+			first := tokens[start]
+			if _, ok := queryTerms[first]; ok {
+				if size := end - start + 1; size < window {
+					window = size
+				}
+				counts[first]--
+				if counts[first] == 0 {
+					delete(counts, first)
+				}
+			}
+			start++
+		}
+	}
+	if window == math.MaxInt {
+		return 0
+	}
+	return float64(matched-1) / float64(window-1)
+}
@@ -0,0 +1,171 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package clients
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestRank(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	c := New(logger)
+
+	rank := func(t *testing.T, cfg fakeClassConfig, query string, documents ...string) []float64 {
+		res, err := c.Rank(context.Background(), query, documents, cfg)
+		require.Nil(t, err)
+		require.Equal(t, query, res.Query)
+		require.Len(t, res.DocumentScores, len(documents))
+
+		scores := make([]float64, len(documents))
+		for i, score := range res.DocumentScores {
+			assert.Equal(t, documents[i], score.Document)
+			scores[i] = score.Score
+		}
+		return scores
+	}
+
+	t.Run("matching documents score higher", func(t *testing.T) {
+		scores := rank(t, fakeClassConfig{}, "electric car",
+			"an electric car with a large battery",
+			"a bicycle with a large basket",
+		)
+		assert.Greater(t, scores[0], 0.0)
+		assert.Equal(t, 0.0, scores[1])
+	})
+
+	t.Run("rare terms weigh more", func(t *testing.T) {
+		scores := rank(t, fakeClassConfig{}, "car battery",
+			"car one",
+			"battery one",
+			"car two",
+		)
+		assert.Greater(t, scores[1], scores[0])
+		assert.Equal(t, scores[0], scores[2])
+	})
+
+	t.Run("shorter documents score higher", func(t *testing.T) {
+		scores := rank(t, fakeClassConfig{}, "battery",
+			"battery",
+			"battery with many additional unrelated words in it",
+		)
+		assert.Greater(t, scores[0], scores[1])
+	})
+
+	t.Run("length normalization can be disabled", func(t *testing.T) {
+		scores := rank(t, fakeClassConfig{classConfig: map[string]interface{}{"b": 0.0}}, "battery",
+			"battery",
+			"battery with many additional unrelated words in it",
+		)
+		assert.Equal(t, scores[0], scores[1])
+	})
+
+	t.Run("close query terms score higher", func(t *testing.T) {
+		scores := rank(t, fakeClassConfig{}, "electric car",
+			"electric car sold by a dealer in town",
+			"electric bill paid to a car dealer town",
+		)
+		assert.Greater(t, scores[0], scores[1])
+
+		scores = rank(t, fakeClassConfig{classConfig: map[string]interface{}{"proximityWeight": 0.0}},
+			"electric car",
+			"electric car sold by a dealer in town",
+			"electric bill paid to a car dealer town",
+		)
+		assert.Equal(t, scores[0], scores[1])
+	})
+
+	t.Run("stopwords are ignored", func(t *testing.T) {
+		scores := rank(t, fakeClassConfig{}, "the car",
+			"the the the bicycle",
+			"a car",
+		)
+		assert.Equal(t, 0.0, scores[0])
+		assert.Greater(t, scores[1], 0.0)
+	})
+
+	t.Run("query of stopwords only", func(t *testing.T) {
+		scores := rank(t, fakeClassConfig{}, "the",
+			"the bicycle",
+			"a car",
+		)
+		assert.Greater(t, scores[0], 0.0)
+		assert.Equal(t, 0.0, scores[1])
+	})
+
+	t.Run("no documents", func(t *testing.T) {
+		rank(t, fakeClassConfig{}, "car")
+	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := c.Rank(ctx, "car", []string{"car"}, fakeClassConfig{})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestProximity(t *testing.T) {
+	terms := map[string]struct{}{"a": {}, "b": {}, "c": {}}
+
+	tests := []struct {
+		name     string
+		tokens   []string
+		matched  int
+		expected float64
+	}{
+		{name: "adjacent", tokens: []string{"x", "a", "b", "x"}, matched: 2, expected: 1},
+		{name: "one apart", tokens: []string{"a", "x", "b"}, matched: 2, expected: 0.5},
+		{name: "smallest window wins", tokens: []string{"a", "x", "x", "x", "b", "a"}, matched: 2, expected: 1},
+		{name: "three terms", tokens: []string{"c", "x", "a", "b", "x", "c"}, matched: 3, expected: 2.0 / 3},
+		{name: "terms missing", tokens: []string{"a", "x"}, matched: 2, expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.expected, proximity(tt.tokens, terms, tt.matched), 1e-9)
+		})
+	}
+}
+
+type fakeClassConfig struct {
+	classConfig map[string]interface{}
+}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Tenant() string {
+	return ""
+}
+
+func (f fakeClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
+
+func (f fakeClassConfig) TargetVector() string {
+	return ""
+}
+
+func (f fakeClassConfig) PropertiesDataTypes() map[string]schema.DataType {
+	return nil
+}
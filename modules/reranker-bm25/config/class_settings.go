@@ -0,0 +1,108 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"slices"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	basesettings "github.com/weaviate/weaviate/usecases/modulecomponents/settings"
+)
+
+const (
+	k1Property              = "k1"
+	bProperty               = "b"
+	proximityWeightProperty = "proximityWeight"
+	tokenizationProperty    = "tokenization"
+	stopwordsProperty       = "stopwords"
+)
+
+const (
+	DefaultK1              = 1.2
+	DefaultB               = 0.75
+	DefaultProximityWeight = 0.5
+	DefaultTokenization    = models.PropertyTokenizationWord
+	DefaultStopwords       = "en"
+)
+
+var availableTokenizations = []string{
+	models.PropertyTokenizationWord,
+	models.PropertyTokenizationLowercase,
+	models.PropertyTokenizationWhitespace,
+	models.PropertyTokenizationTrigram,
+}
+
+type classSettings struct {
+	cfg                  moduletools.ClassConfig
+	propertyValuesHelper basesettings.PropertyValuesHelper
+}
+
+func NewClassSettings(cfg moduletools.ClassConfig) *classSettings {
+	return &classSettings{cfg: cfg, propertyValuesHelper: basesettings.NewPropertyValuesHelper("reranker-bm25")}
+}
+
+func (ic *classSettings) Validate(class *models.Class) error {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return errors.New("empty config")
+	}
+	if k1 := ic.K1(); k1 < 0 {
+		return errors.Errorf("wrong k1 value %v, must be 0 or greater", k1)
+	}
+	if b := ic.B(); b < 0 || b > 1 {
+		return errors.Errorf("wrong b value %v, must be between 0 and 1", b)
+	}
+	if weight := ic.ProximityWeight(); weight < 0 {
+		return errors.Errorf("wrong proximityWeight value %v, must be 0 or greater", weight)
+	}
+	if tokenization := ic.Tokenization(); !slices.Contains(availableTokenizations, tokenization) {
+		return errors.Errorf("wrong tokenization %q, available tokenizations are: %v",
+			tokenization, availableTokenizations)
+	}
+	if _, err := stopwords.NewDetectorFromPreset(ic.Stopwords()); err != nil {
+		return errors.Wrap(err, "wrong stopwords")
+	}
+	return nil
+}
+
+func (ic *classSettings) getFloatProperty(name string, defaultValue float64) float64 {
+	return *ic.propertyValuesHelper.GetPropertyAsFloat64(ic.cfg, name, &defaultValue)
+}
+
+// K1 controls the saturation of the term frequency
+func (ic *classSettings) K1() float64 {
+	return ic.getFloatProperty(k1Property, DefaultK1)
+}
+
+// B controls the normalization by document length
+func (ic *classSettings) B() float64 {
+	return ic.getFloatProperty(bProperty, DefaultB)
+}
+
+// ProximityWeight controls how much documents containing the query terms
+// close to each other are boosted. 0 disables the boost.
+func (ic *classSettings) ProximityWeight() float64 {
+	return ic.getFloatProperty(proximityWeightProperty, DefaultProximityWeight)
+}
+
+func (ic *classSettings) Tokenization() string {
+	return ic.propertyValuesHelper.GetPropertyAsString(ic.cfg, tokenizationProperty, DefaultTokenization)
+}
+
+// Stopwords is the name of the stopword preset whose words are ignored in
+// queries
+func (ic *classSettings) Stopwords() string {
+	return ic.propertyValuesHelper.GetPropertyAsString(ic.cfg, stopwordsProperty, DefaultStopwords)
+}
@@ -0,0 +1,141 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func Test_classSettings_Validate(t *testing.T) {
+	tests := []struct {
+		name                string
+		cfg                 moduletools.ClassConfig
+		wantK1              float64
+		wantB               float64
+		wantProximityWeight float64
+		wantTokenization    string
+		wantStopwords       string
+		wantErr             error
+	}{
+		{
+			name: "default settings",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{},
+			},
+			wantK1:              1.2,
+			wantB:               0.75,
+			wantProximityWeight: 0.5,
+			wantTokenization:    "word",
+			wantStopwords:       "en",
+		},
+		{
+			name: "custom settings",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{
+					"k1":              2.0,
+					"b":               0.5,
+					"proximityWeight": 0.0,
+					"tokenization":    "lowercase",
+					"stopwords":       "none",
+				},
+			},
+			wantK1:              2,
+			wantB:               0.5,
+			wantProximityWeight: 0,
+			wantTokenization:    "lowercase",
+			wantStopwords:       "none",
+		},
+		{
+			name: "negative k1",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{"k1": -1.0},
+			},
+			wantErr: fmt.Errorf("wrong k1 value -1, must be 0 or greater"),
+		},
+		{
+			name: "b out of range",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{"b": 1.5},
+			},
+			wantErr: fmt.Errorf("wrong b value 1.5, must be between 0 and 1"),
+		},
+		{
+			name: "negative proximity weight",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{"proximityWeight": -0.5},
+			},
+			wantErr: fmt.Errorf("wrong proximityWeight value -0.5, must be 0 or greater"),
+		},
+		{
+			name: "unsupported tokenization",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{"tokenization": "field"},
+			},
+			wantErr: fmt.Errorf("wrong tokenization \"field\", available tokenizations are: [word lowercase whitespace trigram]"),
+		},
+		{
+			name: "unknown stopwords preset",
+			cfg: fakeClassConfig{
+				classConfig: map[string]interface{}{"stopwords": "klingon"},
+			},
+			wantErr: fmt.Errorf("wrong stopwords: preset \"klingon\" not known to stopword detector"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ic := NewClassSettings(tt.cfg)
+			if tt.wantErr != nil {
+				assert.EqualError(t, ic.Validate(nil), tt.wantErr.Error())
+			} else {
+				assert.NoError(t, ic.Validate(nil))
+				assert.Equal(t, tt.wantK1, ic.K1())
+				assert.Equal(t, tt.wantB, ic.B())
+				assert.Equal(t, tt.wantProximityWeight, ic.ProximityWeight())
+				assert.Equal(t, tt.wantTokenization, ic.Tokenization())
+				assert.Equal(t, tt.wantStopwords, ic.Stopwords())
+			}
+		})
+	}
+}
+
+type fakeClassConfig struct {
+	classConfig map[string]interface{}
+}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Tenant() string {
+	return ""
+}
+
+func (f fakeClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return f.classConfig
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
+
+func (f fakeClassConfig) TargetVector() string {
+	return ""
+}
+
+func (f fakeClassConfig) PropertiesDataTypes() map[string]schema.DataType {
+	return nil
+}
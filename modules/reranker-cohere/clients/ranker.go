@@ -24,6 +24,7 @@ import (
 	"time"
 
 	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 
 	"github.com/weaviate/weaviate/usecases/modulecomponents"
 
@@ -49,7 +50,7 @@ type client struct {
 func New(apiKey string, timeout time.Duration, logger logrus.FieldLogger) *client {
 	return &client{
 		apiKey:       apiKey,
-		httpClient:   &http.Client{Timeout: timeout},
+		httpClient:   httpclient.New("cohere", timeout, httpclient.WithRateLimitRetries()),
 		host:         "https://api.cohere.ai",
 		path:         "/v1/rerank",
 		maxDocuments: 1000,
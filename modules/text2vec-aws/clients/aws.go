@@ -31,6 +31,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/modules/text2vec-aws/ent"
 	"github.com/weaviate/weaviate/usecases/modulecomponents"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 )
 
 type operationType string
@@ -66,12 +67,10 @@ type awsClient struct {
 
 func New(awsAccessKey, awsSecret, awsSessionToken string, timeout time.Duration, logger logrus.FieldLogger) *awsClient {
 	return &awsClient{
-		awsAccessKey:    awsAccessKey,
-		awsSecret:       awsSecret,
-		awsSessionToken: awsSessionToken,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
+		awsAccessKey:        awsAccessKey,
+		awsSecret:           awsSecret,
+		awsSessionToken:     awsSessionToken,
+		httpClient:          httpclient.New("aws", timeout),
 		buildBedrockUrlFn:   buildBedrockUrl,
 		buildSagemakerUrlFn: buildSagemakerUrl,
 		logger:              logger,
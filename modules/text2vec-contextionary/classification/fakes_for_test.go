@@ -47,6 +47,10 @@ func (f *fakeSchemaGetter) CopyShardingState(class string) *sharding.State {
 	panic("not implemented")
 }
 
+func (f *fakeSchemaGetter) IsResharding(class string) bool {
+	return false
+}
+
 func (f *fakeSchemaGetter) ShardOwner(class, shard string) (string, error)      { return "", nil }
 func (f *fakeSchemaGetter) ShardReplicas(class, shard string) ([]string, error) { return nil, nil }
 
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 
 	"github.com/weaviate/weaviate/usecases/modulecomponents"
 
@@ -61,10 +62,8 @@ type client struct {
 func New(databricksToken string, timeout time.Duration, logger logrus.FieldLogger) *client {
 	return &client{
 		databricksToken: databricksToken,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
-		logger: logger,
+		httpClient:      httpclient.New("databricks", timeout),
+		logger:          logger,
 	}
 }
 
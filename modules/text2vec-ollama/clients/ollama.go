@@ -24,6 +24,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 
 	"github.com/weaviate/weaviate/modules/text2vec-ollama/ent"
 	"github.com/weaviate/weaviate/usecases/modulecomponents"
@@ -41,9 +42,7 @@ type ollama struct {
 
 func New(timeout time.Duration, logger logrus.FieldLogger) *ollama {
 	return &ollama{
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
+		httpClient:   httpclient.New("ollama", timeout),
 		urlBuilderFn: buildURL,
 		logger:       logger,
 	}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/usecases/logrusext"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 
 	"github.com/weaviate/weaviate/usecases/modulecomponents"
@@ -118,12 +119,10 @@ func New(openAIApiKey, openAIOrganization, azureApiKey string, timeout time.Dura
 		openAIApiKey:       openAIApiKey,
 		openAIOrganization: openAIOrganization,
 		azureApiKey:        azureApiKey,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
-		buildUrlFn:    buildUrl,
-		logger:        logger,
-		sampledLogger: logrusext.NewSampler(logger, 5, time.Minute),
+		httpClient:         httpclient.New("openai", timeout),
+		buildUrlFn:         buildUrl,
+		logger:             logger,
+		sampledLogger:      logrusext.NewSampler(logger, 5, time.Minute),
 	}
 }
 
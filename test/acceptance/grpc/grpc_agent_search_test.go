@@ -0,0 +1,57 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/test/helper"
+	"github.com/weaviate/weaviate/test/helper/sample-schema/planets"
+)
+
+func TestGRPC_AgentSearch(t *testing.T) {
+	ctx := context.Background()
+	helper.SetupClient("localhost:8080")
+	grpcClient, conn := newClient(t)
+	defer conn.Close()
+
+	className := "PlanetsAgentSearch"
+	class := planets.BaseClass(className)
+	class.Vectorizer = "none"
+	class.ModuleConfig = map[string]interface{}{
+		"generative-dummy": map[string]interface{}{},
+	}
+	helper.CreateClass(t, class)
+	defer helper.DeleteClass(t, class.Class)
+	planets.InsertObjects(t, class.Class)
+
+	reply, err := grpcClient.AgentSearch(ctx, &pb.AgentSearchRequest{
+		Collection: className,
+		Prompt:     "Earth",
+	})
+	require.NoError(t, err)
+
+	// the dummy module searches for the prompt once and answers with the result
+	require.Len(t, reply.Steps, 1)
+	step := reply.Steps[0]
+	assert.Equal(t, "search", step.Tool)
+	assert.JSONEq(t, `{"query":"Earth"}`, step.Arguments)
+	assert.Nil(t, step.Error)
+	// Mars' description mentions Earth as well, Earth ranks first
+	require.Len(t, step.ObjectIds, 2)
+	assert.Equal(t, planets.Planets[0].ID.String(), step.ObjectIds[0])
+	assert.Contains(t, reply.Answer, "Earth")
+}
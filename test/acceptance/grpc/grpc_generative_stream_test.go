@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/test/helper"
+	"github.com/weaviate/weaviate/test/helper/sample-schema/planets"
+)
+
+func TestGRPC_SearchStream(t *testing.T) {
+	ctx := context.Background()
+	helper.SetupClient("localhost:8080")
+	grpcClient, conn := newClient(t)
+	defer conn.Close()
+
+	className := "PlanetsGenerativeStream"
+	class := planets.BaseClass(className)
+	class.Vectorizer = "none"
+	class.ModuleConfig = map[string]interface{}{
+		"generative-dummy": map[string]interface{}{},
+	}
+	helper.CreateClass(t, class)
+	defer helper.DeleteClass(t, class.Class)
+	planets.InsertObjects(t, class.Class)
+
+	stream, err := grpcClient.SearchStream(ctx, &pb.SearchRequest{
+		Collection: className,
+		Limit:      2,
+		Metadata:   &pb.MetadataRequest{Uuid: true},
+		Generative: &pb.GenerativeSearch{
+			Single:  &pb.GenerativeSearch_Single{Prompt: "Write a short tweet about planet {name}"},
+			Grouped: &pb.GenerativeSearch_Grouped{Task: "Write a short tweet about the following planets"},
+		},
+		Uses_127Api: true,
+	})
+	require.NoError(t, err)
+
+	streamed := map[string]string{}
+	var result *pb.SearchReply
+	for {
+		reply, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		require.Nil(t, result, "the search reply must be the last message")
+		if delta := reply.GetGenerativeDelta(); delta != nil {
+			streamed[delta.GetObjectId()] += delta.Text
+			continue
+		}
+		result = reply.GetResult()
+	}
+
+	require.NotNil(t, result)
+	require.Len(t, result.Results, 2)
+	for _, res := range result.Results {
+		id := res.Metadata.Id
+		require.Len(t, res.Generative.GetValues(), 1)
+		assert.Equal(t, res.Generative.GetValues()[0].Result, streamed[id])
+		assert.Contains(t, streamed[id], "Write a short tweet about planet")
+	}
+	require.Len(t, result.GenerativeGroupedResults.GetValues(), 1)
+	assert.Equal(t, result.GenerativeGroupedResults.GetValues()[0].Result, streamed[""])
+	assert.Len(t, streamed, 3)
+}
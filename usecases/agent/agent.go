@@ -0,0 +1,197 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package agent answers questions about a collection with a generative model
+// which can search the collection through tool calls before it answers.
+package agent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+const (
+	DefaultMaxSteps = 5
+	MaxSteps        = 10
+	DefaultLimit    = 5
+	MaxLimit        = 50
+)
+
+// Traverser runs the searches requested by the model
+type Traverser interface {
+	GetClass(ctx context.Context, principal *models.Principal, params dto.GetParams) ([]interface{}, error)
+	Aggregate(ctx context.Context, principal *models.Principal, params *aggregation.Params) (interface{}, error)
+}
+
+type schemaGetter interface {
+	ReadOnlyClass(name string) *models.Class
+}
+
+type generativeProvider interface {
+	GenerativeClient(className, provider string) (modulecapabilities.GenerativeClient, moduletools.ClassConfig, error)
+}
+
+// Params of an agent run. ProviderOptions are the module specific request
+// parameters of the provider, like the ones of a generative search.
+type Params struct {
+	ClassName       string
+	Tenant          string
+	Prompt          string
+	MaxSteps        int
+	Limit           int
+	Properties      []string
+	Provider        string
+	ProviderOptions interface{}
+}
+
+// Step is a tool call of the model and what it retrieved
+type Step struct {
+	Tool      string
+	Arguments string
+	ObjectIDs []strfmt.UUID
+	Output    string
+	Error     string
+}
+
+// Result is the answer of the model and the trace of the tool calls it made
+// to come up with it
+type Result struct {
+	Answer string
+	Steps  []Step
+}
+
+type Agent struct {
+	traverser  Traverser
+	schema     schemaGetter
+	generative generativeProvider
+	logger     logrus.FieldLogger
+}
+
+func New(traverser Traverser, schema schemaGetter, generative generativeProvider,
+	logger logrus.FieldLogger,
+) *Agent {
+	return &Agent{
+		traverser:  traverser,
+		schema:     schema,
+		generative: generative,
+		logger:     logger,
+	}
+}
+
+// Run lets the model call tools until it answers or runs out of steps. Every
+// search runs on behalf of the principal. Once the steps are used up the
+// model is asked to answer with what it retrieved so far.
+func (a *Agent) Run(ctx context.Context, principal *models.Principal, params Params) (*Result, error) {
+	if err := params.validate(); err != nil {
+		return nil, err
+	}
+	class := a.schema.ReadOnlyClass(params.ClassName)
+	if class == nil {
+		return nil, objects.NewErrNotFound("could not find class %s in schema", params.ClassName)
+	}
+	client, cfg, err := a.generative.GenerativeClient(class.Class, params.Provider)
+	if err != nil {
+		// the class has no generative module, or not the requested one
+		return nil, objects.NewErrInvalidUserInput("%v", err)
+	}
+	toolsClient, ok := client.(modulecapabilities.GenerativeToolsClient)
+	if !ok {
+		return nil, objects.NewErrInvalidUserInput("generative provider of class %s does not support tool calls", class.Class)
+	}
+
+	tools := newToolbox(a.traverser, principal, class, params)
+	request := &modulecapabilities.GenerateToolsRequest{
+		System: tools.systemPrompt(),
+		Messages: []modulecapabilities.GenerativeMessage{
+			{Role: modulecapabilities.GenerativeRoleUser, Content: params.Prompt},
+		},
+		Tools: tools.definitions(),
+	}
+
+	result := &Result{}
+	for step := 0; ; step++ {
+		request.DisableTools = step == params.MaxSteps
+		res, err := toolsClient.GenerateWithTools(ctx, request, params.ProviderOptions, cfg)
+		if err != nil {
+			return nil, fmt.Errorf("generate step %d: %w", step, err)
+		}
+		if len(res.ToolCalls) == 0 || request.DisableTools {
+			if res.Result != nil {
+				result.Answer = *res.Result
+			}
+			return result, nil
+		}
+
+		request.Messages = append(request.Messages, modulecapabilities.GenerativeMessage{
+			Role:      modulecapabilities.GenerativeRoleAssistant,
+			Content:   stringValue(res.Result),
+			ToolCalls: res.ToolCalls,
+		})
+		for _, call := range res.ToolCalls {
+			trace := tools.call(ctx, call)
+			a.logger.WithFields(logrus.Fields{
+				"action": "agent_tool_call",
+				"class":  class.Class,
+				"step":   step,
+				"tool":   call.Name,
+			}).Debug(trace.Arguments)
+			result.Steps = append(result.Steps, trace)
+			content := trace.Output
+			if trace.Error != "" {
+				content = "error: " + trace.Error
+			}
+			request.Messages = append(request.Messages, modulecapabilities.GenerativeMessage{
+				Role:       modulecapabilities.GenerativeRoleTool,
+				Content:    content,
+				ToolCallID: call.ID,
+			})
+		}
+	}
+}
+
+func (p *Params) validate() error {
+	if p.ClassName == "" {
+		return objects.NewErrInvalidUserInput("missing collection")
+	}
+	if p.Prompt == "" {
+		return objects.NewErrInvalidUserInput("missing prompt")
+	}
+	if p.MaxSteps == 0 {
+		p.MaxSteps = DefaultMaxSteps
+	}
+	if p.MaxSteps < 0 || p.MaxSteps > MaxSteps {
+		return objects.NewErrInvalidUserInput("max steps must be between 1 and %d", MaxSteps)
+	}
+	if p.Limit == 0 {
+		p.Limit = DefaultLimit
+	}
+	if p.Limit < 0 || p.Limit > MaxLimit {
+		return objects.NewErrInvalidUserInput("limit must be between 1 and %d", MaxLimit)
+	}
+	return nil
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
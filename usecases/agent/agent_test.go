@@ -0,0 +1,241 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package agent
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestAgentRun(t *testing.T) {
+	t.Run("answers with the trace of its tool calls", func(t *testing.T) {
+		traverser := &fakeTraverser{}
+		client := &fakeToolsClient{calls: [][]modulecapabilities.GenerativeToolCall{
+			{
+				{ID: "1", Name: toolSearch, Arguments: `{"query":"red planet","mode":"bm25","filters":[{"property":"moons","operator":"GreaterThan","value":1}]}`},
+				{ID: "2", Name: toolAggregate, Arguments: `{"property":"color"}`},
+			},
+		}}
+		a := newTestAgent(traverser, client)
+
+		res, err := a.Run(context.Background(), nil, Params{ClassName: "Planet", Prompt: "Which planet is red?"})
+		require.NoError(t, err)
+
+		assert.Equal(t, "answer", res.Answer)
+		require.Len(t, res.Steps, 2)
+		assert.Equal(t, toolSearch, res.Steps[0].Tool)
+		assert.Empty(t, res.Steps[0].Error)
+		assert.Equal(t, []strfmt.UUID{"8fc3c4ef-5ba6-4c8c-a0a1-a5b4bca4c0ea"}, res.Steps[0].ObjectIDs)
+		assert.JSONEq(t, `[{"id":"8fc3c4ef-5ba6-4c8c-a0a1-a5b4bca4c0ea","properties":{"name":"Mars"}}]`, res.Steps[0].Output)
+		assert.Equal(t, toolAggregate, res.Steps[1].Tool)
+		assert.JSONEq(t, `{"count":8,"color":{"topOccurrences":[{"value":"blue","occurs":3}]}}`, res.Steps[1].Output)
+
+		require.Len(t, traverser.gets, 1)
+		get := traverser.gets[0]
+		assert.Equal(t, DefaultLimit, get.Pagination.Limit)
+		require.NotNil(t, get.KeywordRanking)
+		assert.Equal(t, "red planet", get.KeywordRanking.Query)
+		require.NotNil(t, get.Filters)
+		assert.Equal(t, filters.OperatorGreaterThan, get.Filters.Root.Operator)
+		assert.Equal(t, 1, get.Filters.Root.Value.Value)
+
+		require.Len(t, client.requests, 2)
+		messages := client.requests[1].Messages
+		require.Len(t, messages, 4)
+		assert.Equal(t, modulecapabilities.GenerativeRoleAssistant, messages[1].Role)
+		assert.Equal(t, modulecapabilities.GenerativeRoleTool, messages[2].Role)
+		assert.Equal(t, "1", messages[2].ToolCallID)
+		assert.Equal(t, "2", messages[3].ToolCallID)
+	})
+
+	t.Run("answers once the steps are used up", func(t *testing.T) {
+		calls := make([][]modulecapabilities.GenerativeToolCall, 10)
+		for i := range calls {
+			calls[i] = []modulecapabilities.GenerativeToolCall{{ID: fmt.Sprint(i), Name: toolSearch, Arguments: `{"query":"planet"}`}}
+		}
+		client := &fakeToolsClient{calls: calls}
+		a := newTestAgent(&fakeTraverser{}, client)
+
+		res, err := a.Run(context.Background(), nil, Params{ClassName: "Planet", Prompt: "Which planet is red?", MaxSteps: 2})
+		require.NoError(t, err)
+
+		assert.Equal(t, "answer", res.Answer)
+		assert.Len(t, res.Steps, 2)
+		require.Len(t, client.requests, 3)
+		assert.False(t, client.requests[1].DisableTools)
+		assert.True(t, client.requests[2].DisableTools)
+	})
+
+	t.Run("reports invalid tool calls to the model", func(t *testing.T) {
+		client := &fakeToolsClient{calls: [][]modulecapabilities.GenerativeToolCall{
+			{
+				{ID: "1", Name: "delete", Arguments: `{}`},
+				{ID: "2", Name: toolSearch, Arguments: `{"query":"planet","filters":[{"property":"secret","operator":"Equal","value":"x"}]}`},
+				{ID: "3", Name: toolSearch, Arguments: `{"query":"planet","filters":[{"property":"moons","operator":"Equal","value":"many"}]}`},
+			},
+		}}
+		a := newTestAgent(&fakeTraverser{}, client)
+
+		res, err := a.Run(context.Background(), nil, Params{ClassName: "Planet", Prompt: "Which planet is red?"})
+		require.NoError(t, err)
+
+		require.Len(t, res.Steps, 3)
+		assert.Equal(t, `unknown tool "delete"`, res.Steps[0].Error)
+		assert.Equal(t, `cannot filter by property "secret"`, res.Steps[1].Error)
+		assert.Contains(t, res.Steps[2].Error, "expected an integer")
+		assert.Equal(t, "error: "+res.Steps[0].Error, client.requests[1].Messages[2].Content)
+	})
+
+	t.Run("rejects invalid params", func(t *testing.T) {
+		a := newTestAgent(&fakeTraverser{}, &fakeToolsClient{})
+		for _, params := range []Params{
+			{Prompt: "Which planet is red?"},
+			{ClassName: "Planet"},
+			{ClassName: "Planet", Prompt: "Which planet is red?", MaxSteps: MaxSteps + 1},
+			{ClassName: "Planet", Prompt: "Which planet is red?", Limit: MaxLimit + 1},
+		} {
+			_, err := a.Run(context.Background(), nil, params)
+			assert.ErrorAs(t, err, &objects.ErrInvalidUserInput{})
+		}
+
+		_, err := a.Run(context.Background(), nil, Params{ClassName: "Moon", Prompt: "Which moon is red?"})
+		assert.ErrorAs(t, err, &objects.ErrNotFound{})
+	})
+
+	t.Run("needs a client supporting tool calls", func(t *testing.T) {
+		a := New(&fakeTraverser{}, &fakeSchema{}, &fakeProvider{client: &fakeClient{}}, nullLogger())
+		_, err := a.Run(context.Background(), nil, Params{ClassName: "Planet", Prompt: "Which planet is red?"})
+		assert.ErrorContains(t, err, "does not support tool calls")
+		assert.ErrorAs(t, err, &objects.ErrInvalidUserInput{})
+	})
+}
+
+func TestToolboxModes(t *testing.T) {
+	class := planetClass()
+	assert.Equal(t, []string{searchModeBM25}, newToolbox(nil, nil, class, Params{}).modes)
+
+	class.Vectorizer = "text2vec-contextionary"
+	assert.Equal(t, []string{searchModeHybrid, searchModeNearText, searchModeBM25}, newToolbox(nil, nil, class, Params{}).modes)
+
+	class.Vectorizer = "none"
+	class.VectorConfig = map[string]models.VectorConfig{
+		"description": {Vectorizer: map[string]interface{}{"text2vec-contextionary": map[string]interface{}{}}},
+	}
+	assert.Equal(t, []string{searchModeHybrid, searchModeNearText, searchModeBM25}, newToolbox(nil, nil, class, Params{}).modes)
+}
+
+func newTestAgent(traverser Traverser, client modulecapabilities.GenerativeClient) *Agent {
+	return New(traverser, &fakeSchema{}, &fakeProvider{client: client}, nullLogger())
+}
+
+func planetClass() *models.Class {
+	return &models.Class{
+		Class: "Planet",
+		Properties: []*models.Property{
+			{Name: "name", DataType: schema.DataTypeText.PropString()},
+			{Name: "color", DataType: schema.DataTypeText.PropString()},
+			{Name: "moons", DataType: schema.DataTypeInt.PropString()},
+			{Name: "image", DataType: schema.DataTypeBlob.PropString()},
+		},
+	}
+}
+
+func nullLogger() logrus.FieldLogger {
+	logger, _ := test.NewNullLogger()
+	return logger
+}
+
+type fakeTraverser struct {
+	gets []dto.GetParams
+}
+
+func (f *fakeTraverser) GetClass(ctx context.Context, principal *models.Principal, params dto.GetParams) ([]interface{}, error) {
+	f.gets = append(f.gets, params)
+	return []interface{}{
+		map[string]interface{}{"id": strfmt.UUID("8fc3c4ef-5ba6-4c8c-a0a1-a5b4bca4c0ea"), "name": "Mars"},
+	}, nil
+}
+
+func (f *fakeTraverser) Aggregate(ctx context.Context, principal *models.Principal, params *aggregation.Params) (interface{}, error) {
+	return &aggregation.Result{Groups: []aggregation.Group{{
+		Count: 8,
+		Properties: map[string]aggregation.Property{
+			"color": {
+				Type:            aggregation.PropertyTypeText,
+				TextAggregation: aggregation.Text{Items: []aggregation.TextOccurrence{{Value: "blue", Occurs: 3}}},
+			},
+		},
+	}}}, nil
+}
+
+type fakeSchema struct{}
+
+func (f *fakeSchema) ReadOnlyClass(name string) *models.Class {
+	if name == "Planet" {
+		return planetClass()
+	}
+	return nil
+}
+
+type fakeProvider struct {
+	client modulecapabilities.GenerativeClient
+}
+
+func (f *fakeProvider) GenerativeClient(className, provider string) (modulecapabilities.GenerativeClient, moduletools.ClassConfig, error) {
+	return f.client, nil, nil
+}
+
+type fakeClient struct{}
+
+func (f *fakeClient) GenerateSingleResult(ctx context.Context, properties *modulecapabilities.GenerateProperties, prompt string, options interface{}, debug bool, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateResponse, error) {
+	return nil, nil
+}
+
+func (f *fakeClient) GenerateAllResults(ctx context.Context, properties []*modulecapabilities.GenerateProperties, task string, options interface{}, debug bool, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateResponse, error) {
+	return nil, nil
+}
+
+// fakeToolsClient returns the tool calls of a step and answers once there are
+// no more calls or the tools are disabled
+type fakeToolsClient struct {
+	fakeClient
+	calls    [][]modulecapabilities.GenerativeToolCall
+	requests []modulecapabilities.GenerateToolsRequest
+}
+
+func (f *fakeToolsClient) GenerateWithTools(ctx context.Context, request *modulecapabilities.GenerateToolsRequest, options interface{}, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateToolsResponse, error) {
+	step := len(f.requests)
+	req := *request
+	req.Messages = append([]modulecapabilities.GenerativeMessage{}, request.Messages...)
+	f.requests = append(f.requests, req)
+	if request.DisableTools || step >= len(f.calls) {
+		answer := "answer"
+		return &modulecapabilities.GenerateToolsResponse{Result: &answer}, nil
+	}
+	return &modulecapabilities.GenerateToolsResponse{ToolCalls: f.calls[step]}, nil
+}
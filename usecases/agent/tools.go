@@ -0,0 +1,515 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/local/common_filters"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/filterext"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/arguments/nearText"
+)
+
+const (
+	toolSearch    = "search"
+	toolAggregate = "aggregate"
+
+	searchModeHybrid   = "hybrid"
+	searchModeNearText = "near_text"
+	searchModeBM25     = "bm25"
+
+	hybridAlpha = 0.75
+	// maxValueLength bounds the text of a single property shown to the model
+	maxValueLength = 2000
+	topOccurrences = 5
+)
+
+var filterOperators = []string{
+	"Equal", "NotEqual", "GreaterThan", "GreaterThanEqual", "LessThan", "LessThanEqual", "Like", "ContainsAny",
+}
+
+// toolbox executes the tool calls of one agent run against its collection
+type toolbox struct {
+	traverser Traverser
+	principal *models.Principal
+	class     *models.Class
+	params    Params
+	// properties holds the primitive properties by their filter data type
+	properties map[string]schema.DataType
+	returned   []string
+	modes      []string
+}
+
+func newToolbox(traverser Traverser, principal *models.Principal, class *models.Class, params Params) *toolbox {
+	t := &toolbox{
+		traverser:  traverser,
+		principal:  principal,
+		class:      class,
+		params:     params,
+		properties: map[string]schema.DataType{},
+		modes:      []string{searchModeBM25},
+	}
+	for _, prop := range class.Properties {
+		if dt, ok := filterDataType(prop); ok {
+			t.properties[prop.Name] = dt
+			t.returned = append(t.returned, prop.Name)
+		}
+	}
+	if len(params.Properties) > 0 {
+		t.returned = params.Properties
+	}
+	if hasVectorizer(class) {
+		t.modes = []string{searchModeHybrid, searchModeNearText, searchModeBM25}
+	}
+	return t
+}
+
+func (t *toolbox) systemPrompt() string {
+	props := make([]string, 0, len(t.properties))
+	for _, prop := range t.class.Properties {
+		if dt, ok := t.properties[prop.Name]; ok {
+			props = append(props, fmt.Sprintf("%s (%s)", prop.Name, dt))
+		}
+	}
+	return fmt.Sprintf("You answer questions about the objects of the %s collection. "+
+		"Use the %s tool to retrieve objects and the %s tool to count and summarize them. "+
+		"Refine your searches if the results are not sufficient and answer once you have enough information. "+
+		"The objects have the properties: %s.",
+		t.class.Class, toolSearch, toolAggregate, strings.Join(props, ", "))
+}
+
+func (t *toolbox) definitions() []modulecapabilities.GenerativeTool {
+	names := make([]string, 0, len(t.properties))
+	for _, prop := range t.class.Properties {
+		if _, ok := t.properties[prop.Name]; ok {
+			names = append(names, prop.Name)
+		}
+	}
+	filtersSchema := map[string]interface{}{
+		"type":        "array",
+		"description": "Conditions which all objects must match",
+		"items": map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"property": map[string]interface{}{"type": "string", "enum": names},
+				"operator": map[string]interface{}{"type": "string", "enum": filterOperators},
+				"value": map[string]interface{}{
+					"description": "Value to compare with, an array of values for ContainsAny. Dates are RFC3339 strings.",
+				},
+			},
+			"required": []string{"property", "operator", "value"},
+		},
+	}
+
+	return []modulecapabilities.GenerativeTool{
+		{
+			Name:        toolSearch,
+			Description: fmt.Sprintf("Search the objects of the %s collection, returns the most relevant objects first", t.class.Class),
+			Parameters: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"query": map[string]interface{}{"type": "string", "description": "What to search for"},
+					"mode": map[string]interface{}{
+						"type": "string", "enum": t.modes,
+						"description": "bm25 matches keywords, near_text matches the meaning, hybrid combines both",
+					},
+					"filters": filtersSchema,
+					"limit":   map[string]interface{}{"type": "integer", "minimum": 1, "maximum": t.params.Limit},
+				},
+				"required": []string{"query"},
+			},
+		},
+		{
+			Name:        toolAggregate,
+			Description: fmt.Sprintf("Count the objects of the %s collection matching the filters and summarize the values of a property", t.class.Class),
+			Parameters: map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"filters":  filtersSchema,
+					"property": map[string]interface{}{"type": "string", "enum": names},
+				},
+			},
+		},
+	}
+}
+
+type filterArg struct {
+	Property string      `json:"property"`
+	Operator string      `json:"operator"`
+	Value    interface{} `json:"value"`
+}
+
+type searchArgs struct {
+	Query   string      `json:"query"`
+	Mode    string      `json:"mode"`
+	Filters []filterArg `json:"filters"`
+	Limit   int         `json:"limit"`
+}
+
+type aggregateArgs struct {
+	Filters  []filterArg `json:"filters"`
+	Property string      `json:"property"`
+}
+
+// call executes a tool call, errors are part of the step so the model can
+// correct its call
+func (t *toolbox) call(ctx context.Context, call modulecapabilities.GenerativeToolCall) Step {
+	step := Step{Tool: call.Name, Arguments: call.Arguments}
+	var err error
+	switch call.Name {
+	case toolSearch:
+		var args searchArgs
+		if err = unmarshalArgs(call.Arguments, &args); err == nil {
+			step.Output, step.ObjectIDs, err = t.search(ctx, args)
+		}
+	case toolAggregate:
+		var args aggregateArgs
+		if err = unmarshalArgs(call.Arguments, &args); err == nil {
+			step.Output, err = t.aggregate(ctx, args)
+		}
+	default:
+		err = fmt.Errorf("unknown tool %q", call.Name)
+	}
+	if err != nil {
+		step.Error = err.Error()
+	}
+	return step
+}
+
+func unmarshalArgs(raw string, args interface{}) error {
+	if raw == "" {
+		return nil
+	}
+	if err := json.Unmarshal([]byte(raw), args); err != nil {
+		return fmt.Errorf("invalid arguments: %w", err)
+	}
+	return nil
+}
+
+func (t *toolbox) search(ctx context.Context, args searchArgs) (string, []strfmt.UUID, error) {
+	if args.Query == "" {
+		return "", nil, fmt.Errorf("missing query")
+	}
+	limit := t.params.Limit
+	if args.Limit > 0 && args.Limit < limit {
+		limit = args.Limit
+	}
+	filter, err := t.filter(args.Filters)
+	if err != nil {
+		return "", nil, err
+	}
+
+	params := dto.GetParams{
+		ClassName:            t.class.Class,
+		Tenant:               t.params.Tenant,
+		Pagination:           &filters.Pagination{Limit: limit},
+		Filters:              filter,
+		Properties:           t.selectProperties(),
+		AdditionalProperties: additional.Properties{ID: true},
+	}
+	if len(params.Properties) == 0 {
+		params.AdditionalProperties.NoProps = true
+	}
+
+	mode := args.Mode
+	if mode == "" {
+		mode = t.modes[0]
+	}
+	switch mode {
+	case searchModeBM25:
+		params.KeywordRanking = &searchparams.KeywordRanking{Type: "bm25", Query: args.Query}
+	case searchModeHybrid:
+		params.HybridSearch = &searchparams.HybridSearch{
+			Query: args.Query, Alpha: hybridAlpha, FusionAlgorithm: common_filters.HybridFusionDefault,
+		}
+	case searchModeNearText:
+		params.ModuleParams = map[string]interface{}{
+			"nearText": &nearText.NearTextParams{Values: []string{args.Query}, Limit: limit},
+		}
+	default:
+		return "", nil, fmt.Errorf("unsupported search mode %q, use one of %v", mode, t.modes)
+	}
+	if !t.supportsMode(mode) {
+		return "", nil, fmt.Errorf("search mode %q needs a vectorizer, use one of %v", mode, t.modes)
+	}
+
+	res, err := t.traverser.GetClass(ctx, t.principal, params)
+	if err != nil {
+		return "", nil, err
+	}
+
+	type object struct {
+		ID         strfmt.UUID            `json:"id"`
+		Properties map[string]interface{} `json:"properties"`
+	}
+	objects := make([]object, 0, len(res))
+	ids := make([]strfmt.UUID, 0, len(res))
+	for _, r := range res {
+		asMap, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		id, _ := asMap["id"].(strfmt.UUID)
+		props := make(map[string]interface{}, len(t.returned))
+		for _, name := range t.returned {
+			if v, ok := asMap[name]; ok && v != nil {
+				props[name] = truncate(v)
+			}
+		}
+		objects = append(objects, object{ID: id, Properties: props})
+		ids = append(ids, id)
+	}
+	out, err := json.Marshal(objects)
+	if err != nil {
+		return "", nil, err
+	}
+	return string(out), ids, nil
+}
+
+func (t *toolbox) aggregate(ctx context.Context, args aggregateArgs) (string, error) {
+	filter, err := t.filter(args.Filters)
+	if err != nil {
+		return "", err
+	}
+	params := &aggregation.Params{
+		ClassName:        schema.ClassName(t.class.Class),
+		Tenant:           t.params.Tenant,
+		Filters:          filter,
+		IncludeMetaCount: true,
+	}
+	if args.Property != "" {
+		dt, ok := t.properties[args.Property]
+		if !ok {
+			return "", fmt.Errorf("cannot aggregate property %q", args.Property)
+		}
+		params.Properties = []aggregation.ParamProperty{{
+			Name:        schema.PropertyName(args.Property),
+			Aggregators: aggregators(dt),
+		}}
+	}
+
+	res, err := t.traverser.Aggregate(ctx, t.principal, params)
+	if err != nil {
+		return "", err
+	}
+	out := map[string]interface{}{"count": 0}
+	if result, ok := res.(*aggregation.Result); ok && len(result.Groups) > 0 {
+		group := result.Groups[0]
+		out["count"] = group.Count
+		if prop, ok := group.Properties[args.Property]; ok {
+			out[args.Property] = summary(prop)
+		}
+	}
+	b, err := json.Marshal(out)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (t *toolbox) supportsMode(mode string) bool {
+	for _, m := range t.modes {
+		if m == mode {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *toolbox) selectProperties() search.SelectProperties {
+	props := make(search.SelectProperties, 0, len(t.returned))
+	for _, name := range t.returned {
+		if _, ok := t.properties[name]; ok {
+			props = append(props, search.SelectProperty{Name: name, IsPrimitive: true})
+		}
+	}
+	return props
+}
+
+// filter combines the conditions of the model into a where filter, which is
+// parsed like the where filter of a REST request
+func (t *toolbox) filter(in []filterArg) (*filters.LocalFilter, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	operands := make([]*models.WhereFilter, 0, len(in))
+	for _, f := range in {
+		dt, ok := t.properties[f.Property]
+		if !ok {
+			return nil, fmt.Errorf("cannot filter by property %q", f.Property)
+		}
+		where := &models.WhereFilter{Path: []string{f.Property}, Operator: f.Operator}
+		if err := setWhereValue(where, dt, f.Value); err != nil {
+			return nil, fmt.Errorf("filter on %q: %w", f.Property, err)
+		}
+		operands = append(operands, where)
+	}
+	where := operands[0]
+	if len(operands) > 1 {
+		where = &models.WhereFilter{Operator: "And", Operands: operands}
+	}
+	return filterext.Parse(where, t.class.Class)
+}
+
+func setWhereValue(where *models.WhereFilter, dt schema.DataType, value interface{}) error {
+	values, isArray := value.([]interface{})
+	if !isArray {
+		values = []interface{}{value}
+	}
+	for _, v := range values {
+		switch dt {
+		case schema.DataTypeInt:
+			n, ok := v.(float64)
+			if !ok || n != float64(int64(n)) {
+				return fmt.Errorf("expected an integer, got %v", v)
+			}
+			where.ValueIntArray = append(where.ValueIntArray, int64(n))
+		case schema.DataTypeNumber:
+			n, ok := v.(float64)
+			if !ok {
+				return fmt.Errorf("expected a number, got %v", v)
+			}
+			where.ValueNumberArray = append(where.ValueNumberArray, n)
+		case schema.DataTypeBoolean:
+			b, ok := v.(bool)
+			if !ok {
+				return fmt.Errorf("expected a boolean, got %v", v)
+			}
+			where.ValueBooleanArray = append(where.ValueBooleanArray, b)
+		case schema.DataTypeDate:
+			s, ok := v.(string)
+			if !ok {
+				return fmt.Errorf("expected an RFC3339 date, got %v", v)
+			}
+			where.ValueDateArray = append(where.ValueDateArray, s)
+		default:
+			s, ok := v.(string)
+			if !ok {
+				return fmt.Errorf("expected a string, got %v", v)
+			}
+			where.ValueTextArray = append(where.ValueTextArray, s)
+		}
+	}
+	if isArray {
+		return nil
+	}
+	if len(values) != 1 {
+		return fmt.Errorf("missing value")
+	}
+	// single values go into the scalar fields
+	switch {
+	case where.ValueIntArray != nil:
+		where.ValueInt, where.ValueIntArray = &where.ValueIntArray[0], nil
+	case where.ValueNumberArray != nil:
+		where.ValueNumber, where.ValueNumberArray = &where.ValueNumberArray[0], nil
+	case where.ValueBooleanArray != nil:
+		where.ValueBoolean, where.ValueBooleanArray = &where.ValueBooleanArray[0], nil
+	case where.ValueDateArray != nil:
+		where.ValueDate, where.ValueDateArray = &where.ValueDateArray[0], nil
+	case where.ValueTextArray != nil:
+		where.ValueText, where.ValueTextArray = &where.ValueTextArray[0], nil
+	}
+	return nil
+}
+
+// filterDataType returns the data type the values of a property are filtered
+// and aggregated as. Only primitive properties can be used by the model.
+func filterDataType(prop *models.Property) (schema.DataType, bool) {
+	dt, ok := schema.AsPrimitive(prop.DataType)
+	if !ok {
+		return "", false
+	}
+	switch dt {
+	case schema.DataTypeText, schema.DataTypeTextArray, schema.DataTypeUUID, schema.DataTypeUUIDArray:
+		return schema.DataTypeText, true
+	case schema.DataTypeInt, schema.DataTypeIntArray:
+		return schema.DataTypeInt, true
+	case schema.DataTypeNumber, schema.DataTypeNumberArray:
+		return schema.DataTypeNumber, true
+	case schema.DataTypeBoolean, schema.DataTypeBooleanArray:
+		return schema.DataTypeBoolean, true
+	case schema.DataTypeDate, schema.DataTypeDateArray:
+		return schema.DataTypeDate, true
+	default:
+		return "", false
+	}
+}
+
+func aggregators(dt schema.DataType) []aggregation.Aggregator {
+	switch dt {
+	case schema.DataTypeInt, schema.DataTypeNumber:
+		return []aggregation.Aggregator{
+			aggregation.MeanAggregator, aggregation.MinimumAggregator,
+			aggregation.MaximumAggregator, aggregation.SumAggregator,
+		}
+	case schema.DataTypeBoolean:
+		return []aggregation.Aggregator{
+			aggregation.TotalTrueAggregator, aggregation.TotalFalseAggregator,
+			aggregation.PercentageTrueAggregator,
+		}
+	case schema.DataTypeDate:
+		return []aggregation.Aggregator{aggregation.MinimumAggregator, aggregation.MaximumAggregator}
+	default:
+		limit := topOccurrences
+		return []aggregation.Aggregator{aggregation.NewTopOccurrencesAggregator(&limit)}
+	}
+}
+
+func summary(prop aggregation.Property) interface{} {
+	switch prop.Type {
+	case aggregation.PropertyTypeNumerical:
+		return prop.NumericalAggregations
+	case aggregation.PropertyTypeBoolean:
+		return prop.BooleanAggregation
+	case aggregation.PropertyTypeDate:
+		return prop.DateAggregations
+	default:
+		return map[string]interface{}{"topOccurrences": prop.TextAggregation.Items}
+	}
+}
+
+func truncate(v interface{}) interface{} {
+	if s, ok := v.(string); ok && len(s) > maxValueLength {
+		return s[:maxValueLength] + "..."
+	}
+	return v
+}
+
+func hasVectorizer(class *models.Class) bool {
+	if class.Vectorizer != "" && class.Vectorizer != "none" {
+		return true
+	}
+	for _, cfg := range class.VectorConfig {
+		if vectorizer, ok := cfg.Vectorizer.(map[string]interface{}); ok {
+			for name := range vectorizer {
+				if name != "none" {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
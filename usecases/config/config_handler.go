@@ -164,6 +164,18 @@ type Config struct {
 	RuntimeOverrides RuntimeOverrides `json:"runtime_overrides" yaml:"runtime_overrides"`
 
 	ReplicaMovementMinimumFinalizingWait *runtime.DynamicValue[time.Duration] `json:"replica_movement_minimum_finalizing_wait" yaml:"replica_movement_minimum_finalizing_wait"`
+
+	ReplicaRebalancer ReplicaRebalancerConfig `json:"replica_rebalancer" yaml:"replica_rebalancer"`
+
+	CrossClusterReplication CrossClusterReplicationConfig `json:"cross_cluster_replication" yaml:"cross_cluster_replication"`
+
+	HintedHandoff HintedHandoffConfig `json:"hinted_handoff" yaml:"hinted_handoff"`
+
+	SlowQueryLog SlowQueryLogConfig `json:"slow_query_log" yaml:"slow_query_log"`
+
+	EmbeddingCache EmbeddingCacheConfig `json:"embedding_cache" yaml:"embedding_cache"`
+
+	ModuleClients ModuleClientsConfig `json:"module_clients" yaml:"module_clients"`
 }
 
 type MapToBlockamaxConfig struct {
@@ -294,6 +306,12 @@ type GRPC struct {
 	CertFile   string `json:"certFile" yaml:"certFile"`
 	KeyFile    string `json:"keyFile" yaml:"keyFile"`
 	MaxMsgSize int    `json:"maxMsgSize" yaml:"maxMsgSize"`
+	// BatchStreamMaxBatchSize is the number of objects per message the batch
+	// stream recommends to clients when the node is not under pressure
+	BatchStreamMaxBatchSize int `json:"batchStreamMaxBatchSize" yaml:"batchStreamMaxBatchSize"`
+	// BatchStreamMaxQueueSize is the number of vectors waiting to be indexed
+	// above which the batch stream stops reading objects
+	BatchStreamMaxQueueSize int `json:"batchStreamMaxQueueSize" yaml:"batchStreamMaxQueueSize"`
 }
 
 type Profiling struct {
@@ -308,6 +326,80 @@ type DistributedTasksConfig struct {
 	SchedulerTickInterval time.Duration `json:"schedulerTickInterval" yaml:"schedulerTickInterval"`
 }
 
+// ReplicaRebalancerConfig configures the automatic movement of shard replicas
+// between nodes to even out disk usage, shard count and object count.
+//
+// Query and write load is out of scope: the node-wide metrics only track the
+// activity of tenants on the local node, nothing the leader could compare
+// across nodes. A node busy with few but hot shards is not relieved.
+type ReplicaRebalancerConfig struct {
+	Enabled            bool                        `json:"enabled" yaml:"enabled"`
+	Interval           time.Duration               `json:"interval" yaml:"interval"`
+	MaxConcurrentMoves int                         `json:"max_concurrent_moves" yaml:"max_concurrent_moves"`
+	ImbalanceThreshold float64                     `json:"imbalance_threshold" yaml:"imbalance_threshold"`
+	DryRun             *runtime.DynamicValue[bool] `json:"dry_run" yaml:"dry_run"`
+	Paused             *runtime.DynamicValue[bool] `json:"paused" yaml:"paused"`
+}
+
+// CrossClusterReplicationConfig configures the asynchronous replication of
+// collections to a standby cluster for disaster recovery. A standby cluster
+// rejects client writes until it is promoted.
+type CrossClusterReplicationConfig struct {
+	Enabled            bool                        `json:"enabled" yaml:"enabled"`
+	Standby            bool                        `json:"standby" yaml:"standby"`
+	TargetURL          string                      `json:"target_url" yaml:"target_url"`
+	TargetAPIKey       string                      `json:"target_api_key" yaml:"target_api_key"`
+	Collections        []string                    `json:"collections" yaml:"collections"`
+	Interval           time.Duration               `json:"interval" yaml:"interval"`
+	DeleteSyncInterval time.Duration               `json:"delete_sync_interval" yaml:"delete_sync_interval"`
+	BatchSize          int                         `json:"batch_size" yaml:"batch_size"`
+	Paused             *runtime.DynamicValue[bool] `json:"paused" yaml:"paused"`
+}
+
+// HintedHandoffConfig configures the replay of writes missed by unavailable
+// replicas once they rejoin the cluster.
+type HintedHandoffConfig struct {
+	Enabled         bool          `json:"enabled" yaml:"enabled"`
+	MaxHintsPerNode int           `json:"max_hints_per_node" yaml:"max_hints_per_node"`
+	MaxHintAge      time.Duration `json:"max_hint_age" yaml:"max_hint_age"`
+	ReplayInterval  time.Duration `json:"replay_interval" yaml:"replay_interval"`
+}
+
+// SlowQueryLogConfig configures the per-node log of queries exceeding the
+// threshold. Threshold and sample rate can be changed at runtime.
+type SlowQueryLogConfig struct {
+	Enabled       bool                                 `json:"enabled" yaml:"enabled"`
+	Threshold     *runtime.DynamicValue[time.Duration] `json:"threshold" yaml:"threshold"`
+	SampleRate    *runtime.DynamicValue[float64]       `json:"sample_rate" yaml:"sample_rate"`
+	BufferSize    int                                  `json:"buffer_size" yaml:"buffer_size"`
+	FilePath      string                               `json:"file_path" yaml:"file_path"`
+	MaxFileSizeMB int                                  `json:"max_file_size_mb" yaml:"max_file_size_mb"`
+	MaxFiles      int                                  `json:"max_files" yaml:"max_files"`
+	// InvalidThreshold holds a configured threshold which could not be used,
+	// the default threshold is used instead.
+	InvalidThreshold string `json:"-" yaml:"-"`
+}
+
+// EmbeddingCacheConfig configures the node-local cache of vectors returned
+// by vectorizer modules. A TTL of 0 keeps entries until they are evicted.
+type EmbeddingCacheConfig struct {
+	Enabled   bool          `json:"enabled" yaml:"enabled"`
+	Path      string        `json:"path" yaml:"path"`
+	MaxSizeMB int           `json:"max_size_mb" yaml:"max_size_mb"`
+	TTL       time.Duration `json:"ttl" yaml:"ttl"`
+}
+
+// ModuleClientsConfig configures how the modules call remote providers:
+// transient failures are retried and a provider failing FailureThreshold
+// requests in a row is not called for OpenDuration.
+type ModuleClientsConfig struct {
+	MaxRetries       int           `json:"max_retries" yaml:"max_retries"`
+	InitialBackoff   time.Duration `json:"initial_backoff" yaml:"initial_backoff"`
+	MaxBackoff       time.Duration `json:"max_backoff" yaml:"max_backoff"`
+	FailureThreshold int           `json:"failure_threshold" yaml:"failure_threshold"`
+	OpenDuration     time.Duration `json:"open_duration" yaml:"open_duration"`
+}
+
 type Persistence struct {
 	DataPath                            string `json:"dataPath" yaml:"dataPath"`
 	MemtablesFlushDirtyAfter            int    `json:"flushDirtyMemtablesAfter" yaml:"flushDirtyMemtablesAfter"`
@@ -425,7 +517,7 @@ type CORS struct {
 const (
 	DefaultCORSAllowOrigin  = "*"
 	DefaultCORSAllowMethods = "*"
-	DefaultCORSAllowHeaders = "Content-Type, Authorization, Batch, X-Openai-Api-Key, X-Openai-Organization, X-Openai-Baseurl, X-Anyscale-Baseurl, X-Anyscale-Api-Key, X-Cohere-Api-Key, X-Cohere-Baseurl, X-Huggingface-Api-Key, X-Azure-Api-Key, X-Azure-Deployment-Id, X-Azure-Resource-Name, X-Azure-Concurrency, X-Azure-Block-Size, X-Google-Api-Key, X-Google-Vertex-Api-Key, X-Google-Studio-Api-Key, X-Goog-Api-Key, X-Goog-Vertex-Api-Key, X-Goog-Studio-Api-Key, X-Palm-Api-Key, X-Jinaai-Api-Key, X-Aws-Access-Key, X-Aws-Secret-Key, X-Voyageai-Baseurl, X-Voyageai-Api-Key, X-Mistral-Baseurl, X-Mistral-Api-Key, X-Anthropic-Baseurl, X-Anthropic-Api-Key, X-Databricks-Endpoint, X-Databricks-Token, X-Databricks-User-Agent, X-Friendli-Token, X-Friendli-Baseurl, X-Weaviate-Api-Key, X-Weaviate-Cluster-Url, X-Nvidia-Api-Key, X-Nvidia-Baseurl"
+	DefaultCORSAllowHeaders = "Content-Type, Authorization, Batch, X-Openai-Api-Key, X-Openai-Organization, X-Openai-Baseurl, X-Anyscale-Baseurl, X-Anyscale-Api-Key, X-Cohere-Api-Key, X-Cohere-Baseurl, X-Huggingface-Api-Key, X-Azure-Api-Key, X-Azure-Deployment-Id, X-Azure-Resource-Name, X-Azure-Concurrency, X-Azure-Block-Size, X-Google-Api-Key, X-Google-Vertex-Api-Key, X-Google-Studio-Api-Key, X-Goog-Api-Key, X-Goog-Vertex-Api-Key, X-Goog-Studio-Api-Key, X-Palm-Api-Key, X-Jinaai-Api-Key, X-Aws-Access-Key, X-Aws-Secret-Key, X-Voyageai-Baseurl, X-Voyageai-Api-Key, X-Mistral-Baseurl, X-Mistral-Api-Key, X-Anthropic-Baseurl, X-Anthropic-Api-Key, X-Databricks-Endpoint, X-Databricks-Token, X-Databricks-User-Agent, X-Friendli-Token, X-Friendli-Baseurl, X-Weaviate-Api-Key, X-Weaviate-Cluster-Url, X-Weaviate-Session-Token, X-Nvidia-Api-Key, X-Nvidia-Baseurl"
 )
 
 func (r ResourceUsage) Validate() error {
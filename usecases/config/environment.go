@@ -15,6 +15,7 @@ import (
 	"fmt"
 	"math"
 	"os"
+	"path/filepath"
 	"regexp"
 	"slices"
 	"strconv"
@@ -49,7 +50,36 @@ const (
 	DefaultReplicationEngineMaxWorkers          = 5
 	DefaultReplicaMovementMinimumFinalizingWait = 100 * time.Second
 
+	DefaultReplicaRebalancerInterval           = 5 * time.Minute
+	DefaultReplicaRebalancerMaxConcurrentMoves = 1
+	DefaultReplicaRebalancerImbalanceThreshold = 0.2
+
+	DefaultCrossClusterReplicationInterval           = 10 * time.Second
+	DefaultCrossClusterReplicationDeleteSyncInterval = 10 * time.Minute
+	DefaultCrossClusterReplicationBatchSize          = 100
+
+	DefaultHintedHandoffMaxHintsPerNode = 100_000
+	DefaultHintedHandoffMaxHintAge      = 3 * time.Hour
+	DefaultHintedHandoffReplayInterval  = 10 * time.Second
+
 	DefaultTransferInactivityTimeout = 5 * time.Minute
+
+	DefaultSlowQueryLogThreshold     = 5 * time.Second
+	DefaultSlowQueryLogSampleRate    = 1.0
+	DefaultSlowQueryLogBufferSize    = 1000
+	DefaultSlowQueryLogFileName      = "slow_queries.log"
+	DefaultSlowQueryLogMaxFileSizeMB = 100
+	DefaultSlowQueryLogMaxFiles      = 5
+
+	DefaultEmbeddingCacheDirName   = "embedding_cache"
+	DefaultEmbeddingCacheMaxSizeMB = 1024
+	DefaultEmbeddingCacheTTL       = 30 * 24 * time.Hour
+
+	DefaultModuleClientsMaxRetries       = 3
+	DefaultModuleClientsInitialBackoff   = 500 * time.Millisecond
+	DefaultModuleClientsMaxBackoff       = 30 * time.Second
+	DefaultModuleClientsFailureThreshold = 5
+	DefaultModuleClientsOpenDuration     = 30 * time.Second
 )
 
 // FromEnv takes a *Config as it will respect initial config that has been
@@ -584,6 +614,20 @@ func FromEnv(config *Config) error {
 	); err != nil {
 		return err
 	}
+	if err := parsePositiveInt(
+		"GRPC_BATCH_STREAM_MAX_BATCH_SIZE",
+		func(val int) { config.GRPC.BatchStreamMaxBatchSize = val },
+		DefaultGRPCBatchStreamMaxBatchSize,
+	); err != nil {
+		return err
+	}
+	if err := parsePositiveInt(
+		"GRPC_BATCH_STREAM_MAX_QUEUE_SIZE",
+		func(val int) { config.GRPC.BatchStreamMaxQueueSize = val },
+		DefaultGRPCBatchStreamMaxQueueSize,
+	); err != nil {
+		return err
+	}
 	config.GRPC.CertFile = ""
 	if v := os.Getenv("GRPC_CERT_FILE"); v != "" {
 		config.GRPC.CertFile = v
@@ -699,9 +743,273 @@ func FromEnv(config *Config) error {
 		config.ReplicaMovementMinimumFinalizingWait = runtime.NewDynamicValue(DefaultReplicaMovementMinimumFinalizingWait)
 	}
 
+	if err = parseReplicaRebalancerConfig(config); err != nil {
+		return err
+	}
+
+	if err = parseCrossClusterReplicationConfig(config); err != nil {
+		return err
+	}
+
+	if err = parseHintedHandoffConfig(config); err != nil {
+		return err
+	}
+
+	if err = parseSlowQueryLogConfig(config); err != nil {
+		return err
+	}
+
+	if err = parseEmbeddingCacheConfig(config); err != nil {
+		return err
+	}
+
+	if err = parseModuleClientsConfig(config); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+func parseReplicaRebalancerConfig(config *Config) error {
+	config.ReplicaRebalancer.Enabled = entcfg.Enabled(os.Getenv("REPLICA_REBALANCER_ENABLED"))
+	config.ReplicaRebalancer.DryRun = runtime.NewDynamicValue(entcfg.Enabled(os.Getenv("REPLICA_REBALANCER_DRY_RUN")))
+	config.ReplicaRebalancer.Paused = runtime.NewDynamicValue(entcfg.Enabled(os.Getenv("REPLICA_REBALANCER_PAUSED")))
+
+	config.ReplicaRebalancer.Interval = DefaultReplicaRebalancerInterval
+	if v := os.Getenv("REPLICA_REBALANCER_INTERVAL"); v != "" {
+		interval, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse REPLICA_REBALANCER_INTERVAL as time.Duration: %w", err)
+		}
+		if interval <= 0 {
+			return fmt.Errorf("REPLICA_REBALANCER_INTERVAL must be a positive duration")
+		}
+		config.ReplicaRebalancer.Interval = interval
+	}
+
+	if err := parsePositiveInt(
+		"REPLICA_REBALANCER_MAX_CONCURRENT_MOVES",
+		func(val int) { config.ReplicaRebalancer.MaxConcurrentMoves = val },
+		DefaultReplicaRebalancerMaxConcurrentMoves,
+	); err != nil {
+		return err
+	}
+
+	return parsePercentage(
+		"REPLICA_REBALANCER_IMBALANCE_THRESHOLD",
+		func(val float64) { config.ReplicaRebalancer.ImbalanceThreshold = val },
+		DefaultReplicaRebalancerImbalanceThreshold,
+	)
+}
+
+func parseCrossClusterReplicationConfig(config *Config) error {
+	cfg := &config.CrossClusterReplication
+	cfg.Enabled = entcfg.Enabled(os.Getenv("CROSS_CLUSTER_REPLICATION_ENABLED"))
+	cfg.Standby = entcfg.Enabled(os.Getenv("CROSS_CLUSTER_REPLICATION_STANDBY"))
+	cfg.Paused = runtime.NewDynamicValue(entcfg.Enabled(os.Getenv("CROSS_CLUSTER_REPLICATION_PAUSED")))
+	cfg.TargetURL = os.Getenv("CROSS_CLUSTER_REPLICATION_TARGET_URL")
+	cfg.TargetAPIKey = os.Getenv("CROSS_CLUSTER_REPLICATION_TARGET_API_KEY")
+	cfg.Collections = nil
+	for _, collection := range strings.Split(os.Getenv("CROSS_CLUSTER_REPLICATION_COLLECTIONS"), ",") {
+		if collection = strings.TrimSpace(collection); collection != "" {
+			cfg.Collections = append(cfg.Collections, collection)
+		}
+	}
+
+	for env, target := range map[string]*time.Duration{
+		"CROSS_CLUSTER_REPLICATION_INTERVAL":             &cfg.Interval,
+		"CROSS_CLUSTER_REPLICATION_DELETE_SYNC_INTERVAL": &cfg.DeleteSyncInterval,
+	} {
+		v := os.Getenv(env)
+		if v == "" {
+			continue
+		}
+		interval, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse %s as time.Duration: %w", env, err)
+		}
+		if interval <= 0 {
+			return fmt.Errorf("%s must be a positive duration", env)
+		}
+		*target = interval
+	}
+	if cfg.Interval == 0 {
+		cfg.Interval = DefaultCrossClusterReplicationInterval
+	}
+	if cfg.DeleteSyncInterval == 0 {
+		cfg.DeleteSyncInterval = DefaultCrossClusterReplicationDeleteSyncInterval
+	}
+
+	if err := parsePositiveInt(
+		"CROSS_CLUSTER_REPLICATION_BATCH_SIZE",
+		func(val int) { cfg.BatchSize = val },
+		DefaultCrossClusterReplicationBatchSize,
+	); err != nil {
+		return err
+	}
+
+	if cfg.Enabled {
+		if cfg.TargetURL == "" {
+			return fmt.Errorf("CROSS_CLUSTER_REPLICATION_TARGET_URL must be set if cross-cluster replication is enabled")
+		}
+		if len(cfg.Collections) == 0 {
+			return fmt.Errorf("CROSS_CLUSTER_REPLICATION_COLLECTIONS must be set if cross-cluster replication is enabled")
+		}
+	}
+	return nil
+}
+
+func parseHintedHandoffConfig(config *Config) error {
+	cfg := &config.HintedHandoff
+	cfg.Enabled = entcfg.Enabled(os.Getenv("HINTED_HANDOFF_ENABLED"))
+
+	cfg.MaxHintAge = DefaultHintedHandoffMaxHintAge
+	cfg.ReplayInterval = DefaultHintedHandoffReplayInterval
+	for env, target := range map[string]*time.Duration{
+		"HINTED_HANDOFF_MAX_HINT_AGE":    &cfg.MaxHintAge,
+		"HINTED_HANDOFF_REPLAY_INTERVAL": &cfg.ReplayInterval,
+	} {
+		v := os.Getenv(env)
+		if v == "" {
+			continue
+		}
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse %s as time.Duration: %w", env, err)
+		}
+		if d <= 0 {
+			return fmt.Errorf("%s must be a positive duration", env)
+		}
+		*target = d
+	}
+
+	return parsePositiveInt(
+		"HINTED_HANDOFF_MAX_HINTS_PER_NODE",
+		func(val int) { cfg.MaxHintsPerNode = val },
+		DefaultHintedHandoffMaxHintsPerNode,
+	)
+}
+
+func parseSlowQueryLogConfig(config *Config) error {
+	cfg := &config.SlowQueryLog
+	cfg.Enabled = entcfg.Enabled(os.Getenv("QUERY_SLOW_LOG_ENABLED"))
+
+	// an invalid threshold does not prevent the startup, the default is used
+	// instead and the slow query log warns about the value
+	threshold := DefaultSlowQueryLogThreshold
+	if v := os.Getenv("QUERY_SLOW_LOG_THRESHOLD"); v != "" {
+		if d, err := time.ParseDuration(v); err != nil || d < 0 {
+			cfg.InvalidThreshold = v
+		} else {
+			threshold = d
+		}
+	}
+	cfg.Threshold = runtime.NewDynamicValue(threshold)
+
+	if err := parsePercentage(
+		"QUERY_SLOW_LOG_SAMPLE_RATE",
+		func(val float64) { cfg.SampleRate = runtime.NewDynamicValue(val) },
+		DefaultSlowQueryLogSampleRate,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt(
+		"QUERY_SLOW_LOG_BUFFER_SIZE",
+		func(val int) { cfg.BufferSize = val },
+		DefaultSlowQueryLogBufferSize,
+	); err != nil {
+		return err
+	}
+
+	// an empty path disables writing the log to disk
+	cfg.FilePath = filepath.Join(config.Persistence.DataPath, DefaultSlowQueryLogFileName)
+	if v, ok := os.LookupEnv("QUERY_SLOW_LOG_FILE"); ok {
+		cfg.FilePath = v
+	}
+
+	if err := parsePositiveInt(
+		"QUERY_SLOW_LOG_MAX_FILE_SIZE_MB",
+		func(val int) { cfg.MaxFileSizeMB = val },
+		DefaultSlowQueryLogMaxFileSizeMB,
+	); err != nil {
+		return err
+	}
+
+	return parsePositiveInt(
+		"QUERY_SLOW_LOG_MAX_FILES",
+		func(val int) { cfg.MaxFiles = val },
+		DefaultSlowQueryLogMaxFiles,
+	)
+}
+
+func parseEmbeddingCacheConfig(config *Config) error {
+	cfg := &config.EmbeddingCache
+	cfg.Enabled = entcfg.Enabled(os.Getenv("EMBEDDING_CACHE_ENABLED"))
+	cfg.Path = filepath.Join(config.Persistence.DataPath, DefaultEmbeddingCacheDirName)
+
+	cfg.TTL = DefaultEmbeddingCacheTTL
+	if v := os.Getenv("EMBEDDING_CACHE_TTL"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse EMBEDDING_CACHE_TTL as time.Duration: %w", err)
+		}
+		if d < 0 {
+			return fmt.Errorf("EMBEDDING_CACHE_TTL must not be negative")
+		}
+		cfg.TTL = d
+	}
+
+	return parsePositiveInt(
+		"EMBEDDING_CACHE_MAX_SIZE_MB",
+		func(val int) { cfg.MaxSizeMB = val },
+		DefaultEmbeddingCacheMaxSizeMB,
+	)
+}
+
+func parseModuleClientsConfig(config *Config) error {
+	cfg := &config.ModuleClients
+
+	if err := parseNonNegativeInt(
+		"MODULES_CLIENT_MAX_RETRIES",
+		func(val int) { cfg.MaxRetries = val },
+		DefaultModuleClientsMaxRetries,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveDuration(
+		"MODULES_CLIENT_INITIAL_BACKOFF",
+		func(val time.Duration) { cfg.InitialBackoff = val },
+		DefaultModuleClientsInitialBackoff,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveDuration(
+		"MODULES_CLIENT_MAX_BACKOFF",
+		func(val time.Duration) { cfg.MaxBackoff = val },
+		DefaultModuleClientsMaxBackoff,
+	); err != nil {
+		return err
+	}
+
+	// a threshold of 0 disables the circuit breakers
+	if err := parseNonNegativeInt(
+		"MODULES_CLIENT_CIRCUIT_BREAKER_THRESHOLD",
+		func(val int) { cfg.FailureThreshold = val },
+		DefaultModuleClientsFailureThreshold,
+	); err != nil {
+		return err
+	}
+
+	return parsePositiveDuration(
+		"MODULES_CLIENT_CIRCUIT_BREAKER_OPEN_DURATION",
+		func(val time.Duration) { cfg.OpenDuration = val },
+		DefaultModuleClientsOpenDuration,
+	)
+}
+
 func parseRAFTConfig(hostname string) (Raft, error) {
 	// flag.IntVar()
 	cfg := Raft{
@@ -941,6 +1249,23 @@ func parseNonNegativeInt(envName string, cb func(val int), defaultValue int) err
 	})
 }
 
+func parsePositiveDuration(envName string, cb func(val time.Duration), defaultValue time.Duration) error {
+	val := defaultValue
+	if v := os.Getenv(envName); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse %s as time.Duration: %w", envName, err)
+		}
+		if d <= 0 {
+			return fmt.Errorf("%s must be a positive duration. Got: %v", envName, d)
+		}
+		val = d
+	}
+
+	cb(val)
+	return nil
+}
+
 func parseIntVerify(envName string, defaultValue int, cb func(val int), verify func(val int) error) error {
 	var err error
 	asInt := defaultValue
@@ -1016,6 +1341,8 @@ const (
 	DefaultMaxConcurrentShardLoads             = 500
 	DefaultGRPCPort                            = 50051
 	DefaultGRPCMaxMsgSize                      = 104858000 // 100 * 1024 * 1024 + 400
+	DefaultGRPCBatchStreamMaxBatchSize         = 1000
+	DefaultGRPCBatchStreamMaxQueueSize         = 500_000
 	DefaultMinimumReplicationFactor            = 1
 	DefaultMaximumAllowedCollectionsCount      = -1 // unlimited
 )
@@ -15,7 +15,9 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -1149,3 +1151,298 @@ func TestEnvironmentPersistenceMinMMapSize(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvironmentReplicaRebalancer(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		conf := Config{}
+		require.NoError(t, FromEnv(&conf))
+
+		assert.False(t, conf.ReplicaRebalancer.Enabled)
+		assert.False(t, conf.ReplicaRebalancer.DryRun.Get())
+		assert.False(t, conf.ReplicaRebalancer.Paused.Get())
+		assert.Equal(t, DefaultReplicaRebalancerInterval, conf.ReplicaRebalancer.Interval)
+		assert.Equal(t, DefaultReplicaRebalancerMaxConcurrentMoves, conf.ReplicaRebalancer.MaxConcurrentMoves)
+		assert.Equal(t, DefaultReplicaRebalancerImbalanceThreshold, conf.ReplicaRebalancer.ImbalanceThreshold)
+	})
+
+	t.Run("configured", func(t *testing.T) {
+		t.Setenv("REPLICA_REBALANCER_ENABLED", "true")
+		t.Setenv("REPLICA_REBALANCER_DRY_RUN", "true")
+		t.Setenv("REPLICA_REBALANCER_PAUSED", "true")
+		t.Setenv("REPLICA_REBALANCER_INTERVAL", "30s")
+		t.Setenv("REPLICA_REBALANCER_MAX_CONCURRENT_MOVES", "3")
+		t.Setenv("REPLICA_REBALANCER_IMBALANCE_THRESHOLD", "0.5")
+		conf := Config{}
+		require.NoError(t, FromEnv(&conf))
+
+		assert.True(t, conf.ReplicaRebalancer.Enabled)
+		assert.True(t, conf.ReplicaRebalancer.DryRun.Get())
+		assert.True(t, conf.ReplicaRebalancer.Paused.Get())
+		assert.Equal(t, 30*time.Second, conf.ReplicaRebalancer.Interval)
+		assert.Equal(t, 3, conf.ReplicaRebalancer.MaxConcurrentMoves)
+		assert.Equal(t, 0.5, conf.ReplicaRebalancer.ImbalanceThreshold)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for env, value := range map[string]string{
+			"REPLICA_REBALANCER_INTERVAL":             "0s",
+			"REPLICA_REBALANCER_MAX_CONCURRENT_MOVES": "0",
+			"REPLICA_REBALANCER_IMBALANCE_THRESHOLD":  "1.5",
+		} {
+			t.Run(env, func(t *testing.T) {
+				t.Setenv(env, value)
+				conf := Config{}
+				require.Error(t, FromEnv(&conf))
+			})
+		}
+	})
+}
+
+func TestEnvironmentCrossClusterReplication(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		conf := Config{}
+		require.NoError(t, FromEnv(&conf))
+
+		cfg := conf.CrossClusterReplication
+		assert.False(t, cfg.Enabled)
+		assert.False(t, cfg.Standby)
+		assert.False(t, cfg.Paused.Get())
+		assert.Empty(t, cfg.Collections)
+		assert.Equal(t, DefaultCrossClusterReplicationInterval, cfg.Interval)
+		assert.Equal(t, DefaultCrossClusterReplicationDeleteSyncInterval, cfg.DeleteSyncInterval)
+		assert.Equal(t, DefaultCrossClusterReplicationBatchSize, cfg.BatchSize)
+	})
+
+	t.Run("configured", func(t *testing.T) {
+		t.Setenv("CROSS_CLUSTER_REPLICATION_ENABLED", "true")
+		t.Setenv("CROSS_CLUSTER_REPLICATION_STANDBY", "true")
+		t.Setenv("CROSS_CLUSTER_REPLICATION_PAUSED", "true")
+		t.Setenv("CROSS_CLUSTER_REPLICATION_TARGET_URL", "https://standby:8080")
+		t.Setenv("CROSS_CLUSTER_REPLICATION_TARGET_API_KEY", "secret")
+		t.Setenv("CROSS_CLUSTER_REPLICATION_COLLECTIONS", "Article, Author,")
+		t.Setenv("CROSS_CLUSTER_REPLICATION_INTERVAL", "30s")
+		t.Setenv("CROSS_CLUSTER_REPLICATION_DELETE_SYNC_INTERVAL", "1h")
+		t.Setenv("CROSS_CLUSTER_REPLICATION_BATCH_SIZE", "500")
+		conf := Config{}
+		require.NoError(t, FromEnv(&conf))
+
+		cfg := conf.CrossClusterReplication
+		assert.True(t, cfg.Enabled)
+		assert.True(t, cfg.Standby)
+		assert.True(t, cfg.Paused.Get())
+		assert.Equal(t, "https://standby:8080", cfg.TargetURL)
+		assert.Equal(t, "secret", cfg.TargetAPIKey)
+		assert.Equal(t, []string{"Article", "Author"}, cfg.Collections)
+		assert.Equal(t, 30*time.Second, cfg.Interval)
+		assert.Equal(t, time.Hour, cfg.DeleteSyncInterval)
+		assert.Equal(t, 500, cfg.BatchSize)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for name, env := range map[string]map[string]string{
+			"interval":           {"CROSS_CLUSTER_REPLICATION_INTERVAL": "0s"},
+			"delete sync":        {"CROSS_CLUSTER_REPLICATION_DELETE_SYNC_INTERVAL": "soon"},
+			"batch size":         {"CROSS_CLUSTER_REPLICATION_BATCH_SIZE": "0"},
+			"missing target":     {"CROSS_CLUSTER_REPLICATION_ENABLED": "true", "CROSS_CLUSTER_REPLICATION_COLLECTIONS": "Article"},
+			"missing collection": {"CROSS_CLUSTER_REPLICATION_ENABLED": "true", "CROSS_CLUSTER_REPLICATION_TARGET_URL": "http://standby:8080"},
+		} {
+			t.Run(name, func(t *testing.T) {
+				for k, v := range env {
+					t.Setenv(k, v)
+				}
+				conf := Config{}
+				require.Error(t, FromEnv(&conf))
+			})
+		}
+	})
+}
+
+func TestEnvironmentHintedHandoff(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		conf := Config{}
+		require.NoError(t, FromEnv(&conf))
+
+		cfg := conf.HintedHandoff
+		assert.False(t, cfg.Enabled)
+		assert.Equal(t, DefaultHintedHandoffMaxHintsPerNode, cfg.MaxHintsPerNode)
+		assert.Equal(t, DefaultHintedHandoffMaxHintAge, cfg.MaxHintAge)
+		assert.Equal(t, DefaultHintedHandoffReplayInterval, cfg.ReplayInterval)
+	})
+
+	t.Run("configured", func(t *testing.T) {
+		t.Setenv("HINTED_HANDOFF_ENABLED", "true")
+		t.Setenv("HINTED_HANDOFF_MAX_HINTS_PER_NODE", "1000")
+		t.Setenv("HINTED_HANDOFF_MAX_HINT_AGE", "1h")
+		t.Setenv("HINTED_HANDOFF_REPLAY_INTERVAL", "30s")
+		conf := Config{}
+		require.NoError(t, FromEnv(&conf))
+
+		cfg := conf.HintedHandoff
+		assert.True(t, cfg.Enabled)
+		assert.Equal(t, 1000, cfg.MaxHintsPerNode)
+		assert.Equal(t, time.Hour, cfg.MaxHintAge)
+		assert.Equal(t, 30*time.Second, cfg.ReplayInterval)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for name, env := range map[string]map[string]string{
+			"max hints":       {"HINTED_HANDOFF_MAX_HINTS_PER_NODE": "0"},
+			"max hint age":    {"HINTED_HANDOFF_MAX_HINT_AGE": "-1h"},
+			"replay interval": {"HINTED_HANDOFF_REPLAY_INTERVAL": "often"},
+		} {
+			t.Run(name, func(t *testing.T) {
+				for k, v := range env {
+					t.Setenv(k, v)
+				}
+				conf := Config{}
+				require.Error(t, FromEnv(&conf))
+			})
+		}
+	})
+}
+
+func TestEnvironmentSlowQueryLog(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		conf := Config{}
+		require.NoError(t, FromEnv(&conf))
+
+		assert.False(t, conf.SlowQueryLog.Enabled)
+		assert.Equal(t, DefaultSlowQueryLogThreshold, conf.SlowQueryLog.Threshold.Get())
+		assert.Equal(t, DefaultSlowQueryLogSampleRate, conf.SlowQueryLog.SampleRate.Get())
+		assert.Equal(t, DefaultSlowQueryLogBufferSize, conf.SlowQueryLog.BufferSize)
+		assert.Equal(t, filepath.Join(DefaultPersistenceDataPath, DefaultSlowQueryLogFileName), conf.SlowQueryLog.FilePath)
+		assert.Equal(t, DefaultSlowQueryLogMaxFileSizeMB, conf.SlowQueryLog.MaxFileSizeMB)
+		assert.Equal(t, DefaultSlowQueryLogMaxFiles, conf.SlowQueryLog.MaxFiles)
+	})
+
+	t.Run("configured", func(t *testing.T) {
+		t.Setenv("QUERY_SLOW_LOG_ENABLED", "true")
+		t.Setenv("QUERY_SLOW_LOG_THRESHOLD", "250ms")
+		t.Setenv("QUERY_SLOW_LOG_SAMPLE_RATE", "0.1")
+		t.Setenv("QUERY_SLOW_LOG_BUFFER_SIZE", "50")
+		t.Setenv("QUERY_SLOW_LOG_FILE", "")
+		t.Setenv("QUERY_SLOW_LOG_MAX_FILE_SIZE_MB", "10")
+		t.Setenv("QUERY_SLOW_LOG_MAX_FILES", "2")
+		conf := Config{}
+		require.NoError(t, FromEnv(&conf))
+
+		assert.True(t, conf.SlowQueryLog.Enabled)
+		assert.Equal(t, 250*time.Millisecond, conf.SlowQueryLog.Threshold.Get())
+		assert.Equal(t, 0.1, conf.SlowQueryLog.SampleRate.Get())
+		assert.Equal(t, 50, conf.SlowQueryLog.BufferSize)
+		assert.Empty(t, conf.SlowQueryLog.FilePath)
+		assert.Equal(t, 10, conf.SlowQueryLog.MaxFileSizeMB)
+		assert.Equal(t, 2, conf.SlowQueryLog.MaxFiles)
+	})
+
+	t.Run("invalid threshold falls back to the default", func(t *testing.T) {
+		for _, value := range []string{"fast", "-1s"} {
+			t.Run(value, func(t *testing.T) {
+				t.Setenv("QUERY_SLOW_LOG_THRESHOLD", value)
+				conf := Config{}
+				require.NoError(t, FromEnv(&conf))
+
+				assert.Equal(t, DefaultSlowQueryLogThreshold, conf.SlowQueryLog.Threshold.Get())
+				assert.Equal(t, value, conf.SlowQueryLog.InvalidThreshold)
+			})
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for env, value := range map[string]string{
+			"QUERY_SLOW_LOG_SAMPLE_RATE": "2",
+			"QUERY_SLOW_LOG_BUFFER_SIZE": "0",
+			"QUERY_SLOW_LOG_MAX_FILES":   "-1",
+		} {
+			t.Run(env, func(t *testing.T) {
+				t.Setenv(env, value)
+				conf := Config{}
+				require.Error(t, FromEnv(&conf))
+			})
+		}
+	})
+}
+
+func TestEnvironmentEmbeddingCache(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		conf := Config{}
+		require.NoError(t, FromEnv(&conf))
+
+		assert.False(t, conf.EmbeddingCache.Enabled)
+		assert.Equal(t, filepath.Join(DefaultPersistenceDataPath, DefaultEmbeddingCacheDirName), conf.EmbeddingCache.Path)
+		assert.Equal(t, DefaultEmbeddingCacheMaxSizeMB, conf.EmbeddingCache.MaxSizeMB)
+		assert.Equal(t, DefaultEmbeddingCacheTTL, conf.EmbeddingCache.TTL)
+	})
+
+	t.Run("configured", func(t *testing.T) {
+		t.Setenv("EMBEDDING_CACHE_ENABLED", "true")
+		t.Setenv("EMBEDDING_CACHE_MAX_SIZE_MB", "64")
+		t.Setenv("EMBEDDING_CACHE_TTL", "0s")
+		conf := Config{}
+		require.NoError(t, FromEnv(&conf))
+
+		assert.True(t, conf.EmbeddingCache.Enabled)
+		assert.Equal(t, 64, conf.EmbeddingCache.MaxSizeMB)
+		assert.Equal(t, time.Duration(0), conf.EmbeddingCache.TTL)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for env, value := range map[string]string{
+			"EMBEDDING_CACHE_MAX_SIZE_MB": "0",
+			"EMBEDDING_CACHE_TTL":         "-1h",
+		} {
+			t.Run(env, func(t *testing.T) {
+				t.Setenv(env, value)
+				require.Error(t, FromEnv(&Config{}))
+			})
+		}
+	})
+}
+
+func TestEnvironmentModuleClients(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		conf := Config{}
+		require.NoError(t, FromEnv(&conf))
+
+		assert.Equal(t, ModuleClientsConfig{
+			MaxRetries:       DefaultModuleClientsMaxRetries,
+			InitialBackoff:   DefaultModuleClientsInitialBackoff,
+			MaxBackoff:       DefaultModuleClientsMaxBackoff,
+			FailureThreshold: DefaultModuleClientsFailureThreshold,
+			OpenDuration:     DefaultModuleClientsOpenDuration,
+		}, conf.ModuleClients)
+	})
+
+	t.Run("configured", func(t *testing.T) {
+		t.Setenv("MODULES_CLIENT_MAX_RETRIES", "0")
+		t.Setenv("MODULES_CLIENT_INITIAL_BACKOFF", "1s")
+		t.Setenv("MODULES_CLIENT_MAX_BACKOFF", "1m")
+		t.Setenv("MODULES_CLIENT_CIRCUIT_BREAKER_THRESHOLD", "10")
+		t.Setenv("MODULES_CLIENT_CIRCUIT_BREAKER_OPEN_DURATION", "5m")
+		conf := Config{}
+		require.NoError(t, FromEnv(&conf))
+
+		assert.Equal(t, ModuleClientsConfig{
+			MaxRetries:       0,
+			InitialBackoff:   time.Second,
+			MaxBackoff:       time.Minute,
+			FailureThreshold: 10,
+			OpenDuration:     5 * time.Minute,
+		}, conf.ModuleClients)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for env, value := range map[string]string{
+			"MODULES_CLIENT_MAX_RETRIES":                   "-1",
+			"MODULES_CLIENT_INITIAL_BACKOFF":               "0s",
+			"MODULES_CLIENT_MAX_BACKOFF":                   "soon",
+			"MODULES_CLIENT_CIRCUIT_BREAKER_THRESHOLD":     "-1",
+			"MODULES_CLIENT_CIRCUIT_BREAKER_OPEN_DURATION": "-1m",
+		} {
+			t.Run(env, func(t *testing.T) {
+				t.Setenv(env, value)
+				require.Error(t, FromEnv(&Config{}))
+			})
+		}
+	})
+}
@@ -30,6 +30,11 @@ type WeaviateRuntimeConfig struct {
 	AutoschemaEnabled                    *runtime.DynamicValue[bool]          `json:"autoschema_enabled" yaml:"autoschema_enabled"`
 	AsyncReplicationDisabled             *runtime.DynamicValue[bool]          `json:"async_replication_disabled" yaml:"async_replication_disabled"`
 	ReplicaMovementMinimumFinalizingWait *runtime.DynamicValue[time.Duration] `json:"replica_movement_minimum_finalizing_wait" yaml:"replica_movement_minimum_finalizing_wait"`
+	ReplicaRebalancerDryRun              *runtime.DynamicValue[bool]          `json:"replica_rebalancer_dry_run" yaml:"replica_rebalancer_dry_run"`
+	ReplicaRebalancerPaused              *runtime.DynamicValue[bool]          `json:"replica_rebalancer_paused" yaml:"replica_rebalancer_paused"`
+	CrossClusterReplicationPaused        *runtime.DynamicValue[bool]          `json:"cross_cluster_replication_paused" yaml:"cross_cluster_replication_paused"`
+	QuerySlowLogThreshold                *runtime.DynamicValue[time.Duration] `json:"query_slow_log_threshold" yaml:"query_slow_log_threshold"`
+	QuerySlowLogSampleRate               *runtime.DynamicValue[float64]       `json:"query_slow_log_sample_rate" yaml:"query_slow_log_sample_rate"`
 }
 
 // ParseRuntimeConfig decode WeaviateRuntimeConfig from raw bytes of YAML.
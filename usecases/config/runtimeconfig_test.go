@@ -44,6 +44,11 @@ func TestUpdateRuntimeConfig(t *testing.T) {
 			autoSchema runtime.DynamicValue[bool]
 			asyncRep   runtime.DynamicValue[bool]
 			minFinWait runtime.DynamicValue[time.Duration]
+			rebDryRun  runtime.DynamicValue[bool]
+			rebPaused  runtime.DynamicValue[bool]
+			ccrPaused  runtime.DynamicValue[bool]
+			slowThresh runtime.DynamicValue[time.Duration]
+			slowSample runtime.DynamicValue[float64]
 		)
 
 		reg := &WeaviateRuntimeConfig{
@@ -51,12 +56,20 @@ func TestUpdateRuntimeConfig(t *testing.T) {
 			AutoschemaEnabled:                    &autoSchema,
 			AsyncReplicationDisabled:             &asyncRep,
 			ReplicaMovementMinimumFinalizingWait: &minFinWait,
+			ReplicaRebalancerDryRun:              &rebDryRun,
+			ReplicaRebalancerPaused:              &rebPaused,
+			CrossClusterReplicationPaused:        &ccrPaused,
+			QuerySlowLogThreshold:                &slowThresh,
+			QuerySlowLogSampleRate:               &slowSample,
 		}
 
 		// parsed from yaml configs for example
 		buf := []byte(`autoschema_enabled: true
 maximum_allowed_collections_count: 13
-replica_movement_minimum_finalizing_wait: 10s`)
+replica_movement_minimum_finalizing_wait: 10s
+replica_rebalancer_paused: true
+query_slow_log_threshold: 2s
+query_slow_log_sample_rate: 0.25`)
 		parsed, err := ParseRuntimeConfig(buf)
 		require.NoError(t, err)
 
@@ -64,6 +77,7 @@ replica_movement_minimum_finalizing_wait: 10s`)
 		assert.Equal(t, false, autoSchema.Get())
 		assert.Equal(t, 0, colCount.Get())
 		assert.Equal(t, 0*time.Second, minFinWait.Get())
+		assert.Equal(t, false, rebPaused.Get())
 
 		require.NoError(t, UpdateRuntimeConfig(reg, parsed))
 
@@ -71,6 +85,10 @@ replica_movement_minimum_finalizing_wait: 10s`)
 		assert.Equal(t, true, autoSchema.Get())
 		assert.Equal(t, 13, colCount.Get())
 		assert.Equal(t, 10*time.Second, minFinWait.Get())
+		assert.Equal(t, true, rebPaused.Get())
+		assert.Equal(t, false, rebDryRun.Get())
+		assert.Equal(t, 2*time.Second, slowThresh.Get())
+		assert.Equal(t, 0.25, slowSample.Get())
 	})
 
 	t.Run("updating priorities", func(t *testing.T) {
@@ -83,6 +101,11 @@ replica_movement_minimum_finalizing_wait: 10s`)
 			autoSchema runtime.DynamicValue[bool]
 			asyncRep   runtime.DynamicValue[bool]
 			minFinWait runtime.DynamicValue[time.Duration]
+			rebDryRun  runtime.DynamicValue[bool]
+			rebPaused  runtime.DynamicValue[bool]
+			ccrPaused  runtime.DynamicValue[bool]
+			slowThresh runtime.DynamicValue[time.Duration]
+			slowSample runtime.DynamicValue[float64]
 		)
 
 		reg := &WeaviateRuntimeConfig{
@@ -90,6 +113,11 @@ replica_movement_minimum_finalizing_wait: 10s`)
 			AutoschemaEnabled:                    &autoSchema,
 			AsyncReplicationDisabled:             &asyncRep,
 			ReplicaMovementMinimumFinalizingWait: &minFinWait,
+			ReplicaRebalancerDryRun:              &rebDryRun,
+			ReplicaRebalancerPaused:              &rebPaused,
+			CrossClusterReplicationPaused:        &ccrPaused,
+			QuerySlowLogThreshold:                &slowThresh,
+			QuerySlowLogSampleRate:               &slowSample,
 		}
 
 		// parsed from yaml configs for example
@@ -0,0 +1,85 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package crosscluster
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// CheckpointsFileName is the name of the file in the data path the replicator
+// persists its progress to.
+const CheckpointsFileName = "cross_cluster_replication.json"
+
+// checkpoints holds, per local shard, the update time in unix millis up to
+// which objects have been replicated. Replication resumes from there after a
+// restart.
+type checkpoints struct {
+	path string
+
+	mu     sync.Mutex
+	shards map[string]int64
+}
+
+func loadCheckpoints(path string) (*checkpoints, error) {
+	c := &checkpoints{path: path, shards: map[string]int64{}}
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return c, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read checkpoints: %w", err)
+	}
+	if err := json.Unmarshal(raw, &c.shards); err != nil {
+		return nil, fmt.Errorf("unmarshal checkpoints %q: %w", path, err)
+	}
+	return c, nil
+}
+
+func (c *checkpoints) get(class, shard string) int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.shards[checkpointKey(class, shard)]
+}
+
+// set records the checkpoint of the shard and persists all of them.
+func (c *checkpoints) set(class, shard string, updateTime int64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.shards[checkpointKey(class, shard)] = updateTime
+	raw, err := json.Marshal(c.shards)
+	if err != nil {
+		return fmt.Errorf("marshal checkpoints: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), os.ModePerm); err != nil {
+		return fmt.Errorf("create checkpoints dir: %w", err)
+	}
+	// write to a temporary file first, so that a crash never leaves a
+	// truncated file behind
+	tmp := c.path + ".tmp"
+	if err := os.WriteFile(tmp, raw, 0o644); err != nil {
+		return fmt.Errorf("write checkpoints: %w", err)
+	}
+	if err := os.Rename(tmp, c.path); err != nil {
+		return fmt.Errorf("rename checkpoints: %w", err)
+	}
+	return nil
+}
+
+func checkpointKey(class, shard string) string {
+	return class + "/" + shard
+}
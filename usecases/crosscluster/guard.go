@@ -0,0 +1,86 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package crosscluster
+
+import (
+	"context"
+	"errors"
+)
+
+// ReplicationSourceHeader marks a request as a write replicated from the
+// primary cluster. It is sent by the replicator with the name of the node
+// replicating. It does not grant any permission, replicated writes are
+// authorized like any other.
+const ReplicationSourceHeader = "X-Weaviate-Replication-Source"
+
+var (
+	// ErrStandby is returned for client writes to a standby which has not
+	// been promoted yet.
+	ErrStandby = errors.New("cluster is a cross-cluster replication standby, " +
+		"it only accepts writes replicated from the primary until promoted")
+	// ErrFenced is returned for replicated writes to a cluster which is not a
+	// standby, or not anymore since it was promoted.
+	ErrFenced = errors.New("cluster does not accept cross-cluster replication: " +
+		"it is not a standby or has been promoted")
+	// ErrNotStandby is returned when promoting a cluster which is not a standby.
+	ErrNotStandby = errors.New("cluster is not a cross-cluster replication standby")
+)
+
+// Promotion promotes a standby and reports whether it has been promoted.
+// Promotion is replicated through Raft, so that every node of the standby
+// stops accepting replicated writes.
+type Promotion interface {
+	PromoteStandby(ctx context.Context) error
+	StandbyPromoted() bool
+}
+
+// Guard decides which writes a cluster accepts depending on its role in
+// cross-cluster replication.
+type Guard struct {
+	standby   bool
+	promotion Promotion
+}
+
+func NewGuard(standby bool, promotion Promotion) *Guard {
+	return &Guard{standby: standby, promotion: promotion}
+}
+
+// Standby returns whether the cluster is a standby which has not been
+// promoted yet.
+func (g *Guard) Standby() bool {
+	return g.standby && !g.promotion.StandbyPromoted()
+}
+
+// CheckWrite returns an error if the cluster must reject a write. A write is
+// replicated if it was sent by the replicator of the primary cluster.
+func (g *Guard) CheckWrite(replicated bool) error {
+	standby := g.Standby()
+	switch {
+	case replicated && !standby:
+		// once promoted, the former primary must not overwrite the writes of
+		// the clients that failed over
+		return ErrFenced
+	case !replicated && standby:
+		return ErrStandby
+	default:
+		return nil
+	}
+}
+
+// Promote turns the standby into a primary accepting client writes. Promoting
+// a cluster twice is a no-op.
+func (g *Guard) Promote(ctx context.Context) error {
+	if !g.standby {
+		return ErrNotStandby
+	}
+	return g.promotion.PromoteStandby(ctx)
+}
@@ -0,0 +1,55 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package crosscluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakePromotion struct {
+	promoted bool
+}
+
+func (f *fakePromotion) PromoteStandby(context.Context) error {
+	f.promoted = true
+	return nil
+}
+
+func (f *fakePromotion) StandbyPromoted() bool {
+	return f.promoted
+}
+
+func TestGuard(t *testing.T) {
+	t.Run("primary", func(t *testing.T) {
+		g := NewGuard(false, &fakePromotion{})
+		assert.False(t, g.Standby())
+		assert.NoError(t, g.CheckWrite(false))
+		assert.ErrorIs(t, g.CheckWrite(true), ErrFenced)
+		assert.ErrorIs(t, g.Promote(context.Background()), ErrNotStandby)
+	})
+
+	t.Run("standby", func(t *testing.T) {
+		g := NewGuard(true, &fakePromotion{})
+		assert.True(t, g.Standby())
+		assert.ErrorIs(t, g.CheckWrite(false), ErrStandby)
+		assert.NoError(t, g.CheckWrite(true))
+
+		require.NoError(t, g.Promote(context.Background()))
+		assert.False(t, g.Standby())
+		assert.NoError(t, g.CheckWrite(false))
+		assert.ErrorIs(t, g.CheckWrite(true), ErrFenced)
+	})
+}
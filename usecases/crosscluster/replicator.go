@@ -0,0 +1,509 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package crosscluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/config/runtime"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+const (
+	replicatorLogAction = "cross_cluster_replication"
+
+	// scanOverlap is the window behind the checkpoint of a shard which is
+	// scanned again in every round. It covers objects whose update time was
+	// taken right before the previous scan but which were only persisted
+	// after it, and update times taken by coordinators whose clock lags
+	// behind the one of the local node.
+	scanOverlap = 30 * time.Second
+)
+
+// Source reads the objects of the local shards and checks whether objects
+// still exist in the cluster.
+type Source interface {
+	// LocalObjectIDsUpdatedSince returns up to limit ids of the objects
+	// updated at or after since, in the order of the ids after the given one.
+	LocalObjectIDsUpdatedSince(ctx context.Context, class, shard string, since int64,
+		after strfmt.UUID, limit int) ([]strfmt.UUID, error)
+	LocalObjectsByID(ctx context.Context, class, shard string, ids []strfmt.UUID) ([]*storobj.Object, error)
+	// MultiExists returns whether each of the objects exists on any replica.
+	// It fails if a replica cannot be reached.
+	MultiExists(ctx context.Context, class, tenant string, ids []strfmt.UUID) ([]bool, error)
+}
+
+// MemberLister lists the names of the live nodes of the local cluster.
+type MemberLister interface {
+	AllNames() []string
+}
+
+// SchemaReader returns the classes and sharding states of the local cluster.
+type SchemaReader interface {
+	ReadOnlyClass(name string) *models.Class
+	CopyShardingState(class string) *sharding.State
+}
+
+// LeaderChecker reports whether the local node is the leader of the cluster.
+type LeaderChecker interface {
+	IsLeader() bool
+}
+
+type ReplicatorParams struct {
+	Logger       logrus.FieldLogger
+	Source       Source
+	SchemaReader SchemaReader
+	Leader       LeaderChecker
+	Target       Target
+	NodeName     string
+	// Members are the live nodes the sender of a shard is chosen among
+	Members MemberLister
+	// CheckpointPath is the file the progress of the local shards is
+	// persisted to
+	CheckpointPath    string
+	MetricsRegisterer prometheus.Registerer
+
+	// Collections are the names of the collections to replicate
+	Collections []string
+	// Interval is the time between two replication rounds
+	Interval time.Duration
+	// DeleteSyncInterval is the time between two comparisons of the object
+	// ids of the target with the local ones
+	DeleteSyncInterval time.Duration
+	// BatchSize is the number of objects sent or compared per request
+	BatchSize int
+	// Paused skips replication rounds until unset
+	Paused *runtime.DynamicValue[bool]
+}
+
+// Replicator continuously replicates collections to a standby cluster
+// through its public API.
+//
+// The leader creates the replicated classes, properties and tenants missing
+// on the standby. Every node sends the objects of the local shards it is the
+// first live replica of which were updated since the last checkpoint, so that
+// every shard is sent by a single node and another replica takes over while
+// the first one is down. As deletes leave no trace to scan
+// for, the leader periodically lists the object ids of the standby and
+// deletes those which do not exist locally anymore.
+//
+// Replication stops for good once the standby rejects a request because it
+// has been promoted.
+type Replicator struct {
+	logger       logrus.FieldLogger
+	source       Source
+	schemaReader SchemaReader
+	leader       LeaderChecker
+	target       Target
+	nodeName     string
+	members      MemberLister
+	checkpoints  *checkpoints
+
+	collections        []string
+	interval           time.Duration
+	deleteSyncInterval time.Duration
+	batchSize          int
+	paused             *runtime.DynamicValue[bool]
+
+	// only accessed by the replication loop: lastSynced is the time up to
+	// which the objects of a shard are known to be replicated
+	lastDeleteSync time.Time
+	lastSynced     map[string]time.Time
+
+	lag        *prometheus.GaugeVec
+	objects    *prometheus.CounterVec
+	replErrors *prometheus.CounterVec
+
+	closeOnce sync.Once
+	stopCh    chan struct{}
+}
+
+func NewReplicator(params ReplicatorParams) (*Replicator, error) {
+	checkpoints, err := loadCheckpoints(params.CheckpointPath)
+	if err != nil {
+		return nil, err
+	}
+	reg := promauto.With(params.MetricsRegisterer)
+	return &Replicator{
+		logger:             params.Logger.WithField("action", replicatorLogAction),
+		source:             params.Source,
+		schemaReader:       params.SchemaReader,
+		leader:             params.Leader,
+		target:             params.Target,
+		nodeName:           params.NodeName,
+		members:            params.Members,
+		checkpoints:        checkpoints,
+		collections:        params.Collections,
+		interval:           params.Interval,
+		deleteSyncInterval: params.DeleteSyncInterval,
+		batchSize:          params.BatchSize,
+		paused:             params.Paused,
+		lastSynced:         map[string]time.Time{},
+
+		lag: reg.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "weaviate_cross_cluster_replication_lag_seconds",
+			Help: "Time since the objects of a local shard were last known to be replicated to the standby cluster",
+		}, []string{"class_name", "shard_name"}),
+		objects: reg.NewCounterVec(prometheus.CounterOpts{
+			Name: "weaviate_cross_cluster_replication_objects_total",
+			Help: "Number of objects upserted or deleted on the standby cluster",
+		}, []string{"class_name", "operation"}),
+		replErrors: reg.NewCounterVec(prometheus.CounterOpts{
+			Name: "weaviate_cross_cluster_replication_errors_total",
+			Help: "Number of failed cross-cluster replication attempts",
+		}, []string{"class_name"}),
+
+		stopCh: make(chan struct{}),
+	}, nil
+}
+
+// Start runs replication rounds until ctx is cancelled, Close is called or
+// the standby has been promoted.
+func (r *Replicator) Start(ctx context.Context) {
+	r.logger.WithFields(logrus.Fields{
+		"collections":          r.collections,
+		"interval":             r.interval,
+		"delete_sync_interval": r.deleteSyncInterval,
+	}).Info("starting cross-cluster replication")
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			r.logger.Info("stopping cross-cluster replication")
+			return
+		case <-r.stopCh:
+			r.logger.Info("stopping cross-cluster replication")
+			return
+		case <-ticker.C:
+			if r.paused != nil && r.paused.Get() {
+				continue
+			}
+			if err := r.Replicate(ctx); err != nil {
+				if errors.Is(err, ErrFenced) {
+					r.logger.WithError(err).Error("standby has been promoted, stopping cross-cluster replication")
+					return
+				}
+				r.logger.WithError(err).Warn("cross-cluster replication round failed")
+			}
+		}
+	}
+}
+
+func (r *Replicator) Close() {
+	r.closeOnce.Do(func() { close(r.stopCh) })
+}
+
+// Replicate runs a single replication round over all collections. A failure
+// of one collection does not prevent the others from being replicated.
+func (r *Replicator) Replicate(ctx context.Context) error {
+	isLeader := r.leader.IsLeader()
+	syncDeletes := isLeader && time.Since(r.lastDeleteSync) >= r.deleteSyncInterval
+
+	var live map[string]struct{}
+	if r.members != nil {
+		names := r.members.AllNames()
+		live = make(map[string]struct{}, len(names))
+		for _, name := range names {
+			live[name] = struct{}{}
+		}
+	}
+
+	var errs []error
+	for _, name := range r.collections {
+		if err := r.replicateClass(ctx, name, live, isLeader, syncDeletes); err != nil {
+			if errors.Is(err, ErrFenced) {
+				return err
+			}
+			r.replErrors.WithLabelValues(name).Inc()
+			errs = append(errs, fmt.Errorf("collection %q: %w", name, err))
+		}
+	}
+	if syncDeletes && len(errs) == 0 {
+		r.lastDeleteSync = time.Now()
+	}
+	return errors.Join(errs...)
+}
+
+func (r *Replicator) replicateClass(ctx context.Context, name string, live map[string]struct{},
+	isLeader, syncDeletes bool,
+) error {
+	class := r.schemaReader.ReadOnlyClass(name)
+	if class == nil {
+		return fmt.Errorf("not found")
+	}
+	state := r.schemaReader.CopyShardingState(name)
+	if state == nil {
+		return fmt.Errorf("sharding state not found")
+	}
+	multiTenant := schema.MultiTenancyEnabled(class)
+
+	if isLeader {
+		if err := r.syncSchema(ctx, class, state, multiTenant); err != nil {
+			return fmt.Errorf("sync schema: %w", err)
+		}
+	}
+
+	var errs []error
+	for _, shard := range sortedShardNames(state) {
+		physical := state.Physical[shard]
+		if multiTenant && !hot(physical) {
+			continue
+		}
+		if !r.replicatesShard(physical, live) {
+			continue
+		}
+		if err := r.replicateShard(ctx, name, shard, multiTenant); err != nil {
+			if errors.Is(err, ErrFenced) {
+				return err
+			}
+			errs = append(errs, fmt.Errorf("shard %q: %w", shard, err))
+		}
+	}
+
+	if syncDeletes {
+		tenants := []string{""}
+		if multiTenant {
+			tenants = tenants[:0]
+			for _, shard := range sortedShardNames(state) {
+				if hot(state.Physical[shard]) {
+					tenants = append(tenants, shard)
+				}
+			}
+		}
+		for _, tenant := range tenants {
+			if err := r.syncDeletes(ctx, name, tenant); err != nil {
+				if errors.Is(err, ErrFenced) {
+					return err
+				}
+				errs = append(errs, fmt.Errorf("sync deletes of tenant %q: %w", tenant, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// syncSchema creates the class on the standby if missing, otherwise adds the
+// properties and tenants it lacks. A class which already exists on the
+// standby is not updated otherwise, which allows to create it beforehand
+// with different sharding or replication settings.
+func (r *Replicator) syncSchema(ctx context.Context, class *models.Class, state *sharding.State, multiTenant bool) error {
+	existing, err := r.target.GetClass(ctx, class.Class)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		// the standby likely has a different number of nodes, let it choose
+		// the sharding of the class
+		cls := *class
+		cls.ShardingConfig = nil
+		if err := r.target.CreateClass(ctx, &cls); err != nil {
+			return err
+		}
+		r.logger.WithField("class_name", class.Class).Info("created class on standby")
+	} else {
+		for _, prop := range class.Properties {
+			if slices.ContainsFunc(existing.Properties, func(p *models.Property) bool {
+				return strings.EqualFold(p.Name, prop.Name)
+			}) {
+				continue
+			}
+			if err := r.target.AddProperty(ctx, class.Class, prop); err != nil {
+				return fmt.Errorf("property %q: %w", prop.Name, err)
+			}
+		}
+	}
+
+	if !multiTenant {
+		return nil
+	}
+	targetTenants, err := r.target.GetTenants(ctx, class.Class)
+	if err != nil {
+		return err
+	}
+	known := make(map[string]struct{}, len(targetTenants))
+	for _, t := range targetTenants {
+		known[t.Name] = struct{}{}
+	}
+	var missing []*models.Tenant
+	for _, name := range sortedShardNames(state) {
+		if _, ok := known[name]; ok {
+			continue
+		}
+		missing = append(missing, &models.Tenant{Name: name, ActivityStatus: models.TenantActivityStatusHOT})
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	return r.target.AddTenants(ctx, class.Class, missing)
+}
+
+// replicatesShard returns whether the local node sends the objects of the
+// shard, which is the case if it is the first of its live replicas. A nil
+// live set considers all replicas live.
+func (r *Replicator) replicatesShard(physical sharding.Physical, live map[string]struct{}) bool {
+	nodes := slices.Clone(physical.BelongsToNodes)
+	slices.Sort(nodes)
+	for _, node := range nodes {
+		if live == nil {
+			return node == r.nodeName
+		}
+		if _, ok := live[node]; ok {
+			return node == r.nodeName
+		}
+	}
+	return false
+}
+
+// replicateShard sends the objects of a local shard updated since its last
+// checkpoint, minus the scan overlap, and records the new checkpoint once
+// all of them were accepted.
+func (r *Replicator) replicateShard(ctx context.Context, class, shard string, multiTenant bool) error {
+	key := checkpointKey(class, shard)
+	since := r.checkpoints.get(class, shard)
+	if _, ok := r.lastSynced[key]; !ok && since > 0 {
+		r.lastSynced[key] = time.UnixMilli(since)
+	}
+	defer func() {
+		if synced, ok := r.lastSynced[key]; ok {
+			r.lag.WithLabelValues(class, shard).Set(time.Since(synced).Seconds())
+		}
+	}()
+
+	scanStart := time.Now()
+	scanFrom := since
+	if since > 0 {
+		scanFrom = max(since-scanOverlap.Milliseconds(), 0)
+	}
+
+	var tenant string
+	if multiTenant {
+		tenant = shard
+	}
+	// the ids are paged through, like the objects are fetched, so a shard is
+	// never held in memory as a whole
+	var after strfmt.UUID
+	for {
+		ids, err := r.source.LocalObjectIDsUpdatedSince(ctx, class, shard, scanFrom, after, r.batchSize)
+		if err != nil {
+			return err
+		}
+		if err := r.sendObjects(ctx, class, shard, tenant, ids); err != nil {
+			return err
+		}
+		if len(ids) < r.batchSize {
+			break
+		}
+		after = ids[len(ids)-1]
+	}
+
+	if err := r.checkpoints.set(class, shard, scanStart.UnixMilli()); err != nil {
+		return err
+	}
+	r.lastSynced[key] = scanStart
+	return nil
+}
+
+// sendObjects sends the objects with the given ids to the standby
+func (r *Replicator) sendObjects(ctx context.Context, class, shard, tenant string, ids []strfmt.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	objs, err := r.source.LocalObjectsByID(ctx, class, shard, ids)
+	if err != nil {
+		return err
+	}
+	batch := make([]*models.Object, 0, len(objs))
+	for _, obj := range objs {
+		if obj == nil {
+			// deleted in the meantime, the next delete sync catches up
+			continue
+		}
+		batch = append(batch, toModel(obj, tenant))
+	}
+	if len(batch) == 0 {
+		return nil
+	}
+	if err := r.target.PutObjects(ctx, batch); err != nil {
+		return err
+	}
+	r.objects.WithLabelValues(class, "upsert").Add(float64(len(batch)))
+	return nil
+}
+
+// syncDeletes deletes the objects of the standby which do not exist in the
+// local cluster anymore. The existence of a page of ids is checked at once,
+// and an object is only deleted if no replica has it.
+func (r *Replicator) syncDeletes(ctx context.Context, class, tenant string) error {
+	var after strfmt.UUID
+	for {
+		ids, err := r.target.ListObjectIDs(ctx, class, tenant, after, r.batchSize)
+		if err != nil {
+			return err
+		}
+		exists, err := r.source.MultiExists(ctx, class, tenant, ids)
+		if err != nil {
+			return fmt.Errorf("check objects: %w", err)
+		}
+		for i, id := range ids {
+			if exists[i] {
+				continue
+			}
+			if err := r.target.DeleteObject(ctx, class, tenant, id); err != nil {
+				return err
+			}
+			r.objects.WithLabelValues(class, "delete").Inc()
+		}
+		if len(ids) < r.batchSize {
+			return nil
+		}
+		after = ids[len(ids)-1]
+	}
+}
+
+func toModel(obj *storobj.Object, tenant string) *models.Object {
+	m := obj.Object
+	m.Additional = nil
+	m.Vector = obj.Vector
+	m.Vectors = obj.GetVectors()
+	m.Tenant = tenant
+	return &m
+}
+
+func hot(physical sharding.Physical) bool {
+	return physical.ActivityStatus() == models.TenantActivityStatusHOT
+}
+
+func sortedShardNames(state *sharding.State) []string {
+	names := make([]string, 0, len(state.Physical))
+	for name := range state.Physical {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
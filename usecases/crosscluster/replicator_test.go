@@ -0,0 +1,314 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package crosscluster
+
+import (
+	"context"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+const testClass = "Article"
+
+type fakeSource struct {
+	objects          map[strfmt.UUID]*storobj.Object
+	multiExistsCalls int
+}
+
+func (f *fakeSource) LocalObjectIDsUpdatedSince(_ context.Context, _, _ string, since int64,
+	after strfmt.UUID, limit int,
+) ([]strfmt.UUID, error) {
+	var ids []strfmt.UUID
+	for id, obj := range f.objects {
+		if id > after && obj.LastUpdateTimeUnix() >= since {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	return ids[:min(limit, len(ids))], nil
+}
+
+func (f *fakeSource) LocalObjectsByID(_ context.Context, _, _ string, ids []strfmt.UUID) ([]*storobj.Object, error) {
+	objs := make([]*storobj.Object, len(ids))
+	for i, id := range ids {
+		objs[i] = f.objects[id]
+	}
+	return objs, nil
+}
+
+func (f *fakeSource) MultiExists(_ context.Context, _, _ string, ids []strfmt.UUID) ([]bool, error) {
+	f.multiExistsCalls++
+	exists := make([]bool, len(ids))
+	for i, id := range ids {
+		_, exists[i] = f.objects[id]
+	}
+	return exists, nil
+}
+
+func (f *fakeSource) put(id strfmt.UUID, updateTime int64) {
+	obj := storobj.FromObject(&models.Object{
+		ID:                 id,
+		Class:              testClass,
+		Properties:         map[string]interface{}{"title": string(id)},
+		LastUpdateTimeUnix: updateTime,
+	}, []float32{1, 2, 3}, nil, nil)
+	f.objects[id] = obj
+}
+
+type fakeSchemaReader struct {
+	class *models.Class
+	state *sharding.State
+}
+
+func (f *fakeSchemaReader) ReadOnlyClass(name string) *models.Class {
+	if f.class.Class != name {
+		return nil
+	}
+	return f.class
+}
+
+func (f *fakeSchemaReader) CopyShardingState(string) *sharding.State {
+	return f.state
+}
+
+type fakeLeader bool
+
+func (f fakeLeader) IsLeader() bool {
+	return bool(f)
+}
+
+type fakeMembers []string
+
+func (f fakeMembers) AllNames() []string {
+	return f
+}
+
+type fakeTarget struct {
+	class   *models.Class
+	objects map[strfmt.UUID]*models.Object
+	puts    int
+	fenced  bool
+}
+
+func (f *fakeTarget) GetClass(_ context.Context, name string) (*models.Class, error) {
+	if f.fenced {
+		return nil, ErrFenced
+	}
+	return f.class, nil
+}
+
+func (f *fakeTarget) CreateClass(_ context.Context, class *models.Class) error {
+	f.class = class
+	return nil
+}
+
+func (f *fakeTarget) AddProperty(_ context.Context, _ string, prop *models.Property) error {
+	f.class.Properties = append(f.class.Properties, prop)
+	return nil
+}
+
+func (f *fakeTarget) GetTenants(context.Context, string) ([]*models.Tenant, error) {
+	return nil, nil
+}
+
+func (f *fakeTarget) AddTenants(context.Context, string, []*models.Tenant) error {
+	return nil
+}
+
+func (f *fakeTarget) PutObjects(_ context.Context, objs []*models.Object) error {
+	if f.fenced {
+		return ErrFenced
+	}
+	for _, obj := range objs {
+		f.objects[obj.ID] = obj
+		f.puts++
+	}
+	return nil
+}
+
+func (f *fakeTarget) ListObjectIDs(_ context.Context, _, _ string, after strfmt.UUID, limit int) ([]strfmt.UUID, error) {
+	var ids []strfmt.UUID
+	for id := range f.objects {
+		if id > after {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	return ids[:min(limit, len(ids))], nil
+}
+
+func (f *fakeTarget) DeleteObject(_ context.Context, _, _ string, id strfmt.UUID) error {
+	delete(f.objects, id)
+	return nil
+}
+
+func newTestReplicator(t *testing.T, dir string, source Source, target Target, leader bool) *Replicator {
+	logger, _ := test.NewNullLogger()
+	r, err := NewReplicator(ReplicatorParams{
+		Logger: logger,
+		Source: source,
+		SchemaReader: &fakeSchemaReader{
+			class: &models.Class{
+				Class:          testClass,
+				Properties:     []*models.Property{{Name: "title", DataType: []string{"text"}}},
+				ShardingConfig: map[string]interface{}{"desiredCount": 3},
+			},
+			state: &sharding.State{Physical: map[string]sharding.Physical{
+				"S1": {Name: "S1", BelongsToNodes: []string{"node2", "node1"}},
+			}},
+		},
+		Leader:             fakeLeader(leader),
+		Target:             target,
+		NodeName:           "node1",
+		CheckpointPath:     filepath.Join(dir, CheckpointsFileName),
+		MetricsRegisterer:  prometheus.NewPedanticRegistry(),
+		Collections:        []string{testClass},
+		Interval:           time.Second,
+		DeleteSyncInterval: time.Hour,
+		BatchSize:          2,
+	})
+	require.NoError(t, err)
+	return r
+}
+
+func TestReplicator(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	source := &fakeSource{objects: map[strfmt.UUID]*storobj.Object{}}
+	target := &fakeTarget{objects: map[strfmt.UUID]*models.Object{}}
+
+	old := time.Now().Add(-time.Hour).UnixMilli()
+	for _, id := range []strfmt.UUID{"1", "2", "3"} {
+		source.put(id, old)
+	}
+
+	r := newTestReplicator(t, dir, source, target, true)
+	require.NoError(t, r.Replicate(ctx))
+
+	require.NotNil(t, target.class)
+	assert.Nil(t, target.class.ShardingConfig)
+	assert.Len(t, target.objects, 3)
+	assert.Equal(t, models.C11yVector{1, 2, 3}, target.objects["1"].Vector)
+	assert.Equal(t, 3, target.puts)
+
+	// a restarted replicator resumes from the checkpoint, only sending the
+	// objects updated since and deleting the ones deleted locally
+	source.put("4", time.Now().UnixMilli())
+	delete(source.objects, "2")
+	source.multiExistsCalls = 0
+	r = newTestReplicator(t, dir, source, target, true)
+	require.NoError(t, r.Replicate(ctx))
+
+	assert.Equal(t, 4, target.puts)
+	// the existence of the 4 ids of the standby is checked per page of 2
+	assert.Equal(t, 3, source.multiExistsCalls)
+	assert.Len(t, target.objects, 3)
+	assert.NotContains(t, target.objects, strfmt.UUID("2"))
+	assert.Contains(t, target.objects, strfmt.UUID("4"))
+
+	// deletes are only synced once per interval
+	delete(source.objects, "3")
+	require.NoError(t, r.Replicate(ctx))
+	assert.Contains(t, target.objects, strfmt.UUID("3"))
+}
+
+func TestReplicatorFollower(t *testing.T) {
+	source := &fakeSource{objects: map[strfmt.UUID]*storobj.Object{}}
+	source.put("1", time.Now().UnixMilli())
+	target := &fakeTarget{objects: map[strfmt.UUID]*models.Object{}}
+
+	r := newTestReplicator(t, t.TempDir(), source, target, false)
+	require.NoError(t, r.Replicate(context.Background()))
+
+	// followers leave the schema to the leader but send their shards
+	assert.Nil(t, target.class)
+	assert.Len(t, target.objects, 1)
+
+	// node1 is not the first replica of S2
+	r.schemaReader.(*fakeSchemaReader).state.Physical = map[string]sharding.Physical{
+		"S2": {Name: "S2", BelongsToNodes: []string{"node0", "node1"}},
+	}
+	source.put("2", time.Now().UnixMilli())
+	require.NoError(t, r.Replicate(context.Background()))
+	assert.Len(t, target.objects, 1)
+
+	// but takes over while node0 is down
+	r.members = fakeMembers{"node1", "node2"}
+	require.NoError(t, r.Replicate(context.Background()))
+	assert.Len(t, target.objects, 2)
+}
+
+func TestReplicatorRescansOverlap(t *testing.T) {
+	ctx := context.Background()
+	source := &fakeSource{objects: map[strfmt.UUID]*storobj.Object{}}
+	target := &fakeTarget{objects: map[strfmt.UUID]*models.Object{}}
+	r := newTestReplicator(t, t.TempDir(), source, target, false)
+
+	checkpoint := time.Now().Add(-time.Minute)
+	require.NoError(t, r.checkpoints.set(testClass, "S1", checkpoint.UnixMilli()))
+	// updated right before the checkpoint but persisted after it
+	source.put("1", checkpoint.Add(-scanOverlap/2).UnixMilli())
+	source.put("2", checkpoint.Add(-2*scanOverlap).UnixMilli())
+
+	require.NoError(t, r.Replicate(ctx))
+	assert.Contains(t, target.objects, strfmt.UUID("1"))
+	assert.NotContains(t, target.objects, strfmt.UUID("2"))
+}
+
+func TestReplicatorStopsWhenFenced(t *testing.T) {
+	source := &fakeSource{objects: map[strfmt.UUID]*storobj.Object{}}
+	source.put("1", time.Now().UnixMilli())
+	target := &fakeTarget{objects: map[strfmt.UUID]*models.Object{}, fenced: true}
+
+	r := newTestReplicator(t, t.TempDir(), source, target, true)
+	r.interval = time.Millisecond
+	done := make(chan struct{})
+	go func() {
+		r.Start(context.Background())
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		r.Close()
+		t.Fatal("replicator did not stop after the standby was promoted")
+	}
+}
+
+func TestCheckpoints(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", CheckpointsFileName)
+
+	c, err := loadCheckpoints(path)
+	require.NoError(t, err)
+	assert.Zero(t, c.get("C1", "S1"))
+
+	require.NoError(t, c.set("C1", "S1", 42))
+	require.NoError(t, c.set("C1", "S2", 7))
+
+	c, err = loadCheckpoints(path)
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), c.get("C1", "S1"))
+	assert.Equal(t, int64(7), c.get("C1", "S2"))
+}
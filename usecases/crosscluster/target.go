@@ -0,0 +1,189 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package crosscluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	apiclient "github.com/weaviate/weaviate/client"
+	"github.com/weaviate/weaviate/client/batch"
+	"github.com/weaviate/weaviate/client/objects"
+	"github.com/weaviate/weaviate/client/schema"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// Target is the standby cluster collections are replicated to.
+type Target interface {
+	// GetClass returns nil if the class does not exist on the target.
+	GetClass(ctx context.Context, name string) (*models.Class, error)
+	CreateClass(ctx context.Context, class *models.Class) error
+	AddProperty(ctx context.Context, class string, prop *models.Property) error
+	GetTenants(ctx context.Context, class string) ([]*models.Tenant, error)
+	AddTenants(ctx context.Context, class string, tenants []*models.Tenant) error
+	// PutObjects creates or replaces the objects, including their vectors and
+	// timestamps.
+	PutObjects(ctx context.Context, objects []*models.Object) error
+	// ListObjectIDs returns up to limit ids of the class following after, in
+	// ascending order.
+	ListObjectIDs(ctx context.Context, class, tenant string, after strfmt.UUID, limit int) ([]strfmt.UUID, error)
+	// DeleteObject succeeds if the object does not exist.
+	DeleteObject(ctx context.Context, class, tenant string, id strfmt.UUID) error
+}
+
+// RESTTarget replicates to a standby through its REST API. Every request
+// carries the ReplicationSourceHeader, the standby answers with a conflict
+// once it has been promoted.
+type RESTTarget struct {
+	client   *apiclient.Weaviate
+	authInfo runtime.ClientAuthInfoWriter
+}
+
+// NewRESTTarget returns a target for the cluster at rawURL, e.g.
+// https://standby.example.com:8080. The api key is optional.
+func NewRESTTarget(rawURL, apiKey, sourceNode string) (*RESTTarget, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("parse target url: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return nil, fmt.Errorf("invalid target url %q: expected scheme://host[:port]", rawURL)
+	}
+	basePath := strings.TrimSuffix(u.Path, "/") + apiclient.DefaultBasePath
+	transport := httptransport.New(u.Host, basePath, []string{u.Scheme})
+
+	authInfo := runtime.ClientAuthInfoWriterFunc(func(r runtime.ClientRequest, _ strfmt.Registry) error {
+		if err := r.SetHeaderParam(ReplicationSourceHeader, sourceNode); err != nil {
+			return err
+		}
+		if apiKey == "" {
+			return nil
+		}
+		return r.SetHeaderParam("Authorization", "Bearer "+apiKey)
+	})
+	return &RESTTarget{client: apiclient.New(transport, strfmt.Default), authInfo: authInfo}, nil
+}
+
+func (t *RESTTarget) GetClass(ctx context.Context, name string) (*models.Class, error) {
+	params := schema.NewSchemaObjectsGetParamsWithContext(ctx).WithClassName(name)
+	res, err := t.client.Schema.SchemaObjectsGet(params, t.authInfo)
+	if err != nil {
+		var notFound *schema.SchemaObjectsGetNotFound
+		if errors.As(err, &notFound) {
+			return nil, nil
+		}
+		return nil, targetErr("get class", err)
+	}
+	return res.Payload, nil
+}
+
+func (t *RESTTarget) CreateClass(ctx context.Context, class *models.Class) error {
+	params := schema.NewSchemaObjectsCreateParamsWithContext(ctx).WithObjectClass(class)
+	if _, err := t.client.Schema.SchemaObjectsCreate(params, t.authInfo); err != nil {
+		return targetErr("create class", err)
+	}
+	return nil
+}
+
+func (t *RESTTarget) AddProperty(ctx context.Context, class string, prop *models.Property) error {
+	params := schema.NewSchemaObjectsPropertiesAddParamsWithContext(ctx).
+		WithClassName(class).WithBody(prop)
+	if _, err := t.client.Schema.SchemaObjectsPropertiesAdd(params, t.authInfo); err != nil {
+		return targetErr("add property", err)
+	}
+	return nil
+}
+
+func (t *RESTTarget) GetTenants(ctx context.Context, class string) ([]*models.Tenant, error) {
+	params := schema.NewTenantsGetParamsWithContext(ctx).WithClassName(class)
+	res, err := t.client.Schema.TenantsGet(params, t.authInfo)
+	if err != nil {
+		return nil, targetErr("get tenants", err)
+	}
+	return res.Payload, nil
+}
+
+func (t *RESTTarget) AddTenants(ctx context.Context, class string, tenants []*models.Tenant) error {
+	params := schema.NewTenantsCreateParamsWithContext(ctx).
+		WithClassName(class).WithBody(tenants)
+	if _, err := t.client.Schema.TenantsCreate(params, t.authInfo); err != nil {
+		return targetErr("add tenants", err)
+	}
+	return nil
+}
+
+func (t *RESTTarget) PutObjects(ctx context.Context, objs []*models.Object) error {
+	params := batch.NewBatchObjectsCreateParamsWithContext(ctx).
+		WithBody(batch.BatchObjectsCreateBody{Objects: objs})
+	res, err := t.client.Batch.BatchObjectsCreate(params, t.authInfo)
+	if err != nil {
+		return targetErr("put objects", err)
+	}
+	for i, r := range res.Payload {
+		if r.Result == nil || r.Result.Errors == nil || len(r.Result.Errors.Error) == 0 {
+			continue
+		}
+		return fmt.Errorf("put object %s: %s", objs[i].ID, r.Result.Errors.Error[0].Message)
+	}
+	return nil
+}
+
+func (t *RESTTarget) ListObjectIDs(ctx context.Context, class, tenant string, after strfmt.UUID, limit int) ([]strfmt.UUID, error) {
+	afterID, limit64 := after.String(), int64(limit)
+	params := objects.NewObjectsListParamsWithContext(ctx).
+		WithClass(&class).WithAfter(&afterID).WithLimit(&limit64)
+	if tenant != "" {
+		params.WithTenant(&tenant)
+	}
+	res, err := t.client.Objects.ObjectsList(params, t.authInfo)
+	if err != nil {
+		return nil, targetErr("list objects", err)
+	}
+	ids := make([]strfmt.UUID, len(res.Payload.Objects))
+	for i, obj := range res.Payload.Objects {
+		ids[i] = obj.ID
+	}
+	return ids, nil
+}
+
+func (t *RESTTarget) DeleteObject(ctx context.Context, class, tenant string, id strfmt.UUID) error {
+	params := objects.NewObjectsClassDeleteParamsWithContext(ctx).WithClassName(class).WithID(id)
+	if tenant != "" {
+		params.WithTenant(&tenant)
+	}
+	if _, err := t.client.Objects.ObjectsClassDelete(params, t.authInfo); err != nil {
+		var notFound *objects.ObjectsClassDeleteNotFound
+		if errors.As(err, &notFound) {
+			return nil
+		}
+		return targetErr("delete object", err)
+	}
+	return nil
+}
+
+// targetErr wraps ErrFenced if the standby rejected the request because it
+// has been promoted.
+func targetErr(op string, err error) error {
+	var coded interface{ IsCode(int) bool }
+	if errors.As(err, &coded) && coded.IsCode(http.StatusConflict) {
+		return fmt.Errorf("%s: %w", op, ErrFenced)
+	}
+	return fmt.Errorf("%s: %w", op, err)
+}
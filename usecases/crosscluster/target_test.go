@@ -0,0 +1,97 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package crosscluster
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// fakeStandby stands in for the REST API of the standby cluster.
+func fakeStandby(t *testing.T, promoted *bool) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/schema/{class}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	mux.HandleFunc("POST /v1/batch/objects", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "node1", r.Header.Get(ReplicationSourceHeader))
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		if *promoted {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		var body struct {
+			Objects []*models.Object `json:"objects"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		res := make([]*models.ObjectsGetResponse, len(body.Objects))
+		for i, obj := range body.Objects {
+			res[i] = &models.ObjectsGetResponse{Object: *obj, Result: &models.ObjectsGetResponseAO2Result{}}
+			if obj.Class == "Invalid" {
+				res[i].Result.Errors = &models.ErrorResponse{Error: []*models.ErrorResponseErrorItems0{{Message: "invalid class"}}}
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		require.NoError(t, json.NewEncoder(w).Encode(res))
+	})
+	mux.HandleFunc("DELETE /v1/objects/{class}/{id}", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "tenant1", r.URL.Query().Get("tenant"))
+		w.WriteHeader(http.StatusNotFound)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestRESTTarget(t *testing.T) {
+	ctx := context.Background()
+	promoted := false
+	standby := fakeStandby(t, &promoted)
+	defer standby.Close()
+
+	target, err := NewRESTTarget(standby.URL, "secret", "node1")
+	require.NoError(t, err)
+
+	class, err := target.GetClass(ctx, "Article")
+	require.NoError(t, err)
+	assert.Nil(t, class)
+
+	require.NoError(t, target.PutObjects(ctx, []*models.Object{
+		{ID: "c8f8d1a0-9f0e-4b7a-9d5f-3c1a2b3c4d5e", Class: "Article"},
+	}))
+	err = target.PutObjects(ctx, []*models.Object{
+		{ID: "c8f8d1a0-9f0e-4b7a-9d5f-3c1a2b3c4d5e", Class: "Invalid"},
+	})
+	assert.ErrorContains(t, err, "invalid class")
+
+	// deleting a missing object succeeds
+	require.NoError(t, target.DeleteObject(ctx, "Article", "tenant1", "c8f8d1a0-9f0e-4b7a-9d5f-3c1a2b3c4d5e"))
+
+	promoted = true
+	err = target.PutObjects(ctx, []*models.Object{
+		{ID: "c8f8d1a0-9f0e-4b7a-9d5f-3c1a2b3c4d5e", Class: "Article"},
+	})
+	assert.ErrorIs(t, err, ErrFenced)
+}
+
+func TestNewRESTTarget(t *testing.T) {
+	for _, rawURL := range []string{"standby:8080", "ftp://standby", "http://"} {
+		_, err := NewRESTTarget(rawURL, "", "node1")
+		assert.Error(t, err, rawURL)
+	}
+}
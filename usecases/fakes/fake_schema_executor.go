@@ -53,6 +53,16 @@ func (m *MockSchemaExecutor) DeleteReplicaFromShard(class string, shard string,
 	return args.Error(0)
 }
 
+func (m *MockSchemaExecutor) DropShard(class string, shard string) error {
+	args := m.Called(class, shard)
+	return args.Error(0)
+}
+
+func (m *MockSchemaExecutor) SwapVectorIndex(class, targetVector string, submittedAt int64) error {
+	args := m.Called(class, targetVector, submittedAt)
+	return args.Error(0)
+}
+
 func (m *MockSchemaExecutor) UpdateIndex(req cmd.UpdateClassRequest) error {
 	args := m.Called(req)
 	return args.Error(0)
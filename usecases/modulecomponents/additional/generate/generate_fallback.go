@@ -0,0 +1,137 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package generate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+const generativeModulePrefix = "generative-"
+
+// fallback is the generative module a collection answers with when its
+// configured provider fails, declared in the settings of the configured
+// module, e.g.
+//
+//	"generative-openai": {"fallback": {"module": "generative-cohere", "model": "command-r"}}
+//
+// The module defaults to the configured one, so that a fallback can also be
+// just another model of the same provider.
+type fallback struct {
+	module string
+	client modulecapabilities.GenerativeClient
+	cfg    moduletools.ClassConfig
+}
+
+func (p *GenerateProvider) getFallback(provider string, cfg moduletools.ClassConfig) *fallback {
+	if cfg == nil {
+		return nil
+	}
+	settings, ok := cfg.Class()["fallback"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	module, _ := settings["module"].(string)
+	model, _ := settings["model"].(string)
+	if module == "" {
+		module = generativeModulePrefix + provider
+	}
+	fallbackProvider := strings.TrimPrefix(module, generativeModulePrefix)
+	if fallbackProvider == provider && model == "" {
+		return nil
+	}
+	client, err := p.getClient(fallbackProvider)
+	if err != nil {
+		p.logger.WithField("action", "generate_fallback").
+			Warnf("fallback module %q of the collection is not enabled", module)
+		return nil
+	}
+
+	moduleSettings := map[string]interface{}{}
+	for key, value := range cfg.ClassByModuleName(module) {
+		if key != "fallback" {
+			moduleSettings[key] = value
+		}
+	}
+	if model != "" {
+		moduleSettings["model"] = model
+	}
+	return &fallback{
+		module: module,
+		client: client,
+		cfg:    &fallbackClassConfig{ClassConfig: cfg, module: module, settings: moduleSettings},
+	}
+}
+
+// fallbackClassConfig makes the fallback module read its own settings with
+// the model of the fallback, also when the collection is not configured with
+// the module
+type fallbackClassConfig struct {
+	moduletools.ClassConfig
+	module   string
+	settings map[string]interface{}
+}
+
+func (c *fallbackClassConfig) Class() map[string]interface{} {
+	return c.settings
+}
+
+func (c *fallbackClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	if moduleName == c.module {
+		return c.settings
+	}
+	return c.ClassConfig.ClassByModuleName(moduleName)
+}
+
+type generateFn func(client modulecapabilities.GenerativeClient, settings interface{},
+	cfg moduletools.ClassConfig, onDelta modulecapabilities.GenerateStreamFn,
+) (*modulecapabilities.GenerateResponse, error)
+
+// withFallback generates with the configured client and retries with the
+// fallback if that fails. Generations which have already streamed a part of
+// their result and canceled queries are not retried. The query settings are
+// meant for the configured provider, the fallback only uses the settings of
+// the collection.
+func (p *GenerateProvider) withFallback(ctx context.Context, fb *fallback,
+	client modulecapabilities.GenerativeClient, settings interface{}, cfg moduletools.ClassConfig,
+	onDelta modulecapabilities.GenerateStreamFn, generate generateFn,
+) (*modulecapabilities.GenerateResponse, error) {
+	if fb == nil {
+		return generate(client, settings, cfg, onDelta)
+	}
+
+	var streamed atomic.Bool
+	tracked := onDelta
+	if onDelta != nil {
+		tracked = func(text string) error {
+			streamed.Store(true)
+			return onDelta(text)
+		}
+	}
+	res, err := generate(client, settings, cfg, tracked)
+	if err == nil || streamed.Load() || ctx.Err() != nil {
+		return res, err
+	}
+
+	p.logger.WithField("action", "generate_fallback").WithError(err).
+		Warnf("generating with fallback module %q", fb.module)
+	fallbackRes, fallbackErr := generate(fb.client, nil, fb.cfg, onDelta)
+	if fallbackErr != nil {
+		return res, fmt.Errorf("%w, fallback %s: %w", err, fb.module, fallbackErr)
+	}
+	return fallbackRes, nil
+}
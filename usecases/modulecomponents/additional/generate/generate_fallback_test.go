@@ -0,0 +1,151 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package generate
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+func TestGenerateFallback(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	prompt, task := "prompt", "task"
+
+	run := func(t *testing.T, primary, secondary modulecapabilities.GenerativeClient,
+		cfg moduletools.ClassConfig,
+	) map[string]interface{} {
+		provider := NewGeneric(map[string]modulecapabilities.GenerativeProperty{
+			"openai": {Client: primary},
+			"cohere": {Client: secondary},
+		}, "openai", logger)
+		in := []search.Result{{ID: "uuid-1", Schema: map[string]interface{}{}}}
+		limit := 1
+		_, err := provider.AdditionalPropertyFn(context.Background(), in, &Params{Prompt: &prompt, Task: &task},
+			&limit, nil, cfg)
+		require.Nil(t, err)
+		return in[0].AdditionalProperties["generate"].(map[string]interface{})
+	}
+
+	t.Run("fallback module and model", func(t *testing.T) {
+		secondary := &fakeModelClient{}
+		generate := run(t, &fakeFailingClient{}, secondary, fakeFallbackClassConfig{
+			"generative-openai": {"model": "gpt-4o", "fallback": map[string]interface{}{
+				"module": "generative-cohere", "model": "command-r",
+			}},
+			"generative-cohere": {"temperature": 0.5},
+		})
+
+		assert.Nil(t, generate["error"])
+		assert.Equal(t, "command-r: prompt", *generate["singleResult"].(*string))
+		assert.Equal(t, "command-r: task", *generate["groupedResult"].(*string))
+		assert.Equal(t, 0.5, secondary.settings["temperature"])
+	})
+
+	t.Run("fallback model of the same module", func(t *testing.T) {
+		primary := &fakeModelClient{fail: "gpt-4o"}
+		generate := run(t, primary, &fakeFailingClient{}, fakeFallbackClassConfig{
+			"generative-openai": {"model": "gpt-4o", "fallback": map[string]interface{}{"model": "gpt-4o-mini"}},
+		})
+
+		assert.Nil(t, generate["error"])
+		assert.Equal(t, "gpt-4o-mini: prompt", *generate["singleResult"].(*string))
+		assert.NotContains(t, primary.settings, "fallback")
+	})
+
+	t.Run("no fallback", func(t *testing.T) {
+		generate := run(t, &fakeFailingClient{}, &fakeModelClient{}, fakeFallbackClassConfig{
+			"generative-openai": {"model": "gpt-4o"},
+		})
+
+		assert.EqualError(t, generate["error"].(error), "provider down")
+		assert.Nil(t, generate["singleResult"])
+	})
+
+	t.Run("failing fallback", func(t *testing.T) {
+		generate := run(t, &fakeFailingClient{}, &fakeFailingClient{}, fakeFallbackClassConfig{
+			"generative-openai": {"fallback": map[string]interface{}{"module": "generative-cohere"}},
+		})
+
+		assert.EqualError(t, generate["error"].(error), "provider down, fallback generative-cohere: provider down")
+	})
+}
+
+type fakeFallbackClassConfig map[string]map[string]interface{}
+
+func (c fakeFallbackClassConfig) Class() map[string]interface{} {
+	return c["generative-openai"]
+}
+
+func (c fakeFallbackClassConfig) ClassByModuleName(moduleName string) map[string]interface{} {
+	return c[moduleName]
+}
+
+func (c fakeFallbackClassConfig) Property(propName string) map[string]interface{} {
+	return nil
+}
+
+func (c fakeFallbackClassConfig) PropertiesDataTypes() map[string]schema.DataType {
+	return nil
+}
+
+func (c fakeFallbackClassConfig) Tenant() string {
+	return ""
+}
+
+func (c fakeFallbackClassConfig) TargetVector() string {
+	return ""
+}
+
+type fakeFailingClient struct{}
+
+func (c *fakeFailingClient) GenerateAllResults(ctx context.Context, properties []*modulecapabilities.GenerateProperties, task string, settings interface{}, debug bool, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateResponse, error) {
+	return nil, errors.New("provider down")
+}
+
+func (c *fakeFailingClient) GenerateSingleResult(ctx context.Context, properties *modulecapabilities.GenerateProperties, prompt string, settings interface{}, debug bool, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateResponse, error) {
+	return nil, errors.New("provider down")
+}
+
+// fakeModelClient answers with the model it reads from the class settings,
+// it fails for the model in fail
+type fakeModelClient struct {
+	fail     string
+	settings map[string]interface{}
+}
+
+func (c *fakeModelClient) GenerateAllResults(ctx context.Context, properties []*modulecapabilities.GenerateProperties, task string, settings interface{}, debug bool, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateResponse, error) {
+	return c.generate(task, cfg)
+}
+
+func (c *fakeModelClient) GenerateSingleResult(ctx context.Context, properties *modulecapabilities.GenerateProperties, prompt string, settings interface{}, debug bool, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateResponse, error) {
+	return c.generate(prompt, cfg)
+}
+
+func (c *fakeModelClient) generate(input string, cfg moduletools.ClassConfig) (*modulecapabilities.GenerateResponse, error) {
+	c.settings = cfg.Class()
+	model, _ := c.settings["model"].(string)
+	if model == c.fail {
+		return nil, errors.New("provider down")
+	}
+	result := model + ": " + input
+	return &modulecapabilities.GenerateResponse{Result: &result}, nil
+}
@@ -46,15 +46,17 @@ func (p *GenerateProvider) generateResult(ctx context.Context,
 		return nil, err
 	}
 
+	fb := p.getFallback(provider, cfg)
+
 	var propertyDataTypes map[string]schema.DataType
 	if cfg != nil {
 		propertyDataTypes = cfg.PropertiesDataTypes() // do once for all results to avoid loops over the schema
 	}
 	if task != nil {
-		_, err = p.generateForAllSearchResults(ctx, in, *task, properties, client, settings, debug, cfg, propertyDataTypes)
+		_, err = p.generateForAllSearchResults(ctx, in, *task, properties, client, fb, settings, debug, cfg, propertyDataTypes)
 	}
 	if prompt != nil {
-		_, err = p.generatePerSearchResult(ctx, in, *prompt, client, settings, debug, cfg, propertyDataTypes)
+		_, err = p.generatePerSearchResult(ctx, in, *prompt, client, fb, settings, debug, cfg, propertyDataTypes)
 	}
 
 	return in, err
@@ -88,6 +90,7 @@ func (p *GenerateProvider) generatePerSearchResult(ctx context.Context,
 	in []search.Result,
 	prompt string,
 	client modulecapabilities.GenerativeClient,
+	fb *fallback,
 	settings interface{},
 	debug bool,
 	cfg moduletools.ClassConfig,
@@ -106,7 +109,12 @@ func (p *GenerateProvider) generatePerSearchResult(ctx context.Context,
 			if propertyDataTypes != nil {
 				props = p.getProperties(in[i], nil, propertyDataTypes)
 			}
-			generateResult, err := client.GenerateSingleResult(ctx, props, prompt, settings, debug, cfg)
+			generateResult, err := p.withFallback(ctx, fb, client, settings, cfg, deltaFn(ctx, in[i].ID),
+				func(client modulecapabilities.GenerativeClient, settings interface{},
+					cfg moduletools.ClassConfig, onDelta modulecapabilities.GenerateStreamFn,
+				) (*modulecapabilities.GenerateResponse, error) {
+					return generateSingleResult(ctx, client, props, prompt, settings, debug, cfg, onDelta)
+				})
 			p.setIndividualResult(in, i, generateResult, err)
 		}, p.logger)
 	}
@@ -119,6 +127,7 @@ func (p *GenerateProvider) generateForAllSearchResults(ctx context.Context,
 	task string,
 	properties []string,
 	client modulecapabilities.GenerativeClient,
+	fb *fallback,
 	settings interface{},
 	debug bool,
 	cfg moduletools.ClassConfig,
@@ -130,7 +139,12 @@ func (p *GenerateProvider) generateForAllSearchResults(ctx context.Context,
 			propertiesForAllDocs = append(propertiesForAllDocs, p.getProperties(res, properties, propertyDataTypes))
 		}
 	}
-	generateResult, err := client.GenerateAllResults(ctx, propertiesForAllDocs, task, settings, debug, cfg)
+	generateResult, err := p.withFallback(ctx, fb, client, settings, cfg, deltaFn(ctx, ""),
+		func(client modulecapabilities.GenerativeClient, settings interface{},
+			cfg moduletools.ClassConfig, onDelta modulecapabilities.GenerateStreamFn,
+		) (*modulecapabilities.GenerateResponse, error) {
+			return generateAllResults(ctx, client, propertiesForAllDocs, task, settings, debug, cfg, onDelta)
+		})
 	p.setCombinedResult(in, 0, generateResult, err)
 	return in, nil
 }
@@ -0,0 +1,97 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package generate
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+// StreamDelta is a piece of a generated response
+type StreamDelta struct {
+	// ID is the object the single result belongs to, it is empty for the
+	// grouped result
+	ID   strfmt.UUID
+	Text string
+}
+
+// StreamFn receives the deltas of all generations of a query. Single results
+// are generated concurrently, so it must be safe for concurrent use.
+type StreamFn func(delta StreamDelta) error
+
+type streamCtxKey struct{}
+
+// WithStream returns a context which makes the generate provider forward the
+// generated text to fn while it is produced
+func WithStream(ctx context.Context, fn StreamFn) context.Context {
+	return context.WithValue(ctx, streamCtxKey{}, fn)
+}
+
+func streamFromContext(ctx context.Context) StreamFn {
+	fn, _ := ctx.Value(streamCtxKey{}).(StreamFn)
+	return fn
+}
+
+// deltaFn binds the deltas of one generation to its object, it returns nil if
+// the query is not streamed
+func deltaFn(ctx context.Context, id strfmt.UUID) modulecapabilities.GenerateStreamFn {
+	stream := streamFromContext(ctx)
+	if stream == nil {
+		return nil
+	}
+	return func(text string) error {
+		return stream(StreamDelta{ID: id, Text: text})
+	}
+}
+
+func generateSingleResult(ctx context.Context, client modulecapabilities.GenerativeClient,
+	properties *modulecapabilities.GenerateProperties, prompt string, settings interface{}, debug bool,
+	cfg moduletools.ClassConfig, onDelta modulecapabilities.GenerateStreamFn,
+) (*modulecapabilities.GenerateResponse, error) {
+	if onDelta == nil {
+		return client.GenerateSingleResult(ctx, properties, prompt, settings, debug, cfg)
+	}
+	if streaming, ok := client.(modulecapabilities.GenerativeStreamingClient); ok {
+		return streaming.GenerateSingleResultStream(ctx, properties, prompt, settings, debug, cfg, onDelta)
+	}
+	res, err := client.GenerateSingleResult(ctx, properties, prompt, settings, debug, cfg)
+	return sendAsOneDelta(res, err, onDelta)
+}
+
+func generateAllResults(ctx context.Context, client modulecapabilities.GenerativeClient,
+	properties []*modulecapabilities.GenerateProperties, task string, settings interface{}, debug bool,
+	cfg moduletools.ClassConfig, onDelta modulecapabilities.GenerateStreamFn,
+) (*modulecapabilities.GenerateResponse, error) {
+	if onDelta == nil {
+		return client.GenerateAllResults(ctx, properties, task, settings, debug, cfg)
+	}
+	if streaming, ok := client.(modulecapabilities.GenerativeStreamingClient); ok {
+		return streaming.GenerateAllResultsStream(ctx, properties, task, settings, debug, cfg, onDelta)
+	}
+	res, err := client.GenerateAllResults(ctx, properties, task, settings, debug, cfg)
+	return sendAsOneDelta(res, err, onDelta)
+}
+
+// sendAsOneDelta streams the result of a client which cannot stream by itself
+// as a single delta once it is complete
+func sendAsOneDelta(res *modulecapabilities.GenerateResponse, err error,
+	onDelta modulecapabilities.GenerateStreamFn,
+) (*modulecapabilities.GenerateResponse, error) {
+	if err != nil || res == nil || res.Result == nil {
+		return res, err
+	}
+	return res, onDelta(*res.Result)
+}
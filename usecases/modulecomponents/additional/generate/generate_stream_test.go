@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package generate
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+func TestGenerateStream(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	prompt, task := "prompt", "task"
+
+	run := func(t *testing.T, client modulecapabilities.GenerativeClient) ([]search.Result, []StreamDelta) {
+		provider := NewGeneric(map[string]modulecapabilities.GenerativeProperty{
+			"dummy": {Client: client},
+		}, "dummy", logger)
+		in := []search.Result{
+			{ID: "uuid-1", Schema: map[string]interface{}{}},
+			{ID: "uuid-2", Schema: map[string]interface{}{}},
+		}
+
+		var lock sync.Mutex
+		var deltas []StreamDelta
+		ctx := WithStream(context.Background(), func(delta StreamDelta) error {
+			lock.Lock()
+			defer lock.Unlock()
+			deltas = append(deltas, delta)
+			return nil
+		})
+		limit := 2
+		_, err := provider.AdditionalPropertyFn(ctx, in, &Params{Prompt: &prompt, Task: &task},
+			&limit, nil, nil)
+		require.Nil(t, err)
+		sort.SliceStable(deltas, func(i, j int) bool { return deltas[i].ID < deltas[j].ID })
+		return in, deltas
+	}
+
+	t.Run("streaming client", func(t *testing.T) {
+		in, deltas := run(t, &fakeStreamingClient{})
+		assert.Equal(t, []StreamDelta{
+			{Text: "ta"},
+			{Text: "sk"},
+			{ID: "uuid-1", Text: "pr"},
+			{ID: "uuid-1", Text: "om"},
+			{ID: "uuid-1", Text: "pt"},
+			{ID: "uuid-2", Text: "pr"},
+			{ID: "uuid-2", Text: "om"},
+			{ID: "uuid-2", Text: "pt"},
+		}, deltas)
+
+		generate := in[0].AdditionalProperties["generate"].(map[string]interface{})
+		assert.Equal(t, "prompt", *generate["singleResult"].(*string))
+		assert.Equal(t, "task", *generate["groupedResult"].(*string))
+	})
+
+	t.Run("client without streaming sends complete results", func(t *testing.T) {
+		in, deltas := run(t, &fakeClient{})
+		assert.Equal(t, []StreamDelta{
+			{Text: "task"}, {ID: "uuid-1", Text: "prompt"}, {ID: "uuid-2", Text: "prompt"},
+		}, deltas)
+
+		generate := in[1].AdditionalProperties["generate"].(map[string]interface{})
+		assert.Equal(t, "prompt", *generate["singleResult"].(*string))
+	})
+}
+
+type fakeStreamingClient struct {
+	fakeClient
+}
+
+func (c *fakeStreamingClient) GenerateSingleResultStream(ctx context.Context, properties *modulecapabilities.GenerateProperties,
+	prompt string, settings interface{}, debug bool, cfg moduletools.ClassConfig, onDelta modulecapabilities.GenerateStreamFn,
+) (*modulecapabilities.GenerateResponse, error) {
+	return c.stream(prompt, onDelta)
+}
+
+func (c *fakeStreamingClient) GenerateAllResultsStream(ctx context.Context, properties []*modulecapabilities.GenerateProperties,
+	task string, settings interface{}, debug bool, cfg moduletools.ClassConfig, onDelta modulecapabilities.GenerateStreamFn,
+) (*modulecapabilities.GenerateResponse, error) {
+	return c.stream(task, onDelta)
+}
+
+// stream sends the text two characters at a time
+func (c *fakeStreamingClient) stream(text string, onDelta modulecapabilities.GenerateStreamFn) (*modulecapabilities.GenerateResponse, error) {
+	var result strings.Builder
+	for i := 0; i < len(text); i += 2 {
+		delta := text[i:min(i+2, len(text))]
+		if err := onDelta(delta); err != nil {
+			return nil, err
+		}
+		result.WriteString(delta)
+	}
+	out := result.String()
+	return &modulecapabilities.GenerateResponse{Result: &out}, nil
+}
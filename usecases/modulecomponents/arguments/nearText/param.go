@@ -38,6 +38,8 @@ type NearTextParams struct {
 	Network       bool
 	Autocorrect   bool
 	TargetVectors []string
+	// Vectorizer overrides the vectorizer module config of the target vector
+	Vectorizer map[string]interface{}
 }
 
 func (n NearTextParams) GetCertainty() float64 {
@@ -56,6 +58,10 @@ func (n NearTextParams) GetTargetVectors() []string {
 	return n.TargetVectors
 }
 
+func (n NearTextParams) GetVectorizer() map[string]interface{} {
+	return n.Vectorizer
+}
+
 func (n NearTextParams) Validate() error {
 	if n.MoveTo.Force > 0 &&
 		n.MoveTo.Values == nil && n.MoveTo.Objects == nil {
@@ -471,13 +471,34 @@ func (b *Batch[T]) makeRequest(job BatchJob[T], texts []string, cfg moduletools.
 func (b *Batch[T]) SubmitBatchAndWait(ctx context.Context, cfg moduletools.ClassConfig, skipObject []bool, tokenCounts []int, texts []string) ([]T, map[int]error) {
 	vecs := make([]T, len(skipObject))
 	errs := make(map[int]error)
-	wg := sync.WaitGroup{}
-	wg.Add(1)
 
+	// texts found in the embedding cache are skipped and neither count
+	// against the rate limits nor are they sent to the provider
+	keys, cacheKeys, skip := b.lookupEmbeddings(ctx, cfg, texts, skipObject, vecs)
 	tokenSum := 0
+	pending := false
 	for i := range tokenCounts {
+		if skip[i] {
+			continue
+		}
+		pending = true
 		tokenSum += tokenCounts[i]
 	}
+	if keys != nil && !pending {
+		return vecs, errs
+	}
+	if keys != nil {
+		counts := make([]int, len(tokenCounts))
+		for i := range tokenCounts {
+			if !skip[i] {
+				counts[i] = tokenCounts[i]
+			}
+		}
+		tokenCounts = counts
+	}
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
 
 	monitoring.GetMetrics().T2VTokensInBatch.WithLabelValues(b.Label).
 		Observe(float64(tokenSum))
@@ -491,7 +512,7 @@ func (b *Batch[T]) SubmitBatchAndWait(ctx context.Context, cfg moduletools.Class
 		texts:      texts,
 		tokens:     tokenCounts,
 		vecs:       vecs,
-		skipObject: skipObject,
+		skipObject: skip,
 		apiKeyHash: b.client.GetApiKeyHash(ctx, cfg),
 		startTime:  time.Now(),
 		tokenSum:   tokenSum,
@@ -502,6 +523,7 @@ func (b *Batch[T]) SubmitBatchAndWait(ctx context.Context, cfg moduletools.Class
 		Observe(time.Since(beforeEnqueue).Seconds())
 
 	wg.Wait()
+	b.storeEmbeddings(keys, cacheKeys, skip, vecs, errs)
 
 	// observe total duration
 	monitoring.GetMetrics().T2VBatchQueueDuration.WithLabelValues(b.Label, "total").
@@ -0,0 +1,273 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package batch
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"math"
+	"sync"
+
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/usecases/modulecomponents"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+)
+
+// EmbeddingCache stores vectors by a hash of the vectorized text and the
+// module settings that produced them. Implementations are node-local and may
+// drop entries at any time.
+type EmbeddingCache interface {
+	Get(key []byte) ([]byte, bool)
+	Put(key, value []byte)
+}
+
+var (
+	embeddingCacheLock sync.RWMutex
+	embeddingCache     EmbeddingCache
+)
+
+// SetEmbeddingCache makes all vectorizers consult the cache before calling
+// their provider. A nil cache disables caching.
+func SetEmbeddingCache(cache EmbeddingCache) {
+	embeddingCacheLock.Lock()
+	defer embeddingCacheLock.Unlock()
+	embeddingCache = cache
+}
+
+func getEmbeddingCache() EmbeddingCache {
+	embeddingCacheLock.RLock()
+	defer embeddingCacheLock.RUnlock()
+	return embeddingCache
+}
+
+// embeddingCacheKeys derives cache keys for texts vectorized with the same
+// module settings. The settings are hashed once per batch.
+type embeddingCacheKeys struct {
+	cache  EmbeddingCache
+	label  string
+	prefix []byte
+}
+
+// embeddingCacheHeaders are the request headers overriding the endpoint,
+// deployment or model a vector is produced by. Their values are part of the
+// cache key, a header missing here makes vectors of different models share
+// cache entries.
+var embeddingCacheHeaders = []string{
+	"X-Openai-Baseurl",
+	"X-Azure-Resource-Name",
+	"X-Azure-Deployment-Id",
+	"X-Cohere-Baseurl",
+	"X-Databricks-Endpoint",
+	"X-Nvidia-Baseurl",
+	"X-Voyageai-Baseurl",
+	"X-Weaviate-Baseurl",
+	"X-Weaviate-Cluster-Url",
+	"X-Weaviate-Embedding-Model",
+}
+
+// newEmbeddingCacheKeys returns nil if no cache is configured. The settings
+// include the model and everything else that changes the resulting vector,
+// such as dimensions or the base URL, as configured on the class and as
+// overridden by the request headers.
+func newEmbeddingCacheKeys(ctx context.Context, label string, cfg moduletools.ClassConfig) *embeddingCacheKeys {
+	cache := getEmbeddingCache()
+	if cache == nil || cfg == nil {
+		return nil
+	}
+	settings, err := json.Marshal(cfg.Class())
+	if err != nil {
+		return nil
+	}
+
+	h := sha256.New()
+	h.Write([]byte(label))
+	h.Write([]byte{0})
+	h.Write(settings)
+	for _, header := range embeddingCacheHeaders {
+		if value := modulecomponents.GetValueFromContext(ctx, header); value != "" {
+			h.Write([]byte{0})
+			h.Write([]byte(header))
+			h.Write([]byte{0})
+			h.Write([]byte(value))
+		}
+	}
+	return &embeddingCacheKeys{cache: cache, label: label, prefix: h.Sum(nil)}
+}
+
+func (k *embeddingCacheKeys) key(text string) []byte {
+	h := sha256.New()
+	h.Write(k.prefix)
+	h.Write([]byte(text))
+	return h.Sum(nil)
+}
+
+func lookupEmbedding[T dto.Embedding](k *embeddingCacheKeys, key []byte) (T, bool) {
+	if data, ok := k.cache.Get(key); ok {
+		if vec, ok := decodeEmbedding[T](data); ok {
+			monitoring.GetMetrics().T2VEmbeddingCacheRequests.WithLabelValues(k.label, "hit").Inc()
+			return vec, true
+		}
+	}
+	monitoring.GetMetrics().T2VEmbeddingCacheRequests.WithLabelValues(k.label, "miss").Inc()
+	return nil, false
+}
+
+func putEmbedding[T dto.Embedding](cache EmbeddingCache, key []byte, vec T) {
+	if cache != nil && key != nil && len(vec) > 0 {
+		cache.Put(key, encodeEmbedding(vec))
+	}
+}
+
+// CachedEmbedding returns the cached vector of a single text. The returned
+// key is passed to CacheEmbedding once the text has been vectorized.
+func (b *Batch[T]) CachedEmbedding(ctx context.Context, cfg moduletools.ClassConfig, text string) (T, []byte, bool) {
+	keys := newEmbeddingCacheKeys(ctx, b.Label, cfg)
+	if keys == nil {
+		return nil, nil, false
+	}
+	key := keys.key(text)
+	vec, ok := lookupEmbedding[T](keys, key)
+	return vec, key, ok
+}
+
+// CacheEmbedding stores the vector of a single text under a key returned by
+// CachedEmbedding. It does nothing if caching is disabled.
+func (b *Batch[T]) CacheEmbedding(key []byte, vec T) {
+	putEmbedding(getEmbeddingCache(), key, vec)
+}
+
+// lookupEmbeddings fills vecs with the cached vectors of the texts that are
+// not skipped. It returns the cache keys of all texts (nil if caching is
+// disabled) and a copy of skipObject in which cache hits are skipped as well.
+func (b *Batch[T]) lookupEmbeddings(ctx context.Context, cfg moduletools.ClassConfig, texts []string,
+	skipObject []bool, vecs []T,
+) (*embeddingCacheKeys, [][]byte, []bool) {
+	keys := newEmbeddingCacheKeys(ctx, b.Label, cfg)
+	if keys == nil {
+		return nil, nil, skipObject
+	}
+
+	cacheKeys := make([][]byte, len(texts))
+	skip := make([]bool, len(skipObject))
+	copy(skip, skipObject)
+	for i := range texts {
+		if skip[i] {
+			continue
+		}
+		cacheKeys[i] = keys.key(texts[i])
+		if vec, ok := lookupEmbedding[T](keys, cacheKeys[i]); ok {
+			vecs[i] = vec
+			skip[i] = true
+		}
+	}
+	return keys, cacheKeys, skip
+}
+
+// storeEmbeddings caches the vectors returned by the provider, i.e. those
+// that were neither skipped nor found in the cache.
+func (b *Batch[T]) storeEmbeddings(keys *embeddingCacheKeys, cacheKeys [][]byte, skip []bool,
+	vecs []T, errs map[int]error,
+) {
+	if keys == nil {
+		return
+	}
+	for i := range vecs {
+		if _, failed := errs[i]; failed || skip[i] {
+			continue
+		}
+		putEmbedding(keys.cache, cacheKeys[i], vecs[i])
+	}
+}
+
+// encodeEmbedding writes a single vector as little endian float32s. A multi
+// vector is prefixed with the number of vectors and each vector with its
+// length.
+func encodeEmbedding[T dto.Embedding](vec T) []byte {
+	switch v := any(vec).(type) {
+	case []float32:
+		return appendFloats(make([]byte, 0, 4*len(v)), v)
+	case [][]float32:
+		size := 4
+		for i := range v {
+			size += 4 + 4*len(v[i])
+		}
+		out := binary.LittleEndian.AppendUint32(make([]byte, 0, size), uint32(len(v)))
+		for i := range v {
+			out = binary.LittleEndian.AppendUint32(out, uint32(len(v[i])))
+			out = appendFloats(out, v[i])
+		}
+		return out
+	default:
+		return nil
+	}
+}
+
+func decodeEmbedding[T dto.Embedding](data []byte) (T, bool) {
+	var vec T
+	switch any(vec).(type) {
+	case []float32:
+		if len(data)%4 != 0 {
+			return nil, false
+		}
+		floats, ok := readFloats(data, len(data)/4)
+		if !ok || len(floats) == 0 {
+			return nil, false
+		}
+		return any(floats).(T), true
+	case [][]float32:
+		if len(data) < 4 {
+			return nil, false
+		}
+		n := binary.LittleEndian.Uint32(data)
+		data = data[4:]
+		multi := make([][]float32, 0, n)
+		for i := uint32(0); i < n; i++ {
+			if len(data) < 4 {
+				return nil, false
+			}
+			length := int(binary.LittleEndian.Uint32(data))
+			floats, ok := readFloats(data[4:], length)
+			if !ok {
+				return nil, false
+			}
+			multi = append(multi, floats)
+			data = data[4+4*length:]
+		}
+		if len(multi) == 0 || len(data) != 0 {
+			return nil, false
+		}
+		return any(multi).(T), true
+	default:
+		return nil, false
+	}
+}
+
+func appendFloats(out []byte, floats []float32) []byte {
+	for _, f := range floats {
+		out = binary.LittleEndian.AppendUint32(out, math.Float32bits(f))
+	}
+	return out
+}
+
+func readFloats(data []byte, n int) ([]float32, bool) {
+	if n < 0 || len(data) < 4*n {
+		return nil, false
+	}
+	floats := make([]float32, n)
+	for i := range floats {
+		floats[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[4*i:]))
+	}
+	return floats, true
+}
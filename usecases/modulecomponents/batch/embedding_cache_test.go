@@ -0,0 +1,147 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package batch
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/usecases/modulecomponents"
+)
+
+type fakeEmbeddingCache struct {
+	sync.Mutex
+	entries map[string][]byte
+}
+
+func (c *fakeEmbeddingCache) Get(key []byte) ([]byte, bool) {
+	c.Lock()
+	defer c.Unlock()
+	v, ok := c.entries[string(key)]
+	return v, ok
+}
+
+func (c *fakeEmbeddingCache) Put(key, value []byte) {
+	c.Lock()
+	defer c.Unlock()
+	c.entries[string(key)] = value
+}
+
+type countingBatchClient struct {
+	fakeBatchClientWithoutRL[[]float32]
+	sync.Mutex
+	sent []string
+}
+
+func (c *countingBatchClient) Vectorize(ctx context.Context,
+	text []string, cfg moduletools.ClassConfig,
+) (*modulecomponents.VectorizationResult[[]float32], *modulecomponents.RateLimits, int, error) {
+	c.Lock()
+	c.sent = append(c.sent, text...)
+	c.Unlock()
+	return c.fakeBatchClientWithoutRL.Vectorize(ctx, text, cfg)
+}
+
+func TestBatchEmbeddingCache(t *testing.T) {
+	cache := &fakeEmbeddingCache{entries: map[string][]byte{}}
+	SetEmbeddingCache(cache)
+	defer SetEmbeddingCache(nil)
+
+	logger, _ := test.NewNullLogger()
+	cfg := &fakeClassConfig{classConfig: map[string]interface{}{"vectorizeClassName": false}}
+	client := &countingBatchClient{}
+	v := NewBatchVectorizer[[]float32](client, 1*time.Second,
+		Settings{MaxObjectsPerBatch: 2000, MaxTokensPerBatch: maxTokensPerBatch, MaxTimePerBatch: 10},
+		logger, "test")
+
+	submit := func(texts []string, skip []bool) ([][]float32, map[int]error) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		tokenCounts := make([]int, len(texts))
+		for i := range tokenCounts {
+			tokenCounts[i] = 5
+		}
+		return v.SubmitBatchAndWait(ctx, cfg, skip, tokenCounts, texts)
+	}
+
+	vecs, errs := submit([]string{"first", "error failed", "skipped"}, []bool{false, false, true})
+	require.Len(t, errs, 1)
+	require.Equal(t, []float32{0, 1, 2, 3}, vecs[0])
+	require.Nil(t, vecs[2])
+	require.Equal(t, []string{"first", "error failed"}, client.sent)
+
+	client.sent = nil
+	vecs, errs = submit([]string{"first", "error failed", "second"}, []bool{false, false, false})
+	require.Len(t, errs, 1)
+	require.Equal(t, []float32{0, 1, 2, 3}, vecs[0])
+	require.Equal(t, []float32{0, 1, 2, 3}, vecs[2])
+	// errors are never cached, so the failing text is sent again
+	require.Equal(t, []string{"error failed", "second"}, client.sent)
+
+	client.sent = nil
+	vecs, errs = submit([]string{"first", "second"}, []bool{false, false})
+	require.Empty(t, errs)
+	require.Len(t, vecs, 2)
+	require.Empty(t, client.sent)
+
+	// a different class configuration must not share cached embeddings
+	other := &fakeClassConfig{classConfig: map[string]interface{}{"vectorizeClassName": true}}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	_, errs = v.SubmitBatchAndWait(ctx, other, []bool{false}, []int{5}, []string{"first"})
+	require.Empty(t, errs)
+	require.Equal(t, []string{"first"}, client.sent)
+
+	// neither must a request sent to a different endpoint
+	client.sent = nil
+	headerCtx := context.WithValue(ctx, "X-Openai-Baseurl", []string{"https://proxy.example.com"})
+	_, errs = v.SubmitBatchAndWait(headerCtx, cfg, []bool{false}, []int{5}, []string{"first"})
+	require.Empty(t, errs)
+	require.Equal(t, []string{"first"}, client.sent)
+
+	client.sent = nil
+	_, errs = v.SubmitBatchAndWait(headerCtx, cfg, []bool{false}, []int{5}, []string{"first"})
+	require.Empty(t, errs)
+	require.Empty(t, client.sent)
+}
+
+func TestEmbeddingCacheEncoding(t *testing.T) {
+	t.Run("single vector", func(t *testing.T) {
+		vec := []float32{0.5, -1, 3.25}
+		decoded, ok := decodeEmbedding[[]float32](encodeEmbedding(vec))
+		require.True(t, ok)
+		require.Equal(t, vec, decoded)
+	})
+
+	t.Run("multi vector", func(t *testing.T) {
+		vec := [][]float32{{1, 2}, {3, 4, 5}, {}}
+		decoded, ok := decodeEmbedding[[][]float32](encodeEmbedding(vec))
+		require.True(t, ok)
+		require.Len(t, decoded, 3)
+		require.Equal(t, vec[0], decoded[0])
+		require.Equal(t, vec[1], decoded[1])
+		require.Empty(t, decoded[2])
+	})
+
+	t.Run("corrupt entry", func(t *testing.T) {
+		_, ok := decodeEmbedding[[]float32]([]byte{1, 2, 3})
+		require.False(t, ok)
+		_, ok = decodeEmbedding[[][]float32]([]byte{5, 0, 0, 0})
+		require.False(t, ok)
+	})
+}
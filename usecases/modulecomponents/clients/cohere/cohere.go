@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 
 	"github.com/weaviate/weaviate/usecases/modulecomponents"
 
@@ -96,10 +97,8 @@ type Settings struct {
 
 func New(apiKey string, timeout time.Duration, logger logrus.FieldLogger) *Client {
 	return &Client{
-		apiKey: apiKey,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
+		apiKey:     apiKey,
+		httpClient: httpclient.New("cohere", timeout),
 		urlBuilder: newCohereUrlBuilder(),
 		logger:     logger,
 	}
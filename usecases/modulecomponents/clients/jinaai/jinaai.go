@@ -25,6 +25,7 @@ import (
 
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
 
 	"github.com/weaviate/weaviate/usecases/modulecomponents"
 
@@ -117,13 +118,11 @@ func New[T dto.Embedding](jinaAIApiKey string,
 ) *Client[T] {
 	return &Client[T]{
 		jinaAIApiKey: jinaAIApiKey,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
-		buildUrlFn: buildUrlFn,
-		defaultRPM: defaultRPM,
-		defaultTPM: defaultTPM,
-		logger:     logger,
+		httpClient:   httpclient.New("jinaai", timeout),
+		buildUrlFn:   buildUrlFn,
+		defaultRPM:   defaultRPM,
+		defaultTPM:   defaultTPM,
+		logger:       logger,
 	}
 }
 
@@ -0,0 +1,62 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package generative
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ReadServerSentEvents(t *testing.T) {
+	type ev struct{ event, data string }
+
+	t.Run("events and data", func(t *testing.T) {
+		body := ": keep-alive\n\n" +
+			"event: message_start\ndata: {\"a\":1}\n\n" +
+			"data: first\ndata: second\n\n" +
+			"data: [DONE]"
+		var got []ev
+		err := ReadServerSentEvents(strings.NewReader(body), func(event, data string) error {
+			got = append(got, ev{event, data})
+			return nil
+		})
+		require.Nil(t, err)
+		require.Equal(t, []ev{
+			{"message_start", `{"a":1}`},
+			{"", "first\nsecond"},
+			{"", "[DONE]"},
+		}, got)
+	})
+
+	t.Run("stops on callback error", func(t *testing.T) {
+		calls := 0
+		err := ReadServerSentEvents(strings.NewReader("data: a\n\ndata: b\n\n"), func(event, data string) error {
+			calls++
+			return errors.New("stop")
+		})
+		require.EqualError(t, err, "stop")
+		require.Equal(t, 1, calls)
+	})
+}
+
+func Test_ReadJSONLines(t *testing.T) {
+	var got []string
+	err := ReadJSONLines(strings.NewReader("{\"a\":1}\n\n  {\"b\":2}\n"), func(line []byte) error {
+		got = append(got, string(line))
+		return nil
+	})
+	require.Nil(t, err)
+	require.Equal(t, []string{`{"a":1}`, `{"b":2}`}, got)
+}
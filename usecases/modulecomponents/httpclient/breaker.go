@@ -0,0 +1,205 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// State of the circuit breaker of a provider
+type State string
+
+const (
+	// StateClosed lets all requests through
+	StateClosed State = "closed"
+	// StateOpen fails requests without sending them
+	StateOpen State = "open"
+	// StateHalfOpen lets a single request through to probe the provider
+	StateHalfOpen State = "half_open"
+)
+
+// Health is the state of a provider as seen by the requests of this node
+type Health struct {
+	Provider string
+	// Host the requests were sent to, a provider has a circuit per host, so
+	// that a failing self-hosted or regional endpoint does not fail the
+	// requests to the other endpoints of the same provider
+	Host                string
+	State               State
+	ConsecutiveFailures int
+	LastError           string
+	LastFailure         time.Time
+}
+
+type breakerKey struct {
+	provider string
+	host     string
+}
+
+var (
+	breakersLock sync.Mutex
+	breakers     = map[breakerKey]*breaker{}
+)
+
+func breakerFor(provider, host string) *breaker {
+	key := breakerKey{provider: provider, host: host}
+	breakersLock.Lock()
+	defer breakersLock.Unlock()
+	b, ok := breakers[key]
+	if !ok {
+		b = &breaker{provider: provider, host: host, state: StateClosed}
+		breakers[key] = b
+	}
+	return b
+}
+
+// ProviderHealth returns the health of the least healthy host of a provider,
+// it is false for providers no request has been sent to
+func ProviderHealth(provider string) (Health, bool) {
+	breakersLock.Lock()
+	var hosts []*breaker
+	for key, b := range breakers {
+		if key.provider == provider {
+			hosts = append(hosts, b)
+		}
+	}
+	breakersLock.Unlock()
+
+	var (
+		worst Health
+		found bool
+	)
+	for _, b := range hosts {
+		health := b.health()
+		if !found || health.worseThan(worst) {
+			worst, found = health, true
+		}
+	}
+	return worst, found
+}
+
+// Providers returns the health of all hosts of all providers, ordered by
+// provider and host
+func Providers() []Health {
+	breakersLock.Lock()
+	all := make([]*breaker, 0, len(breakers))
+	for _, b := range breakers {
+		all = append(all, b)
+	}
+	breakersLock.Unlock()
+
+	health := make([]Health, len(all))
+	for i, b := range all {
+		health[i] = b.health()
+	}
+	sort.Slice(health, func(i, j int) bool {
+		if health[i].Provider != health[j].Provider {
+			return health[i].Provider < health[j].Provider
+		}
+		return health[i].Host < health[j].Host
+	})
+	return health
+}
+
+var stateSeverity = map[State]int{StateClosed: 0, StateHalfOpen: 1, StateOpen: 2}
+
+func (h Health) worseThan(other Health) bool {
+	if stateSeverity[h.State] != stateSeverity[other.State] {
+		return stateSeverity[h.State] > stateSeverity[other.State]
+	}
+	return h.ConsecutiveFailures > other.ConsecutiveFailures
+}
+
+type breaker struct {
+	sync.Mutex
+	provider    string
+	host        string
+	state       State
+	failures    int
+	openedAt    time.Time
+	probing     bool
+	lastError   string
+	lastFailure time.Time
+}
+
+func (b *breaker) allow(cfg Config) error {
+	b.Lock()
+	defer b.Unlock()
+	switch b.state {
+	case StateOpen:
+		if time.Since(b.openedAt) < cfg.OpenDuration {
+			return ErrUnavailable
+		}
+		b.state = StateHalfOpen
+		b.probing = true
+		return nil
+	case StateHalfOpen:
+		if b.probing {
+			return ErrUnavailable
+		}
+		b.probing = true
+		return nil
+	default:
+		return nil
+	}
+}
+
+// record updates the state with the outcome of a request. Errors of the
+// provider open the circuit, any other answer closes it. Requests canceled by
+// the caller say nothing about the provider.
+func (b *breaker) record(cfg Config, res *http.Response, err error) {
+	failed := err != nil || res.StatusCode >= http.StatusInternalServerError
+	canceled := errors.Is(err, context.Canceled)
+
+	b.Lock()
+	defer b.Unlock()
+	b.probing = false
+	if canceled {
+		return
+	}
+	if !failed {
+		b.state = StateClosed
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	b.lastFailure = time.Now()
+	if err != nil {
+		b.lastError = err.Error()
+	} else {
+		b.lastError = res.Status
+	}
+	if b.state == StateHalfOpen ||
+		(cfg.FailureThreshold > 0 && b.failures >= cfg.FailureThreshold) {
+		b.state = StateOpen
+		b.openedAt = time.Now()
+	}
+}
+
+func (b *breaker) health() Health {
+	b.Lock()
+	defer b.Unlock()
+	return Health{
+		Provider:            b.provider,
+		Host:                b.host,
+		State:               b.state,
+		ConsecutiveFailures: b.failures,
+		LastError:           b.lastError,
+		LastFailure:         b.lastFailure,
+	}
+}
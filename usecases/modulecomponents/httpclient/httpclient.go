@@ -0,0 +1,101 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package httpclient is the HTTP client of the modules calling remote
+// providers. It retries transient failures with exponential backoff, honours
+// the Retry-After header of the provider and stops calling a provider host
+// which keeps failing until it recovers.
+package httpclient
+
+import (
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	DefaultMaxRetries       = 3
+	DefaultInitialBackoff   = 500 * time.Millisecond
+	DefaultMaxBackoff       = 30 * time.Second
+	DefaultFailureThreshold = 5
+	DefaultOpenDuration     = 30 * time.Second
+)
+
+// Config is shared by the clients of all providers
+type Config struct {
+	// MaxRetries is the number of times a request is repeated after a
+	// transient failure, 0 disables retries
+	MaxRetries     int
+	InitialBackoff time.Duration
+	// MaxBackoff bounds the backoff and the wait requested by Retry-After
+	MaxBackoff time.Duration
+	// FailureThreshold is the number of consecutive failed requests after
+	// which the circuit of a provider opens, 0 disables circuit breaking
+	FailureThreshold int
+	// OpenDuration is how long requests to a provider fail fast before a
+	// single request probes whether it recovered
+	OpenDuration time.Duration
+}
+
+var config atomic.Pointer[Config]
+
+func init() {
+	Configure(Config{
+		MaxRetries:       DefaultMaxRetries,
+		InitialBackoff:   DefaultInitialBackoff,
+		MaxBackoff:       DefaultMaxBackoff,
+		FailureThreshold: DefaultFailureThreshold,
+		OpenDuration:     DefaultOpenDuration,
+	})
+}
+
+// Configure replaces the config of all clients, it applies to the requests
+// sent afterwards
+func Configure(cfg Config) {
+	config.Store(&cfg)
+}
+
+func currentConfig() Config {
+	return *config.Load()
+}
+
+// Option adjusts the client of a provider
+type Option func(t *transport)
+
+// WithRateLimitRetries retries requests which the provider rejected with 429
+// Too Many Requests, waiting as long as its Retry-After header asks for. It
+// is meant for the clients of single requests like generative, reranker and
+// qna modules, which have no rate limiter of their own. The vectorizers leave
+// it out, their batch rate limiter already waits for the limits to reset.
+func WithRateLimitRetries() Option {
+	return func(t *transport) {
+		t.retryRateLimits = true
+	}
+}
+
+// New returns a client for the requests to a provider. The timeout bounds a
+// request including its retries. All clients of a provider share a circuit
+// breaker per host they send requests to. Providers are named like the suffix
+// of the modules using them, e.g. "openai" for text2vec-openai and
+// generative-openai, so their health can be attributed to the modules.
+func New(provider string, timeout time.Duration, opts ...Option) *http.Client {
+	// the timeout is applied by the transport rather than the client, so the
+	// retries know the deadline they have to finish by
+	t := &transport{
+		provider: provider,
+		next:     http.DefaultTransport,
+		timeout:  timeout,
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return &http.Client{Transport: t}
+}
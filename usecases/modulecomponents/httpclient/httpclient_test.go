@@ -0,0 +1,264 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package httpclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testConfig(t *testing.T, cfg Config) {
+	previous := currentConfig()
+	Configure(cfg)
+	t.Cleanup(func() { Configure(previous) })
+}
+
+func TestRetries(t *testing.T) {
+	testConfig(t, Config{MaxRetries: 3, InitialBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond})
+
+	t.Run("retries transient failures with the same body", func(t *testing.T) {
+		var calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.Equal(t, "payload", string(body))
+			if calls.Add(1) < 3 {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Write([]byte("ok"))
+		}))
+		defer server.Close()
+
+		res, err := New("retry-test", time.Second).Post(server.URL, "text/plain", strings.NewReader("payload"))
+		require.NoError(t, err)
+		defer res.Body.Close()
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, int32(3), calls.Load())
+	})
+
+	t.Run("returns the last answer once the retries are used up", func(t *testing.T) {
+		var calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		res, err := New("exhausted-test", time.Second).Get(server.URL)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		assert.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+		assert.Equal(t, int32(4), calls.Load())
+	})
+
+	t.Run("does not retry other errors", func(t *testing.T) {
+		var calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			w.WriteHeader(http.StatusBadRequest)
+		}))
+		defer server.Close()
+
+		res, err := New("bad-request-test", time.Second).Get(server.URL)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("leaves rate limits to the caller", func(t *testing.T) {
+		var calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer server.Close()
+
+		res, err := New("rate-limit-test", time.Second).Get(server.URL)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		assert.Equal(t, http.StatusTooManyRequests, res.StatusCode)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("retries rate limits if asked to", func(t *testing.T) {
+		var calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if calls.Add(1) < 3 {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.Write([]byte("ok"))
+		}))
+		defer server.Close()
+
+		res, err := New("rate-limit-retry-test", time.Second, WithRateLimitRetries()).Get(server.URL)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, int32(3), calls.Load())
+	})
+
+	t.Run("does not wait beyond the deadline", func(t *testing.T) {
+		testConfig(t, Config{MaxRetries: 3, InitialBackoff: time.Minute, MaxBackoff: time.Minute})
+		var calls atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		require.NoError(t, err)
+		res, err := New("deadline-test", 0).Do(req)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		assert.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+}
+
+func TestBackoff(t *testing.T) {
+	cfg := Config{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	for attempt, expected := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond} {
+		wait := backoff(cfg, attempt, nil)
+		assert.GreaterOrEqual(t, wait, expected)
+		assert.Less(t, wait, expected+expected/5+1)
+	}
+	assert.Equal(t, time.Second, backoff(cfg, 10, nil))
+
+	res := &http.Response{Header: http.Header{"Retry-After": []string{"3"}}}
+	assert.Equal(t, time.Second, backoff(cfg, 0, res), "bounded by the max backoff")
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	for header, expected := range map[string]time.Duration{
+		"2":                             2 * time.Second,
+		"0":                             0,
+		"Mon, 01 Jan 2024 12:00:30 GMT": 30 * time.Second,
+		"Mon, 01 Jan 2024 11:00:00 GMT": 0,
+	} {
+		wait, ok := retryAfter(header, now)
+		assert.True(t, ok, header)
+		assert.Equal(t, expected, wait, header)
+	}
+	for _, header := range []string{"", "-1", "soon"} {
+		_, ok := retryAfter(header, now)
+		assert.False(t, ok, header)
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	testConfig(t, Config{FailureThreshold: 2, OpenDuration: 50 * time.Millisecond})
+
+	var healthy atomic.Bool
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	provider := fmt.Sprintf("breaker-test-%d", time.Now().UnixNano())
+	client := New(provider, time.Second)
+	get := func() error {
+		res, err := client.Get(server.URL)
+		if err == nil {
+			res.Body.Close()
+		}
+		return err
+	}
+
+	require.NoError(t, get())
+	health, ok := ProviderHealth(provider)
+	require.True(t, ok)
+	assert.Equal(t, StateClosed, health.State)
+	assert.Equal(t, 1, health.ConsecutiveFailures)
+	assert.Equal(t, "500 Internal Server Error", health.LastError)
+
+	require.NoError(t, get())
+	health, _ = ProviderHealth(provider)
+	assert.Equal(t, StateOpen, health.State)
+
+	err := get()
+	assert.ErrorIs(t, err, ErrUnavailable)
+	assert.Equal(t, int32(2), calls.Load(), "open circuits do not send requests")
+
+	// the probe after the open duration fails and opens the circuit again
+	time.Sleep(60 * time.Millisecond)
+	require.NoError(t, get())
+	assert.ErrorIs(t, get(), ErrUnavailable)
+	assert.Equal(t, int32(3), calls.Load())
+
+	// a successful probe closes it
+	healthy.Store(true)
+	time.Sleep(60 * time.Millisecond)
+	require.NoError(t, get())
+	require.NoError(t, get())
+	health, _ = ProviderHealth(provider)
+	assert.Equal(t, StateClosed, health.State)
+	assert.Equal(t, 0, health.ConsecutiveFailures)
+	assert.Equal(t, int32(5), calls.Load())
+
+	assert.Contains(t, Providers(), health)
+}
+
+func TestCircuitBreakerPerHost(t *testing.T) {
+	testConfig(t, Config{FailureThreshold: 1, OpenDuration: time.Minute})
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer healthy.Close()
+
+	provider := fmt.Sprintf("breaker-host-test-%d", time.Now().UnixNano())
+	client := New(provider, time.Second)
+	get := func(url string) error {
+		res, err := client.Get(url)
+		if err == nil {
+			res.Body.Close()
+		}
+		return err
+	}
+
+	require.NoError(t, get(failing.URL))
+	assert.ErrorIs(t, get(failing.URL), ErrUnavailable)
+	require.NoError(t, get(healthy.URL), "other hosts of the provider are not affected")
+
+	health, ok := ProviderHealth(provider)
+	require.True(t, ok)
+	assert.Equal(t, StateOpen, health.State, "the provider reports its least healthy host")
+	assert.Equal(t, strings.TrimPrefix(failing.URL, "http://"), health.Host)
+}
@@ -0,0 +1,176 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ErrUnavailable is returned without sending a request while the circuit of a
+// provider is open
+var ErrUnavailable = errors.New("provider unavailable")
+
+type transport struct {
+	provider string
+	next     http.RoundTripper
+	timeout  time.Duration
+	// retryRateLimits retries 429 answers, see WithRateLimitRetries
+	retryRateLimits bool
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.timeout <= 0 {
+		return t.roundTrip(req)
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+	res, err := t.roundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	// the body is read after the round trip, within the same timeout
+	res.Body = &cancelBody{ReadCloser: res.Body, cancel: cancel}
+	return res, nil
+}
+
+func (t *transport) roundTrip(req *http.Request) (*http.Response, error) {
+	cfg := currentConfig()
+	breaker := breakerFor(t.provider, req.URL.Host)
+	if err := breaker.allow(cfg); err != nil {
+		return nil, fmt.Errorf("%s: %w", t.provider, err)
+	}
+
+	for attempt := 0; ; attempt++ {
+		res, err := t.next.RoundTrip(req)
+		if attempt >= cfg.MaxRetries || !t.retryable(req, res, err) || !rewindable(req) {
+			breaker.record(cfg, res, err)
+			return res, err
+		}
+
+		wait := backoff(cfg, attempt, res)
+		if deadline, ok := req.Context().Deadline(); ok && time.Until(deadline) < wait {
+			// the retry would not finish in time, the caller gets this answer
+			breaker.record(cfg, res, err)
+			return res, err
+		}
+		if res != nil {
+			io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+		if req, err = rewind(req); err != nil {
+			return nil, err
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// retryable reports whether a request failed for a reason which is likely
+// gone after a while: an overloaded provider or a network error. Rate limits
+// are only retried if the client asked for it, by default they are returned
+// to the caller right away. The batch rate limiter of the vectorizers already
+// waits for the limits of the provider to reset and a retry here would hold
+// the batch back for up to the max backoff.
+func (t *transport) retryable(req *http.Request, res *http.Response, err error) bool {
+	if err != nil {
+		return req.Context().Err() == nil && !errors.Is(err, context.Canceled) &&
+			!errors.Is(err, context.DeadlineExceeded)
+	}
+	switch res.StatusCode {
+	case http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	case http.StatusTooManyRequests:
+		return t.retryRateLimits
+	default:
+		return false
+	}
+}
+
+// backoff doubles the wait with every attempt and adds jitter, unless the
+// provider asked for a specific wait with Retry-After
+func backoff(cfg Config, attempt int, res *http.Response) time.Duration {
+	if res != nil {
+		if wait, ok := retryAfter(res.Header.Get("Retry-After"), time.Now()); ok {
+			return min(wait, cfg.MaxBackoff)
+		}
+	}
+	wait := cfg.InitialBackoff << attempt
+	if wait <= 0 || wait > cfg.MaxBackoff {
+		wait = cfg.MaxBackoff
+	}
+	// up to 20% jitter, so clients rate limited together do not retry together
+	if jitter := int64(wait) / 5; jitter > 0 {
+		wait += time.Duration(rand.Int63n(jitter))
+	}
+	return min(wait, cfg.MaxBackoff)
+}
+
+// retryAfter parses both forms of the header, seconds and an HTTP date
+func retryAfter(header string, now time.Time) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(header); err == nil {
+		return max(date.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+func rewindable(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// rewind prepares a request to be sent again, its body has been consumed by
+// the previous attempt
+func rewind(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, fmt.Errorf("read request body again: %w", err)
+	}
+	req = req.Clone(req.Context())
+	req.Body = body
+	return req, nil
+}
+
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
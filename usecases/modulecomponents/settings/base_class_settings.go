@@ -270,6 +270,14 @@ func (s BaseClassSettings) Validate(class *models.Class) error {
 		return err
 	}
 
+	chunking, err := NewChunkingSettings(s.GetSettings())
+	if err != nil {
+		return err
+	}
+	if chunking != nil {
+		return chunking.Validate(class)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,194 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package settings
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+const (
+	// ChunkParentProperty is the reference property linking a chunk to the
+	// object it was split from.
+	ChunkParentProperty = "parent"
+	// ChunkIndexProperty holds the position of a chunk within its parent.
+	ChunkIndexProperty = "chunkIndex"
+)
+
+const (
+	ChunkingStrategyTokens    = "tokens"
+	ChunkingStrategySentences = "sentences"
+)
+
+const (
+	DefaultChunkingStrategy   = ChunkingStrategyTokens
+	DefaultChunkSizeTokens    = 256
+	DefaultChunkSizeSentences = 5
+	DefaultChunkOverlap       = 0
+)
+
+var availableChunkingStrategies = []string{ChunkingStrategyTokens, ChunkingStrategySentences}
+
+// ChunkingSettings describe how a long text property is split into linked
+// child objects at import time. They are read from the "chunking" key of a
+// vectorizer's class settings, e.g.
+//
+//	"chunking": {
+//	  "property": "body",
+//	  "childClass": "DocumentChunk",
+//	  "strategy": "sentences",
+//	  "size": 5,
+//	  "overlap": 1
+//	}
+type ChunkingSettings struct {
+	Property   string
+	ChildClass string
+	Strategy   string
+	Size       int
+	Overlap    int
+}
+
+// NewChunkingSettings parses the chunking block of the given module settings.
+// It returns nil if chunking is not configured.
+func NewChunkingSettings(moduleSettings map[string]interface{}) (*ChunkingSettings, error) {
+	value, ok := moduleSettings["chunking"]
+	if !ok || value == nil {
+		return nil, nil
+	}
+	cfg, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("chunking needs to be an object, got: %T", value)
+	}
+
+	var err error
+	cs := &ChunkingSettings{}
+	if cs.Property, err = chunkingString(cfg, "property", ""); err != nil {
+		return nil, err
+	}
+	if cs.ChildClass, err = chunkingString(cfg, "childClass", ""); err != nil {
+		return nil, err
+	}
+	if cs.Strategy, err = chunkingString(cfg, "strategy", DefaultChunkingStrategy); err != nil {
+		return nil, err
+	}
+	defaultSize := DefaultChunkSizeTokens
+	if cs.Strategy == ChunkingStrategySentences {
+		defaultSize = DefaultChunkSizeSentences
+	}
+	if cs.Size, err = chunkingNumber(cfg, "size", defaultSize); err != nil {
+		return nil, err
+	}
+	if cs.Overlap, err = chunkingNumber(cfg, "overlap", DefaultChunkOverlap); err != nil {
+		return nil, err
+	}
+
+	return cs, nil
+}
+
+// ClassChunkingSettings returns the chunking settings of the class'
+// vectorizer, or nil if the class is not chunked.
+func ClassChunkingSettings(class *models.Class) (*ChunkingSettings, error) {
+	var moduleConfigs []interface{}
+	if modConfig, ok := class.ModuleConfig.(map[string]interface{}); ok && class.Vectorizer != "" {
+		moduleConfigs = append(moduleConfigs, modConfig[class.Vectorizer])
+	}
+	for _, vectorConfig := range class.VectorConfig {
+		if vectorizer, ok := vectorConfig.Vectorizer.(map[string]interface{}); ok {
+			for _, modConfig := range vectorizer {
+				moduleConfigs = append(moduleConfigs, modConfig)
+			}
+		}
+	}
+
+	var found *ChunkingSettings
+	for _, modConfig := range moduleConfigs {
+		asMap, ok := modConfig.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		cs, err := NewChunkingSettings(asMap)
+		if err != nil {
+			return nil, err
+		}
+		if cs == nil {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("chunking can only be configured for a single vectorizer of class %q", class.Class)
+		}
+		found = cs
+	}
+	return found, nil
+}
+
+// Validate checks the settings against the parent class. The child class is
+// validated at import time, as it is not part of the parent's schema.
+func (cs *ChunkingSettings) Validate(class *models.Class) error {
+	if cs.Property == "" {
+		return fmt.Errorf("chunking.property needs to be set")
+	}
+	if cs.ChildClass == "" {
+		return fmt.Errorf("chunking.childClass needs to be set")
+	}
+	if cs.ChildClass == class.Class {
+		return fmt.Errorf("chunking.childClass cannot be the class itself")
+	}
+	if !ValidateSetting(cs.Strategy, availableChunkingStrategies) {
+		return fmt.Errorf("wrong chunking.strategy: %q, available strategies are: %v",
+			cs.Strategy, availableChunkingStrategies)
+	}
+	if cs.Size <= 0 {
+		return fmt.Errorf("chunking.size needs to be greater than 0, got: %d", cs.Size)
+	}
+	if cs.Overlap < 0 || cs.Overlap >= cs.Size {
+		return fmt.Errorf("chunking.overlap needs to be between 0 and size (%d), got: %d",
+			cs.Size, cs.Overlap)
+	}
+
+	for _, prop := range class.Properties {
+		if prop.Name != cs.Property {
+			continue
+		}
+		if len(prop.DataType) != 1 || prop.DataType[0] != schema.DataTypeText.String() {
+			return fmt.Errorf("chunking.property %q needs to be of type text, got: %v",
+				cs.Property, prop.DataType)
+		}
+		return nil
+	}
+	return fmt.Errorf("chunking.property %q not found in class %q", cs.Property, class.Class)
+}
+
+func chunkingString(cfg map[string]interface{}, key, defaultValue string) (string, error) {
+	value, ok := cfg[key]
+	if !ok {
+		return defaultValue, nil
+	}
+	asString, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("chunking.%s needs to be a string, got: %T", key, value)
+	}
+	return asString, nil
+}
+
+func chunkingNumber(cfg map[string]interface{}, key string, defaultValue int) (int, error) {
+	value, ok := cfg[key]
+	if !ok {
+		return defaultValue, nil
+	}
+	num, err := (&classPropertyValuesHelper{}).GetNumber(value)
+	if err != nil {
+		return 0, fmt.Errorf("chunking.%s needs to be a number: %w", key, err)
+	}
+	return int(num), nil
+}
@@ -0,0 +1,121 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package settings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/modules"
+)
+
+func Test_ChunkingSettings(t *testing.T) {
+	getClass := func(chunking interface{}) *models.Class {
+		return &models.Class{
+			Class:      "Document",
+			Vectorizer: "my-module",
+			ModuleConfig: map[string]interface{}{
+				"my-module": map[string]interface{}{
+					"vectorizeClassName": false,
+					"chunking":           chunking,
+				},
+			},
+			Properties: []*models.Property{
+				{Name: "body", DataType: schema.DataTypeText.PropString()},
+				{Name: "pages", DataType: schema.DataTypeInt.PropString()},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		chunking interface{}
+		expected *ChunkingSettings
+		wantErr  string
+	}{
+		{
+			name:     "defaults",
+			chunking: map[string]interface{}{"property": "body", "childClass": "Chunk"},
+			expected: &ChunkingSettings{
+				Property: "body", ChildClass: "Chunk", Strategy: ChunkingStrategyTokens,
+				Size: DefaultChunkSizeTokens, Overlap: DefaultChunkOverlap,
+			},
+		},
+		{
+			name: "sentences",
+			chunking: map[string]interface{}{
+				"property": "body", "childClass": "Chunk", "strategy": "sentences", "overlap": float64(1),
+			},
+			expected: &ChunkingSettings{
+				Property: "body", ChildClass: "Chunk", Strategy: ChunkingStrategySentences,
+				Size: DefaultChunkSizeSentences, Overlap: 1,
+			},
+		},
+		{
+			name:     "not an object",
+			chunking: "body",
+			wantErr:  "chunking needs to be an object, got: string",
+		},
+		{
+			name:     "missing child class",
+			chunking: map[string]interface{}{"property": "body"},
+			wantErr:  "chunking.childClass needs to be set",
+		},
+		{
+			name:     "unknown strategy",
+			chunking: map[string]interface{}{"property": "body", "childClass": "Chunk", "strategy": "pages"},
+			wantErr:  `wrong chunking.strategy: "pages", available strategies are: [tokens sentences]`,
+		},
+		{
+			name:     "overlap not smaller than size",
+			chunking: map[string]interface{}{"property": "body", "childClass": "Chunk", "size": 2, "overlap": 2},
+			wantErr:  "chunking.overlap needs to be between 0 and size (2), got: 2",
+		},
+		{
+			name:     "size not a number",
+			chunking: map[string]interface{}{"property": "body", "childClass": "Chunk", "size": true},
+			wantErr:  "chunking.size needs to be a number: unrecognized type: bool",
+		},
+		{
+			name:     "property not text",
+			chunking: map[string]interface{}{"property": "pages", "childClass": "Chunk"},
+			wantErr:  `chunking.property "pages" needs to be of type text, got: [int]`,
+		},
+		{
+			name:     "unknown property",
+			chunking: map[string]interface{}{"property": "title", "childClass": "Chunk"},
+			wantErr:  `chunking.property "title" not found in class "Document"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			class := getClass(tt.chunking)
+			cfg := modules.NewClassBasedModuleConfig(class, "my-module", "", "")
+			s := NewBaseClassSettings(cfg, false)
+
+			err := s.Validate(class)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantErr, err.Error())
+				return
+			}
+			require.NoError(t, err)
+			cs, err := NewChunkingSettings(s.GetSettings())
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, cs)
+		})
+	}
+}
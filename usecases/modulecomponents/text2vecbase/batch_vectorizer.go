@@ -50,15 +50,22 @@ func (v *BatchVectorizer[T]) Object(ctx context.Context, object *models.Object,
 func (v *BatchVectorizer[T]) object(ctx context.Context, object *models.Object, cfg moduletools.ClassConfig, cs objectsvectorizer.ClassSettings,
 ) (T, error) {
 	text := v.objectVectorizer.Texts(ctx, object, cs)
+	cached, cacheKey, ok := v.batchVectorizer.CachedEmbedding(ctx, cfg, text)
+	if ok {
+		return cached, nil
+	}
+
 	res, _, _, err := v.client.Vectorize(ctx, []string{text}, cfg)
 	if err != nil {
 		return nil, err
 	}
 
+	vec := res.Vector[0]
 	if len(res.Vector) > 1 {
-		return libvectorizer.CombineVectors(res.Vector), nil
+		vec = libvectorizer.CombineVectors(res.Vector)
 	}
-	return res.Vector[0], nil
+	v.batchVectorizer.CacheEmbedding(cacheKey, vec)
+	return vec, nil
 }
 
 func (v *BatchVectorizer[T]) ObjectBatch(ctx context.Context, objects []*models.Object, skipObject []bool, cfg moduletools.ClassConfig,
@@ -0,0 +1,124 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package text2vecbase
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/weaviate/weaviate/usecases/modulecomponents/settings"
+)
+
+// Chunk splits text into overlapping windows as configured by the chunking
+// settings. Tokens are whitespace-separated words, so that chunk boundaries do
+// not depend on the tokenizer of the vectorizer's model. Chunks are cut from
+// the original text, which keeps punctuation and line breaks intact.
+func Chunk(text string, cs settings.ChunkingSettings) []string {
+	var spans []span
+	switch cs.Strategy {
+	case settings.ChunkingStrategySentences:
+		spans = sentenceSpans(text)
+	default:
+		spans = wordSpans(text)
+	}
+	return chunkSpans(text, spans, cs.Size, cs.Overlap)
+}
+
+// span is a byte range [start, end) of a word or sentence in the input text
+type span struct {
+	start, end int
+}
+
+func chunkSpans(text string, spans []span, size, overlap int) []string {
+	if len(spans) == 0 || size <= 0 {
+		return nil
+	}
+	step := size - overlap
+	if step <= 0 {
+		step = 1
+	}
+
+	chunks := make([]string, 0, len(spans)/step+1)
+	for i := 0; i < len(spans); i += step {
+		end := i + size
+		if end > len(spans) {
+			end = len(spans)
+		}
+		chunks = append(chunks, text[spans[i].start:spans[end-1].end])
+		if end == len(spans) {
+			break
+		}
+	}
+	return chunks
+}
+
+func wordSpans(text string) []span {
+	var spans []span
+	start := -1
+	for i, r := range text {
+		if unicode.IsSpace(r) {
+			if start >= 0 {
+				spans = append(spans, span{start, i})
+				start = -1
+			}
+			continue
+		}
+		if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		spans = append(spans, span{start, len(text)})
+	}
+	return spans
+}
+
+// sentenceSpans ends a sentence at '.', '!' or '?' followed by whitespace and
+// at paragraph breaks.
+func sentenceSpans(text string) []span {
+	var spans []span
+	start := -1
+	end := func(i int) {
+		if start >= 0 {
+			if s := strings.TrimRightFunc(text[start:i], unicode.IsSpace); s != "" {
+				spans = append(spans, span{start, start + len(s)})
+			}
+			start = -1
+		}
+	}
+
+	for i, r := range text {
+		if start < 0 {
+			if !unicode.IsSpace(r) {
+				start = i
+			}
+			continue
+		}
+		next := i + utf8.RuneLen(r)
+		switch {
+		case r == '.' || r == '!' || r == '?':
+			if next == len(text) || startsWithSpace(text[next:]) {
+				end(next)
+			}
+		case r == '\n' && strings.HasPrefix(strings.TrimLeft(text[next:], " \t\r"), "\n"):
+			end(i)
+		}
+	}
+	end(len(text))
+	return spans
+}
+
+func startsWithSpace(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	return unicode.IsSpace(r)
+}
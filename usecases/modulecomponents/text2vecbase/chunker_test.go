@@ -0,0 +1,83 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package text2vecbase
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/weaviate/weaviate/usecases/modulecomponents/settings"
+)
+
+func TestChunk(t *testing.T) {
+	text := "Berlin is the capital of Germany. It has 3.8 million inhabitants!\n" +
+		"Is it big?\n\nA new paragraph without a full stop\n\nThe end."
+
+	tests := []struct {
+		name     string
+		text     string
+		settings settings.ChunkingSettings
+		expected []string
+	}{
+		{
+			name:     "tokens",
+			text:     "one two  three\nfour five",
+			settings: settings.ChunkingSettings{Strategy: settings.ChunkingStrategyTokens, Size: 2},
+			expected: []string{"one two", "three\nfour", "five"},
+		},
+		{
+			name:     "tokens with overlap",
+			text:     " one two three four five ",
+			settings: settings.ChunkingSettings{Strategy: settings.ChunkingStrategyTokens, Size: 3, Overlap: 1},
+			expected: []string{"one two three", "three four five"},
+		},
+		{
+			name:     "tokens fit into a single chunk",
+			text:     "one two",
+			settings: settings.ChunkingSettings{Strategy: settings.ChunkingStrategyTokens, Size: 10},
+			expected: []string{"one two"},
+		},
+		{
+			name:     "sentences",
+			text:     text,
+			settings: settings.ChunkingSettings{Strategy: settings.ChunkingStrategySentences, Size: 1},
+			expected: []string{
+				"Berlin is the capital of Germany.",
+				"It has 3.8 million inhabitants!",
+				"Is it big?",
+				"A new paragraph without a full stop",
+				"The end.",
+			},
+		},
+		{
+			name:     "sentences with overlap",
+			text:     text,
+			settings: settings.ChunkingSettings{Strategy: settings.ChunkingStrategySentences, Size: 3, Overlap: 1},
+			expected: []string{
+				"Berlin is the capital of Germany. It has 3.8 million inhabitants!\nIs it big?",
+				"Is it big?\n\nA new paragraph without a full stop\n\nThe end.",
+			},
+		},
+		{
+			name:     "empty text",
+			text:     " \n ",
+			settings: settings.ChunkingSettings{Strategy: settings.ChunkingStrategySentences, Size: 3},
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Chunk(tt.text, tt.settings))
+		})
+	}
+}
@@ -200,6 +200,10 @@ func (f *fakeSchemaGetter) ReadOnlyClass(name string) *models.Class {
 	return f.schema.GetClass(name)
 }
 
+func (f *fakeSchemaGetter) GetSchemaSkipAuth() schema.Schema {
+	return f.schema
+}
+
 type fakeObjectsRepo struct {
 	mock.Mock
 }
@@ -0,0 +1,61 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modules
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
+)
+
+// ModuleHealth returns the health of the providers the registered modules
+// have sent requests to. The provider of a module is the part of its name
+// after the module type, e.g. "openai" for "text2vec-openai".
+func (p *Provider) ModuleHealth() []*models.NodeModuleStatus {
+	var out []*models.NodeModuleStatus
+	for name := range p.registered {
+		_, provider, ok := strings.Cut(name, "-")
+		if !ok {
+			continue
+		}
+		health, ok := httpclient.ProviderHealth(provider)
+		if !ok {
+			continue
+		}
+		status := &models.NodeModuleStatus{
+			Name:                name,
+			Provider:            provider,
+			Status:              moduleHealthStatus(health),
+			ConsecutiveFailures: int64(health.ConsecutiveFailures),
+			LastError:           health.LastError,
+		}
+		if !health.LastFailure.IsZero() {
+			status.LastFailureTimeUnix = health.LastFailure.UnixMilli()
+		}
+		out = append(out, status)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+func moduleHealthStatus(health httpclient.Health) string {
+	switch {
+	case health.State == httpclient.StateOpen:
+		return models.NodeModuleStatusStatusUNAVAILABLE
+	case health.State == httpclient.StateHalfOpen, health.ConsecutiveFailures > 0:
+		return models.NodeModuleStatusStatusDEGRADED
+	default:
+		return models.NodeModuleStatusStatusHEALTHY
+	}
+}
@@ -0,0 +1,60 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modules
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/httpclient"
+)
+
+func TestModuleHealth(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	logger, _ := test.NewNullLogger()
+	p := NewProvider(logger)
+	p.Register(newDummyModule("text2vec-healthtest", modulecapabilities.Text2Vec))
+	p.Register(newDummyModule("generative-healthtest", modulecapabilities.Text2TextGenerative))
+	p.Register(newDummyModule("text2vec-unused", modulecapabilities.Text2Vec))
+
+	assert.Empty(t, p.ModuleHealth())
+
+	client := httpclient.New("healthtest", time.Second)
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, server.URL, nil)
+	require.NoError(t, err)
+	res, err := client.Do(req)
+	require.NoError(t, err)
+	res.Body.Close()
+
+	health := p.ModuleHealth()
+	require.Len(t, health, 2)
+	assert.Equal(t, "generative-healthtest", health[0].Name)
+	assert.Equal(t, "text2vec-healthtest", health[1].Name)
+	for _, h := range health {
+		assert.Equal(t, "healthtest", h.Provider)
+		assert.Equal(t, "DEGRADED", h.Status)
+		assert.Equal(t, int64(1), h.ConsecutiveFailures)
+		assert.Equal(t, "500 Internal Server Error", h.LastError)
+		assert.NotZero(t, h.LastFailureTimeUnix)
+	}
+}
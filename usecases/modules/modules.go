@@ -48,10 +48,18 @@ type Provider struct {
 	hasMultipleVectorizers    bool
 	targetVectorNameValidator *regexp.Regexp
 	logger                    logrus.FieldLogger
+	vectorDimensions          vectorDimensions
 }
 
 type schemaGetter interface {
 	ReadOnlyClass(name string) *models.Class
+	GetSchemaSkipAuth() schema.Schema
+}
+
+// vectorDimensions returns the dimensions of the vectors indexed for a target
+// vector, zero if none are indexed
+type vectorDimensions interface {
+	TargetVectorDimensions(ctx context.Context, className, targetVector string) (int, error)
 }
 
 func NewProvider(logger logrus.FieldLogger) *Provider {
@@ -107,6 +115,10 @@ func (p *Provider) SetSchemaGetter(sg schemaGetter) {
 	p.schemaGetter = sg
 }
 
+func (p *Provider) SetVectorDimensions(vd vectorDimensions) {
+	p.vectorDimensions = vd
+}
+
 func (p *Provider) Init(ctx context.Context,
 	params moduletools.ModuleInitParams, logger logrus.FieldLogger,
 ) error {
@@ -641,6 +653,42 @@ func (p *Provider) ExtractAdditionalField(className, name string, params []*ast.
 	return nil
 }
 
+// GenerativeClient returns the client of the named generative provider along
+// with the module config of the class it is used for. Without a provider the
+// generative module configured for the class is used.
+func (p *Provider) GenerativeClient(className, provider string) (modulecapabilities.GenerativeClient, moduletools.ClassConfig, error) {
+	class, err := p.getClass(className)
+	if err != nil {
+		return nil, nil, err
+	}
+	defaultProvider := ""
+	clients := map[string]modulecapabilities.GenerativeClient{}
+	for _, module := range p.GetAll() {
+		if !p.isGenerativeModule(module.Type()) {
+			continue
+		}
+		if arg, ok := module.(modulecapabilities.AdditionalGenerativeProperties); ok {
+			for name, additionalGenerativeParameter := range arg.AdditionalGenerativeProperties() {
+				clients[name] = additionalGenerativeParameter.Client
+				if p.shouldIncludeClassArgument(class, module.Name(), module.Type(), p.getModuleAltNames(module)) {
+					defaultProvider = name
+				}
+			}
+		}
+	}
+	if provider == "" {
+		if defaultProvider == "" {
+			return nil, nil, errors.Errorf("no generative module configured for class %q", className)
+		}
+		provider = defaultProvider
+	}
+	client, ok := clients[provider]
+	if !ok || client == nil {
+		return nil, nil, errors.Errorf("generative provider %q is not enabled", provider)
+	}
+	return client, NewClassBasedModuleConfig(class, "", "", ""), nil
+}
+
 // GetObjectAdditionalExtend extends rest api get queries with additional properties
 func (p *Provider) GetObjectAdditionalExtend(ctx context.Context,
 	in *search.Result, moduleParams map[string]interface{},
@@ -848,7 +896,8 @@ func (p *Provider) IsTargetVectorMultiVector(className, targetVector string) (bo
 }
 
 // VectorFromSearchParam gets a vector for a given argument. This is used in
-// Get { Class() } for example
+// Get { Class() } for example. If the params override the vectorizer, the
+// vector is checked to have the dimensions of the target vector.
 func (p *Provider) VectorFromSearchParam(ctx context.Context, className, targetVector, tenant, param string, params interface{},
 	findVectorFn modulecapabilities.FindVectorFn[[]float32],
 ) ([]float32, error) {
@@ -857,10 +906,21 @@ func (p *Provider) VectorFromSearchParam(ctx context.Context, className, targetV
 		return nil, err
 	}
 
-	targetModule := p.getModuleNameForTargetVector(class, targetVector)
+	vectorClass := class
+	vectorizer := vectorizerOverride(params)
+	if vectorizer != nil {
+		if vectorClass, err = p.overrideVectorizer(ctx, class, targetVector, vectorizer); err != nil {
+			return nil, err
+		}
+	}
+
+	targetModule := p.getModuleNameForTargetVector(vectorClass, targetVector)
 
 	for _, mod := range p.GetAll() {
-		if found, vector, err := vectorFromSearchParam(ctx, class, mod, targetModule, targetVector, tenant, param, params, findVectorFn, p.isModuleNameEqual); found {
+		if found, vector, err := vectorFromSearchParam(ctx, vectorClass, mod, targetModule, targetVector, tenant, param, params, findVectorFn, p.isModuleNameEqual); found {
+			if err == nil && vectorizer != nil {
+				err = p.checkOverrideDimensions(ctx, class, targetVector, vector)
+			}
 			return vector, err
 		}
 	}
@@ -877,6 +937,9 @@ func (p *Provider) MultiVectorFromSearchParam(ctx context.Context, className, ta
 	if err != nil {
 		return nil, err
 	}
+	if vectorizerOverride(params) != nil {
+		return nil, fmt.Errorf("vectorizer override: multi-vector target vector %q is not supported", targetVector)
+	}
 
 	targetModule := p.getModuleNameForTargetVector(class, targetVector)
 
@@ -963,6 +1026,12 @@ func (p *Provider) VectorFromInput(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	return p.vectorFromInput(ctx, class, input, targetVector)
+}
+
+func (p *Provider) vectorFromInput(ctx context.Context,
+	class *models.Class, input, targetVector string,
+) ([]float32, error) {
 	targetModule := p.getModuleNameForTargetVector(class, targetVector)
 
 	for _, mod := range p.GetAll() {
@@ -975,7 +1044,7 @@ func (p *Provider) VectorFromInput(ctx context.Context,
 		}
 	}
 
-	return nil, fmt.Errorf("VectorFromInput was called without vectorizer on class %v for input %v", className, input)
+	return nil, fmt.Errorf("VectorFromInput was called without vectorizer on class %v for input %v", class.Class, input)
 }
 
 func (p *Provider) MultiVectorFromInput(ctx context.Context,
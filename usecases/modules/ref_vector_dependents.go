@@ -0,0 +1,153 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modules
+
+import (
+	"slices"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+)
+
+// RefVectorDependents returns the classes whose ref2vec vectors are
+// calculated from objects of className, together with the reference
+// properties pointing to className that the vectors depend on. Whenever the
+// vector of an object of className changes, the vectors of the objects
+// referencing it through these properties need to be recalculated.
+func (p *Provider) RefVectorDependents(className string) map[string][]string {
+	sch := p.schemaGetter.GetSchemaSkipAuth()
+	if sch.Objects == nil {
+		return nil
+	}
+
+	dependents := map[string][]string{}
+	for _, class := range sch.Objects.Classes {
+		props := p.refVectorProperties(class)
+		for _, prop := range class.Properties {
+			if _, ok := props[prop.Name]; ok && slices.Contains(prop.DataType, className) {
+				dependents[class.Class] = append(dependents[class.Class], prop.Name)
+			}
+		}
+	}
+	return dependents
+}
+
+// refVectorProperties returns the names of the reference properties the
+// ref2vec vectorizers of class calculate their vectors from
+func (p *Provider) refVectorProperties(class *models.Class) map[string]struct{} {
+	modConfigs, err := p.getModuleConfigs(class)
+	if err != nil {
+		return nil
+	}
+
+	var props map[string]struct{}
+	for targetVector, modConfig := range modConfigs {
+		for modName := range modConfig {
+			mod := p.GetByName(modName)
+			if mod == nil || !p.implementsReferenceVectorizer(mod) {
+				continue
+			}
+			if props == nil {
+				props = map[string]struct{}{}
+			}
+
+			withProps, ok := mod.(modulecapabilities.ReferenceVectorizerProperties)
+			if !ok {
+				for _, prop := range class.Properties {
+					props[prop.Name] = struct{}{}
+				}
+				continue
+			}
+
+			cfg := NewClassBasedModuleConfig(class, mod.Name(), "", targetVector)
+			names, err := withProps.VectorizableReferenceProperties(cfg)
+			if err != nil {
+				p.logger.WithField("className", class.Class).
+					WithField("module", mod.Name()).
+					WithError(err).
+					Warn("cannot determine reference properties of ref2vec vectorizer")
+				continue
+			}
+			for _, name := range names {
+				props[name] = struct{}{}
+			}
+		}
+	}
+	return props
+}
+
+// RefVectorWeightProperties returns the properties of objects of className
+// which the ref2vec vectors of the objects referencing them are weighted by.
+// Whenever one of them changes, the vectors of the referencing objects need
+// to be recalculated just as if the vector had changed.
+func (p *Provider) RefVectorWeightProperties(className string) []string {
+	sch := p.schemaGetter.GetSchemaSkipAuth()
+	if sch.Objects == nil {
+		return nil
+	}
+
+	var weightProps []string
+	for _, class := range sch.Objects.Classes {
+		refProps := p.refVectorProperties(class)
+		references := false
+		for _, prop := range class.Properties {
+			if _, ok := refProps[prop.Name]; ok && slices.Contains(prop.DataType, className) {
+				references = true
+				break
+			}
+		}
+		if !references {
+			continue
+		}
+		for _, prop := range p.refVectorWeightProperties(class) {
+			if !slices.Contains(weightProps, prop) {
+				weightProps = append(weightProps, prop)
+			}
+		}
+	}
+	return weightProps
+}
+
+// refVectorWeightProperties returns the names of the properties of the
+// referenced objects the ref2vec vectorizers of class weight them by
+func (p *Provider) refVectorWeightProperties(class *models.Class) []string {
+	modConfigs, err := p.getModuleConfigs(class)
+	if err != nil {
+		return nil
+	}
+
+	var props []string
+	for targetVector, modConfig := range modConfigs {
+		for modName := range modConfig {
+			mod := p.GetByName(modName)
+			if mod == nil || !p.implementsReferenceVectorizer(mod) {
+				continue
+			}
+			withWeights, ok := mod.(modulecapabilities.ReferenceVectorizerWeightProperties)
+			if !ok {
+				continue
+			}
+
+			cfg := NewClassBasedModuleConfig(class, mod.Name(), "", targetVector)
+			names, err := withWeights.ReferenceWeightProperties(cfg)
+			if err != nil {
+				p.logger.WithField("className", class.Class).
+					WithField("module", mod.Name()).
+					WithError(err).
+					Warn("cannot determine weight properties of ref2vec vectorizer")
+				continue
+			}
+			props = append(props, names...)
+		}
+	}
+	return props
+}
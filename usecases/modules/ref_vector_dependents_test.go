@@ -0,0 +1,117 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modules
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+type dummyRef2VecModuleWithProperties struct {
+	dummyRef2VecModuleNoCapabilities
+	props []string
+}
+
+func (m dummyRef2VecModuleWithProperties) VectorizableReferenceProperties(cfg moduletools.ClassConfig) ([]string, error) {
+	return m.props, nil
+}
+
+type dummyRef2VecModuleWithWeights struct {
+	dummyRef2VecModuleWithProperties
+	weights []string
+}
+
+func (m dummyRef2VecModuleWithWeights) ReferenceWeightProperties(cfg moduletools.ClassConfig) ([]string, error) {
+	return m.weights, nil
+}
+
+func TestProvider_RefVectorDependents(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	sch := schema.Schema{Objects: &models.Schema{
+		Classes: []*models.Class{
+			{
+				Class:      "Article",
+				Vectorizer: "text2vec",
+				ModuleConfig: map[string]interface{}{
+					"text2vec": map[string]interface{}{},
+				},
+				Properties: []*models.Property{{Name: "title", DataType: schema.DataTypeText.PropString()}},
+			},
+			{
+				Class:      "Reader",
+				Vectorizer: "ref2vec-legacy",
+				ModuleConfig: map[string]interface{}{
+					"ref2vec-legacy": map[string]interface{}{},
+				},
+				Properties: []*models.Property{
+					{Name: "read", DataType: []string{"Article"}},
+					{Name: "liked", DataType: []string{"Article", "Comment"}},
+					{Name: "follows", DataType: []string{"Reader"}},
+				},
+			},
+			{
+				Class: "Author",
+				VectorConfig: map[string]models.VectorConfig{
+					"wrote": {
+						Vectorizer: map[string]interface{}{
+							"ref2vec-props": map[string]interface{}{},
+						},
+					},
+				},
+				Properties: []*models.Property{
+					{Name: "wrote", DataType: []string{"Article"}},
+					{Name: "reviewed", DataType: []string{"Article"}},
+				},
+			},
+		},
+	}}
+
+	p := NewProvider(logger)
+	p.SetSchemaGetter(&fakeSchemaGetter{sch})
+	p.Register(newDummyModule("text2vec", modulecapabilities.Text2Vec))
+	p.Register(newDummyRef2VecModule("ref2vec-legacy"))
+	p.Register(dummyRef2VecModuleWithWeights{
+		dummyRef2VecModuleWithProperties: dummyRef2VecModuleWithProperties{
+			dummyRef2VecModuleNoCapabilities: newDummyRef2VecModule("ref2vec-props"),
+			props:                            []string{"wrote"},
+		},
+		weights: []string{"rating"},
+	})
+
+	t.Run("referenced by ref2vec classes", func(t *testing.T) {
+		assert.Equal(t, map[string][]string{
+			"Reader": {"read", "liked"},
+			"Author": {"wrote"},
+		}, p.RefVectorDependents("Article"))
+	})
+
+	t.Run("referenced by its own ref2vec class", func(t *testing.T) {
+		assert.Equal(t, map[string][]string{
+			"Reader": {"follows"},
+		}, p.RefVectorDependents("Reader"))
+	})
+
+	t.Run("not referenced by any ref2vec class", func(t *testing.T) {
+		assert.Empty(t, p.RefVectorDependents("Author"))
+	})
+
+	t.Run("weight properties of the referencing ref2vec classes", func(t *testing.T) {
+		assert.Equal(t, []string{"rating"}, p.RefVectorWeightProperties("Article"))
+		assert.Empty(t, p.RefVectorWeightProperties("Reader"))
+	})
+}
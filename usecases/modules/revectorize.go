@@ -0,0 +1,75 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modules
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+// RevectorizeBatch embeds the objects for the target vector of the class using
+// the given vectorizer module config instead of the configured one. Vectors
+// already present on the objects are ignored and the objects are not
+// modified, the returned vectors are in the order of the objects.
+func (p *Provider) RevectorizeBatch(ctx context.Context, class *models.Class, targetVector string,
+	vectorizer map[string]interface{}, objects []*models.Object,
+) ([][]float32, error) {
+	revectorized, err := classWithVectorizer(class, targetVector, vectorizer)
+	if err != nil {
+		return nil, err
+	}
+
+	copies := make([]*models.Object, len(objects))
+	for i, obj := range objects {
+		c := *obj
+		c.Vectors = nil
+		copies[i] = &c
+	}
+
+	// the previous vectors of the objects were embedded with different
+	// settings and must never be reused
+	vecErrors, err := p.batchUpdateVector(ctx, copies, revectorized, findNoObject, targetVector, vectorizer)
+	if err != nil {
+		return nil, err
+	}
+	if len(vecErrors) > 0 {
+		errs := make([]error, 0, len(vecErrors))
+		for i, vecErr := range vecErrors {
+			errs = append(errs, fmt.Errorf("object %s: %w", objects[i].ID, vecErr))
+		}
+		return nil, fmt.Errorf("vectorize objects: %w", errors.Join(errs...))
+	}
+
+	vectors := make([][]float32, len(copies))
+	for i, c := range copies {
+		vector, ok := c.Vectors[targetVector].([]float32)
+		if !ok {
+			return nil, fmt.Errorf("object %s: vectorizer did not return a single vector for %q",
+				objects[i].ID, targetVector)
+		}
+		vectors[i] = vector
+	}
+	return vectors, nil
+}
+
+func findNoObject(context.Context, string, strfmt.UUID, search.SelectProperties,
+	additional.Properties, string,
+) (*search.Result, error) {
+	return nil, nil
+}
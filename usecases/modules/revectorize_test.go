@@ -0,0 +1,73 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modules
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestProvider_RevectorizeBatch(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	ctx := context.Background()
+
+	class := &models.Class{
+		Class: "SomeClass",
+		VectorConfig: map[string]models.VectorConfig{
+			"text": {
+				Vectorizer:        map[string]interface{}{"old-module": map[string]interface{}{}},
+				VectorIndexConfig: hnsw.UserConfig{},
+				VectorIndexType:   "hnsw",
+			},
+		},
+	}
+
+	p := NewProvider(logger)
+	p.Register(newDummyModule("new-module", modulecapabilities.Text2Vec))
+
+	t.Run("embeds with the given vectorizer", func(t *testing.T) {
+		objects := []*models.Object{
+			{Class: class.Class, ID: newUUID(), Vectors: models.Vectors{"text": []float32{9, 9, 9}}},
+			{Class: class.Class, ID: newUUID()},
+		}
+
+		vectors, err := p.RevectorizeBatch(ctx, class, "text",
+			map[string]interface{}{"new-module": map[string]interface{}{}}, objects)
+		require.NoError(t, err)
+		assert.Equal(t, [][]float32{{1, 2, 3}, {1, 2, 3}}, vectors)
+
+		// neither the objects nor the class are changed
+		assert.Equal(t, []float32{9, 9, 9}, objects[0].Vectors["text"])
+		assert.Nil(t, objects[1].Vectors)
+		assert.Contains(t, class.VectorConfig["text"].Vectorizer, "old-module")
+	})
+
+	t.Run("with unknown target vector", func(t *testing.T) {
+		_, err := p.RevectorizeBatch(ctx, class, "image",
+			map[string]interface{}{"new-module": map[string]interface{}{}}, nil)
+		require.ErrorContains(t, err, `target vector "image" not found`)
+	})
+
+	t.Run("with unregistered vectorizer", func(t *testing.T) {
+		_, err := p.RevectorizeBatch(ctx, class, "text",
+			map[string]interface{}{"does-not-exist": map[string]interface{}{}}, nil)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,193 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modules
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+)
+
+// classWithVectorizer returns a copy of the class with the vectorizer module
+// config of the target vector replaced.
+func classWithVectorizer(class *models.Class, targetVector string,
+	vectorizer map[string]interface{},
+) (*models.Class, error) {
+	vectorConfig, ok := class.VectorConfig[targetVector]
+	if !ok {
+		return nil, fmt.Errorf("target vector %q not found in class %q", targetVector, class.Class)
+	}
+	vectorConfig.Vectorizer = vectorizer
+
+	cp := *class
+	cp.VectorConfig = maps.Clone(class.VectorConfig)
+	cp.VectorConfig[targetVector] = vectorConfig
+	return &cp, nil
+}
+
+// vectorizerOverride returns the vectorizer override of the search params,
+// if any.
+func vectorizerOverride(params interface{}) map[string]interface{} {
+	if p, ok := params.(modulecapabilities.VectorizerParam); ok {
+		return p.GetVectorizer()
+	}
+	return nil
+}
+
+// overrideVectorizer validates the vectorizer override of a query and returns
+// a copy of the class using it for the target vector. The module defaults are
+// set on the override and it is validated by the module like the config of a
+// class.
+func (p *Provider) overrideVectorizer(ctx context.Context, class *models.Class, targetVector string,
+	vectorizer map[string]interface{},
+) (*models.Class, error) {
+	if len(vectorizer) != 1 {
+		return nil, fmt.Errorf("vectorizer override must configure exactly one module, got %d", len(vectorizer))
+	}
+	for name, settings := range vectorizer {
+		if _, ok := settings.(map[string]interface{}); !ok {
+			return nil, fmt.Errorf("vectorizer override: config of module %q must be an object", name)
+		}
+		mod := p.GetByName(name)
+		if mod == nil || !p.isVectorizerModule(mod.Type()) {
+			return nil, fmt.Errorf("vectorizer override: no vectorizer module with name %q present", name)
+		}
+		if p.IsMultiVector(name) {
+			return nil, fmt.Errorf("vectorizer override: multi-vector module %q is not supported", name)
+		}
+	}
+	if p.IsMultiVector(p.getModuleNameForTargetVector(class, targetVector)) {
+		return nil, fmt.Errorf("vectorizer override: multi-vector target vector %q is not supported", targetVector)
+	}
+	if _, ok := class.VectorConfig[targetVector]; !ok {
+		return nil, fmt.Errorf("vectorizer override: target vector %q is not a named vector of class %q",
+			targetVector, class.Class)
+	}
+
+	// the override is cloned, the defaults must not be set on the params
+	merged := maps.Clone(vectorizer)
+	overridden, err := classWithVectorizer(class, targetVector, merged)
+	if err != nil {
+		return nil, err
+	}
+	for name := range merged {
+		if cc, ok := p.GetByName(name).(modulecapabilities.ClassConfigurator); ok {
+			cfg := NewClassBasedModuleConfig(overridden, name, "", targetVector)
+			p.setPerClassConfigDefaults(cfg, cc, func(vectorizerConfig map[string]interface{}) {
+				merged[name] = vectorizerConfig
+			})
+		}
+		if err := p.validateClassModuleConfig(ctx, overridden, name, targetVector); err != nil {
+			return nil, fmt.Errorf("vectorizer override: %w", err)
+		}
+	}
+	return overridden, nil
+}
+
+// checkOverrideDimensions rejects vectors of an overriding vectorizer whose
+// dimensions differ from the vectors indexed for the target vector, as they
+// cannot be compared to them.
+func (p *Provider) checkOverrideDimensions(ctx context.Context, class *models.Class,
+	targetVector string, vector []float32,
+) error {
+	dims, err := p.targetVectorDimensions(ctx, class, targetVector)
+	if err != nil {
+		return fmt.Errorf("vectorizer override: %w", err)
+	}
+	if dims > 0 && len(vector) != dims {
+		return fmt.Errorf("vectorizer override: vector has %d dimensions, but target vector %q has %d",
+			len(vector), targetVector, dims)
+	}
+	return nil
+}
+
+// targetVectorDimensions returns the dimensions of the vectors indexed for
+// the target vector, or the ones declared in its module config if there are
+// none yet. It is zero if neither is known, the vector index then rejects
+// vectors of other dimensions itself.
+func (p *Provider) targetVectorDimensions(ctx context.Context, class *models.Class,
+	targetVector string,
+) (int, error) {
+	if p.vectorDimensions != nil {
+		dims, err := p.vectorDimensions.TargetVectorDimensions(ctx, class.Class, targetVector)
+		if err != nil {
+			return 0, fmt.Errorf("dimensions of target vector %q: %w", targetVector, err)
+		}
+		if dims > 0 {
+			return dims, nil
+		}
+	}
+	dims, _ := declaredDimensions(class.VectorConfig[targetVector].Vectorizer)
+	return dims, nil
+}
+
+// declaredDimensions returns the dimensions set in the module config of a
+// vectorizer, the modules supporting it name the setting "dimensions".
+func declaredDimensions(vectorizer interface{}) (int, bool) {
+	modules, ok := vectorizer.(map[string]interface{})
+	if !ok || len(modules) != 1 {
+		return 0, false
+	}
+	for _, settings := range modules {
+		settings, ok := settings.(map[string]interface{})
+		if !ok {
+			return 0, false
+		}
+		var dims int
+		switch v := settings["dimensions"].(type) {
+		case float64:
+			dims = int(v)
+		case int:
+			dims = v
+		case int64:
+			dims = int(v)
+		case json.Number:
+			n, err := v.Int64()
+			if err != nil {
+				return 0, false
+			}
+			dims = int(n)
+		}
+		if dims > 0 {
+			return dims, true
+		}
+	}
+	return 0, false
+}
+
+// VectorFromInputWithVectorizer vectorizes the input for the target vector
+// with the given vectorizer module config instead of the configured one. The
+// vector must have the dimensions of the target vector.
+func (p *Provider) VectorFromInputWithVectorizer(ctx context.Context,
+	className, input, targetVector string, vectorizer map[string]interface{},
+) ([]float32, error) {
+	class, err := p.getClass(className)
+	if err != nil {
+		return nil, err
+	}
+	overridden, err := p.overrideVectorizer(ctx, class, targetVector, vectorizer)
+	if err != nil {
+		return nil, err
+	}
+	vector, err := p.vectorFromInput(ctx, overridden, input, targetVector)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.checkOverrideDimensions(ctx, class, targetVector, vector); err != nil {
+		return nil, err
+	}
+	return vector, nil
+}
@@ -0,0 +1,281 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modules
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/generictypes"
+)
+
+func TestProvider_VectorizerOverride(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	ctx := context.Background()
+
+	sch := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "Named",
+					VectorConfig: map[string]models.VectorConfig{
+						"text": {Vectorizer: map[string]interface{}{"configured": map[string]interface{}{}}},
+					},
+				},
+				{
+					Class:      "Legacy",
+					Vectorizer: "configured",
+				},
+			},
+		},
+	}
+
+	configured := newInputVectorizerModule("configured", 3, 1)
+	p := NewProvider(logger)
+	p.SetSchemaGetter(&fakeSchemaGetter{schema: sch})
+	p.SetVectorDimensions(fakeVectorDimensions{"Named/text": 3})
+	p.Register(configured)
+	p.Register(newInputVectorizerModule("compatible", 3, 2))
+	p.Register(newInputVectorizerModule("incompatible", 4, 3))
+	p.Register(newDummyModule("colbert", modulecapabilities.Text2ColBERT))
+	p.Register(newDummyModule("generative", modulecapabilities.Text2TextGenerative))
+	validating := &validatingVectorizerModule{inputVectorizerModule: newInputVectorizerModule("validating", 3, 5)}
+	p.Register(validating)
+	require.NoError(t, p.Init(ctx, nil, logger))
+
+	override := func(module string) vectorizerParams {
+		return vectorizerParams{vectorizer: map[string]interface{}{module: map[string]interface{}{}}}
+	}
+
+	t.Run("nearText without override", func(t *testing.T) {
+		vector, err := p.VectorFromSearchParam(ctx, "Named", "text", "", "nearText",
+			vectorizerParams{}, generictypes.FindVectorFn(fakeFindVector))
+		require.NoError(t, err)
+		assert.Equal(t, []float32{1, 1, 1}, vector)
+	})
+
+	t.Run("nearText with compatible override", func(t *testing.T) {
+		vector, err := p.VectorFromSearchParam(ctx, "Named", "text", "", "nearText",
+			override("compatible"), generictypes.FindVectorFn(fakeFindVector))
+		require.NoError(t, err)
+		assert.Equal(t, []float32{2, 2, 2}, vector)
+	})
+
+	t.Run("nearText with incompatible override", func(t *testing.T) {
+		_, err := p.VectorFromSearchParam(ctx, "Named", "text", "", "nearText",
+			override("incompatible"), generictypes.FindVectorFn(fakeFindVector))
+		require.ErrorContains(t, err, "vector has 4 dimensions, but target vector \"text\" has 3")
+	})
+
+	t.Run("hybrid with compatible override", func(t *testing.T) {
+		vector, err := p.VectorFromInputWithVectorizer(ctx, "Named", "query", "text",
+			override("compatible").vectorizer)
+		require.NoError(t, err)
+		assert.Equal(t, []float32{2, 2, 2}, vector)
+	})
+
+	t.Run("hybrid with incompatible override", func(t *testing.T) {
+		_, err := p.VectorFromInputWithVectorizer(ctx, "Named", "query", "text",
+			override("incompatible").vectorizer)
+		require.ErrorContains(t, err, "dimensions")
+	})
+
+	t.Run("dimensions are taken from the vector index", func(t *testing.T) {
+		assert.Equal(t, 0, configured.inputs, "the configured vectorizer is not called")
+	})
+
+	t.Run("override is validated with the module defaults", func(t *testing.T) {
+		vector, err := p.VectorFromInputWithVectorizer(ctx, "Named", "query", "text",
+			override("validating").vectorizer)
+		require.NoError(t, err)
+		assert.Equal(t, []float32{5, 5, 5}, vector)
+		assert.Equal(t, "default", validating.validatedModel)
+
+		params := map[string]interface{}{"validating": map[string]interface{}{"model": "unknown"}}
+		_, err = p.VectorFromInputWithVectorizer(ctx, "Named", "query", "text", params)
+		require.ErrorContains(t, err, `unsupported model "unknown"`)
+		assert.Equal(t, map[string]interface{}{"validating": map[string]interface{}{"model": "unknown"}}, params,
+			"defaults are not set on the params")
+	})
+
+	t.Run("declared dimensions without indexed vectors", func(t *testing.T) {
+		declared := newInputVectorizerModule("declared", 3, 1)
+		p := NewProvider(logger)
+		p.SetVectorDimensions(fakeVectorDimensions{})
+		p.SetSchemaGetter(&fakeSchemaGetter{schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{{
+			Class: "Declared",
+			VectorConfig: map[string]models.VectorConfig{
+				"text": {Vectorizer: map[string]interface{}{"declared": map[string]interface{}{"dimensions": float64(3)}}},
+			},
+		}}}}})
+		p.Register(declared)
+		p.Register(newInputVectorizerModule("compatible", 3, 2))
+		p.Register(newInputVectorizerModule("incompatible", 4, 3))
+		require.NoError(t, p.Init(ctx, nil, logger))
+
+		vector, err := p.VectorFromInputWithVectorizer(ctx, "Declared", "query", "text",
+			override("compatible").vectorizer)
+		require.NoError(t, err)
+		assert.Equal(t, []float32{2, 2, 2}, vector)
+		assert.Equal(t, 0, declared.inputs)
+
+		_, err = p.VectorFromInputWithVectorizer(ctx, "Declared", "query", "text",
+			override("incompatible").vectorizer)
+		require.ErrorContains(t, err, "vector has 4 dimensions, but target vector \"text\" has 3")
+	})
+
+	t.Run("unknown dimensions are left to the vector index", func(t *testing.T) {
+		p := NewProvider(logger)
+		p.SetSchemaGetter(&fakeSchemaGetter{schema: sch})
+		p.SetVectorDimensions(fakeVectorDimensions{})
+		p.Register(newInputVectorizerModule("configured", 3, 1))
+		p.Register(newInputVectorizerModule("incompatible", 4, 3))
+		require.NoError(t, p.Init(ctx, nil, logger))
+
+		vector, err := p.VectorFromInputWithVectorizer(ctx, "Named", "query", "text",
+			override("incompatible").vectorizer)
+		require.NoError(t, err)
+		assert.Equal(t, []float32{3, 3, 3, 3}, vector)
+	})
+
+	t.Run("invalid overrides", func(t *testing.T) {
+		for name, tc := range map[string]struct {
+			className, targetVector string
+			vectorizer              map[string]interface{}
+			expectedErr             string
+		}{
+			"unknown module": {
+				className: "Named", targetVector: "text",
+				vectorizer:  override("unknown").vectorizer,
+				expectedErr: `no vectorizer module with name "unknown"`,
+			},
+			"not a vectorizer": {
+				className: "Named", targetVector: "text",
+				vectorizer:  override("generative").vectorizer,
+				expectedErr: `no vectorizer module with name "generative"`,
+			},
+			"module config not an object": {
+				className: "Named", targetVector: "text",
+				vectorizer:  map[string]interface{}{"compatible": "text-embedding-3-small"},
+				expectedErr: `config of module "compatible" must be an object`,
+			},
+			"multiple modules": {
+				className: "Named", targetVector: "text",
+				vectorizer: map[string]interface{}{
+					"compatible": map[string]interface{}{}, "incompatible": map[string]interface{}{},
+				},
+				expectedErr: "exactly one module",
+			},
+			"multi-vector module": {
+				className: "Named", targetVector: "text",
+				vectorizer:  override("colbert").vectorizer,
+				expectedErr: "multi-vector",
+			},
+			"legacy vector": {
+				className: "Legacy", targetVector: "",
+				vectorizer:  override("compatible").vectorizer,
+				expectedErr: "is not a named vector",
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := p.VectorFromInputWithVectorizer(ctx, tc.className, "query", tc.targetVector, tc.vectorizer)
+				require.ErrorContains(t, err, tc.expectedErr)
+			})
+		}
+	})
+}
+
+// fakeVectorDimensions maps class/targetVector to the dimensions of the
+// indexed vectors
+type fakeVectorDimensions map[string]int
+
+func (f fakeVectorDimensions) TargetVectorDimensions(ctx context.Context, className, targetVector string) (int, error) {
+	return f[className+"/"+targetVector], nil
+}
+
+type vectorizerParams struct {
+	vectorizer map[string]interface{}
+}
+
+func (p vectorizerParams) GetVectorizer() map[string]interface{} {
+	return p.vectorizer
+}
+
+// inputVectorizerModule returns vectors of the given dimensions with all
+// elements set to the given value.
+type inputVectorizerModule struct {
+	*dummySearcherModule[[]float32]
+	dims   int
+	value  float32
+	inputs int
+}
+
+func newInputVectorizerModule(name string, dims int, value float32) *inputVectorizerModule {
+	m := &inputVectorizerModule{dims: dims, value: value}
+	m.dummySearcherModule = newSearcherModule[[]float32](name).
+		withArg("nearText").
+		withSearcher("nearText", generictypes.VectorForParams(func(ctx context.Context, params interface{},
+			className string, findVectorFn modulecapabilities.FindVectorFn[[]float32], cfg moduletools.ClassConfig,
+		) ([]float32, error) {
+			return m.vector(), nil
+		}))
+	return m
+}
+
+func (m *inputVectorizerModule) VectorizeInput(ctx context.Context, input string,
+	cfg moduletools.ClassConfig,
+) ([]float32, error) {
+	m.inputs++
+	return m.vector(), nil
+}
+
+func (m *inputVectorizerModule) vector() []float32 {
+	vector := make([]float32, m.dims)
+	for i := range vector {
+		vector[i] = m.value
+	}
+	return vector
+}
+
+// validatingVectorizerModule only supports its default model
+type validatingVectorizerModule struct {
+	*inputVectorizerModule
+	validatedModel string
+}
+
+func (m *validatingVectorizerModule) ClassConfigDefaults() map[string]interface{} {
+	return map[string]interface{}{"model": "default"}
+}
+
+func (m *validatingVectorizerModule) PropertyConfigDefaults(dataType *schema.DataType) map[string]interface{} {
+	return nil
+}
+
+func (m *validatingVectorizerModule) ValidateClass(ctx context.Context, class *models.Class,
+	cfg moduletools.ClassConfig,
+) error {
+	model, _ := cfg.Class()["model"].(string)
+	if model != "default" {
+		return fmt.Errorf("unsupported model %q", model)
+	}
+	m.validatedModel = model
+	return nil
+}
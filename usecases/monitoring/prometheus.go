@@ -140,6 +140,9 @@ type PrometheusMetrics struct {
 	T2VRepeatStats        *prometheus.GaugeVec
 	T2VRequestsPerBatch   *prometheus.HistogramVec
 
+	T2VEmbeddingCacheRequests *prometheus.CounterVec
+	T2VEmbeddingCacheSize     prometheus.Gauge
+
 	TokenizerDuration           *prometheus.HistogramVec
 	TokenizerRequests           *prometheus.CounterVec
 	TokenizerInitializeDuration *prometheus.HistogramVec
@@ -740,6 +743,14 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Help:    "Number of requests required to process an entire (user) batch",
 			Buckets: []float64{1, 2, 5, 10, 100, 1000},
 		}, []string{"vectorizer"}),
+		T2VEmbeddingCacheRequests: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "t2v_embedding_cache_requests_total",
+			Help: "Number of embedding cache lookups by result (hit or miss)",
+		}, []string{"vectorizer", "result"}),
+		T2VEmbeddingCacheSize: promauto.NewGauge(prometheus.GaugeOpts{
+			Name: "t2v_embedding_cache_size_bytes",
+			Help: "Approximate size of the node-local embedding cache",
+		}),
 		TokenizerDuration: promauto.NewHistogramVec(prometheus.HistogramOpts{
 			Name:    "tokenizer_duration_seconds",
 			Help:    "Duration of a tokenizer operation",
@@ -31,6 +31,7 @@ const GetNodeStatusTimeout = 30 * time.Second
 type db interface {
 	GetNodeStatus(ctx context.Context, className, verbosity string) ([]*models.NodeStatus, error)
 	GetNodeStatistics(ctx context.Context) ([]*models.Statistics, error)
+	GetSlowQueries(ctx context.Context, collection string, limit int) (*models.SlowQueriesResponse, error)
 }
 
 type Manager struct {
@@ -99,3 +100,16 @@ func (m *Manager) GetNodeStatistics(ctx context.Context,
 	}
 	return m.db.GetNodeStatistics(ctxWithTimeout)
 }
+
+// GetSlowQueries collects the most recent slow queries of all nodes
+func (m *Manager) GetSlowQueries(ctx context.Context,
+	principal *models.Principal, collection string, limit int,
+) (*models.SlowQueriesResponse, error) {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, GetNodeStatusTimeout)
+	defer cancel()
+
+	if err := m.authorizer.Authorize(principal, authorization.READ, authorization.Cluster()); err != nil {
+		return nil, err
+	}
+	return m.db.GetSlowQueries(ctxWithTimeout, collection, limit)
+}
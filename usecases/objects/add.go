@@ -100,6 +100,11 @@ func (m *Manager) addObjectToConnectorAndSchema(ctx context.Context, principal *
 		return nil, err
 	}
 
+	chunks, errs := m.chunker().prepare(ctx, principal, []*models.Object{object}, fetchedClasses, repl)
+	if err := errs[0]; err != nil {
+		return nil, err
+	}
+
 	// Ensure that the local schema has caught up to the version we used to validate
 	if err := m.schemaManager.WaitForUpdate(ctx, schemaVersion); err != nil {
 		return nil, fmt.Errorf("error waiting for local schema to catch up to version %d: %w", schemaVersion, err)
@@ -112,6 +117,11 @@ func (m *Manager) addObjectToConnectorAndSchema(ctx context.Context, principal *
 	if err != nil {
 		return nil, fmt.Errorf("put object: %w", err)
 	}
+	if chunks[0] != nil {
+		if err := m.chunker().put(ctx, object, chunks[0], repl); err != nil {
+			return nil, fmt.Errorf("put chunks: %w", err)
+		}
+	}
 
 	return object, nil
 }
@@ -129,6 +129,13 @@ func Test_Kinds_Authorization(t *testing.T) {
 			expectedResources: []string{authorization.ShardsMetadata("", "")[0]},
 		},
 
+		{
+			methodName:        "Export",
+			additionalArgs:    []interface{}{&ExportParams{Class: "class"}, (func(*ExportPage) error)(nil)},
+			expectedVerb:      authorization.READ,
+			expectedResources: authorization.CollectionsData("Class"),
+		},
+
 		{ // list objects is deprecated by query
 			methodName:        "GetObjects",
 			additionalArgs:    []interface{}{(*int64)(nil), (*int64)(nil), (*string)(nil), (*string)(nil), additional.Properties{}},
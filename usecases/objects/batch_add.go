@@ -88,6 +88,7 @@ func (b *BatchManager) addObjects(ctx context.Context, principal *models.Princip
 
 	var maxSchemaVersion uint64
 	batchObjects, maxSchemaVersion := b.validateAndGetVector(ctx, principal, objects, repl, fetchedClasses)
+	chunks := b.prepareChunks(ctx, principal, batchObjects, repl, fetchedClasses)
 	schemaVersion, tenantCount, err := b.autoSchemaManager.autoTenants(ctx, principal, objects, fetchedClasses)
 	if err != nil {
 		return nil, fmt.Errorf("auto create tenants: %w", err)
@@ -112,10 +113,31 @@ func (b *BatchManager) addObjects(ctx context.Context, principal *models.Princip
 	if res, err = b.vectorRepo.BatchPutObjects(ctx, batchObjects, repl, maxSchemaVersion); err != nil {
 		return nil, NewErrInternal("batch objects: %#v", err)
 	}
+	b.chunker().putBatch(ctx, b.vectorRepo, res, chunks, repl)
+	b.dependentRefVectors().updateBatch(ctx, res, repl)
 
 	return res, nil
 }
 
+// prepareChunks splits and vectorizes the chunks of all valid objects. A
+// failure marks the parent as failed, so that it is not stored without its
+// chunks.
+func (b *BatchManager) prepareChunks(ctx context.Context, principal *models.Principal,
+	batchObjects BatchObjects, repl *additional.ReplicationProperties, fetchedClasses map[string]versioned.Class,
+) []*objectChunks {
+	parents := make([]*models.Object, len(batchObjects))
+	for i := range batchObjects {
+		if batchObjects[i].Err == nil {
+			parents[i] = batchObjects[i].Object
+		}
+	}
+	chunks, errs := b.chunker().prepare(ctx, principal, parents, fetchedClasses, repl)
+	for i, err := range errs {
+		batchObjects[i].Err = err
+	}
+	return chunks
+}
+
 func (b *BatchManager) validateAndGetVector(ctx context.Context, principal *models.Principal,
 	objects []*models.Object, repl *additional.ReplicationProperties, fetchedClasses map[string]versioned.Class,
 ) (BatchObjects, uint64) {
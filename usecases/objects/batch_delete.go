@@ -60,7 +60,14 @@ func (b *BatchManager) DeleteObjectsFromGRPCAfterAuth(ctx context.Context, princ
 	defer b.metrics.BatchDeleteDec()
 
 	deletionTime := time.UnixMilli(b.timeSource.Now())
-	return b.vectorRepo.BatchDeleteObjects(ctx, params, deletionTime, repl, tenant, 0)
+	updateDependents := b.beforeBatchDelete(ctx, params, repl, tenant)
+	result, err := b.vectorRepo.BatchDeleteObjects(ctx, params, deletionTime, repl, tenant, 0)
+	if err != nil {
+		return result, err
+	}
+	updateDependents()
+	b.deleteChunks(ctx, principal, params.ClassName.String(), &result, repl, tenant)
+	return result, nil
 }
 
 func (b *BatchManager) deleteObjects(ctx context.Context, principal *models.Principal,
@@ -81,14 +88,51 @@ func (b *BatchManager) deleteObjects(ctx context.Context, principal *models.Prin
 		deletionTime = time.UnixMilli(*deletionTimeUnixMilli)
 	}
 
+	updateDependents := b.beforeBatchDelete(ctx, *params, repl, tenant)
 	result, err := b.vectorRepo.BatchDeleteObjects(ctx, *params, deletionTime, repl, tenant, schemaVersion)
 	if err != nil {
 		return nil, fmt.Errorf("batch delete objects: %w", err)
 	}
+	updateDependents()
+	b.deleteChunks(ctx, principal, params.ClassName.String(), &result, repl, tenant)
 
 	return b.toResponse(match, params.Output, result)
 }
 
+// beforeBatchDelete finds the objects whose ref2vec vectors depend on the
+// objects about to be deleted, the returned func recalculates their vectors
+func (b *BatchManager) beforeBatchDelete(ctx context.Context, params BatchDeleteParams,
+	repl *additional.ReplicationProperties, tenant string,
+) func() {
+	if params.DryRun || params.Filters == nil || params.Filters.Root == nil {
+		return func() {}
+	}
+	return b.dependentRefVectors().beforeDelete(ctx, params.ClassName.String(), tenant, params.Filters, repl)
+}
+
+// deleteChunks deletes the chunks of all deleted objects, if their class is
+// chunked. Errors are reported on the deleted object.
+func (b *BatchManager) deleteChunks(ctx context.Context, principal *models.Principal,
+	className string, result *BatchDeleteResult, repl *additional.ReplicationProperties, tenant string,
+) {
+	if result.DryRun {
+		return
+	}
+	class := b.schemaManager.ReadOnlyClass(className)
+	if class == nil {
+		return
+	}
+	chunker := b.chunker()
+	for i := range result.Objects {
+		if result.Objects[i].Err != nil {
+			continue
+		}
+		if err := chunker.deleteAll(ctx, principal, class, result.Objects[i].UUID, tenant, repl); err != nil {
+			result.Objects[i].Err = fmt.Errorf("delete chunks: %w", err)
+		}
+	}
+}
+
 func (b *BatchManager) toResponse(match *models.BatchDeleteMatch, output string,
 	result BatchDeleteResult,
 ) (*BatchDeleteResponse, error) {
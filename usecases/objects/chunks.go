@@ -0,0 +1,439 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/entities/versioned"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/settings"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/text2vecbase"
+	"github.com/weaviate/weaviate/usecases/objects/validation"
+)
+
+// objectChunks are the child objects split off a single parent object. They
+// are vectorized together with the parent, but only stored once the parent
+// has been stored successfully.
+type objectChunks struct {
+	class         *models.Class
+	schemaVersion uint64
+	objects       []*models.Object
+}
+
+// chunker imports the chunks of objects whose vectorizer has chunking
+// configured. It is shared by the single object and the batch import.
+type chunker struct {
+	schemaManager   schemaManager
+	authorizer      authorization.Authorizer
+	modulesProvider ModulesProvider
+	vectorRepo      VectorRepo
+	config          *config.WeaviateConfig
+	logger          logrus.FieldLogger
+	findObject      modulecapabilities.FindObjectFn
+}
+
+func (m *Manager) chunker() *chunker {
+	return &chunker{
+		schemaManager:   m.schemaManager,
+		authorizer:      m.authorizer,
+		modulesProvider: m.modulesProvider,
+		vectorRepo:      m.vectorRepo,
+		config:          m.config,
+		logger:          m.logger,
+		findObject:      m.findObject,
+	}
+}
+
+func (b *BatchManager) chunker() *chunker {
+	return &chunker{
+		schemaManager:   b.schemaManager,
+		authorizer:      b.authorizer,
+		modulesProvider: b.modulesProvider,
+		vectorRepo:      b.vectorRepo,
+		config:          b.config,
+		logger:          b.logger,
+		findObject:      b.findObject,
+	}
+}
+
+// prepare splits and vectorizes the chunks of the given parents. Nil parents
+// are skipped. The returned slice holds the chunks at the index of their
+// parent, or nil if the parent's class is not chunked. Errors are returned per
+// parent index.
+func (c *chunker) prepare(ctx context.Context, principal *models.Principal,
+	parents []*models.Object, fetchedClasses map[string]versioned.Class,
+	repl *additional.ReplicationProperties,
+) ([]*objectChunks, map[int]error) {
+	var (
+		chunks      = make([]*objectChunks, len(parents))
+		errs        = make(map[int]error)
+		perClass    = make(map[string][]int)
+		classOrder  []string
+		pendingIDs  = make(map[strfmt.UUID]struct{})
+		settingsFor = make(map[string]*settings.ChunkingSettings)
+	)
+
+	for i, parent := range parents {
+		if parent == nil || fetchedClasses[parent.Class].Class == nil {
+			continue
+		}
+		cs, ok := settingsFor[parent.Class]
+		if !ok {
+			var err error
+			cs, err = settings.ClassChunkingSettings(fetchedClasses[parent.Class].Class)
+			if err != nil {
+				errs[i] = err
+				continue
+			}
+			settingsFor[parent.Class] = cs
+		}
+		if cs == nil {
+			continue
+		}
+		if _, ok := perClass[parent.Class]; !ok {
+			classOrder = append(classOrder, parent.Class)
+		}
+		perClass[parent.Class] = append(perClass[parent.Class], i)
+		pendingIDs[parent.ID] = struct{}{}
+	}
+
+	// references to the parents are valid even though the parents are only
+	// stored after their chunks have been vectorized
+	exists := func(ctx context.Context, class string, id strfmt.UUID,
+		repl *additional.ReplicationProperties, tenant string,
+	) (bool, error) {
+		if _, ok := pendingIDs[id]; ok {
+			return true, nil
+		}
+		return c.vectorRepo.Exists(ctx, class, id, repl, tenant)
+	}
+	validator := validation.New(exists, c.config, repl)
+
+	for _, className := range classOrder {
+		indices := perClass[className]
+		cs := settingsFor[className]
+
+		childClass, err := c.childClass(ctx, principal, className, cs, parents, indices)
+		if err != nil {
+			for _, i := range indices {
+				errs[i] = err
+			}
+			continue
+		}
+
+		var (
+			children      []*models.Object
+			childToParent []int
+		)
+		for _, i := range indices {
+			parentChunks := &objectChunks{
+				class:         childClass.Class,
+				schemaVersion: childClass.Version,
+				objects:       chunkObjects(parents[i], cs),
+			}
+			var invalid error
+			for _, child := range parentChunks.objects {
+				if err := validator.Object(ctx, childClass.Class, child, nil); err != nil {
+					invalid = fmt.Errorf("invalid chunk: %w", err)
+					break
+				}
+			}
+			if invalid != nil {
+				errs[i] = invalid
+				continue
+			}
+			chunks[i] = parentChunks
+			for _, child := range parentChunks.objects {
+				children = append(children, child)
+				childToParent = append(childToParent, i)
+			}
+		}
+		if len(children) == 0 {
+			continue
+		}
+
+		vecErrs, err := c.modulesProvider.BatchUpdateVector(ctx, childClass.Class, children, c.findObject, c.logger)
+		if err != nil {
+			vecErrs = make(map[int]error, len(children))
+			for j := range children {
+				vecErrs[j] = err
+			}
+		}
+		for j, err := range vecErrs {
+			if err == nil {
+				continue
+			}
+			i := childToParent[j]
+			errs[i] = errors.Join(errs[i], fmt.Errorf("vectorize chunk %d: %w", chunkIndex(children[j]), err))
+			chunks[i] = nil
+		}
+	}
+
+	return chunks, errs
+}
+
+// childClass returns the class the chunks of the given parents are stored
+// in, after checking that it is usable and that the principal may write to it.
+func (c *chunker) childClass(ctx context.Context, principal *models.Principal,
+	parentClass string, cs *settings.ChunkingSettings, parents []*models.Object, indices []int,
+) (versioned.Class, error) {
+	tenants := make([]string, 0, len(indices))
+	for _, i := range indices {
+		tenants = append(tenants, parents[i].Tenant)
+	}
+	if err := c.authorizer.Authorize(principal, authorization.CREATE, authorization.ShardsData(cs.ChildClass, tenants...)...); err != nil {
+		return versioned.Class{}, err
+	}
+	if err := c.authorizer.Authorize(principal, authorization.UPDATE, authorization.ShardsData(cs.ChildClass, tenants...)...); err != nil {
+		return versioned.Class{}, err
+	}
+
+	classes, err := c.schemaManager.GetCachedClassNoAuth(ctx, cs.ChildClass)
+	if err != nil {
+		return versioned.Class{}, err
+	}
+	child, ok := classes[cs.ChildClass]
+	if !ok || child.Class == nil {
+		return versioned.Class{}, fmt.Errorf("chunking: child class %q not present in schema", cs.ChildClass)
+	}
+	if err := validateChunkClass(child.Class, parentClass, cs); err != nil {
+		return versioned.Class{}, fmt.Errorf("chunking: %w", err)
+	}
+	return child, nil
+}
+
+// put stores the chunks of a single parent and removes chunks that were left
+// over from a longer previous version of the parent.
+func (c *chunker) put(ctx context.Context, parent *models.Object, chunks *objectChunks,
+	repl *additional.ReplicationProperties,
+) error {
+	if err := c.schemaManager.WaitForUpdate(ctx, chunks.schemaVersion); err != nil {
+		return fmt.Errorf("error waiting for local schema to catch up to version %d: %w", chunks.schemaVersion, err)
+	}
+	for _, child := range chunks.objects {
+		vectors, multiVectors, err := dto.GetVectors(child.Vectors)
+		if err != nil {
+			return fmt.Errorf("chunk %d: cannot get vectors: %w", chunkIndex(child), err)
+		}
+		if err := c.vectorRepo.PutObject(ctx, child, child.Vector, vectors, multiVectors,
+			repl, chunks.schemaVersion); err != nil {
+			return fmt.Errorf("chunk %d: %w", chunkIndex(child), err)
+		}
+	}
+	return c.deleteStale(ctx, parent, chunks, repl)
+}
+
+// putBatch stores the chunks of all parents that were stored successfully.
+// Errors are reported on the parent.
+func (c *chunker) putBatch(ctx context.Context, repo batchRepoNew, parents BatchObjects,
+	chunks []*objectChunks, repl *additional.ReplicationProperties,
+) {
+	var (
+		batch         BatchObjects
+		childToParent []int
+		schemaVersion uint64
+	)
+	for i := range parents {
+		parentChunks := chunks[parents[i].OriginalIndex]
+		if parentChunks == nil || parents[i].Err != nil {
+			continue
+		}
+		for _, child := range parentChunks.objects {
+			batch = append(batch, BatchObject{
+				OriginalIndex: len(batch),
+				Object:        child,
+				UUID:          child.ID,
+			})
+			childToParent = append(childToParent, i)
+		}
+		if parentChunks.schemaVersion > schemaVersion {
+			schemaVersion = parentChunks.schemaVersion
+		}
+	}
+
+	if len(batch) > 0 {
+		if err := c.schemaManager.WaitForUpdate(ctx, schemaVersion); err != nil {
+			for _, i := range childToParent {
+				parents[i].Err = fmt.Errorf("put chunks: %w", err)
+			}
+			return
+		}
+		res, err := repo.BatchPutObjects(ctx, batch, repl, schemaVersion)
+		if err != nil {
+			for _, i := range childToParent {
+				parents[i].Err = fmt.Errorf("put chunks: %w", err)
+			}
+			return
+		}
+		for _, child := range res {
+			if child.Err != nil {
+				i := childToParent[child.OriginalIndex]
+				parents[i].Err = errors.Join(parents[i].Err,
+					fmt.Errorf("put chunk %d: %w", chunkIndex(child.Object), child.Err))
+			}
+		}
+	}
+
+	for i := range parents {
+		parentChunks := chunks[parents[i].OriginalIndex]
+		if parentChunks == nil || parents[i].Err != nil {
+			continue
+		}
+		if err := c.deleteStale(ctx, parents[i].Object, parentChunks, repl); err != nil {
+			parents[i].Err = err
+		}
+	}
+}
+
+// deleteStale deletes the chunks following the last chunk of the parent.
+// Chunk ids are derived from the parent id and the chunk index, so they are
+// contiguous and the first missing id ends the search.
+func (c *chunker) deleteStale(ctx context.Context, parent *models.Object, chunks *objectChunks,
+	repl *additional.ReplicationProperties,
+) error {
+	for i := len(chunks.objects); ; i++ {
+		id := chunkID(parent.ID, i)
+		exists, err := c.vectorRepo.Exists(ctx, chunks.class.Class, id, repl, parent.Tenant)
+		if err != nil {
+			return fmt.Errorf("check stale chunk %d: %w", i, err)
+		}
+		if !exists {
+			return nil
+		}
+		if err := c.vectorRepo.DeleteObject(ctx, chunks.class.Class, id, time.Now(),
+			repl, parent.Tenant, chunks.schemaVersion); err != nil {
+			return fmt.Errorf("delete stale chunk %d: %w", i, err)
+		}
+	}
+}
+
+// deleteAll deletes all chunks of a deleted parent. It is a no-op if the
+// parent's class is not chunked.
+func (c *chunker) deleteAll(ctx context.Context, principal *models.Principal,
+	parentClass *models.Class, parentID strfmt.UUID, tenant string,
+	repl *additional.ReplicationProperties,
+) error {
+	cs, err := settings.ClassChunkingSettings(parentClass)
+	if err != nil || cs == nil {
+		return err
+	}
+	if err := c.authorizer.Authorize(principal, authorization.DELETE, authorization.ShardsData(cs.ChildClass, tenant)...); err != nil {
+		return err
+	}
+	classes, err := c.schemaManager.GetCachedClassNoAuth(ctx, cs.ChildClass)
+	if err != nil {
+		return err
+	}
+	child, ok := classes[cs.ChildClass]
+	if !ok || child.Class == nil {
+		// nothing to delete if the child class was never created
+		return nil
+	}
+	parent := &models.Object{Class: parentClass.Class, ID: parentID, Tenant: tenant}
+	return c.deleteStale(ctx, parent, &objectChunks{class: child.Class, schemaVersion: child.Version}, repl)
+}
+
+// validateChunkClass checks that the child class has the chunked text
+// property, the chunk index and a reference back to the parent class.
+func validateChunkClass(child *models.Class, parentClass string, cs *settings.ChunkingSettings) error {
+	props := make(map[string]*models.Property, len(child.Properties))
+	for _, prop := range child.Properties {
+		props[prop.Name] = prop
+	}
+
+	if prop, ok := props[cs.Property]; !ok || len(prop.DataType) != 1 ||
+		prop.DataType[0] != schema.DataTypeText.String() {
+		return fmt.Errorf("child class %q needs a text property %q", child.Class, cs.Property)
+	}
+	if prop, ok := props[settings.ChunkIndexProperty]; !ok || len(prop.DataType) != 1 ||
+		prop.DataType[0] != schema.DataTypeInt.String() {
+		return fmt.Errorf("child class %q needs an int property %q", child.Class, settings.ChunkIndexProperty)
+	}
+	if prop, ok := props[settings.ChunkParentProperty]; ok {
+		for _, dataType := range prop.DataType {
+			if dataType == parentClass {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("child class %q needs a reference property %q to class %q",
+		child.Class, settings.ChunkParentProperty, parentClass)
+}
+
+// chunkObjects splits the chunked property of the parent into child objects
+func chunkObjects(parent *models.Object, cs *settings.ChunkingSettings) []*models.Object {
+	var text string
+	if props, ok := parent.Properties.(map[string]interface{}); ok {
+		text, _ = props[cs.Property].(string)
+	}
+
+	// references are given in their json form, as they are validated like
+	// user input
+	parentBeacon := crossref.NewLocalhost(parent.Class, parent.ID).String()
+	texts := text2vecbase.Chunk(text, *cs)
+	children := make([]*models.Object, len(texts))
+	for i, chunk := range texts {
+		children[i] = &models.Object{
+			Class:              cs.ChildClass,
+			ID:                 chunkID(parent.ID, i),
+			Tenant:             parent.Tenant,
+			CreationTimeUnix:   parent.CreationTimeUnix,
+			LastUpdateTimeUnix: parent.LastUpdateTimeUnix,
+			Properties: map[string]interface{}{
+				cs.Property:                 chunk,
+				settings.ChunkIndexProperty: int64(i),
+				settings.ChunkParentProperty: []interface{}{
+					map[string]interface{}{"beacon": parentBeacon},
+				},
+			},
+		}
+	}
+	return children
+}
+
+// chunkID derives a stable id from the parent id, so that re-importing a
+// parent overwrites its chunks instead of duplicating them.
+func chunkID(parentID strfmt.UUID, index int) strfmt.UUID {
+	namespace, err := uuid.Parse(parentID.String())
+	if err != nil {
+		namespace = uuid.NameSpaceOID
+	}
+	return strfmt.UUID(uuid.NewSHA1(namespace, []byte(strconv.Itoa(index))).String())
+}
+
+func chunkIndex(child *models.Object) int64 {
+	if props, ok := child.Properties.(map[string]interface{}); ok {
+		switch index := props[settings.ChunkIndexProperty].(type) {
+		case int64:
+			return index
+		case float64:
+			return int64(index)
+		}
+	}
+	return -1
+}
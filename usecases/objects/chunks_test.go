@@ -0,0 +1,252 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/config/runtime"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/settings"
+)
+
+func Test_BatchManager_AddObjects_WithChunking(t *testing.T) {
+	var (
+		vectorRepo      *fakeVectorRepo
+		modulesProvider *fakeModulesProvider
+		manager         *BatchManager
+	)
+
+	parentID := strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+	chunkClass := func(props ...*models.Property) *models.Class {
+		return &models.Class{
+			Class:      "DocumentChunk",
+			Vectorizer: "text2vec-fake",
+			Properties: props,
+		}
+	}
+	chunkProps := []*models.Property{
+		{Name: "body", DataType: schema.DataTypeText.PropString()},
+		{Name: settings.ChunkIndexProperty, DataType: schema.DataTypeInt.PropString()},
+		{Name: settings.ChunkParentProperty, DataType: []string{"Document"}},
+	}
+
+	reset := func(child *models.Class) {
+		vectorRepo = &fakeVectorRepo{}
+		cfg := &config.WeaviateConfig{
+			Config: config.Config{
+				AutoSchema: config.AutoSchema{Enabled: runtime.NewDynamicValue(false)},
+			},
+		}
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: schema.Schema{
+				Objects: &models.Schema{
+					Classes: []*models.Class{
+						{
+							Class:      "Document",
+							Vectorizer: "text2vec-fake",
+							ModuleConfig: map[string]interface{}{
+								"text2vec-fake": map[string]interface{}{
+									"chunking": map[string]interface{}{
+										"property":   "body",
+										"childClass": "DocumentChunk",
+										"strategy":   "sentences",
+										"size":       float64(1),
+									},
+								},
+							},
+							Properties: []*models.Property{
+								{Name: "body", DataType: schema.DataTypeText.PropString()},
+							},
+						},
+						child,
+					},
+				},
+			},
+		}
+		logger, _ := test.NewNullLogger()
+		authorizer := mocks.NewMockAuthorizer()
+		modulesProvider = getFakeModulesProvider()
+		manager = NewBatchManager(vectorRepo, modulesProvider, schemaManager, cfg, logger, authorizer, nil,
+			NewAutoSchemaManager(schemaManager, vectorRepo, cfg, authorizer, logger, prometheus.NewPedanticRegistry()))
+	}
+
+	document := func() []*models.Object {
+		return []*models.Object{{
+			Class: "Document",
+			ID:    parentID,
+			Properties: map[string]interface{}{
+				"body": "First sentence. Second sentence.",
+			},
+		}}
+	}
+
+	t.Run("chunks are vectorized and stored after their parent", func(t *testing.T) {
+		reset(chunkClass(chunkProps...))
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Twice()
+		vectorRepo.On("Exists", "DocumentChunk", chunkID(parentID, 2)).Return(true, nil).Once()
+		vectorRepo.On("DeleteObject", "DocumentChunk", chunkID(parentID, 2), mock.Anything).Return(nil).Once()
+		vectorRepo.On("Exists", "DocumentChunk", chunkID(parentID, 3)).Return(false, nil).Once()
+		modulesProvider.On("BatchUpdateVector").Return([]float32{0.1, 0.2}, nil)
+
+		res, err := manager.AddObjects(context.Background(), nil, document(), nil, nil)
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		require.NoError(t, res[0].Err)
+		vectorRepo.AssertExpectations(t)
+
+		parents := vectorRepo.Calls[0].Arguments[0].(BatchObjects)
+		require.Len(t, parents, 1)
+		assert.Equal(t, parentID, parents[0].UUID)
+
+		chunks := vectorRepo.Calls[1].Arguments[0].(BatchObjects)
+		require.Len(t, chunks, 2)
+		parentRef := crossref.NewLocalhost("Document", parentID).SingleRef()
+		for i, body := range []string{"First sentence.", "Second sentence."} {
+			chunk := chunks[i].Object
+			assert.Equal(t, "DocumentChunk", chunk.Class)
+			assert.Equal(t, chunkID(parentID, i), chunk.ID)
+			assert.Equal(t, models.C11yVector{0.1, 0.2}, chunk.Vector)
+			props := chunk.Properties.(map[string]interface{})
+			assert.Equal(t, body, props["body"])
+			assert.Equal(t, float64(i), props[settings.ChunkIndexProperty])
+			refs := props[settings.ChunkParentProperty].(models.MultipleRef)
+			require.Len(t, refs, 1)
+			assert.Equal(t, parentRef.Beacon, refs[0].Beacon)
+		}
+	})
+
+	t.Run("parent fails if the child class cannot hold chunks", func(t *testing.T) {
+		reset(chunkClass(chunkProps[:2]...))
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Once()
+		modulesProvider.On("BatchUpdateVector").Return([]float32{0.1, 0.2}, nil)
+
+		res, err := manager.AddObjects(context.Background(), nil, document(), nil, nil)
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		require.EqualError(t, res[0].Err, `chunking: child class "DocumentChunk" needs a `+
+			`reference property "parent" to class "Document"`)
+		vectorRepo.AssertNumberOfCalls(t, "BatchPutObjects", 1)
+	})
+}
+
+func Test_Manager_ChunksFollowTheirParent(t *testing.T) {
+	parentID := strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+	sch := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class:      "Document",
+					Vectorizer: "text2vec-fake",
+					ModuleConfig: map[string]interface{}{
+						"text2vec-fake": map[string]interface{}{
+							"chunking": map[string]interface{}{
+								"property":   "body",
+								"childClass": "DocumentChunk",
+								"strategy":   "sentences",
+								"size":       float64(1),
+							},
+						},
+					},
+					Properties: []*models.Property{
+						{Name: "body", DataType: schema.DataTypeText.PropString()},
+						{Name: "title", DataType: schema.DataTypeText.PropString()},
+					},
+				},
+				{
+					Class:      "DocumentChunk",
+					Vectorizer: "text2vec-fake",
+					Properties: []*models.Property{
+						{Name: "body", DataType: schema.DataTypeText.PropString()},
+						{Name: settings.ChunkIndexProperty, DataType: schema.DataTypeInt.PropString()},
+						{Name: settings.ChunkParentProperty, DataType: []string{"Document"}},
+					},
+				},
+			},
+		},
+	}
+	stored := func() *search.Result {
+		return &search.Result{
+			ID:        parentID,
+			ClassName: "Document",
+			Schema:    map[string]interface{}{"body": "First sentence. Second sentence. Third sentence."},
+		}
+	}
+	isChunk := func(obj *models.Object) bool { return obj.Class == "DocumentChunk" }
+
+	t.Run("update re-chunks the parent and deletes stale chunks", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+		m.repo.On("Object", "Document", parentID, mock.Anything, mock.Anything, "").Return(stored(), nil).Once()
+		m.modulesProvider.On("UpdateVector", mock.Anything, mock.Anything).Return([]float32{0.1, 0.2}, nil)
+		m.modulesProvider.On("BatchUpdateVector").Return([]float32{0.1, 0.2}, nil)
+		m.repo.On("PutObject", mock.MatchedBy(func(obj *models.Object) bool { return !isChunk(obj) }), mock.Anything).
+			Return(nil).Once()
+		m.repo.On("PutObject", mock.MatchedBy(isChunk), mock.Anything).Return(nil).Twice()
+		m.repo.On("Exists", "DocumentChunk", chunkID(parentID, 2)).Return(true, nil).Once()
+		m.repo.On("DeleteObject", "DocumentChunk", chunkID(parentID, 2), mock.Anything).Return(nil).Once()
+		m.repo.On("Exists", "DocumentChunk", chunkID(parentID, 3)).Return(false, nil).Once()
+
+		_, err := m.UpdateObject(context.Background(), nil, "Document", parentID, &models.Object{
+			Class:      "Document",
+			ID:         parentID,
+			Properties: map[string]interface{}{"body": "First sentence. Second sentence."},
+		}, nil)
+		require.NoError(t, err)
+		m.repo.AssertExpectations(t)
+	})
+
+	t.Run("merge removing the chunked property deletes all chunks", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+		m.repo.On("Object", "Document", parentID, mock.Anything, mock.Anything, "").Return(stored(), nil).Once()
+		m.modulesProvider.On("UpdateVector", mock.Anything, mock.Anything).Return([]float32{0.1, 0.2}, nil)
+		m.repo.On("Merge", mock.Anything).Return(nil).Once()
+		m.repo.On("Exists", "DocumentChunk", chunkID(parentID, 0)).Return(true, nil).Once()
+		m.repo.On("DeleteObject", "DocumentChunk", chunkID(parentID, 0), mock.Anything).Return(nil).Once()
+		m.repo.On("Exists", "DocumentChunk", chunkID(parentID, 1)).Return(false, nil).Once()
+
+		err := m.MergeObject(context.Background(), nil, &models.Object{
+			Class:      "Document",
+			ID:         parentID,
+			Properties: map[string]interface{}{"body": nil},
+		}, nil)
+		require.Nil(t, err)
+		m.repo.AssertExpectations(t)
+		m.repo.AssertNotCalled(t, "PutObject", mock.Anything, mock.Anything)
+	})
+
+	t.Run("delete deletes all chunks", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+		m.repo.On("DeleteObject", "Document", parentID, mock.Anything).Return(nil).Once()
+		for i := 0; i < 3; i++ {
+			m.repo.On("Exists", "DocumentChunk", chunkID(parentID, i)).Return(true, nil).Once()
+			m.repo.On("DeleteObject", "DocumentChunk", chunkID(parentID, i), mock.Anything).Return(nil).Once()
+		}
+		m.repo.On("Exists", "DocumentChunk", chunkID(parentID, 3)).Return(false, nil).Once()
+
+		err := m.DeleteObject(context.Background(), nil, "Document", parentID, nil, "")
+		require.NoError(t, err)
+		m.repo.AssertExpectations(t)
+	})
+}
@@ -51,7 +51,7 @@ func (m *Manager) DeleteObject(ctx context.Context,
 	defer m.metrics.DeleteObjectDec()
 
 	if className == "" { // deprecated
-		return m.deleteObjectFromRepo(ctx, id, time.UnixMilli(m.timeSource.Now()))
+		return m.deleteObjectFromRepo(ctx, principal, id, time.UnixMilli(m.timeSource.Now()))
 	}
 
 	// we only use the schemaVersion in this endpoint
@@ -64,6 +64,8 @@ func (m *Manager) DeleteObject(ctx context.Context,
 	if err := m.schemaManager.WaitForUpdate(ctx, fetchedClasses[className].Version); err != nil {
 		return fmt.Errorf("error waiting for local schema to catch up to version %d: %w", fetchedClasses[className].Version, err)
 	}
+	updateDependents := m.dependentRefVectors().beforeDelete(ctx, className, tenant,
+		idsFilter(className, []strfmt.UUID{id}), repl)
 	if err = m.vectorRepo.DeleteObject(ctx, className, id, time.UnixMilli(m.timeSource.Now()), repl, tenant, fetchedClasses[className].Version); err != nil {
 		var e1 ErrMultiTenancy
 		if errors.As(err, &e1) {
@@ -79,14 +81,39 @@ func (m *Manager) DeleteObject(ctx context.Context,
 		}
 		return NewErrInternal("could not delete object from vector repo: %v", err)
 	}
+	updateDependents()
 
+	return m.deleteChunks(ctx, principal, className, id, repl, tenant)
+}
+
+// deleteChunks deletes the chunks of a deleted object, if its class is chunked
+func (m *Manager) deleteChunks(ctx context.Context, principal *models.Principal,
+	className string, id strfmt.UUID, repl *additional.ReplicationProperties, tenant string,
+) error {
+	fetchedClasses, err := m.schemaManager.GetCachedClassNoAuth(ctx, className)
+	if err != nil {
+		return fmt.Errorf("could not get class %s: %w", className, err)
+	}
+	class := fetchedClasses[className].Class
+	if class == nil {
+		return nil
+	}
+	if err := m.chunker().deleteAll(ctx, principal, class, id, tenant, repl); err != nil {
+		var e authzerrs.Forbidden
+		if errors.As(err, &e) {
+			return fmt.Errorf("delete chunks: %w", err)
+		}
+		return NewErrInternal("could not delete chunks: %v", err)
+	}
 	return nil
 }
 
 // deleteObjectFromRepo deletes objects with same id and different classes.
 //
 // Deprecated
-func (m *Manager) deleteObjectFromRepo(ctx context.Context, id strfmt.UUID, deletionTime time.Time) error {
+func (m *Manager) deleteObjectFromRepo(ctx context.Context, principal *models.Principal,
+	id strfmt.UUID, deletionTime time.Time,
+) error {
 	// There might be a situation to have UUIDs which are not unique across classes.
 	// Added loop in order to delete all of the objects with given UUID across all classes.
 	// This change is added in response to this issue:
@@ -105,10 +132,16 @@ func (m *Manager) deleteObjectFromRepo(ctx context.Context, id strfmt.UUID, dele
 		}
 
 		object := objectRes.Object()
+		updateDependents := m.dependentRefVectors().beforeDelete(ctx, object.Class, "",
+			idsFilter(object.Class, []strfmt.UUID{id}), nil)
 		err = m.vectorRepo.DeleteObject(ctx, object.Class, id, deletionTime, nil, "", 0)
 		if err != nil {
 			return NewErrInternal("could not delete object from vector repo: %v", err)
 		}
+		updateDependents()
+		if err := m.deleteChunks(ctx, principal, object.Class, id, nil, ""); err != nil {
+			return err
+		}
 		deleteCounter++
 	}
 }
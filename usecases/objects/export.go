@@ -0,0 +1,150 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+const DefaultExportPageSize = 100
+
+// ExportParams select the objects of a collection to export
+type ExportParams struct {
+	Class string
+	// Tenants restricts the export of a multi-tenant collection, all active
+	// tenants are exported if empty
+	Tenants    []string
+	Filters    *filters.LocalFilter
+	Additional additional.Properties
+	// Cursor resumes an export after the last object of a previous page
+	Cursor   string
+	PageSize int
+}
+
+// ExportPage is a page of exported objects
+type ExportPage struct {
+	Objects []*models.Object
+	// Cursor resumes the export after the last object of the page
+	Cursor string
+}
+
+// exportCursor is the position of an export, the cursor token is its
+// base64 encoded json representation
+type exportCursor struct {
+	Shard string      `json:"shard"`
+	After strfmt.UUID `json:"after"`
+}
+
+func (c exportCursor) token() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func parseExportCursor(token string) (exportCursor, error) {
+	var c exportCursor
+	if token == "" {
+		return c, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return c, fmt.Errorf("invalid cursor: %w", err)
+	}
+	if err := json.Unmarshal(b, &c); err != nil {
+		return c, fmt.Errorf("invalid cursor: %w", err)
+	}
+	if c.After != "" && !strfmt.IsUUID(c.After.String()) {
+		return c, fmt.Errorf("invalid cursor: invalid uuid %q", c.After)
+	}
+	return c, nil
+}
+
+// Export walks all objects of a collection shard by shard in the order of
+// their ids and passes them to fn page by page. The walk stops at the first
+// error returned by fn.
+func (m *Manager) Export(ctx context.Context, principal *models.Principal,
+	params *ExportParams, fn func(*ExportPage) error,
+) error {
+	class := schema.UppercaseClassName(params.Class)
+	resources := authorization.CollectionsData(class)
+	if len(params.Tenants) > 0 {
+		resources = authorization.ShardsData(class, params.Tenants...)
+	}
+	if err := m.authorizer.Authorize(principal, authorization.READ, resources...); err != nil {
+		return &Error{err.Error(), StatusForbidden, err}
+	}
+
+	cursor, err := parseExportCursor(params.Cursor)
+	if err != nil {
+		return &Error{"cursor", StatusBadRequest, err}
+	}
+	pageSize := params.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultExportPageSize
+	}
+
+	m.metrics.GetObjectInc()
+	defer m.metrics.GetObjectDec()
+
+	shards, err := m.vectorRepo.ExportShards(ctx, class)
+	if err != nil {
+		return err
+	}
+	if len(params.Tenants) > 0 {
+		for _, tenant := range params.Tenants {
+			if !slices.Contains(shards, tenant) {
+				err := fmt.Errorf("tenant %q not found or not active", tenant)
+				return &Error{err.Error(), StatusUnprocessableEntity, err}
+			}
+		}
+		shards = slices.DeleteFunc(shards, func(shard string) bool {
+			return !slices.Contains(params.Tenants, shard)
+		})
+	}
+
+	for _, shard := range shards {
+		// shards are exported in order, the ones before the cursor are done
+		if shard < cursor.Shard {
+			continue
+		}
+		after := strfmt.UUID("")
+		if shard == cursor.Shard {
+			after = cursor.After
+		}
+
+		err := m.vectorRepo.ExportShard(ctx, class, shard, params.Filters, after, pageSize, params.Additional,
+			func(res search.Results) error {
+				page := &ExportPage{Objects: make([]*models.Object, len(res))}
+				for i := range res {
+					page.Objects[i] = res[i].ObjectWithVector(params.Additional.Vector)
+				}
+				page.Cursor = exportCursor{Shard: shard, After: res[len(res)-1].ID}.token()
+				return fn(page)
+			})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
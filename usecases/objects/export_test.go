@@ -0,0 +1,114 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+func TestExport(t *testing.T) {
+	var (
+		cls = "MyClass"
+		ctx = context.Background()
+		id1 = strfmt.UUID("00000000-0000-0000-0000-000000000001")
+		id2 = strfmt.UUID("00000000-0000-0000-0000-000000000002")
+		id3 = strfmt.UUID("00000000-0000-0000-0000-000000000003")
+	)
+	results := func(ids ...strfmt.UUID) search.Results {
+		res := make(search.Results, len(ids))
+		for i, id := range ids {
+			res[i] = search.Result{ClassName: cls, ID: id}
+		}
+		return res
+	}
+	export := func(m fakeGetManager, params *ExportParams) ([]strfmt.UUID, []string, error) {
+		var ids []strfmt.UUID
+		var cursors []string
+		err := m.Export(ctx, nil, params, func(page *ExportPage) error {
+			for _, obj := range page.Objects {
+				ids = append(ids, obj.ID)
+			}
+			cursors = append(cursors, page.Cursor)
+			return nil
+		})
+		return ids, cursors, err
+	}
+
+	t.Run("all shards", func(t *testing.T) {
+		m := newFakeGetManager(schema.Schema{})
+		m.repo.On("ExportShards", cls).Return([]string{"s1", "s2"}, nil)
+		m.repo.On("ExportShard", cls, "s1", strfmt.UUID(""), 2).
+			Return([]search.Results{results(id1, id2), results(id3)}, nil)
+		m.repo.On("ExportShard", cls, "s2", strfmt.UUID(""), 2).Return([]search.Results{}, nil)
+
+		ids, cursors, err := export(m, &ExportParams{Class: cls, PageSize: 2})
+		require.NoError(t, err)
+		assert.Equal(t, []strfmt.UUID{id1, id2, id3}, ids)
+		require.Len(t, cursors, 2)
+		m.repo.AssertExpectations(t)
+
+		t.Run("resume", func(t *testing.T) {
+			m := newFakeGetManager(schema.Schema{})
+			m.repo.On("ExportShards", cls).Return([]string{"s1", "s2"}, nil)
+			m.repo.On("ExportShard", cls, "s1", id2, 2).Return([]search.Results{results(id3)}, nil)
+			m.repo.On("ExportShard", cls, "s2", strfmt.UUID(""), 2).Return([]search.Results{}, nil)
+
+			ids, _, err := export(m, &ExportParams{Class: cls, PageSize: 2, Cursor: cursors[0]})
+			require.NoError(t, err)
+			assert.Equal(t, []strfmt.UUID{id3}, ids)
+			m.repo.AssertExpectations(t)
+		})
+	})
+
+	t.Run("tenants", func(t *testing.T) {
+		m := newFakeGetManager(schema.Schema{})
+		m.repo.On("ExportShards", cls).Return([]string{"t1", "t2", "t3"}, nil)
+		m.repo.On("ExportShard", cls, "t2", strfmt.UUID(""), DefaultExportPageSize).
+			Return([]search.Results{results(id1)}, nil)
+
+		ids, _, err := export(m, &ExportParams{Class: cls, Tenants: []string{"t2"}})
+		require.NoError(t, err)
+		assert.Equal(t, []strfmt.UUID{id1}, ids)
+		m.repo.AssertExpectations(t)
+
+		_, _, err = export(m, &ExportParams{Class: cls, Tenants: []string{"t4"}})
+		var objErr *Error
+		require.ErrorAs(t, err, &objErr)
+		assert.True(t, objErr.UnprocessableEntity())
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		m := newFakeGetManager(schema.Schema{})
+		_, _, err := export(m, &ExportParams{Class: cls, Cursor: "not a cursor"})
+		var objErr *Error
+		require.ErrorAs(t, err, &objErr)
+		assert.True(t, objErr.BadRequest())
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		m := newFakeGetManager(schema.Schema{})
+		m.authorizer.SetErr(errors.New("forbidden"))
+		_, _, err := export(m, &ExportParams{Class: cls})
+		var objErr *Error
+		require.ErrorAs(t, err, &objErr)
+		assert.True(t, objErr.Forbidden())
+	})
+}
@@ -201,6 +201,8 @@ func (f *fakeSchemaManager) StorageCandidates() []string {
 
 type fakeVectorRepo struct {
 	mock.Mock
+	// exportFilters are the filters ExportShard was called with
+	exportFilters []*filters.LocalFilter
 }
 
 func (f *fakeVectorRepo) Exists(ctx context.Context, class string, id strfmt.UUID, repl *additional.ReplicationProperties, tenant string) (bool, error) {
@@ -244,6 +246,24 @@ func (f *fakeVectorRepo) Query(ctx context.Context, q *QueryInput) (search.Resul
 	return args.Get(0).([]search.Result), customEr
 }
 
+func (f *fakeVectorRepo) ExportShards(ctx context.Context, class string) ([]string, error) {
+	args := f.Called(class)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (f *fakeVectorRepo) ExportShard(ctx context.Context, class, shard string, filters *filters.LocalFilter,
+	after strfmt.UUID, limit int, additional additional.Properties, fn func(search.Results) error,
+) error {
+	f.exportFilters = append(f.exportFilters, filters)
+	args := f.Called(class, shard, after, limit)
+	for _, page := range args.Get(0).([]search.Results) {
+		if err := fn(page); err != nil {
+			return err
+		}
+	}
+	return args.Error(1)
+}
+
 func (f *fakeVectorRepo) PutObject(ctx context.Context, concept *models.Object, vector []float32,
 	vectors map[string][]float32, multiVectors map[string][][]float32, repl *additional.ReplicationProperties, schemaVersion uint64,
 ) error {
@@ -356,8 +376,10 @@ func (f *fakePathBuilder) AdditionalPropertyDefaultValue() interface{} {
 
 type fakeModulesProvider struct {
 	mock.Mock
-	customExtender  *fakeExtender
-	customProjector *fakeProjector
+	customExtender      *fakeExtender
+	customProjector     *fakeProjector
+	refVectorDependents map[string][]string
+	refVectorWeights    []string
 }
 
 func (p *fakeModulesProvider) GetObjectAdditionalExtend(ctx context.Context,
@@ -381,6 +403,14 @@ func (p *fakeModulesProvider) UsingRef2Vec(moduleName string) bool {
 	return args.Bool(0)
 }
 
+func (p *fakeModulesProvider) RefVectorDependents(className string) map[string][]string {
+	return p.refVectorDependents
+}
+
+func (p *fakeModulesProvider) RefVectorWeightProperties(className string) []string {
+	return p.refVectorWeights
+}
+
 func (p *fakeModulesProvider) UpdateVector(ctx context.Context, object *models.Object, class *models.Class,
 	findObjFn modulecapabilities.FindObjectFn, logger logrus.FieldLogger,
 ) error {
@@ -799,7 +829,7 @@ func getFakeModulesProviderWithCustomExtenders(
 	customProjector *fakeProjector,
 	opts ...func(provider *fakeModulesProvider),
 ) *fakeModulesProvider {
-	p := &fakeModulesProvider{mock.Mock{}, customExtender, customProjector}
+	p := &fakeModulesProvider{mock.Mock{}, customExtender, customProjector, nil, nil}
 	p.applyOptions(opts...)
 	return p
 }
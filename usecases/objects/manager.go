@@ -137,6 +137,9 @@ type VectorRepo interface {
 		target *crossref.Ref, repl *additional.ReplicationProperties, tenant string, schemaVersion uint64) error
 	Merge(ctx context.Context, merge MergeDocument, repl *additional.ReplicationProperties, tenant string, schemaVersion uint64) error
 	Query(context.Context, *QueryInput) (search.Results, *Error)
+	ExportShards(ctx context.Context, class string) ([]string, error)
+	ExportShard(ctx context.Context, class, shard string, filters *filters.LocalFilter,
+		after strfmt.UUID, limit int, additional additional.Properties, fn func(search.Results) error) error
 }
 
 type ModulesProvider interface {
@@ -145,6 +148,8 @@ type ModulesProvider interface {
 	ListObjectsAdditionalExtend(ctx context.Context, in search.Results,
 		moduleParams map[string]interface{}) (search.Results, error)
 	UsingRef2Vec(className string) bool
+	RefVectorDependents(className string) map[string][]string
+	RefVectorWeightProperties(className string) []string
 	UpdateVector(ctx context.Context, object *models.Object, class *models.Class, repo modulecapabilities.FindObjectFn,
 		logger logrus.FieldLogger) error
 	BatchUpdateVector(ctx context.Context, class *models.Class, objects []*models.Object,
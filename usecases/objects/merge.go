@@ -121,11 +121,12 @@ func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
 		updates.Properties = map[string]interface{}{}
 	}
 
-	return m.patchObject(ctx, prevObj, updates, repl, propertiesToDelete, updates.Tenant, fetchedClass, maxSchemaVersion)
+	return m.patchObject(ctx, principal, prevObj, updates, repl, propertiesToDelete, updates.Tenant, fetchedClass, maxSchemaVersion)
 }
 
 // patchObject patches an existing object obj with updates
-func (m *Manager) patchObject(ctx context.Context, prevObj, updates *models.Object, repl *additional.ReplicationProperties,
+func (m *Manager) patchObject(ctx context.Context, principal *models.Principal,
+	prevObj, updates *models.Object, repl *additional.ReplicationProperties,
 	propertiesToDelete []string, tenant string, fetchedClass map[string]versioned.Class, maxSchemaVersion uint64,
 ) *Error {
 	cls, id := updates.Class, updates.ID
@@ -151,6 +152,33 @@ func (m *Manager) patchObject(ctx context.Context, prevObj, updates *models.Obje
 		mergeDoc.AdditionalProperties = objWithVec.Additional
 	}
 
+	// the chunks are split off the merged object, as the patch may not
+	// contain the chunked property
+	mergedProps := map[string]interface{}{}
+	if props, ok := objWithVec.Properties.(map[string]interface{}); ok {
+		for name, value := range props {
+			mergedProps[name] = value
+		}
+	}
+	for _, name := range propertiesToDelete {
+		delete(mergedProps, name)
+	}
+	merged := &models.Object{
+		Class:              cls,
+		ID:                 id,
+		Tenant:             tenant,
+		Properties:         mergedProps,
+		CreationTimeUnix:   prevObj.CreationTimeUnix,
+		LastUpdateTimeUnix: mergeDoc.UpdateTime,
+	}
+	chunks, errs := m.chunker().prepare(ctx, principal, []*models.Object{merged}, fetchedClass, repl)
+	if err := errs[0]; err != nil {
+		if errors.As(err, &authzerrs.Forbidden{}) {
+			return &Error{"forbidden", StatusForbidden, err}
+		}
+		return &Error{"chunk", StatusInternalServerError, err}
+	}
+
 	// Ensure that the local schema has caught up to the version we used to validate
 	if err := m.schemaManager.WaitForUpdate(ctx, maxSchemaVersion); err != nil {
 		return &Error{
@@ -167,6 +195,13 @@ func (m *Manager) patchObject(ctx context.Context, prevObj, updates *models.Obje
 		}
 		return &Error{"repo.merge", StatusInternalServerError, err}
 	}
+	if chunks[0] != nil {
+		if err := m.chunker().put(ctx, merged, chunks[0], repl); err != nil {
+			return &Error{"put chunks", StatusInternalServerError, err}
+		}
+	}
+	objWithVec.Tenant = tenant
+	m.dependentRefVectors().updateIfChanged(ctx, prevObj, objWithVec, repl)
 
 	return nil
 }
@@ -0,0 +1,440 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+// dependentRefVectors recalculates the ref2vec vectors of objects whose
+// references point to an object with a changed vector. The recalculation is
+// not cascaded any further, so ref2vec classes referencing each other cannot
+// cause endless update loops.
+type dependentRefVectors struct {
+	config          *config.WeaviateConfig
+	vectorRepo      VectorRepo
+	modulesProvider ModulesProvider
+	schemaManager   schemaManager
+	findObject      modulecapabilities.FindObjectFn
+	logger          logrus.FieldLogger
+}
+
+func (m *Manager) dependentRefVectors() *dependentRefVectors {
+	return &dependentRefVectors{
+		config:          m.config,
+		vectorRepo:      m.vectorRepo,
+		modulesProvider: m.modulesProvider,
+		schemaManager:   m.schemaManager,
+		findObject:      m.findObject,
+		logger:          m.logger,
+	}
+}
+
+func (b *BatchManager) dependentRefVectors() *dependentRefVectors {
+	return &dependentRefVectors{
+		config:          b.config,
+		vectorRepo:      b.vectorRepo,
+		modulesProvider: b.modulesProvider,
+		schemaManager:   b.schemaManager,
+		findObject:      b.findObject,
+		logger:          b.logger,
+	}
+}
+
+// refVectorTargetsPerQuery bounds the number of referenced objects which the
+// referencing objects are searched for at once
+const refVectorTargetsPerQuery = 100
+
+// updateIfChanged recalculates the dependent ref2vec vectors of next if its
+// vectors or one of the properties the dependent vectors are weighted by
+// differ from those of prev
+func (d *dependentRefVectors) updateIfChanged(ctx context.Context, prev, next *models.Object,
+	repl *additional.ReplicationProperties,
+) {
+	if !vectorsChanged(prev, next) &&
+		!propertiesChanged(prev, next, d.modulesProvider.RefVectorWeightProperties(next.Class)) {
+		return
+	}
+	d.update(ctx, next.Class, next.Tenant, []strfmt.UUID{next.ID}, repl)
+}
+
+// updateBatch recalculates the dependent ref2vec vectors of all objects
+// which were stored successfully. As the previous state of the objects is
+// unknown, the vectors are always considered to have changed. Objects
+// referencing several objects of the batch are only recalculated once.
+func (d *dependentRefVectors) updateBatch(ctx context.Context, batch BatchObjects,
+	repl *additional.ReplicationProperties,
+) {
+	type target struct{ className, tenant string }
+	var (
+		order []target
+		ids   = map[target][]strfmt.UUID{}
+	)
+	for _, obj := range batch {
+		if obj.Err != nil || obj.Object == nil {
+			continue
+		}
+		t := target{className: obj.Object.Class, tenant: obj.Object.Tenant}
+		if _, ok := ids[t]; !ok {
+			order = append(order, t)
+		}
+		ids[t] = append(ids[t], obj.Object.ID)
+	}
+	for _, t := range order {
+		d.update(ctx, t.className, t.tenant, ids[t], repl)
+	}
+}
+
+// beforeDelete finds the objects whose ref2vec vectors depend on the objects
+// of className matching the filter, as they can no longer be found through
+// their references once the objects are deleted. Only their ids are kept, the
+// returned func reads the objects again page by page to recalculate their
+// vectors and is called after the delete.
+func (d *dependentRefVectors) beforeDelete(ctx context.Context, className, tenant string,
+	filter *filters.LocalFilter, repl *additional.ReplicationProperties,
+) func() {
+	dependents := d.dependents(ctx, className, tenant)
+	if len(dependents) == 0 {
+		return func() {}
+	}
+
+	ids := make([][]strfmt.UUID, len(dependents))
+	for i, dep := range dependents {
+		d.find(ctx, dep, className, tenant, filter, func(objects []dependentObject) {
+			for _, obj := range objects {
+				ids[i] = append(ids[i], obj.object.ID)
+			}
+		})
+	}
+	return func() {
+		for i, dep := range dependents {
+			d.recalculateIDs(ctx, dep, className, tenant, ids[i], repl)
+		}
+	}
+}
+
+// update recalculates the vectors of all objects which reference one of the
+// given objects through one of the dependents' reference properties. The
+// referenced objects have already been persisted at this point, so failures
+// are logged instead of failing the request.
+func (d *dependentRefVectors) update(ctx context.Context, className, tenant string,
+	ids []strfmt.UUID, repl *additional.ReplicationProperties,
+) {
+	dependents := d.dependents(ctx, className, tenant)
+	if len(dependents) == 0 {
+		return
+	}
+
+	for _, dep := range dependents {
+		// an object referencing objects of several chunks is only
+		// recalculated once
+		seen := map[strfmt.UUID]struct{}{}
+		for start := 0; start < len(ids); start += refVectorTargetsPerQuery {
+			end := min(start+refVectorTargetsPerQuery, len(ids))
+			d.find(ctx, dep, className, tenant, idsFilter(className, ids[start:end]), func(objects []dependentObject) {
+				fresh := objects[:0]
+				for _, obj := range objects {
+					if _, ok := seen[obj.object.ID]; ok {
+						continue
+					}
+					seen[obj.object.ID] = struct{}{}
+					fresh = append(fresh, obj)
+				}
+				d.recalculate(ctx, fresh, repl)
+			})
+		}
+	}
+}
+
+// dependentClass is a class whose ref2vec vectors depend on the objects of
+// another class through the reference properties props
+type dependentClass struct {
+	class *models.Class
+	// schemaVersion of the class, the recalculated objects are stored with
+	schemaVersion uint64
+	props         []string
+}
+
+type dependentObject struct {
+	class         *models.Class
+	schemaVersion uint64
+	object        *models.Object
+}
+
+// dependents returns the ref2vec classes depending on className which can
+// reference objects of the tenant
+func (d *dependentRefVectors) dependents(ctx context.Context, className, tenant string) []dependentClass {
+	var out []dependentClass
+	for depClassName, props := range d.modulesProvider.RefVectorDependents(className) {
+		classes, err := d.schemaManager.GetCachedClassNoAuth(ctx, depClassName)
+		if err != nil {
+			d.logger.WithField("action", "update_dependent_ref_vectors").
+				WithField("dependentClassName", depClassName).
+				WithError(err).
+				Error("could not get class of referencing objects")
+			continue
+		}
+		depClass := classes[depClassName]
+		if depClass.Class == nil {
+			continue
+		}
+		// references from multi-tenant classes to objects of a tenant are
+		// only possible within the same tenant, and vice versa
+		if schema.MultiTenancyEnabled(depClass.Class) != (tenant != "") {
+			continue
+		}
+		out = append(out, dependentClass{class: depClass.Class, schemaVersion: depClass.Version, props: props})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].class.Class < out[j].class.Class })
+	return out
+}
+
+// find passes the objects of the dependent class referencing objects of
+// className matching the filter through one of its reference properties to
+// fn, page by page. The shards of the class are walked in the order of the
+// object ids, each object is passed once, even if it references several
+// matching objects.
+func (d *dependentRefVectors) find(ctx context.Context, dep dependentClass,
+	className, tenant string, filter *filters.LocalFilter, fn func([]dependentObject),
+) {
+	logger := d.logger.WithField("action", "update_dependent_ref_vectors").
+		WithField("className", className).
+		WithField("dependentClassName", dep.class.Class)
+
+	shards := []string{tenant}
+	if tenant == "" {
+		var err error
+		if shards, err = d.vectorRepo.ExportShards(ctx, dep.class.Class); err != nil {
+			logger.WithError(err).Error("could not list the shards of the referencing objects")
+			return
+		}
+	}
+
+	refFilter := referencingFilter(dep.class.Class, dep.props[0], filter)
+	if len(dep.props) > 1 {
+		operands := make([]filters.Clause, len(dep.props))
+		for i, prop := range dep.props {
+			operands[i] = *referencingFilter(dep.class.Class, prop, filter).Root
+		}
+		refFilter = &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorOr,
+			Operands: operands,
+		}}
+	}
+
+	for _, shard := range shards {
+		err := d.vectorRepo.ExportShard(ctx, dep.class.Class, shard, refFilter, "", d.pageSize(),
+			d.additional(dep), func(res search.Results) error {
+				fn(d.dependentObjects(dep, res))
+				return nil
+			})
+		if err != nil {
+			logger.WithField("shard", shard).
+				WithError(err).
+				Errorf("could not find objects referencing the objects via %v", dep.props)
+		}
+	}
+}
+
+// recalculateIDs reads the objects of the dependent class with the given ids
+// page by page and recalculates their vectors.
+func (d *dependentRefVectors) recalculateIDs(ctx context.Context, dep dependentClass,
+	className, tenant string, ids []strfmt.UUID, repl *additional.ReplicationProperties,
+) {
+	for start := 0; start < len(ids); start += refVectorTargetsPerQuery {
+		end := min(start+refVectorTargetsPerQuery, len(ids))
+		res, qErr := d.vectorRepo.Query(ctx, &QueryInput{
+			Class:      dep.class.Class,
+			Limit:      end - start,
+			Filters:    idsFilter(dep.class.Class, ids[start:end]),
+			Tenant:     tenant,
+			Additional: d.additional(dep),
+		})
+		if qErr != nil {
+			d.logger.WithField("action", "update_dependent_ref_vectors").
+				WithField("className", className).
+				WithField("dependentClassName", dep.class.Class).
+				WithError(qErr).
+				Error("could not read the objects referencing the deleted objects")
+			continue
+		}
+		d.recalculate(ctx, d.dependentObjects(dep, res), repl)
+	}
+}
+
+// pageSize is the number of referencing objects read and recalculated at once
+func (d *dependentRefVectors) pageSize() int {
+	limit := int(d.config.Config.QueryMaximumResults)
+	if limit <= 0 {
+		limit = int(config.DefaultQueryMaximumResults)
+	}
+	return limit
+}
+
+// additional returns the additional properties of the objects of the
+// dependent class, they are stored again with all their vectors
+func (d *dependentRefVectors) additional(dep dependentClass) additional.Properties {
+	vectorNames := make([]string, 0, len(dep.class.VectorConfig))
+	for name := range dep.class.VectorConfig {
+		vectorNames = append(vectorNames, name)
+	}
+	return additional.Properties{Vector: true, Vectors: vectorNames}
+}
+
+func (d *dependentRefVectors) dependentObjects(dep dependentClass, res search.Results) []dependentObject {
+	objects := make([]dependentObject, len(res))
+	for i, r := range res {
+		objects[i] = dependentObject{
+			class:         dep.class,
+			schemaVersion: dep.schemaVersion,
+			object:        r.Object(),
+		}
+	}
+	return objects
+}
+
+// recalculate calculates and stores the ref2vec vectors of the objects.
+// Failures are logged, they do not stop the remaining objects from being
+// recalculated.
+func (d *dependentRefVectors) recalculate(ctx context.Context, objects []dependentObject,
+	repl *additional.ReplicationProperties,
+) {
+	for _, dep := range objects {
+		if err := d.recalculateObject(ctx, dep, repl); err != nil {
+			d.logger.WithField("action", "update_dependent_ref_vectors").
+				WithField("dependentClassName", dep.class.Class).
+				WithField("id", dep.object.ID).
+				WithError(err).
+				Error("could not update ref2vec vector of referencing object")
+		}
+	}
+}
+
+func (d *dependentRefVectors) recalculateObject(ctx context.Context, dep dependentObject,
+	repl *additional.ReplicationProperties,
+) error {
+	obj := dep.object
+	if err := d.modulesProvider.UpdateVector(ctx, obj, dep.class, d.findObject, d.logger); err != nil {
+		return fmt.Errorf("calculate ref vector: %w", err)
+	}
+	vectors, multiVectors, err := dto.GetVectors(obj.Vectors)
+	if err != nil {
+		return fmt.Errorf("put object: cannot get vectors: %w", err)
+	}
+	if err := d.vectorRepo.PutObject(ctx, obj, obj.Vector, vectors, multiVectors, repl, dep.schemaVersion); err != nil {
+		return fmt.Errorf("put object: %w", err)
+	}
+	return nil
+}
+
+// idsFilter matches the objects of class with the given ids
+func idsFilter(class string, ids []strfmt.UUID) *filters.LocalFilter {
+	clauses := make([]filters.Clause, len(ids))
+	for i, id := range ids {
+		clauses[i] = filters.Clause{
+			Operator: filters.OperatorEqual,
+			On: &filters.Path{
+				Class:    schema.ClassName(class),
+				Property: "id",
+			},
+			Value: &filters.Value{
+				Value: id.String(),
+				Type:  schema.DataTypeText,
+			},
+		}
+	}
+	if len(clauses) == 1 {
+		return &filters.LocalFilter{Root: &clauses[0]}
+	}
+	return &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorOr,
+		Operands: clauses,
+	}}
+}
+
+// referencingFilter matches objects of class whose reference property prop
+// points to an object matching filter
+func referencingFilter(class, prop string, filter *filters.LocalFilter) *filters.LocalFilter {
+	root := referencingClause(class, prop, *filter.Root)
+	return &filters.LocalFilter{Root: &root}
+}
+
+func referencingClause(class, prop string, in filters.Clause) filters.Clause {
+	out := in
+	if in.On != nil {
+		out.On = &filters.Path{
+			Class:    schema.ClassName(class),
+			Property: schema.PropertyName(prop),
+			Child:    in.On,
+		}
+	}
+	if len(in.Operands) > 0 {
+		out.Operands = make([]filters.Clause, len(in.Operands))
+		for i := range in.Operands {
+			out.Operands[i] = referencingClause(class, prop, in.Operands[i])
+		}
+	}
+	return out
+}
+
+// propertiesChanged reports whether one of the properties differs between
+// prev and next
+func propertiesChanged(prev, next *models.Object, props []string) bool {
+	if len(props) == 0 {
+		return false
+	}
+	if prev == nil || next == nil {
+		return true
+	}
+	prevProps, _ := prev.Properties.(map[string]interface{})
+	nextProps, _ := next.Properties.(map[string]interface{})
+	for _, prop := range props {
+		if !reflect.DeepEqual(prevProps[prop], nextProps[prop]) {
+			return true
+		}
+	}
+	return false
+}
+
+func vectorsChanged(prev, next *models.Object) bool {
+	if prev == nil || next == nil {
+		return true
+	}
+	if !slices.Equal(prev.Vector, next.Vector) {
+		return true
+	}
+	if len(prev.Vectors) != len(next.Vectors) {
+		return true
+	}
+	for name, vec := range next.Vectors {
+		if !reflect.DeepEqual(prev.Vectors[name], vec) {
+			return true
+		}
+	}
+	return false
+}
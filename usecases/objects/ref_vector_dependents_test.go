@@ -0,0 +1,151 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+func Test_DependentRefVectors(t *testing.T) {
+	var (
+		cls      = "Article"
+		ids      = []strfmt.UUID{"34e9df15-0c3b-468d-ab99-f929662834c7", "5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc"}
+		readerID = strfmt.UUID("a1b2c3d4-0c3b-468d-ab99-f929662834c7")
+	)
+
+	sch := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{Class: cls},
+				{
+					Class: "Reader",
+					Properties: []*models.Property{
+						{Name: "read", DataType: []string{cls}},
+						{Name: "reread", DataType: []string{cls}},
+					},
+				},
+			},
+		},
+	}
+	reader := search.Result{ID: readerID, ClassName: "Reader", Schema: map[string]interface{}{}}
+	putReader := func(m fakeGetManager) *mock.Call {
+		return m.repo.On("PutObject", mock.MatchedBy(func(obj *models.Object) bool {
+			return obj.ID == readerID
+		}), mock.Anything).Return(nil)
+	}
+
+	t.Run("objects referencing several objects of a batch are updated once", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+		m.modulesProvider.refVectorDependents = map[string][]string{"Reader": {"read"}}
+		m.modulesProvider.On("UpdateVector", mock.Anything, mock.Anything).Return([]float32{1, 2}, nil)
+
+		m.repo.On("ExportShards", "Reader").Return([]string{"S1"}, nil).Once()
+		m.repo.On("ExportShard", "Reader", "S1", strfmt.UUID(""), mock.Anything).
+			Return([]search.Results{{reader}}, nil).Once()
+		putReader(m).Once()
+
+		m.dependentRefVectors().updateBatch(context.Background(), BatchObjects{
+			{Object: &models.Object{Class: cls, ID: ids[0]}},
+			{Object: &models.Object{Class: cls, ID: ids[1]}},
+		}, nil)
+		m.repo.AssertExpectations(t)
+
+		require.Len(t, m.repo.exportFilters, 1)
+		root := m.repo.exportFilters[0].Root
+		require.Equal(t, filters.OperatorOr, root.Operator)
+		require.Len(t, root.Operands, 2)
+		assert.Equal(t, schema.PropertyName("read"), root.Operands[1].On.Property)
+		assert.Equal(t, ids[1].String(), root.Operands[1].Value.Value)
+	})
+
+	t.Run("all pages of referencing objects are updated", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+		m.modulesProvider.refVectorDependents = map[string][]string{"Reader": {"read"}}
+		m.modulesProvider.On("UpdateVector", mock.Anything, mock.Anything).Return([]float32{1, 2}, nil)
+
+		other := search.Result{ID: "b2c3d4e5-0c3b-468d-ab99-f929662834c7", ClassName: "Reader", Schema: map[string]interface{}{}}
+		m.repo.On("ExportShards", "Reader").Return([]string{"S1", "S2"}, nil).Once()
+		m.repo.On("ExportShard", "Reader", "S1", strfmt.UUID(""), mock.Anything).
+			Return([]search.Results{{reader}, {other}}, nil).Once()
+		m.repo.On("ExportShard", "Reader", "S2", strfmt.UUID(""), mock.Anything).
+			Return([]search.Results{}, nil).Once()
+		putReader(m).Once()
+		m.repo.On("PutObject", mock.MatchedBy(func(obj *models.Object) bool {
+			return obj.ID == other.ID
+		}), mock.Anything).Return(nil).Once()
+
+		m.dependentRefVectors().update(context.Background(), cls, "", ids[:1], nil)
+		m.repo.AssertExpectations(t)
+	})
+
+	t.Run("objects referencing objects of several chunks are updated once", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+		m.modulesProvider.refVectorDependents = map[string][]string{"Reader": {"read", "reread"}}
+		m.modulesProvider.On("UpdateVector", mock.Anything, mock.Anything).Return([]float32{1, 2}, nil)
+
+		many := make([]strfmt.UUID, refVectorTargetsPerQuery+1)
+		for i := range many {
+			many[i] = strfmt.UUID(fmt.Sprintf("00000000-0000-0000-0000-%012d", i))
+		}
+		m.repo.On("ExportShards", "Reader").Return([]string{"S1"}, nil).Twice()
+		m.repo.On("ExportShard", "Reader", "S1", strfmt.UUID(""), mock.Anything).
+			Return([]search.Results{{reader}}, nil).Twice()
+		putReader(m).Once()
+
+		m.dependentRefVectors().update(context.Background(), cls, "", many, nil)
+		m.repo.AssertExpectations(t)
+
+		// the reference properties are searched together
+		require.Len(t, m.repo.exportFilters, 2)
+		root := m.repo.exportFilters[1].Root
+		require.Equal(t, filters.OperatorOr, root.Operator)
+		require.Len(t, root.Operands, 2)
+		assert.Equal(t, schema.PropertyName("read"), root.Operands[0].On.Property)
+		assert.Equal(t, schema.PropertyName("reread"), root.Operands[1].On.Property)
+	})
+
+	t.Run("deleted objects update the objects referencing them", func(t *testing.T) {
+		m := newFakeGetManager(sch)
+		m.modulesProvider.refVectorDependents = map[string][]string{"Reader": {"read"}}
+		m.modulesProvider.On("UpdateVector", mock.Anything, mock.Anything).Return([]float32{1, 2}, nil)
+
+		// the referencing objects are found before the delete, as the
+		// reference filter cannot match a deleted object, and read again
+		// by id after it
+		m.repo.On("ExportShards", "Reader").Return([]string{"S1"}, nil).Once()
+		export := m.repo.On("ExportShard", "Reader", "S1", strfmt.UUID(""), mock.Anything).
+			Return([]search.Results{{reader}}, nil).Once()
+		del := m.repo.On("DeleteObject", cls, ids[0], mock.Anything).Return(nil).Once().NotBefore(export)
+		query := m.repo.On("Query", mock.MatchedBy(func(q *QueryInput) bool {
+			return q.Class == "Reader" && q.Filters.Root.Value.Value == readerID.String()
+		})).Return([]search.Result{reader}, nil).Once().NotBefore(del)
+		putReader(m).Once().NotBefore(query)
+
+		err := m.DeleteObject(context.Background(), nil, cls, ids[0], nil, "")
+		require.NoError(t, err)
+		m.repo.AssertExpectations(t)
+		require.Len(t, m.repo.exportFilters, 1)
+		assert.Equal(t, ids[0].String(), m.repo.exportFilters[0].Root.Value.Value)
+	})
+}
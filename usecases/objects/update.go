@@ -108,6 +108,11 @@ func (m *Manager) updateObjectToConnectorAndSchema(ctx context.Context,
 		return nil, NewErrInternal("update object: %v", err)
 	}
 
+	chunks, errs := m.chunker().prepare(ctx, principal, []*models.Object{updates}, fetchedClasses, repl)
+	if err := errs[0]; err != nil {
+		return nil, err
+	}
+
 	if err := m.schemaManager.WaitForUpdate(ctx, maxSchemaVersion); err != nil {
 		return nil, fmt.Errorf("error waiting for local schema to catch up to version %d: %w", maxSchemaVersion, err)
 	}
@@ -120,6 +125,12 @@ func (m *Manager) updateObjectToConnectorAndSchema(ctx context.Context,
 	if err != nil {
 		return nil, fmt.Errorf("put object: %w", err)
 	}
+	if chunks[0] != nil {
+		if err := m.chunker().put(ctx, updates, chunks[0], repl); err != nil {
+			return nil, fmt.Errorf("put chunks: %w", err)
+		}
+	}
+	m.dependentRefVectors().updateIfChanged(ctx, prevObj, updates, repl)
 
 	return updates, nil
 }
@@ -189,3 +189,136 @@ func Test_UpdateObject(t *testing.T) {
 	res.LastUpdateTimeUnix = 0 // to allow for equality
 	assert.Equal(t, expected, res)
 }
+
+func Test_UpdateObject_DependentRefVectors(t *testing.T) {
+	var (
+		cls      = "MyClass"
+		id       = strfmt.UUID("34e9df15-0c3b-468d-ab99-f929662834c7")
+		readerID = strfmt.UUID("a1b2c3d4-0c3b-468d-ab99-f929662834c7")
+		vec      = []float32{0, 1, 2}
+	)
+
+	schema := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class:             cls,
+					VectorIndexConfig: enthnsw.NewDefaultUserConfig(),
+					Properties: []*models.Property{
+						{
+							DataType:     schema.DataTypeText.PropString(),
+							Tokenization: models.PropertyTokenizationWhitespace,
+							Name:         "foo",
+						},
+					},
+				},
+				{
+					Class:             "Reader",
+					VectorIndexConfig: enthnsw.NewDefaultUserConfig(),
+					Properties: []*models.Property{
+						{
+							DataType: []string{cls},
+							Name:     "read",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	payload := func() *models.Object {
+		return &models.Object{
+			Class:      cls,
+			ID:         id,
+			Properties: map[string]interface{}{"foo": "baz"},
+		}
+	}
+
+	t.Run("changed vector updates referencing objects", func(t *testing.T) {
+		m := newFakeGetManager(schema)
+		m.modulesProvider.refVectorDependents = map[string][]string{"Reader": {"read"}}
+
+		m.repo.On("Object", cls, id, mock.Anything, mock.Anything, "").Return(&search.Result{
+			ID:        id,
+			ClassName: cls,
+			Schema:    map[string]interface{}{"foo": "bar"},
+			Vector:    []float32{9, 9, 9},
+		}, nil).Once()
+		m.modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(vec, nil)
+		m.repo.On("PutObject", mock.MatchedBy(func(obj *models.Object) bool {
+			return obj.ID == id
+		}), mock.Anything).Return(nil).Once()
+		m.repo.On("ExportShards", "Reader").Return([]string{"S1"}, nil).Once()
+		m.repo.On("ExportShard", "Reader", "S1", strfmt.UUID(""), mock.Anything).
+			Return([]search.Results{{{
+				ID:        readerID,
+				ClassName: "Reader",
+				Schema:    map[string]interface{}{},
+			}}}, nil).Once()
+		m.repo.On("PutObject", mock.MatchedBy(func(obj *models.Object) bool {
+			return obj.ID == readerID
+		}), mock.Anything).Return(nil).Once()
+
+		_, err := m.UpdateObject(context.Background(), &models.Principal{}, cls, id, payload(), nil)
+		require.Nil(t, err)
+		m.repo.AssertExpectations(t)
+
+		require.Len(t, m.repo.exportFilters, 1)
+		on := m.repo.exportFilters[0].Root.On
+		assert.Equal(t, "read", string(on.Property))
+		assert.Equal(t, cls, string(on.Child.Class))
+		assert.Equal(t, id.String(), m.repo.exportFilters[0].Root.Value.Value)
+	})
+
+	t.Run("unchanged vector does not update referencing objects", func(t *testing.T) {
+		m := newFakeGetManager(schema)
+		m.modulesProvider.refVectorDependents = map[string][]string{"Reader": {"read"}}
+
+		m.repo.On("Object", cls, id, mock.Anything, mock.Anything, "").Return(&search.Result{
+			ID:        id,
+			ClassName: cls,
+			Schema:    map[string]interface{}{"foo": "bar"},
+			Vector:    vec,
+		}, nil).Once()
+		m.modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(vec, nil)
+		m.repo.On("PutObject", mock.Anything, mock.Anything).Return(nil).Once()
+
+		_, err := m.UpdateObject(context.Background(), &models.Principal{}, cls, id, payload(), nil)
+		require.Nil(t, err)
+		m.repo.AssertNotCalled(t, "ExportShards", mock.Anything)
+	})
+
+	t.Run("changed weight property updates referencing objects", func(t *testing.T) {
+		m := newFakeGetManager(schema)
+		m.modulesProvider.refVectorDependents = map[string][]string{"Reader": {"read"}}
+		m.modulesProvider.refVectorWeights = []string{"foo"}
+
+		m.repo.On("Object", cls, id, mock.Anything, mock.Anything, "").Return(&search.Result{
+			ID:        id,
+			ClassName: cls,
+			Schema:    map[string]interface{}{"foo": "bar"},
+			Vector:    vec,
+		}, nil).Once()
+		m.modulesProvider.On("UpdateVector", mock.Anything, mock.AnythingOfType(FindObjectFn)).
+			Return(vec, nil)
+		m.repo.On("PutObject", mock.MatchedBy(func(obj *models.Object) bool {
+			return obj.ID == id
+		}), mock.Anything).Return(nil).Once()
+		m.repo.On("ExportShards", "Reader").Return([]string{"S1"}, nil).Once()
+		m.repo.On("ExportShard", "Reader", "S1", strfmt.UUID(""), mock.Anything).
+			Return([]search.Results{{{
+				ID:        readerID,
+				ClassName: "Reader",
+				Schema:    map[string]interface{}{},
+			}}}, nil).Once()
+		m.repo.On("PutObject", mock.MatchedBy(func(obj *models.Object) bool {
+			return obj.ID == readerID
+		}), mock.Anything).Return(nil).Once()
+
+		_, err := m.UpdateObject(context.Background(), &models.Principal{}, cls, id, payload(), nil)
+		require.Nil(t, err)
+		m.repo.AssertExpectations(t)
+	})
+}
@@ -14,6 +14,7 @@ package replica
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sync"
 	"time"
 
@@ -52,6 +53,9 @@ type (
 		pullBackOffPreInitialInterval time.Duration
 		pullBackOffMaxElapsedTime     time.Duration // stop retrying after this long
 		deletionStrategy              string
+		// missed is called with the replicas which did not apply a write
+		// that succeeded on the others, if set
+		missed func(nodes []string)
 	}
 )
 
@@ -197,10 +201,71 @@ func (c *coordinator[T]) Push(ctx context.Context,
 		"duration": 20 * time.Second,
 		"level":    level,
 	}).Debug("context.WithTimeout")
+	if c.missed != nil {
+		ask, com = c.trackMissed(routingPlan, level, ask, com)
+	}
 	nodeCh := c.broadcast(ctxWithTimeout, routingPlan.ReplicasHostAddrs, ask, level)
 	return c.commitAll(context.Background(), nodeCh, com), level, nil
 }
 
+// trackMissed wraps both phases of a write to find the replicas which missed
+// it. Once all replicas have answered and if the write reached the consistency
+// level, the missed replicas are passed to c.missed, including the ones left
+// out of the routing plan because they are down.
+func (c *coordinator[T]) trackMissed(plan types.RoutingPlan, level int,
+	ask readyOp, com commitOp[T],
+) (readyOp, commitOp[T]) {
+	var (
+		mu        sync.Mutex
+		pending   = len(plan.ReplicasHostAddrs)
+		committed = 0
+		failed    = make(map[string]bool, len(plan.ReplicasHostAddrs))
+	)
+	done := func(host string, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			failed[host] = true
+		} else {
+			committed++
+		}
+		if pending--; pending > 0 || committed < level {
+			return
+		}
+
+		var nodes []string
+		for i, host := range plan.ReplicasHostAddrs {
+			if failed[host] {
+				nodes = append(nodes, plan.Replicas[i])
+			}
+		}
+		if replicas, err := c.Router.GetWriteReplicasLocation(c.Class, c.Shard); err == nil {
+			for _, replica := range replicas {
+				if !slices.Contains(plan.Replicas, replica) {
+					nodes = append(nodes, replica)
+				}
+			}
+		}
+		if len(nodes) > 0 {
+			c.missed(nodes)
+		}
+	}
+
+	wrappedAsk := func(ctx context.Context, host, requestID string) error {
+		err := ask(ctx, host, requestID)
+		if err != nil {
+			done(host, err)
+		}
+		return err
+	}
+	wrappedCom := func(ctx context.Context, host, requestID string) (T, error) {
+		resp, err := com(ctx, host, requestID)
+		done(host, err)
+		return resp, err
+	}
+	return wrappedAsk, wrappedCom
+}
+
 // Pull data from replica depending on consistency level, trying to reach level successful calls
 // to op, while cycling through replicas for the coordinator's shard.
 //
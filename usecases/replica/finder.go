@@ -98,12 +98,33 @@ func NewFinder(className string,
 	}
 }
 
-// GetOne gets object which satisfies the giving consistency
+// GetOne gets object which satisfies the giving consistency.
+//
+// If ctx carries a session which wrote to the shard at a level a read at l
+// does not overlap with, and the object found is missing or older than the
+// session's latest write, it is read again at a level which does.
 func (f *Finder) GetOne(ctx context.Context,
 	l types.ConsistencyLevel, shard string,
 	id strfmt.UUID,
 	props search.SelectProperties,
 	adds additional.Properties,
+) (*storobj.Object, error) {
+	obj, err := f.getOne(ctx, l, shard, id, props, adds)
+	if err != nil {
+		return obj, err
+	}
+	sl, updateTime, ok := SessionFromContext(ctx).readLevel(f.class, shard, l)
+	if !ok || (obj != nil && obj.LastUpdateTimeUnix() >= updateTime) {
+		return obj, nil
+	}
+	return f.getOne(ctx, sl, shard, id, props, adds)
+}
+
+func (f *Finder) getOne(ctx context.Context,
+	l types.ConsistencyLevel, shard string,
+	id strfmt.UUID,
+	props search.SelectProperties,
+	adds additional.Properties,
 ) (*storobj.Object, error) {
 	c := newReadCoordinator[findOneReply](f, shard,
 		f.coordinatorPullBackoffInitialInterval, f.coordinatorPullBackoffMaxElapsedTime, f.getDeletionStrategy())
@@ -190,7 +211,11 @@ type ShardDesc struct {
 
 // CheckConsistency for objects belonging to different physical shards.
 //
-// For each x in xs the fields BelongsToNode and BelongsToShard must be set non empty
+// For each x in xs the fields BelongsToNode and BelongsToShard must be set non empty.
+//
+// If ctx carries a session which wrote to a shard at a level a read at l
+// does not overlap with, the objects of the shard are checked at a level
+// which does.
 func (f *Finder) CheckConsistency(ctx context.Context,
 	l types.ConsistencyLevel, xs []*storobj.Object,
 ) error {
@@ -206,18 +231,23 @@ func (f *Finder) CheckConsistency(ctx context.Context,
 		}
 	}
 
-	if l == types.ConsistencyLevelOne { // already consistent
-		for i := range xs {
-			xs[i].IsConsistent = true
-		}
-		return nil
-	}
+	session := SessionFromContext(ctx)
 	// check shard consistency concurrently
 	gr, ctx := enterrors.NewErrorGroupWithContextWrapper(f.logger, ctx)
 	for _, part := range cluster(createBatch(xs)) {
 		part := part
+		pl := l
+		if sl, _, ok := session.readLevel(f.class, part.Shard, l); ok {
+			pl = sl
+		}
+		if pl == types.ConsistencyLevelOne { // already consistent
+			for _, idx := range part.Index {
+				xs[idx].IsConsistent = true
+			}
+			continue
+		}
 		gr.Go(func() error {
-			_, err := f.checkShardConsistency(ctx, l, part)
+			_, err := f.checkShardConsistency(ctx, pl, part)
 			if err != nil {
 				f.log.WithField("op", "check_shard_consistency").
 					WithField("shard", part.Shard).Error(err)
@@ -228,12 +258,18 @@ func (f *Finder) CheckConsistency(ctx context.Context,
 	return gr.Wait()
 }
 
-// Exists checks if an object exists which satisfies the giving consistency
+// Exists checks if an object exists which satisfies the giving consistency.
+//
+// If ctx carries a session which wrote to the shard at a level a read at l
+// does not overlap with, the read is done at a level which does.
 func (f *Finder) Exists(ctx context.Context,
 	l types.ConsistencyLevel,
 	shard string,
 	id strfmt.UUID,
 ) (bool, error) {
+	if sl, _, ok := SessionFromContext(ctx).readLevel(f.class, shard, l); ok {
+		l = sl
+	}
 	c := newReadCoordinator[existReply](f, shard,
 		f.coordinatorPullBackoffInitialInterval, f.coordinatorPullBackoffMaxElapsedTime, f.getDeletionStrategy())
 	op := func(ctx context.Context, host string, _ bool) (existReply, error) {
@@ -259,6 +295,43 @@ func (f *Finder) Exists(ctx context.Context,
 	return result.Value, err
 }
 
+// MultiExists reads the digests of the objects from every replica of the
+// shard, with one request per replica, and returns whether the most recent
+// version of each object is not deleted. It fails if any replica cannot be
+// reached, so that an object reported missing is missing on all replicas.
+func (f *Finder) MultiExists(ctx context.Context,
+	shard string,
+	ids []strfmt.UUID,
+) ([]bool, error) {
+	routingPlan, err := f.router.BuildReadRoutingPlan(types.RoutingPlanBuildOptions{
+		Collection:       f.class,
+		Shard:            shard,
+		ConsistencyLevel: types.ConsistencyLevelAll,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w : class %q shard %q", err, f.class, shard)
+	}
+
+	latest := make([]types.RepairResponse, len(ids))
+	for _, host := range routingPlan.ReplicasHostAddrs {
+		xs, err := f.client.DigestReads(ctx, host, f.class, shard, ids, 0)
+		if err != nil {
+			return nil, fmt.Errorf("%s %q: read digests from %q: %w", msgCLevel, types.ConsistencyLevelAll, host, err)
+		}
+		for i, x := range xs {
+			if x.UpdateTime > latest[i].UpdateTime {
+				latest[i] = x
+			}
+		}
+	}
+
+	exists := make([]bool, len(ids))
+	for i, x := range latest {
+		exists[i] = !x.Deleted && x.UpdateTime != 0
+	}
+	return exists, nil
+}
+
 // NodeObject gets object from a specific node.
 // it is used mainly for debugging purposes
 func (f *Finder) NodeObject(ctx context.Context,
@@ -0,0 +1,522 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+const (
+	// HintsDirName is the directory, relative to the data path, hints are stored in
+	HintsDirName = "replication_hints"
+
+	hintsFileExt        = ".hints"
+	hintReplayBatchSize = 100
+
+	// reasons for dropping a hint without replaying it
+	hintDropLimit    = "limit"
+	hintDropExpired  = "expired"
+	hintDropObsolete = "obsolete"
+)
+
+// errObsoleteHint is returned when the hinted node no longer holds a replica of the shard
+var errObsoleteHint = errors.New("node is no longer a replica of the shard")
+
+type (
+	// hintKey identifies the object a replica missed a write of
+	hintKey struct {
+		Class string      `json:"class"`
+		Shard string      `json:"shard"`
+		ID    strfmt.UUID `json:"id"`
+	}
+
+	// hint is the on-disk representation of a missed write
+	hint struct {
+		hintKey
+		// CreatedAt is the time of the last missed write in milliseconds
+		CreatedAt int64 `json:"createdAt"`
+	}
+)
+
+type HintedHandoffParams struct {
+	Logger            logrus.FieldLogger
+	Router            router
+	Client            Client
+	MetricsRegisterer prometheus.Registerer
+
+	// Dir is the directory the hints of every node are persisted in
+	Dir string
+	// MaxHintsPerNode bounds the number of objects hinted for a single node,
+	// further missed writes are left to async replication
+	MaxHintsPerNode int
+	// MaxHintAge is the time after which a hint that could not be replayed is dropped
+	MaxHintAge time.Duration
+	// ReplayInterval is the time between two attempts to replay pending hints
+	ReplayInterval time.Duration
+}
+
+// HintedHandoff keeps track of the writes replicas missed while they were
+// unavailable and replays them once the replicas are reachable again.
+//
+// Hints only record which objects were missed, not the writes themselves.
+// They are replayed by reading the most recent version of the objects from
+// the other replicas and conditionally overwriting the one of the hinted
+// node, like read repair does. This makes replaying idempotent and prevents
+// an outdated hint from overwriting a more recent write.
+type HintedHandoff struct {
+	logger logrus.FieldLogger
+	router router
+	client Client
+
+	dir             string
+	maxHintsPerNode int
+	maxHintAge      time.Duration
+	replayInterval  time.Duration
+
+	mu    sync.Mutex
+	hints map[string]map[hintKey]int64 // node -> object -> created at
+
+	stopCh    chan struct{}
+	closeOnce sync.Once
+
+	stored   prometheus.Counter
+	replayed prometheus.Counter
+	dropped  *prometheus.CounterVec
+	pending  *prometheus.GaugeVec
+}
+
+// NewHintedHandoff loads the hints persisted in params.Dir
+func NewHintedHandoff(params HintedHandoffParams) (*HintedHandoff, error) {
+	reg := promauto.With(params.MetricsRegisterer)
+	h := &HintedHandoff{
+		logger:          params.Logger.WithField("action", "hinted_handoff"),
+		router:          params.Router,
+		client:          params.Client,
+		dir:             params.Dir,
+		maxHintsPerNode: params.MaxHintsPerNode,
+		maxHintAge:      params.MaxHintAge,
+		replayInterval:  params.ReplayInterval,
+		hints:           make(map[string]map[hintKey]int64),
+		stopCh:          make(chan struct{}),
+		stored: reg.NewCounter(prometheus.CounterOpts{
+			Name: "weaviate_replication_hints_stored_total",
+			Help: "Number of writes missed by an unavailable replica and stored as hint",
+		}),
+		replayed: reg.NewCounter(prometheus.CounterOpts{
+			Name: "weaviate_replication_hints_replayed_total",
+			Help: "Number of hints successfully replayed to a replica",
+		}),
+		dropped: reg.NewCounterVec(prometheus.CounterOpts{
+			Name: "weaviate_replication_hints_dropped_total",
+			Help: "Number of hints dropped without being replayed",
+		}, []string{"reason"}),
+		pending: reg.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "weaviate_replication_hints_pending",
+			Help: "Number of hints waiting to be replayed to a replica",
+		}, []string{"node"}),
+	}
+
+	if err := os.MkdirAll(h.dir, os.ModePerm); err != nil {
+		return nil, fmt.Errorf("create hints directory: %w", err)
+	}
+	entries, err := os.ReadDir(h.dir)
+	if err != nil {
+		return nil, fmt.Errorf("read hints directory: %w", err)
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), hintsFileExt) {
+			continue
+		}
+		node, err := url.PathUnescape(strings.TrimSuffix(entry.Name(), hintsFileExt))
+		if err != nil {
+			return nil, fmt.Errorf("hints file %q: %w", entry.Name(), err)
+		}
+		if err := h.load(node); err != nil {
+			return nil, err
+		}
+	}
+	return h, nil
+}
+
+// load reads the hints file of node, later entries for the same object
+// supersede earlier ones
+func (h *HintedHandoff) load(node string) error {
+	f, err := os.Open(h.path(node))
+	if err != nil {
+		return fmt.Errorf("open hints of node %q: %w", node, err)
+	}
+	defer f.Close()
+
+	hints := make(map[hintKey]int64)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var x hint
+		if err := json.Unmarshal(scanner.Bytes(), &x); err != nil {
+			// a crash may leave a partially written last line behind
+			h.logger.WithField("node", node).WithError(err).Warn("skip corrupted hint")
+			continue
+		}
+		hints[x.hintKey] = x.CreatedAt
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("read hints of node %q: %w", node, err)
+	}
+	h.hints[node] = hints
+	h.pending.WithLabelValues(node).Set(float64(len(hints)))
+	return nil
+}
+
+func (h *HintedHandoff) path(node string) string {
+	return filepath.Join(h.dir, url.PathEscape(node)+hintsFileExt)
+}
+
+// Store durably records that nodes missed a write of the objects ids
+func (h *HintedHandoff) Store(nodes []string, class, shard string, ids []strfmt.UUID) {
+	if len(ids) == 0 {
+		return
+	}
+	now := time.Now().UnixMilli()
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, node := range nodes {
+		hints, ok := h.hints[node]
+		if !ok {
+			hints = make(map[hintKey]int64)
+			h.hints[node] = hints
+		}
+
+		added := make([]hint, 0, len(ids))
+		for _, id := range ids {
+			key := hintKey{Class: class, Shard: shard, ID: id}
+			if _, ok := hints[key]; ok {
+				// only kept in memory so that the hints file of a node
+				// does not grow with every write while it is down
+				hints[key] = now
+				continue
+			}
+			if len(hints) >= h.maxHintsPerNode {
+				h.dropped.WithLabelValues(hintDropLimit).Inc()
+				continue
+			}
+			hints[key] = now
+			added = append(added, hint{hintKey: key, CreatedAt: now})
+		}
+		if len(added) == 0 {
+			continue
+		}
+		h.stored.Add(float64(len(added)))
+		h.pending.WithLabelValues(node).Set(float64(len(hints)))
+
+		if err := h.appendHints(node, added); err != nil {
+			// the hints are still replayed unless this node restarts first
+			h.logger.WithField("node", node).WithError(err).Error("persist hints")
+		}
+	}
+}
+
+func (h *HintedHandoff) appendHints(node string, hints []hint) error {
+	f, err := os.OpenFile(h.path(node), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	for _, x := range hints {
+		if err := enc.Encode(x); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// rewrite replaces the hints file of node by its hints still pending.
+// It must be called with the lock held.
+func (h *HintedHandoff) rewrite(node string) error {
+	hints := h.hints[node]
+	if len(hints) == 0 {
+		delete(h.hints, node)
+		h.pending.DeleteLabelValues(node)
+		if err := os.Remove(h.path(node)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		return nil
+	}
+	h.pending.WithLabelValues(node).Set(float64(len(hints)))
+
+	tmp := h.path(node) + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	for key, createdAt := range hints {
+		if err := enc.Encode(hint{hintKey: key, CreatedAt: createdAt}); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, h.path(node))
+}
+
+// Pending returns the number of hints waiting to be replayed to node
+func (h *HintedHandoff) Pending(node string) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.hints[node])
+}
+
+// Start replays pending hints every replay interval until ctx is cancelled or Close is called.
+func (h *HintedHandoff) Start(ctx context.Context) {
+	h.logger.WithFields(logrus.Fields{
+		"replay_interval":    h.replayInterval,
+		"max_hints_per_node": h.maxHintsPerNode,
+		"max_hint_age":       h.maxHintAge,
+	}).Info("starting hinted handoff")
+
+	ticker := time.NewTicker(h.replayInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			h.logger.Info("stopping hinted handoff")
+			return
+		case <-h.stopCh:
+			h.logger.Info("stopping hinted handoff")
+			return
+		case <-ticker.C:
+			h.Replay(ctx)
+		}
+	}
+}
+
+// Close stops the replay loop started by Start
+func (h *HintedHandoff) Close() {
+	h.closeOnce.Do(func() { close(h.stopCh) })
+}
+
+// Replay replays the pending hints of every node which is reachable.
+// Hints that cannot be replayed are kept until they expire.
+func (h *HintedHandoff) Replay(ctx context.Context) {
+	h.mu.Lock()
+	nodes := make([]string, 0, len(h.hints))
+	for node := range h.hints {
+		nodes = append(nodes, node)
+	}
+	h.mu.Unlock()
+	slices.Sort(nodes)
+
+	for _, node := range nodes {
+		if _, ok := h.router.NodeHostname(node); !ok {
+			continue
+		}
+		if err := h.replayNode(ctx, node); err != nil {
+			h.logger.WithField("node", node).WithError(err).Warn("replay hints")
+		}
+	}
+}
+
+func (h *HintedHandoff) replayNode(ctx context.Context, node string) error {
+	expiredBefore := time.Now().Add(-h.maxHintAge).UnixMilli()
+
+	type shardKey struct{ class, shard string }
+	groups := make(map[shardKey][]strfmt.UUID)
+	snapshot := make(map[hintKey]int64)
+
+	h.mu.Lock()
+	for key, createdAt := range h.hints[node] {
+		if createdAt < expiredBefore {
+			delete(h.hints[node], key)
+			h.dropped.WithLabelValues(hintDropExpired).Inc()
+			continue
+		}
+		snapshot[key] = createdAt
+		sk := shardKey{key.Class, key.Shard}
+		groups[sk] = append(groups[sk], key.ID)
+	}
+	h.mu.Unlock()
+
+	var errs []error
+	for sk, ids := range groups {
+		slices.Sort(ids)
+		for len(ids) > 0 {
+			batch := ids[:min(hintReplayBatchSize, len(ids))]
+			ids = ids[len(batch):]
+
+			err := h.replay(ctx, node, sk.class, sk.shard, batch)
+			if err != nil && !errors.Is(err, errObsoleteHint) {
+				errs = append(errs, fmt.Errorf("class %q shard %q: %w", sk.class, sk.shard, err))
+				continue
+			}
+
+			h.mu.Lock()
+			for _, id := range batch {
+				key := hintKey{Class: sk.class, Shard: sk.shard, ID: id}
+				// the replica may have missed another write while replaying
+				if createdAt, ok := h.hints[node][key]; ok && createdAt == snapshot[key] {
+					delete(h.hints[node], key)
+				}
+			}
+			h.mu.Unlock()
+			if err != nil {
+				h.dropped.WithLabelValues(hintDropObsolete).Add(float64(len(batch)))
+			} else {
+				h.replayed.Add(float64(len(batch)))
+			}
+		}
+	}
+
+	h.mu.Lock()
+	if err := h.rewrite(node); err != nil {
+		errs = append(errs, fmt.Errorf("persist hints: %w", err))
+	}
+	h.mu.Unlock()
+
+	return errors.Join(errs...)
+}
+
+// replay brings the objects ids of node up to date with the most recent
+// version held by the other replicas of the shard
+func (h *HintedHandoff) replay(ctx context.Context, node, class, shard string, ids []strfmt.UUID) error {
+	replicas, err := h.router.GetWriteReplicasLocation(class, shard)
+	if err != nil {
+		// the class or shard no longer exists
+		return fmt.Errorf("%w: %w", errObsoleteHint, err)
+	}
+	if !slices.Contains(replicas, node) {
+		return errObsoleteHint
+	}
+	target, ok := h.router.NodeHostname(node)
+	if !ok {
+		return fmt.Errorf("node %q is unavailable", node)
+	}
+
+	latest := make([]objects.Replica, len(ids))
+	sources := 0
+	for _, replica := range replicas {
+		if replica == node {
+			continue
+		}
+		host, ok := h.router.NodeHostname(replica)
+		if !ok {
+			continue
+		}
+		xs, err := h.client.FetchObjects(ctx, host, class, shard, ids)
+		if err == nil && len(xs) != len(ids) {
+			err = fmt.Errorf("malformed full read response: length expected %d got %d", len(ids), len(xs))
+		}
+		if err != nil {
+			h.logger.WithFields(logrus.Fields{"node": replica, "class": class, "shard": shard}).
+				WithError(err).Debug("fetch hinted objects")
+			continue
+		}
+		sources++
+		for i, x := range xs {
+			if x.UpdateTime() > latest[i].UpdateTime() {
+				latest[i] = x
+			}
+		}
+	}
+	if sources == 0 {
+		return fmt.Errorf("no other replica is available")
+	}
+
+	digests, err := h.client.DigestObjects(ctx, target, class, shard, ids, 0)
+	if err == nil && len(digests) != len(ids) {
+		err = fmt.Errorf("malformed digest read response: length expected %d got %d", len(ids), len(digests))
+	}
+	if err != nil {
+		return fmt.Errorf("digest objects of node %q: %w", node, err)
+	}
+
+	updates := make([]*objects.VObject, 0, len(ids))
+	for i, x := range latest {
+		if x.UpdateTime() <= digests[i].UpdateTime {
+			continue // up to date or unknown to all replicas
+		}
+		updates = append(updates, hintUpdate(x, digests[i].UpdateTime))
+	}
+	if len(updates) == 0 {
+		return nil
+	}
+
+	resp, err := h.client.OverwriteObjects(ctx, target, class, shard, updates)
+	if err != nil {
+		return fmt.Errorf("overwrite objects of node %q: %w", node, err)
+	}
+	for _, r := range resp {
+		// a conflict means the object changed in the meantime, which leaves
+		// the replica no longer behind
+		if r.Err != "" && r.Err != "conflict" {
+			return fmt.Errorf("overwrite object %s of node %q: %s", r.ID, node, r.Err)
+		}
+	}
+	return nil
+}
+
+// hintUpdate overwrites an object last updated at staleUpdateTime by x
+func hintUpdate(x objects.Replica, staleUpdateTime int64) *objects.VObject {
+	update := &objects.VObject{
+		ID:                      x.ID,
+		Deleted:                 x.Deleted,
+		LastUpdateTimeUnixMilli: x.UpdateTime(),
+		StaleUpdateTime:         staleUpdateTime,
+	}
+	if !x.Deleted && x.Object != nil {
+		update.LatestObject = &x.Object.Object
+		update.Vector = x.Object.Vector
+		update.Vectors = x.Object.Vectors
+		update.MultiVectors = x.Object.MultiVectors
+	}
+	return update
+}
@@ -0,0 +1,198 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/cluster/router/types"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func (f *fakeFactory) newHintedHandoff(dir string, maxHints int, maxAge time.Duration) *HintedHandoff {
+	h, err := NewHintedHandoff(HintedHandoffParams{
+		Logger: f.log,
+		Router: f.newRouter(""),
+		Client: struct {
+			rClient
+			wClient
+		}{f.RClient, f.WClient},
+		MetricsRegisterer: prometheus.NewPedanticRegistry(),
+		Dir:               dir,
+		MaxHintsPerNode:   maxHints,
+		MaxHintAge:        maxAge,
+		ReplayInterval:    time.Second,
+	})
+	require.NoError(f.t, err)
+	return h
+}
+
+func TestReplicatorHintsMissedWrites(t *testing.T) {
+	var (
+		cls   = "C1"
+		shard = "SH1"
+		ctx   = context.Background()
+		obj   = storobj.FromObject(&models.Object{ID: "c8f8d1a0-9f0e-4b7a-9d5f-3c1a2b3c4d5e", Class: cls}, nil, nil, nil)
+		resp  = SimpleResponse{}
+	)
+
+	// C is a replica of the shard but has left the cluster
+	f := newFakeFactory(t, cls, shard, []string{"A", "B"})
+	f.Shard2replicas[shard] = []string{"A", "B", "C"}
+	h := f.newHintedHandoff(t.TempDir(), 10, time.Hour)
+	rep := f.newReplicator()
+	rep.SetHintedHandoff(h)
+
+	f.WClient.On("PutObject", mock.Anything, "A", cls, shard, anyVal, obj, uint64(123)).Return(resp, nil)
+	f.WClient.On("Commit", ctx, "A", cls, shard, anyVal, anyVal).Return(nil)
+	f.WClient.On("PutObject", mock.Anything, "B", cls, shard, anyVal, obj, uint64(123)).Return(resp, errAny)
+
+	require.NoError(t, rep.PutObject(ctx, shard, obj, types.ConsistencyLevelOne, 123))
+
+	assert.Eventually(t, func() bool {
+		return h.Pending("B") == 1 && h.Pending("C") == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Zero(t, h.Pending("A"))
+}
+
+func TestReplicatorDoesNotHintFailedWrites(t *testing.T) {
+	var (
+		cls   = "C1"
+		shard = "SH1"
+		ctx   = context.Background()
+		obj   = storobj.FromObject(&models.Object{ID: "c8f8d1a0-9f0e-4b7a-9d5f-3c1a2b3c4d5e", Class: cls}, nil, nil, nil)
+		resp  = SimpleResponse{}
+	)
+
+	f := newFakeFactory(t, cls, shard, []string{"A", "B"})
+	h := f.newHintedHandoff(t.TempDir(), 10, time.Hour)
+	rep := f.newReplicator()
+	rep.SetHintedHandoff(h)
+
+	f.WClient.On("PutObject", mock.Anything, "A", cls, shard, anyVal, obj, uint64(123)).Return(resp, nil)
+	f.WClient.On("PutObject", mock.Anything, "B", cls, shard, anyVal, obj, uint64(123)).Return(resp, errAny)
+	f.WClient.On("Abort", mock.Anything, anyVal, cls, shard, anyVal).Return(resp, nil)
+
+	require.Error(t, rep.PutObject(ctx, shard, obj, types.ConsistencyLevelAll, 123))
+	assert.Zero(t, h.Pending("B"))
+}
+
+func TestHintedHandoffReplay(t *testing.T) {
+	var (
+		cls      = "C1"
+		shard    = "SH1"
+		ctx      = context.Background()
+		dir      = t.TempDir()
+		outdated = strfmt.UUID("00000000-0000-0000-0000-000000000001")
+		upToDate = strfmt.UUID("00000000-0000-0000-0000-000000000002")
+		deleted  = strfmt.UUID("00000000-0000-0000-0000-000000000003")
+		ids      = []strfmt.UUID{outdated, upToDate, deleted}
+	)
+	object := func(id strfmt.UUID, updateTime int64) objects.Replica {
+		return objects.Replica{ID: id, Object: storobj.FromObject(&models.Object{
+			ID: id, Class: cls, LastUpdateTimeUnix: updateTime,
+		}, []float32{1, 2}, nil, nil)}
+	}
+
+	f := newFakeFactory(t, cls, shard, []string{"A", "B"})
+	f.Shard2replicas[shard] = []string{"A", "B", "C"}
+	h := f.newHintedHandoff(dir, 10, time.Hour)
+	h.Store([]string{"C"}, cls, shard, ids)
+
+	// C is unavailable, hints are kept
+	h.Replay(ctx)
+	assert.Equal(t, 3, h.Pending("C"))
+
+	// C rejoins, the hints are loaded again after a restart
+	f.Nodes = []string{"A", "B", "C"}
+	h = f.newHintedHandoff(dir, 10, time.Hour)
+	require.Equal(t, 3, h.Pending("C"))
+
+	f.RClient.On("FetchObjects", ctx, "A", cls, shard, ids).Return([]objects.Replica{
+		object(outdated, 20), object(upToDate, 10), {ID: deleted, Deleted: true, LastUpdateTimeUnixMilli: 30},
+	}, nil)
+	f.RClient.On("FetchObjects", ctx, "B", cls, shard, ids).Return([]objects.Replica{
+		object(outdated, 10), object(upToDate, 10), object(deleted, 5),
+	}, nil)
+	f.RClient.On("DigestObjects", ctx, "C", cls, shard, ids).Return([]types.RepairResponse{
+		{ID: outdated.String(), UpdateTime: 10}, {ID: upToDate.String(), UpdateTime: 10}, {ID: deleted.String(), UpdateTime: 5},
+	}, nil)
+	f.RClient.On("OverwriteObjects", ctx, "C", cls, shard, mock.MatchedBy(func(xs []*objects.VObject) bool {
+		return len(xs) == 2 &&
+			xs[0].ID == outdated && !xs[0].Deleted && xs[0].LatestObject != nil &&
+			xs[0].StaleUpdateTime == 10 && xs[0].LastUpdateTimeUnixMilli == 20 &&
+			xs[1].ID == deleted && xs[1].Deleted && xs[1].LatestObject == nil &&
+			xs[1].StaleUpdateTime == 5 && xs[1].LastUpdateTimeUnixMilli == 30
+	})).Return([]types.RepairResponse{}, nil)
+
+	h.Replay(ctx)
+	f.RClient.AssertExpectations(t)
+	assert.Zero(t, h.Pending("C"))
+	assert.NoFileExists(t, h.path("C"))
+
+	h = f.newHintedHandoff(dir, 10, time.Hour)
+	assert.Zero(t, h.Pending("C"))
+}
+
+func TestHintedHandoffBounds(t *testing.T) {
+	var (
+		cls   = "C1"
+		shard = "SH1"
+		ctx   = context.Background()
+		ids   = []strfmt.UUID{
+			"00000000-0000-0000-0000-000000000001",
+			"00000000-0000-0000-0000-000000000002",
+			"00000000-0000-0000-0000-000000000003",
+		}
+	)
+
+	t.Run("max hints per node", func(t *testing.T) {
+		f := newFakeFactory(t, cls, shard, []string{"A", "B"})
+		h := f.newHintedHandoff(t.TempDir(), 2, time.Hour)
+		h.Store([]string{"B"}, cls, shard, ids)
+		assert.Equal(t, 2, h.Pending("B"))
+
+		// hinted objects are still updated
+		h.Store([]string{"B"}, cls, shard, ids[:1])
+		assert.Equal(t, 2, h.Pending("B"))
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		f := newFakeFactory(t, cls, shard, []string{"A", "B"})
+		h := f.newHintedHandoff(t.TempDir(), 10, time.Nanosecond)
+		h.Store([]string{"B"}, cls, shard, ids)
+		time.Sleep(time.Millisecond)
+
+		h.Replay(ctx)
+		assert.Zero(t, h.Pending("B"))
+	})
+
+	t.Run("no longer a replica", func(t *testing.T) {
+		f := newFakeFactory(t, cls, shard, []string{"A", "B", "C"})
+		f.Shard2replicas[shard] = []string{"A", "B"}
+		h := f.newHintedHandoff(t.TempDir(), 10, time.Hour)
+		h.Store([]string{"C"}, cls, shard, ids)
+
+		h.Replay(ctx)
+		assert.Zero(t, h.Pending("C"))
+	})
+}
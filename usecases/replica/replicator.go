@@ -15,6 +15,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sync/atomic"
 	"time"
 
@@ -43,6 +44,7 @@ type (
 	router interface {
 		BuildReadRoutingPlan(params types.RoutingPlanBuildOptions) (types.RoutingPlan, error)
 		BuildWriteRoutingPlan(params types.RoutingPlanBuildOptions) (types.RoutingPlan, error)
+		GetWriteReplicasLocation(collection string, shard string) ([]string, error)
 		NodeHostname(nodeName string) (string, bool)
 		AllHostnames() []string
 	}
@@ -62,6 +64,7 @@ type Replicator struct {
 	log            logrus.FieldLogger
 	requestCounter atomic.Uint64
 	stream         replicatorStream
+	hints          *HintedHandoff
 	*Finder
 }
 
@@ -91,6 +94,22 @@ func NewReplicator(className string,
 	}
 }
 
+// SetHintedHandoff makes the replicator store hints for the replicas which
+// miss a write, nil disables hinted handoff
+func (r *Replicator) SetHintedHandoff(h *HintedHandoff) {
+	r.hints = h
+}
+
+// hintMissed makes coord store hints for the replicas missing its write of the objects returned by ids
+func hintMissed[T any](r *Replicator, coord *coordinator[T], shard string, ids func() []strfmt.UUID) {
+	if r.hints == nil {
+		return
+	}
+	coord.missed = func(nodes []string) {
+		r.hints.Store(nodes, r.class, shard, ids())
+	}
+}
+
 func (r *Replicator) AllHostnames() []string {
 	return r.router.AllHostnames()
 }
@@ -102,6 +121,7 @@ func (r *Replicator) PutObject(ctx context.Context,
 	schemaVersion uint64,
 ) error {
 	coord := newCoordinator[SimpleResponse](r, shard, r.requestID(opPutObject), r.log)
+	hintMissed(r, coord, shard, func() []strfmt.UUID { return []strfmt.UUID{obj.ID()} })
 	isReady := func(ctx context.Context, host, requestID string) error {
 		resp, err := r.client.PutObject(ctx, host, r.class, shard, requestID, obj, schemaVersion)
 		if err == nil {
@@ -123,8 +143,10 @@ func (r *Replicator) PutObject(ctx context.Context,
 	if err != nil {
 		r.log.WithField("op", "put").WithField("class", r.class).
 			WithField("shard", shard).WithField("uuid", obj.ID()).Error(err)
+		return err
 	}
-	return err
+	r.recordWrite(ctx, shard, l, obj.LastUpdateTimeUnix())
+	return nil
 }
 
 func (r *Replicator) MergeObject(ctx context.Context,
@@ -134,6 +156,7 @@ func (r *Replicator) MergeObject(ctx context.Context,
 	schemaVersion uint64,
 ) error {
 	coord := newCoordinator[SimpleResponse](r, shard, r.requestID(opMergeObject), r.log)
+	hintMissed(r, coord, shard, func() []strfmt.UUID { return []strfmt.UUID{doc.ID} })
 	op := func(ctx context.Context, host, requestID string) error {
 		resp, err := r.client.MergeObject(ctx, host, r.class, shard, requestID, doc, schemaVersion)
 		if err == nil {
@@ -158,8 +181,10 @@ func (r *Replicator) MergeObject(ctx context.Context,
 		if errors.As(err, &replicaErr) && replicaErr != nil && replicaErr.Code == StatusObjectNotFound {
 			return objects.NewErrDirtyWriteOfDeletedObject(replicaErr)
 		}
+		return err
 	}
-	return err
+	r.recordWrite(ctx, shard, l, doc.UpdateTime)
+	return nil
 }
 
 func (r *Replicator) DeleteObject(ctx context.Context,
@@ -170,6 +195,7 @@ func (r *Replicator) DeleteObject(ctx context.Context,
 	schemaVersion uint64,
 ) error {
 	coord := newCoordinator[SimpleResponse](r, shard, r.requestID(opDeleteObject), r.log)
+	hintMissed(r, coord, shard, func() []strfmt.UUID { return []strfmt.UUID{id} })
 	op := func(ctx context.Context, host, requestID string) error {
 		resp, err := r.client.DeleteObject(ctx, host, r.class, shard, requestID, id, deletionTime, schemaVersion)
 		if err == nil {
@@ -190,8 +216,10 @@ func (r *Replicator) DeleteObject(ctx context.Context,
 	if err != nil {
 		r.log.WithField("op", "put").WithField("class", r.class).
 			WithField("shard", shard).WithField("uuid", id).Error(err)
+		return err
 	}
-	return err
+	r.recordWrite(ctx, shard, l, deletionTime.UnixMilli())
+	return nil
 }
 
 func (r *Replicator) PutObjects(ctx context.Context,
@@ -201,6 +229,13 @@ func (r *Replicator) PutObjects(ctx context.Context,
 	schemaVersion uint64,
 ) []error {
 	coord := newCoordinator[SimpleResponse](r, shard, r.requestID(opPutObjects), r.log)
+	hintMissed(r, coord, shard, func() []strfmt.UUID {
+		ids := make([]strfmt.UUID, len(objs))
+		for i, obj := range objs {
+			ids[i] = obj.ID()
+		}
+		return ids
+	})
 	op := func(ctx context.Context, host, requestID string) error {
 		resp, err := r.client.PutObjects(ctx, host, r.class, shard, requestID, objs, schemaVersion)
 		if err == nil {
@@ -228,6 +263,15 @@ func (r *Replicator) PutObjects(ctx context.Context,
 		r.log.WithField("op", "put.many").WithField("class", r.class).
 			WithField("shard", shard).Error(errs)
 	}
+	var updateTime int64
+	for i, err := range errs {
+		if err == nil && objs[i].LastUpdateTimeUnix() > updateTime {
+			updateTime = objs[i].LastUpdateTimeUnix()
+		}
+	}
+	if updateTime > 0 {
+		r.recordWrite(ctx, shard, l, updateTime)
+	}
 	return errs
 }
 
@@ -240,6 +284,9 @@ func (r *Replicator) DeleteObjects(ctx context.Context,
 	schemaVersion uint64,
 ) []objects.BatchSimpleObject {
 	coord := newCoordinator[DeleteBatchResponse](r, shard, r.requestID(opDeleteObjects), r.log)
+	if !dryRun {
+		hintMissed(r, coord, shard, func() []strfmt.UUID { return uuids })
+	}
 	op := func(ctx context.Context, host, requestID string) error {
 		resp, err := r.client.DeleteObjects(ctx, host, r.class, shard, requestID, uuids, deletionTime, dryRun, schemaVersion)
 		if err == nil {
@@ -278,6 +325,9 @@ func (r *Replicator) DeleteObjects(ctx context.Context,
 		r.log.WithField("op", "put.deletes").WithField("class", r.class).
 			WithField("shard", shard).Error(rs)
 	}
+	if !dryRun && slices.ContainsFunc(rs, func(x objects.BatchSimpleObject) bool { return x.Err == nil }) {
+		r.recordWrite(ctx, shard, l, deletionTime.UnixMilli())
+	}
 	return rs
 }
 
@@ -287,7 +337,16 @@ func (r *Replicator) AddReferences(ctx context.Context,
 	l types.ConsistencyLevel,
 	schemaVersion uint64,
 ) []error {
+	// replicas set the update time of the objects references are added to
+	addedAt := time.Now().UnixMilli()
 	coord := newCoordinator[SimpleResponse](r, shard, r.requestID(opAddReferences), r.log)
+	hintMissed(r, coord, shard, func() []strfmt.UUID {
+		ids := make([]strfmt.UUID, len(refs))
+		for i, ref := range refs {
+			ids[i] = ref.From.TargetID
+		}
+		return ids
+	})
 	op := func(ctx context.Context, host, requestID string) error {
 		resp, err := r.client.AddReferences(ctx, host, r.class, shard, requestID, refs, schemaVersion)
 		if err == nil {
@@ -314,9 +373,17 @@ func (r *Replicator) AddReferences(ctx context.Context,
 		r.log.WithField("op", "put.refs").WithField("class", r.class).
 			WithField("shard", shard).Error(errs)
 	}
+	if slices.ContainsFunc(errs, func(err error) bool { return err == nil }) {
+		r.recordWrite(ctx, shard, l, addedAt)
+	}
 	return errs
 }
 
+// recordWrite records a successful write in the session carried by ctx, if any
+func (r *Replicator) recordWrite(ctx context.Context, shard string, l types.ConsistencyLevel, updateTime int64) {
+	SessionFromContext(ctx).recordWrite(r.class, shard, l, updateTime)
+}
+
 // simpleCommit generate commit function for the coordinator
 func (r *Replicator) simpleCommit(shard string) commitOp[SimpleResponse] {
 	return func(ctx context.Context, host, requestID string) (SimpleResponse, error) {
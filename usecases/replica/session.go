@@ -0,0 +1,213 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"cmp"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/cluster/router/types"
+)
+
+const (
+	// sessionWriteTTL is the time after which a write is dropped from a
+	// session, by then hinted handoff and async replication are expected to
+	// have brought the other replicas up to date
+	sessionWriteTTL = 10 * time.Minute
+	// maxSessionShards is the number of shards a session tracks writes to,
+	// the least recently written shards are dropped first
+	maxSessionShards = 256
+)
+
+type sessionCtxKey struct{}
+
+// ContextWithSession returns a copy of ctx carrying the session. Replicated
+// writes made with the returned context are recorded in the session, reads
+// only return objects reflecting the writes recorded so far.
+func ContextWithSession(ctx context.Context, s *Session) context.Context {
+	return context.WithValue(ctx, sessionCtxKey{}, s)
+}
+
+// SessionFromContext returns the session carried by ctx, or nil.
+func SessionFromContext(ctx context.Context) *Session {
+	s, _ := ctx.Value(sessionCtxKey{}).(*Session)
+	return s
+}
+
+// sessionWrite is the latest update time of the objects a session wrote to a
+// shard, along with the weakest consistency level any of these writes were
+// acknowledged with.
+type sessionWrite struct {
+	UpdateTime int64                  `json:"t"`
+	Level      types.ConsistencyLevel `json:"l"`
+}
+
+// Session provides read-your-writes consistency across requests. Clients
+// pass its token along with every request, the token of a write response
+// includes the write.
+type Session struct {
+	mu     sync.Mutex
+	shards map[string]sessionWrite
+	// truncated is set once writes were dropped from the session, reads no
+	// longer reflect them for sure
+	truncated bool
+}
+
+func NewSession() *Session {
+	return &Session{shards: map[string]sessionWrite{}}
+}
+
+// ParseSessionToken restores the session a token was created from.
+func ParseSessionToken(token string) (*Session, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid session token: %w", err)
+	}
+	s := NewSession()
+	if err := json.Unmarshal(raw, &s.shards); err != nil {
+		return nil, fmt.Errorf("invalid session token: %w", err)
+	}
+	for key, w := range s.shards {
+		switch w.Level {
+		case types.ConsistencyLevelOne, types.ConsistencyLevelQuorum, types.ConsistencyLevelAll:
+		default:
+			return nil, fmt.Errorf("invalid session token: unknown consistency level %q for %q", w.Level, key)
+		}
+	}
+	s.prune(time.Now())
+	return s, nil
+}
+
+// Token encodes the writes recorded in the session which have not expired.
+// It is empty if there are none.
+func (s *Session) Token() string {
+	if s == nil {
+		return ""
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.prune(time.Now())
+	if len(s.shards) == 0 {
+		return ""
+	}
+	raw, _ := json.Marshal(s.shards) // cannot error
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// Truncated reports whether writes were dropped from the session, either
+// because they expired or because the session tracks too many shards. Reads
+// are not guaranteed to reflect the dropped writes, clients are told so along
+// with the token.
+func (s *Session) Truncated() bool {
+	if s == nil {
+		return false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.prune(time.Now())
+	return s.truncated
+}
+
+// prune drops the writes older than sessionWriteTTL, and the oldest writes
+// beyond maxSessionShards, and marks the session as truncated if there were
+// any. s.mu must be held or s not be shared yet.
+func (s *Session) prune(now time.Time) {
+	expired := now.Add(-sessionWriteTTL).UnixMilli()
+	for key, w := range s.shards {
+		if w.UpdateTime < expired {
+			delete(s.shards, key)
+			s.truncated = true
+		}
+	}
+	if len(s.shards) <= maxSessionShards {
+		return
+	}
+
+	keys := make([]string, 0, len(s.shards))
+	for key := range s.shards {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b string) int {
+		return cmp.Compare(s.shards[b].UpdateTime, s.shards[a].UpdateTime)
+	})
+	for _, key := range keys[maxSessionShards:] {
+		delete(s.shards, key)
+	}
+	s.truncated = true
+}
+
+func (s *Session) recordWrite(class, shard string, l types.ConsistencyLevel, updateTime int64) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if levelRank(l) == 0 {
+		// the coordinator treats an unset level like ONE
+		l = types.ConsistencyLevelOne
+	}
+	key := sessionKey(class, shard)
+	w, ok := s.shards[key]
+	if !ok || levelRank(l) < levelRank(w.Level) {
+		w.Level = l
+	}
+	if updateTime > w.UpdateTime {
+		w.UpdateTime = updateTime
+	}
+	s.shards[key] = w
+}
+
+// readLevel returns the consistency level a read from the shard needs to
+// reflect the writes of the session, and the update time of the latest one.
+// ok is false if a read at level l already does.
+func (s *Session) readLevel(class, shard string, l types.ConsistencyLevel) (_ types.ConsistencyLevel, updateTime int64, ok bool) {
+	if s == nil {
+		return "", 0, false
+	}
+	s.mu.Lock()
+	w, found := s.shards[sessionKey(class, shard)]
+	s.mu.Unlock()
+
+	// read and write replica sets overlap if their levels add up to at least
+	// ALL (ONE + ALL or QUORUM + QUORUM)
+	if !found || levelRank(l)+levelRank(w.Level) >= levelRank(types.ConsistencyLevelAll) {
+		return "", 0, false
+	}
+	if w.Level == types.ConsistencyLevelQuorum {
+		return types.ConsistencyLevelQuorum, w.UpdateTime, true
+	}
+	return types.ConsistencyLevelAll, w.UpdateTime, true
+}
+
+func sessionKey(class, shard string) string {
+	return class + "/" + shard
+}
+
+func levelRank(l types.ConsistencyLevel) int {
+	switch l {
+	case types.ConsistencyLevelQuorum:
+		return 1
+	case types.ConsistencyLevelAll:
+		return 2
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,229 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/cluster/router/types"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestSessionToken(t *testing.T) {
+	s := NewSession()
+	assert.Empty(t, s.Token())
+
+	now := time.Now().UnixMilli()
+	s.recordWrite("C1", "S1", types.ConsistencyLevelQuorum, now+5)
+	s.recordWrite("C1", "S1", types.ConsistencyLevelAll, now+3)
+	s.recordWrite("C1", "S2", "", now+7)
+
+	parsed, err := ParseSessionToken(s.Token())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]sessionWrite{
+		"C1/S1": {UpdateTime: now + 5, Level: types.ConsistencyLevelQuorum},
+		"C1/S2": {UpdateTime: now + 7, Level: types.ConsistencyLevelOne},
+	}, parsed.shards)
+
+	for _, token := range []string{"not base64!", "bm90IGpzb24", "eyJDMS9TMSI6eyJ0IjoxLCJsIjoiVFdPIn19"} {
+		_, err := ParseSessionToken(token)
+		assert.ErrorContains(t, err, "invalid session token", token)
+	}
+}
+
+func TestSessionPrune(t *testing.T) {
+	now := time.Now()
+
+	t.Run("expired writes", func(t *testing.T) {
+		s := NewSession()
+		s.recordWrite("C1", "S1", types.ConsistencyLevelOne, now.Add(-2*sessionWriteTTL).UnixMilli())
+		s.recordWrite("C1", "S2", types.ConsistencyLevelOne, now.UnixMilli())
+
+		parsed, err := ParseSessionToken(s.Token())
+		require.NoError(t, err)
+		assert.Len(t, parsed.shards, 1)
+		assert.Contains(t, parsed.shards, "C1/S2")
+		assert.True(t, s.Truncated())
+		assert.False(t, parsed.Truncated())
+
+		s = NewSession()
+		s.recordWrite("C1", "S1", types.ConsistencyLevelOne, now.Add(-2*sessionWriteTTL).UnixMilli())
+		assert.Empty(t, s.Token())
+		assert.True(t, s.Truncated())
+	})
+
+	t.Run("expired in token", func(t *testing.T) {
+		s := NewSession()
+		s.recordWrite("C1", "S1", types.ConsistencyLevelOne, now.Add(-2*sessionWriteTTL).UnixMilli())
+		s.recordWrite("C1", "S2", types.ConsistencyLevelOne, now.UnixMilli())
+		// encode the token without pruning, like a client sending it late
+		raw, err := json.Marshal(s.shards)
+		require.NoError(t, err)
+
+		parsed, err := ParseSessionToken(base64.RawURLEncoding.EncodeToString(raw))
+		require.NoError(t, err)
+		assert.Len(t, parsed.shards, 1)
+		assert.True(t, parsed.Truncated())
+	})
+
+	t.Run("too many shards", func(t *testing.T) {
+		s := NewSession()
+		for i := 0; i < maxSessionShards+10; i++ {
+			s.recordWrite("C1", fmt.Sprintf("S%d", i), types.ConsistencyLevelOne, now.UnixMilli()+int64(i))
+		}
+
+		parsed, err := ParseSessionToken(s.Token())
+		require.NoError(t, err)
+		assert.Len(t, parsed.shards, maxSessionShards)
+		assert.NotContains(t, parsed.shards, "C1/S9", "the oldest writes are dropped")
+		assert.Contains(t, parsed.shards, "C1/S10")
+		assert.True(t, s.Truncated())
+	})
+
+	t.Run("nothing dropped", func(t *testing.T) {
+		s := NewSession()
+		s.recordWrite("C1", "S1", types.ConsistencyLevelOne, now.UnixMilli())
+		assert.NotEmpty(t, s.Token())
+		assert.False(t, s.Truncated())
+
+		var nilSession *Session
+		assert.False(t, nilSession.Truncated())
+	})
+}
+
+func TestFinderCheckConsistencyWithSession(t *testing.T) {
+	var (
+		ids       = []strfmt.UUID{"1", "2"}
+		cls       = "C1"
+		shard     = "SH1"
+		nodes     = []string{"A", "B", "C"}
+		digestR   = []types.RepairResponse{{ID: "1", UpdateTime: 2}, {ID: "2", UpdateTime: 2}}
+		newObject = func(id strfmt.UUID) *storobj.Object {
+			x := object(id, 2)
+			x.BelongsToShard = shard
+			x.BelongsToNode = nodes[0]
+			return x
+		}
+	)
+
+	f := newFakeFactory(t, cls, shard, nodes)
+	finder := f.newFinder("A")
+	session := NewSession()
+	session.recordWrite(cls, shard, types.ConsistencyLevelOne, 2)
+	ctx := ContextWithSession(context.Background(), session)
+
+	for _, n := range nodes[1:] {
+		f.RClient.On("DigestObjects", anyVal, n, cls, shard, ids).Return(digestR, nil)
+	}
+
+	xs := []*storobj.Object{newObject(ids[0]), newObject(ids[1])}
+	require.NoError(t, finder.CheckConsistency(ctx, types.ConsistencyLevelOne, xs))
+	f.RClient.AssertCalled(t, "DigestObjects", anyVal, nodes[1], cls, shard, ids)
+	assert.True(t, xs[0].IsConsistent)
+	assert.True(t, xs[1].IsConsistent)
+}
+
+func TestSessionReadLevel(t *testing.T) {
+	var nilSession *Session
+	_, _, ok := nilSession.readLevel("C1", "S1", types.ConsistencyLevelOne)
+	assert.False(t, ok)
+
+	for _, tc := range []struct {
+		write, read, want types.ConsistencyLevel
+	}{
+		{types.ConsistencyLevelOne, types.ConsistencyLevelOne, types.ConsistencyLevelAll},
+		{types.ConsistencyLevelOne, types.ConsistencyLevelQuorum, types.ConsistencyLevelAll},
+		{types.ConsistencyLevelOne, types.ConsistencyLevelAll, ""},
+		{types.ConsistencyLevelQuorum, types.ConsistencyLevelOne, types.ConsistencyLevelQuorum},
+		{types.ConsistencyLevelQuorum, types.ConsistencyLevelQuorum, ""},
+		{types.ConsistencyLevelAll, types.ConsistencyLevelOne, ""},
+	} {
+		s := NewSession()
+		s.recordWrite("C1", "S1", tc.write, 3)
+
+		l, updateTime, ok := s.readLevel("C1", "S1", tc.read)
+		assert.Equal(t, tc.want != "", ok, "write %s read %s", tc.write, tc.read)
+		assert.Equal(t, tc.want, l, "write %s read %s", tc.write, tc.read)
+		if ok {
+			assert.Equal(t, int64(3), updateTime)
+		}
+
+		_, _, ok = s.readLevel("C1", "S2", tc.read)
+		assert.False(t, ok)
+	}
+}
+
+func TestFinderGetOneWithSession(t *testing.T) {
+	var (
+		id        = strfmt.UUID("123")
+		cls       = "C1"
+		shard     = "SH1"
+		nodes     = []string{"A", "B", "C"}
+		adds      = additional.Properties{}
+		proj      = search.SelectProperties{}
+		digestIDs = []strfmt.UUID{id}
+		item2     = objects.Replica{ID: id, Object: object(id, 2)}
+		item3     = objects.Replica{ID: id, Object: object(id, 3)}
+		digestR2  = []types.RepairResponse{{ID: id.String(), UpdateTime: 2}}
+		digestR3  = []types.RepairResponse{{ID: id.String(), UpdateTime: 3}}
+	)
+
+	t.Run("CaughtUp", func(t *testing.T) {
+		f := newFakeFactory(t, cls, shard, nodes)
+		finder := f.newFinder("A")
+		session := NewSession()
+		session.recordWrite(cls, shard, types.ConsistencyLevelOne, 3)
+		ctx := ContextWithSession(context.Background(), session)
+
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item3, nil)
+
+		got, err := finder.GetOne(ctx, types.ConsistencyLevelOne, shard, id, proj, adds)
+		require.NoError(t, err)
+		assert.Equal(t, item3.Object, got)
+		f.RClient.AssertNotCalled(t, "DigestObjects", anyVal, anyVal, cls, shard, digestIDs)
+	})
+
+	t.Run("Stale", func(t *testing.T) {
+		f := newFakeFactory(t, cls, shard, nodes)
+		finder := f.newFinder("A")
+		session := NewSession()
+		session.recordWrite(cls, shard, types.ConsistencyLevelOne, 3)
+		ctx := ContextWithSession(context.Background(), session)
+
+		f.RClient.On("FetchObject", anyVal, nodes[0], cls, shard, id, proj, adds).Return(item2, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[1], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("DigestObjects", anyVal, nodes[2], cls, shard, digestIDs).Return(digestR3, nil)
+		f.RClient.On("FetchObject", anyVal, nodes[1], cls, shard, id, proj, adds).Return(item3, nil)
+		f.RClient.On("FetchObject", anyVal, nodes[2], cls, shard, id, proj, adds).Return(item3, nil)
+		f.RClient.On("OverwriteObjects", anyVal, nodes[0], cls, shard, anyVal).
+			Return(digestR2, nil).RunFn = func(a mock.Arguments) {
+			require.Equal(t, &item3.Object.Object, a[4].([]*objects.VObject)[0].LatestObject)
+		}
+
+		got, err := finder.GetOne(ctx, types.ConsistencyLevelOne, shard, id, proj, adds)
+		require.NoError(t, err)
+		assert.Equal(t, item3.Object, got)
+	})
+}
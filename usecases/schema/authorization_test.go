@@ -92,6 +92,12 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:      authorization.UPDATE,
 			expectedResources: authorization.ShardsMetadata("className", "shardName"),
 		},
+		{
+			methodName:        "ReshardShard",
+			additionalArgs:    []interface{}{"className", "shardName", "MERGE", "targetShard"},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.ShardsMetadata("className", "shardName"),
+		},
 		{
 			methodName:        "ShardsStatus",
 			additionalArgs:    []interface{}{"className", "tenant"},
@@ -124,6 +130,12 @@ func Test_Schema_Authorization(t *testing.T) {
 			expectedVerb:      authorization.READ,
 			expectedResources: authorization.ShardsMetadata("className", "P1"),
 		},
+		{
+			methodName:        "RevectorizeVector",
+			additionalArgs:    []interface{}{"className", "vectorName", map[string]interface{}{}, 0},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: authorization.CollectionsMetadata("className"),
+		},
 	}
 
 	t.Run("verify that a test for every public method exists", func(t *testing.T) {
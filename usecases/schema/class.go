@@ -265,6 +265,10 @@ func UpdateClassInternal(h *Handler, ctx context.Context, className string, upda
 		return err
 	}
 
+	if err := validateQueryPipelines(updated, h.moduleConfig); err != nil {
+		return err
+	}
+
 	initial := h.schemaReader.ReadOnlyClass(className)
 	var shardingState *sharding.State
 
@@ -677,6 +681,10 @@ func (h *Handler) validateClassInvariants(
 		return err
 	}
 
+	if err := validateQueryPipelines(class, h.moduleConfig); err != nil {
+		return err
+	}
+
 	// all is fine!
 	return nil
 }
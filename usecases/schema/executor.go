@@ -124,6 +124,18 @@ func (e *executor) DeleteReplicaFromShard(class string, shard string, targetNode
 	return e.migrator.DeleteReplicaFromShard(ctx, class, shard)
 }
 
+func (e *executor) DropShard(class string, shard string) error {
+	ctx := context.Background()
+	if _, err := e.schemaReader.ShardReplicas(class, shard); err == nil {
+		return fmt.Errorf("shard %s still exists for collection %s", shard, class)
+	}
+	return e.migrator.DeleteReplicaFromShard(ctx, class, shard)
+}
+
+func (e *executor) SwapVectorIndex(class, targetVector string, submittedAt int64) error {
+	return e.migrator.SwapVectorIndex(context.Background(), class, targetVector, submittedAt)
+}
+
 // RestoreClassDir restores classes on the filesystem directly from the temporary class backup stored on disk.
 // This function is invoked by the Raft store when a restoration request is sent by the backup coordinator.
 func (e *executor) RestoreClassDir(class string) error {
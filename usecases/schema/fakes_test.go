@@ -56,6 +56,16 @@ func (f *fakeSchemaManager) AddProperty(_ context.Context, class string, p ...*m
 	return 0, args.Error(0)
 }
 
+func (f *fakeSchemaManager) StartResharding(_ context.Context, req command.StartReshardingRequest) (uint64, error) {
+	args := f.Called(req)
+	return 0, args.Error(0)
+}
+
+func (f *fakeSchemaManager) AddDistributedTask(_ context.Context, namespace, taskID string, payload any) error {
+	args := f.Called(namespace, taskID, payload)
+	return args.Error(0)
+}
+
 func (f *fakeSchemaManager) UpdateShardStatus(c_ context.Context, class, shard, status string) (uint64, error) {
 	args := f.Called(class, shard, status)
 	return 0, args.Error(0)
@@ -216,6 +226,11 @@ func (f *fakeSchemaManager) CopyShardingState(class string) *sharding.State {
 	return args.Get(0).(*sharding.State)
 }
 
+func (f *fakeSchemaManager) IsResharding(class string) bool {
+	args := f.Called(class)
+	return args.Bool(0)
+}
+
 func (f *fakeSchemaManager) CopyShardingStateWithVersion(ctx context.Context, class string, version uint64) (*sharding.State, error) {
 	args := f.Called(ctx, class, version)
 	return args.Get(0).(*sharding.State), args.Error(1)
@@ -14,8 +14,10 @@ package schema
 import (
 	"context"
 	"fmt"
+	"maps"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -51,6 +53,8 @@ type SchemaManager interface {
 	DeleteClass(ctx context.Context, name string) (uint64, error)
 	AddProperty(ctx context.Context, class string, p ...*models.Property) (uint64, error)
 	UpdateShardStatus(ctx context.Context, class, shard, status string) (uint64, error)
+	StartResharding(ctx context.Context, req command.StartReshardingRequest) (uint64, error)
+	AddDistributedTask(ctx context.Context, namespace, taskID string, payload any) error
 	AddTenants(ctx context.Context, class string, req *command.AddTenantsRequest) (uint64, error)
 	UpdateTenants(ctx context.Context, class string, req *command.UpdateTenantsRequest) (uint64, error)
 	DeleteTenants(ctx context.Context, class string, req *command.DeleteTenantsRequest) (uint64, error)
@@ -89,6 +93,7 @@ type SchemaReader interface {
 	ReadOnlyVersionedClass(name string) versioned.Class
 	ReadOnlySchema() models.Schema
 	CopyShardingState(class string) *sharding.State
+	IsResharding(class string) bool
 	ShardReplicas(class, shard string) ([]string, error)
 	ShardFromUUID(class string, uuid []byte) string
 	ShardOwner(class, shard string) (string, error)
@@ -246,6 +251,144 @@ func (h *Handler) UpdateShardStatus(ctx context.Context,
 	return h.schemaManager.UpdateShardStatus(ctx, class, shard, status)
 }
 
+// ReshardShard starts splitting the given shard into a new shard or merging it
+// into the target shard. The operation runs in the background as distributed
+// tasks, one per phase. It returns the name of the target shard, which is
+// generated for splits if not provided.
+func (h *Handler) ReshardShard(ctx context.Context,
+	principal *models.Principal, class, shard, operation, target string,
+) (string, error) {
+	err := h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.ShardsMetadata(class, shard)...)
+	if err != nil {
+		return "", err
+	}
+
+	state := h.schemaReader.CopyShardingState(class)
+	if state == nil {
+		return "", fmt.Errorf("collection %q: %w", class, ErrNotFound)
+	}
+
+	req := command.StartReshardingRequest{
+		Class:       class,
+		Operation:   operation,
+		SourceShard: shard,
+		TargetShard: target,
+	}
+
+	// validate against a copy of the local state, the leader validates again
+	// when applying the command
+	switch operation {
+	case sharding.ReshardingSplit:
+		if req.TargetShard == "" {
+			req.TargetShard = sharding.NewPhysicalShardName()
+		}
+		if req.Virtual, err = state.PlanSplit(shard); err != nil {
+			return "", err
+		}
+		err = state.StartSplit(shard, req.TargetShard, req.Virtual)
+	case sharding.ReshardingMerge:
+		if target == "" {
+			return "", fmt.Errorf("target shard is required to merge shard %q", shard)
+		}
+		err = h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.ShardsMetadata(class, target)...)
+		if err != nil {
+			return "", err
+		}
+		err = state.StartMerge(shard, target)
+	default:
+		err = fmt.Errorf("unknown resharding operation %q", operation)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := h.schemaManager.StartResharding(ctx, req); err != nil {
+		return "", err
+	}
+	return req.TargetShard, nil
+}
+
+// RevectorizeVector starts re-embedding the objects of a named vector with
+// the given vectorizer module config. The objects are embedded into a shadow
+// vector index in the background, which replaces the serving index once all
+// nodes are done. objectsPerSecond limits the embedding rate of each node,
+// zero means unlimited. It returns the ID of the distributed task building
+// the shadow indexes.
+func (h *Handler) RevectorizeVector(ctx context.Context, principal *models.Principal,
+	class, targetVector string, vectorizer map[string]interface{}, objectsPerSecond int,
+) (string, error) {
+	err := h.Authorizer.Authorize(principal, authorization.UPDATE, authorization.CollectionsMetadata(class)...)
+	if err != nil {
+		return "", err
+	}
+
+	cls := h.schemaReader.ReadOnlyClass(class)
+	if cls == nil {
+		return "", fmt.Errorf("collection %q: %w", class, ErrNotFound)
+	}
+	if schema.MultiTenancyEnabled(cls) {
+		return "", fmt.Errorf("re-vectorizing multi-tenant collection %q is not supported", class)
+	}
+	vectorConfig, ok := cls.VectorConfig[targetVector]
+	if !ok {
+		return "", fmt.Errorf("named vector %q of collection %q: %w", targetVector, class, ErrNotFound)
+	}
+	if indexConfig, ok := vectorConfig.VectorIndexConfig.(schemaConfig.VectorIndexConfig); ok && indexConfig.IsMultiVector() {
+		return "", fmt.Errorf("re-vectorizing multi-vector %q is not supported", targetVector)
+	}
+	if objectsPerSecond < 0 {
+		return "", fmt.Errorf("objects per second must not be negative")
+	}
+
+	if len(vectorizer) != 1 {
+		return "", fmt.Errorf("exactly one vectorizer module must be configured for named vector %q", targetVector)
+	}
+	for name := range vectorizer {
+		if err := h.vectorizerValidator.ValidateVectorizer(name); err != nil {
+			return "", err
+		}
+		if h.moduleConfig.IsMultiVector(name) {
+			return "", fmt.Errorf("re-vectorizing with multi-vector module %q is not supported", name)
+		}
+	}
+
+	// validate the module config as part of a copy of the class, the defaults
+	// are set here so that every node embeds with the same settings
+	vectorConfig.Vectorizer = vectorizer
+	updated := *cls
+	updated.VectorConfig = map[string]models.VectorConfig{targetVector: vectorConfig}
+	updated.Properties = make([]*models.Property, len(cls.Properties))
+	for i, prop := range cls.Properties {
+		cp := *prop
+		if moduleConfig, ok := prop.ModuleConfig.(map[string]interface{}); ok {
+			cp.ModuleConfig = maps.Clone(moduleConfig)
+		}
+		updated.Properties[i] = &cp
+	}
+	h.moduleConfig.SetClassDefaults(&updated)
+	if err := h.moduleConfig.ValidateClass(ctx, &updated); err != nil {
+		return "", fmt.Errorf("vectorizer of named vector %q: %w", targetVector, err)
+	}
+	vectorizer, ok = updated.VectorConfig[targetVector].Vectorizer.(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("unexpected vectorizer config of named vector %q", targetVector)
+	}
+
+	taskID := command.RevectorizeTaskID(class, targetVector, command.RevectorizePhaseBuild)
+	err = h.schemaManager.AddDistributedTask(ctx, command.RevectorizeTasksNamespace, taskID, command.RevectorizeTaskPayload{
+		Class:                 class,
+		TargetVector:          targetVector,
+		Phase:                 command.RevectorizePhaseBuild,
+		Vectorizer:            vectorizer,
+		ObjectsPerSecond:      objectsPerSecond,
+		SubmittedAtUnixMillis: time.Now().UnixMilli(),
+	})
+	if err != nil {
+		return "", err
+	}
+	return taskID, nil
+}
+
 func (h *Handler) ShardsStatus(ctx context.Context,
 	principal *models.Principal, class, shard string,
 ) (models.ShardStatusList, error) {
@@ -19,10 +19,14 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	command "github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/cluster/mocks"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/config/runtime"
+	"github.com/weaviate/weaviate/usecases/sharding"
+	shardingConfig "github.com/weaviate/weaviate/usecases/sharding/config"
 )
 
 var schemaTests = []struct {
@@ -387,3 +391,121 @@ func TestSchema(t *testing.T) {
 		}
 	})
 }
+
+func TestHandlerReshardShard(t *testing.T) {
+	newState := func(t *testing.T, desiredCount int) *sharding.State {
+		cfg, err := shardingConfig.ParseConfig(map[string]interface{}{"desiredCount": float64(desiredCount)}, 2)
+		require.Nil(t, err)
+		nodes := mocks.NewMockNodeSelector("node1", "node2")
+		state, err := sharding.InitState("Car", cfg, nodes.LocalName(), nodes.StorageCandidates(), 2, false)
+		require.Nil(t, err)
+		return state
+	}
+
+	t.Run("split", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		state := newState(t, 1)
+		source := state.AllPhysicalShards()[0]
+		moving, err := state.PlanSplit(source)
+		require.Nil(t, err)
+
+		fakeSchemaManager.On("CopyShardingState", "Car").Return(state)
+		fakeSchemaManager.On("StartResharding", mock.MatchedBy(func(req command.StartReshardingRequest) bool {
+			return req.Class == "Car" && req.Operation == sharding.ReshardingSplit &&
+				req.SourceShard == source && req.TargetShard != "" && assert.ElementsMatch(t, moving, req.Virtual)
+		})).Return(nil)
+
+		target, err := handler.ReshardShard(context.Background(), nil, "Car", source, sharding.ReshardingSplit, "")
+		require.Nil(t, err)
+		assert.NotEmpty(t, target)
+		fakeSchemaManager.AssertExpectations(t)
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		state := newState(t, 2)
+		shards := state.AllPhysicalShards()
+
+		fakeSchemaManager.On("CopyShardingState", "Car").Return(state)
+		fakeSchemaManager.On("StartResharding", command.StartReshardingRequest{
+			Class:       "Car",
+			Operation:   sharding.ReshardingMerge,
+			SourceShard: shards[0],
+			TargetShard: shards[1],
+		}).Return(nil)
+
+		target, err := handler.ReshardShard(context.Background(), nil, "Car", shards[0], sharding.ReshardingMerge, shards[1])
+		require.Nil(t, err)
+		assert.Equal(t, shards[1], target)
+		fakeSchemaManager.AssertExpectations(t)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		state := newState(t, 2)
+		shards := state.AllPhysicalShards()
+		fakeSchemaManager.On("CopyShardingState", "Car").Return(state)
+
+		_, err := handler.ReshardShard(context.Background(), nil, "Car", shards[0], "SHUFFLE", "")
+		assert.ErrorContains(t, err, "unknown resharding operation")
+		_, err = handler.ReshardShard(context.Background(), nil, "Car", shards[0], sharding.ReshardingMerge, "")
+		assert.ErrorContains(t, err, "target shard is required")
+		_, err = handler.ReshardShard(context.Background(), nil, "Car", "unknown", sharding.ReshardingSplit, "")
+		assert.ErrorContains(t, err, "does not exist")
+		_, err = handler.ReshardShard(context.Background(), nil, "Car", shards[0], sharding.ReshardingSplit, shards[1])
+		assert.ErrorContains(t, err, "already exists")
+		fakeSchemaManager.AssertNotCalled(t, "StartResharding", mock.Anything)
+	})
+}
+
+func TestHandlerRevectorizeVector(t *testing.T) {
+	newClass := func() *models.Class {
+		return &models.Class{
+			Class: "Car",
+			VectorConfig: map[string]models.VectorConfig{
+				"description": {
+					Vectorizer:        map[string]interface{}{"model1": map[string]interface{}{}},
+					VectorIndexConfig: fakeVectorConfig{},
+				},
+			},
+			Properties: []*models.Property{{Name: "description", DataType: []string{"text"}}},
+		}
+	}
+
+	t.Run("adds the build task", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "Car").Return(newClass())
+		fakeSchemaManager.On("AddDistributedTask", command.RevectorizeTasksNamespace, "Car/description/build",
+			mock.MatchedBy(func(payload command.RevectorizeTaskPayload) bool {
+				_, ok := payload.Vectorizer["model2"]
+				return payload.Class == "Car" && payload.TargetVector == "description" &&
+					payload.Phase == command.RevectorizePhaseBuild && payload.ObjectsPerSecond == 10 && ok
+			})).Return(nil)
+
+		taskID, err := handler.RevectorizeVector(context.Background(), nil, "Car", "description",
+			map[string]interface{}{"model2": map[string]interface{}{}}, 10)
+		require.Nil(t, err)
+		assert.Equal(t, "Car/description/build", taskID)
+		fakeSchemaManager.AssertExpectations(t)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+		fakeSchemaManager.On("ReadOnlyClass", "Car").Return(newClass())
+		fakeSchemaManager.On("ReadOnlyClass", "Unknown").Return(nil)
+		valid := map[string]interface{}{"model2": map[string]interface{}{}}
+
+		_, err := handler.RevectorizeVector(context.Background(), nil, "Unknown", "description", valid, 0)
+		assert.ErrorIs(t, err, ErrNotFound)
+		_, err = handler.RevectorizeVector(context.Background(), nil, "Car", "unknown", valid, 0)
+		assert.ErrorIs(t, err, ErrNotFound)
+		_, err = handler.RevectorizeVector(context.Background(), nil, "Car", "description", valid, -1)
+		assert.ErrorContains(t, err, "must not be negative")
+		_, err = handler.RevectorizeVector(context.Background(), nil, "Car", "description", map[string]interface{}{}, 0)
+		assert.ErrorContains(t, err, "exactly one vectorizer")
+		_, err = handler.RevectorizeVector(context.Background(), nil, "Car", "description",
+			map[string]interface{}{"unknown": map[string]interface{}{}}, 0)
+		assert.ErrorContains(t, err, "invalid vectorizer")
+		fakeSchemaManager.AssertNotCalled(t, "AddDistributedTask", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -108,6 +108,14 @@ func (f *fakeDB) DeleteReplicaFromShard(class string, shard string, targetNode s
 	return nil
 }
 
+func (f *fakeDB) DropShard(class string, shard string) error {
+	return nil
+}
+
+func (f *fakeDB) SwapVectorIndex(class, targetVector string, submittedAt int64) error {
+	return nil
+}
+
 func (f *fakeDB) UpdateClass(cmd command.UpdateClassRequest) error {
 	return nil
 }
@@ -317,6 +325,11 @@ func (f *fakeMigrator) DeleteReplicaFromShard(ctx context.Context, class string,
 	return args.Error(0)
 }
 
+func (f *fakeMigrator) SwapVectorIndex(ctx context.Context, class, targetVector string, submittedAt int64) error {
+	args := f.Called(ctx, class, targetVector, submittedAt)
+	return args.Error(0)
+}
+
 func (f *fakeMigrator) UpdateProperty(ctx context.Context, className string, propName string, newName *string) error {
 	return nil
 }
@@ -65,6 +65,7 @@ type SchemaGetter interface {
 	Statistics() map[string]any
 
 	CopyShardingState(class string) *sharding.State
+	IsResharding(class string) bool
 	ShardOwner(class, shard string) (string, error)
 	TenantsShards(ctx context.Context, class string, tenants ...string) (map[string]string, error)
 	OptimisticTenantStatus(ctx context.Context, class string, tenants string) (map[string]string, error)
@@ -40,6 +40,7 @@ type Migrator interface {
 	GetShardsQueueSize(ctx context.Context, className, tenant string) (map[string]int64, error)
 	AddReplicaToShard(ctx context.Context, class, shard string) error
 	DeleteReplicaFromShard(ctx context.Context, class, shard string) error
+	SwapVectorIndex(ctx context.Context, class, targetVector string, submittedAt int64) error
 
 	AddProperty(ctx context.Context, className string,
 		props ...*models.Property) error
@@ -175,6 +175,52 @@ func (_c *MockSchemaGetter_GetSchemaSkipAuth_Call) RunAndReturn(run func() entit
 	return _c
 }
 
+// IsResharding provides a mock function with given fields: class
+func (_m *MockSchemaGetter) IsResharding(class string) bool {
+	ret := _m.Called(class)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsResharding")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(class)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// MockSchemaGetter_IsResharding_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsResharding'
+type MockSchemaGetter_IsResharding_Call struct {
+	*mock.Call
+}
+
+// IsResharding is a helper method to define mock.On call
+//   - class string
+func (_e *MockSchemaGetter_Expecter) IsResharding(class interface{}) *MockSchemaGetter_IsResharding_Call {
+	return &MockSchemaGetter_IsResharding_Call{Call: _e.mock.On("IsResharding", class)}
+}
+
+func (_c *MockSchemaGetter_IsResharding_Call) Run(run func(class string)) *MockSchemaGetter_IsResharding_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockSchemaGetter_IsResharding_Call) Return(_a0 bool) *MockSchemaGetter_IsResharding_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSchemaGetter_IsResharding_Call) RunAndReturn(run func(string) bool) *MockSchemaGetter_IsResharding_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NodeName provides a mock function with no fields
 func (_m *MockSchemaGetter) NodeName() string {
 	ret := _m.Called()
@@ -0,0 +1,149 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/adapters/handlers/rest/filterext"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// queryPipelineModules tells the reranker and generative modules apart
+type queryPipelineModules interface {
+	IsGenerative(string) bool
+	IsReranker(string) bool
+}
+
+// validateQueryPipelines checks that the query pipelines declared on a
+// class are well-formed: every pipeline has exactly one retrieval stage,
+// filter stages precede it, as they are applied while retrieving, and
+// generate is last.
+// Rerank and generate stages require a reranker or generative module to be
+// configured on the class.
+func validateQueryPipelines(class *models.Class, modules queryPipelineModules) error {
+	var hasGenerative, hasReranker bool
+	if moduleConfig, ok := class.ModuleConfig.(map[string]interface{}); ok {
+		for name := range moduleConfig {
+			hasGenerative = hasGenerative || modules.IsGenerative(name)
+			hasReranker = hasReranker || modules.IsReranker(name)
+		}
+	}
+
+	names := make(map[string]struct{}, len(class.QueryPipelines))
+	for i, pipeline := range class.QueryPipelines {
+		if pipeline == nil {
+			return fmt.Errorf("query pipeline at position %d is empty", i)
+		}
+		if pipeline.Name == "" {
+			return fmt.Errorf("query pipeline at position %d: name is required", i)
+		}
+		if _, ok := names[pipeline.Name]; ok {
+			return fmt.Errorf("query pipeline %q: name is not unique", pipeline.Name)
+		}
+		names[pipeline.Name] = struct{}{}
+
+		if err := validateQueryPipelineStages(class.Class, pipeline.Stages); err != nil {
+			return fmt.Errorf("query pipeline %q: %w", pipeline.Name, err)
+		}
+		for i, stage := range pipeline.Stages {
+			switch {
+			case stage.Type == models.QueryPipelineStageTypeRerank && !hasReranker:
+				return fmt.Errorf("query pipeline %q: stage %d: no reranker module is configured on the class", pipeline.Name, i)
+			case stage.Type == models.QueryPipelineStageTypeGenerate && !hasGenerative:
+				return fmt.Errorf("query pipeline %q: stage %d: no generative module is configured on the class", pipeline.Name, i)
+			}
+		}
+	}
+	return nil
+}
+
+func validateQueryPipelineStages(className string, stages []*models.QueryPipelineStage) error {
+	if len(stages) == 0 {
+		return fmt.Errorf("at least one stage is required")
+	}
+
+	var retrievalLimit int64
+	var retrieved, reranked, generated bool
+	for i, stage := range stages {
+		if stage == nil {
+			return fmt.Errorf("stage %d is empty", i)
+		}
+		if generated {
+			return fmt.Errorf("stage %d: %q must be the last stage", i-1, models.QueryPipelineStageTypeGenerate)
+		}
+		if stage.Limit < 0 {
+			return fmt.Errorf("stage %d: limit must not be negative", i)
+		}
+
+		switch stage.Type {
+		case models.QueryPipelineStageTypeHybrid, models.QueryPipelineStageTypeBm25:
+			if retrieved {
+				return fmt.Errorf("stage %d: only one retrieval stage is supported", i)
+			}
+			if stage.Query == "" {
+				return fmt.Errorf("stage %d: query is required", i)
+			}
+			if stage.Alpha != nil {
+				if stage.Type != models.QueryPipelineStageTypeHybrid {
+					return fmt.Errorf("stage %d: alpha is only supported by %q stages", i, models.QueryPipelineStageTypeHybrid)
+				}
+				if *stage.Alpha < 0 || *stage.Alpha > 1 {
+					return fmt.Errorf("stage %d: alpha must be between 0 and 1, got %v", i, *stage.Alpha)
+				}
+			}
+			retrievalLimit = stage.Limit
+			retrieved = true
+		case models.QueryPipelineStageTypeFilter:
+			// filters restrict the candidates of the retrieval, they cannot
+			// drop results which have already been retrieved
+			if retrieved {
+				return fmt.Errorf("stage %d: %q stages must precede the retrieval stage", i, stage.Type)
+			}
+			if stage.Where == nil {
+				return fmt.Errorf("stage %d: where is required", i)
+			}
+			if _, err := filterext.Parse(stage.Where, className); err != nil {
+				return fmt.Errorf("stage %d: %w", i, err)
+			}
+		case models.QueryPipelineStageTypeRerank:
+			if !retrieved {
+				return fmt.Errorf("stage %d: %q must follow the retrieval stage", i, stage.Type)
+			}
+			if reranked {
+				return fmt.Errorf("stage %d: only one %q stage is supported", i, stage.Type)
+			}
+			if stage.Property == "" {
+				return fmt.Errorf("stage %d: property is required", i)
+			}
+			if retrievalLimit > 0 && stage.Limit > retrievalLimit {
+				return fmt.Errorf("stage %d: limit %d exceeds the retrieval limit %d", i, stage.Limit, retrievalLimit)
+			}
+			reranked = true
+		case models.QueryPipelineStageTypeGenerate:
+			if !retrieved {
+				return fmt.Errorf("stage %d: %q must follow the retrieval stage", i, stage.Type)
+			}
+			if stage.SinglePrompt == "" && stage.GroupedTask == "" {
+				return fmt.Errorf("stage %d: singlePrompt or groupedTask is required", i)
+			}
+			generated = true
+		default:
+			return fmt.Errorf("stage %d: unknown type %q", i, stage.Type)
+		}
+	}
+	if !retrieved {
+		return fmt.Errorf("a %q or %q stage is required",
+			models.QueryPipelineStageTypeHybrid, models.QueryPipelineStageTypeBm25)
+	}
+	return nil
+}
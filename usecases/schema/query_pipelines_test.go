@@ -0,0 +1,167 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestValidateQueryPipelines(t *testing.T) {
+	alpha := func(v float64) *float64 { return &v }
+	category := "${category}"
+	hybrid := &models.QueryPipelineStage{Type: models.QueryPipelineStageTypeHybrid, Query: "${q}", Limit: 200}
+	filter := &models.QueryPipelineStage{Type: models.QueryPipelineStageTypeFilter, Where: &models.WhereFilter{
+		Operator: models.WhereFilterOperatorEqual, Path: []string{"category"}, ValueText: &category,
+	}}
+	rerank := &models.QueryPipelineStage{Type: models.QueryPipelineStageTypeRerank, Property: "body", Limit: 20}
+	generate := &models.QueryPipelineStage{Type: models.QueryPipelineStageTypeGenerate, GroupedTask: "Summarize"}
+
+	tests := []struct {
+		name         string
+		pipelines    []*models.QueryPipeline
+		moduleConfig map[string]interface{}
+		expectedErr  string
+	}{
+		{
+			name: "full pipeline",
+			pipelines: []*models.QueryPipeline{
+				{Name: "rag", Stages: []*models.QueryPipelineStage{filter, hybrid, rerank, generate}},
+			},
+		},
+		{
+			name: "bm25 only",
+			pipelines: []*models.QueryPipeline{
+				{Name: "keyword", Stages: []*models.QueryPipelineStage{{Type: models.QueryPipelineStageTypeBm25, Query: "${q}"}}},
+			},
+		},
+		{
+			name:        "missing name",
+			pipelines:   []*models.QueryPipeline{{Stages: []*models.QueryPipelineStage{hybrid}}},
+			expectedErr: "name is required",
+		},
+		{
+			name: "duplicate name",
+			pipelines: []*models.QueryPipeline{
+				{Name: "rag", Stages: []*models.QueryPipelineStage{hybrid}},
+				{Name: "rag", Stages: []*models.QueryPipelineStage{hybrid}},
+			},
+			expectedErr: "name is not unique",
+		},
+		{
+			name:        "no stages",
+			pipelines:   []*models.QueryPipeline{{Name: "rag"}},
+			expectedErr: "at least one stage is required",
+		},
+		{
+			name:        "no retrieval stage",
+			pipelines:   []*models.QueryPipeline{{Name: "rag", Stages: []*models.QueryPipelineStage{filter}}},
+			expectedErr: "a \"hybrid\" or \"bm25\" stage is required",
+		},
+		{
+			name:        "rerank before retrieval",
+			pipelines:   []*models.QueryPipeline{{Name: "rag", Stages: []*models.QueryPipelineStage{rerank, hybrid}}},
+			expectedErr: "must follow the retrieval stage",
+		},
+		{
+			name:        "filter after retrieval",
+			pipelines:   []*models.QueryPipeline{{Name: "rag", Stages: []*models.QueryPipelineStage{hybrid, filter}}},
+			expectedErr: "must precede the retrieval stage",
+		},
+		{
+			name:        "two retrieval stages",
+			pipelines:   []*models.QueryPipeline{{Name: "rag", Stages: []*models.QueryPipelineStage{hybrid, hybrid}}},
+			expectedErr: "only one retrieval stage",
+		},
+		{
+			name: "alpha out of range",
+			pipelines: []*models.QueryPipeline{{Name: "rag", Stages: []*models.QueryPipelineStage{
+				{Type: models.QueryPipelineStageTypeHybrid, Query: "${q}", Alpha: alpha(1.5)},
+			}}},
+			expectedErr: "alpha must be between 0 and 1",
+		},
+		{
+			name: "alpha on bm25",
+			pipelines: []*models.QueryPipeline{{Name: "rag", Stages: []*models.QueryPipelineStage{
+				{Type: models.QueryPipelineStageTypeBm25, Query: "${q}", Alpha: alpha(0.5)},
+			}}},
+			expectedErr: "alpha is only supported",
+		},
+		{
+			name:        "filter after rerank",
+			pipelines:   []*models.QueryPipeline{{Name: "rag", Stages: []*models.QueryPipelineStage{hybrid, rerank, filter}}},
+			expectedErr: "must precede the retrieval stage",
+		},
+		{
+			name: "invalid filter",
+			pipelines: []*models.QueryPipeline{{Name: "rag", Stages: []*models.QueryPipelineStage{
+				{Type: models.QueryPipelineStageTypeFilter, Where: &models.WhereFilter{Operator: "Unknown"}}, hybrid,
+			}}},
+			expectedErr: "stage 0",
+		},
+		{
+			name: "rerank limit above retrieval limit",
+			pipelines: []*models.QueryPipeline{{Name: "rag", Stages: []*models.QueryPipelineStage{
+				hybrid, {Type: models.QueryPipelineStageTypeRerank, Property: "body", Limit: 500},
+			}}},
+			expectedErr: "exceeds the retrieval limit",
+		},
+		{
+			name:        "generate not last",
+			pipelines:   []*models.QueryPipeline{{Name: "rag", Stages: []*models.QueryPipelineStage{hybrid, generate, rerank}}},
+			expectedErr: "must be the last stage",
+		},
+		{
+			name:         "rerank without reranker module",
+			pipelines:    []*models.QueryPipeline{{Name: "rag", Stages: []*models.QueryPipelineStage{hybrid, rerank}}},
+			moduleConfig: map[string]interface{}{"generative-openai": map[string]interface{}{}},
+			expectedErr:  "stage 1: no reranker module is configured on the class",
+		},
+		{
+			name:         "generate without generative module",
+			pipelines:    []*models.QueryPipeline{{Name: "rag", Stages: []*models.QueryPipelineStage{hybrid, generate}}},
+			moduleConfig: map[string]interface{}{"reranker-cohere": map[string]interface{}{}},
+			expectedErr:  "stage 1: no generative module is configured on the class",
+		},
+		{
+			name: "generate without prompt",
+			pipelines: []*models.QueryPipeline{{Name: "rag", Stages: []*models.QueryPipelineStage{
+				hybrid, {Type: models.QueryPipelineStageTypeGenerate},
+			}}},
+			expectedErr: "singlePrompt or groupedTask is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			moduleConfig := tt.moduleConfig
+			if moduleConfig == nil {
+				moduleConfig = map[string]interface{}{
+					"generative-openai": map[string]interface{}{},
+					"reranker-cohere":   map[string]interface{}{},
+				}
+			}
+			err := validateQueryPipelines(&models.Class{
+				Class: "Article", ModuleConfig: moduleConfig, QueryPipelines: tt.pipelines,
+			}, &fakeModuleConfig{})
+			if tt.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
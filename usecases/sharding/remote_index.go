@@ -90,6 +90,8 @@ type RemoteIndexClient interface {
 		cursor *filters.Cursor, groupBy *searchparams.GroupBy,
 		additional additional.Properties, targetCombination *dto.TargetCombination, properties []string,
 	) ([]*storobj.Object, []float32, error)
+	SearchShardBatch(ctx context.Context, hostname, indexName, shardName string,
+		queries []ShardSearchQuery) ([]ShardSearchResult, error)
 
 	Aggregate(ctx context.Context, hostname, indexName, shardName string,
 		params aggregation.Params) (*aggregation.Result, error)
@@ -334,6 +336,51 @@ func (ri *RemoteIndex) SearchShard(ctx context.Context, shard string,
 	return r.first, r.second, node, err
 }
 
+// ShardSearchQuery holds the arguments of one of the vector searches sent to
+// a shard in a single request
+type ShardSearchQuery struct {
+	Vectors           []models.Vector
+	TargetVectors     []string
+	Distance          float32
+	Limit             int
+	Filters           *filters.LocalFilter
+	Sort              []filters.Sort
+	GroupBy           *searchparams.GroupBy
+	Additional        additional.Properties
+	TargetCombination *dto.TargetCombination
+	Properties        []string
+}
+
+// ShardSearchResult is the result of a ShardSearchQuery, a failing query does
+// not fail the other queries of the request
+type ShardSearchResult struct {
+	Objects []*storobj.Object
+	Scores  []float32
+	Err     error
+}
+
+// SearchShardBatch executes many vector searches on one replica of the shard
+// with a single request. The results are in the order of the queries.
+func (ri *RemoteIndex) SearchShardBatch(ctx context.Context, shard string,
+	queries []ShardSearchQuery,
+) ([]ShardSearchResult, string, error) {
+	f := func(node, host string) (interface{}, error) {
+		results, err := ri.client.SearchShardBatch(ctx, host, ri.class, shard, queries)
+		if err != nil {
+			return nil, err
+		}
+		if len(results) != len(queries) {
+			return nil, fmt.Errorf("got %d results for %d queries", len(results), len(queries))
+		}
+		return results, nil
+	}
+	rr, node, err := ri.queryReplicas(ctx, shard, f)
+	if err != nil {
+		return nil, node, err
+	}
+	return rr.([]ShardSearchResult), node, nil
+}
+
 func (ri *RemoteIndex) Aggregate(
 	ctx context.Context,
 	shard string,
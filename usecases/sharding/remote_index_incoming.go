@@ -68,6 +68,8 @@ type RemoteIndexIncomingRepo interface {
 		sort []filters.Sort, cursor *filters.Cursor, groupBy *searchparams.GroupBy,
 		additional additional.Properties, targetCombination *dto.TargetCombination, properties []string,
 	) ([]*storobj.Object, []float32, error)
+	IncomingSearchBatch(ctx context.Context, shardName string,
+		queries []ShardSearchQuery) ([]ShardSearchResult, error)
 	IncomingAggregate(ctx context.Context, shardName string,
 		params aggregation.Params, modules interface{}) (*aggregation.Result, error)
 
@@ -224,6 +226,17 @@ func (rii *RemoteIndexIncoming) Search(ctx context.Context, indexName, shardName
 		ctx, shardName, vectors, targetVectors, distance, limit, filters, keywordRanking, sort, cursor, groupBy, additional, targetCombination, properties)
 }
 
+func (rii *RemoteIndexIncoming) SearchBatch(ctx context.Context, indexName, shardName string,
+	queries []ShardSearchQuery,
+) ([]ShardSearchResult, error) {
+	index := rii.repo.GetIndexForIncomingSharding(schema.ClassName(indexName))
+	if index == nil {
+		return nil, enterrors.NewErrUnprocessable(errors.Errorf("local index %q not found", indexName))
+	}
+
+	return index.IncomingSearchBatch(ctx, shardName, queries)
+}
+
 func (rii *RemoteIndexIncoming) Aggregate(ctx context.Context, indexName, shardName string,
 	params aggregation.Params,
 ) (*aggregation.Result, error) {
@@ -0,0 +1,307 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sharding
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/spaolacci/murmur3"
+)
+
+const (
+	// ReshardingSplit moves the upper half (by token range) of the virtual
+	// shards owned by the source physical shard into a new physical shard.
+	ReshardingSplit = "SPLIT"
+	// ReshardingMerge moves all virtual shards owned by the source physical
+	// shard into an existing target physical shard and removes the source.
+	ReshardingMerge = "MERGE"
+)
+
+const (
+	// ReshardingPhaseCopy is the first phase of a resharding operation. Objects
+	// are copied from the source to the target shard, while reads and writes
+	// are still routed to the source shard.
+	ReshardingPhaseCopy = "COPY"
+	// ReshardingPhaseCleanup starts once the virtual shards have been reassigned
+	// to the target shard. Objects written to the source shard during the copy
+	// phase are caught up and moved objects are removed from the source shard.
+	ReshardingPhaseCleanup = "CLEANUP"
+)
+
+// Resharding describes an in-flight split or merge of a physical shard. It is
+// stored in State.Resharding keyed by the name of the source physical shard.
+type Resharding struct {
+	Operation   string   `json:"operation"`
+	TargetShard string   `json:"targetShard"`
+	Phase       string   `json:"phase"`
+	Virtual     []string `json:"virtual"` // virtual shards moving from source to target
+}
+
+func (r Resharding) DeepCopy() Resharding {
+	return Resharding{
+		Operation:   r.Operation,
+		TargetShard: r.TargetShard,
+		Phase:       r.Phase,
+		Virtual:     slices.Clone(r.Virtual),
+	}
+}
+
+// NewPhysicalShardName returns a random name for a new physical shard.
+func NewPhysicalShardName() string {
+	return generateShardName()
+}
+
+// IsResharding returns true if at least one physical shard is currently being
+// split or merged. While this is the case, objects may temporarily exist in
+// both the source and the target shard.
+func (s *State) IsResharding() bool {
+	return len(s.Resharding) > 0
+}
+
+// ReshardingInvolves returns true if the given physical shard is the source or
+// the target of an in-flight resharding operation.
+func (s *State) ReshardingInvolves(shard string) bool {
+	for source, r := range s.Resharding {
+		if source == shard || r.TargetShard == shard {
+			return true
+		}
+	}
+	return false
+}
+
+// PlanSplit returns the virtual shards which would move to a new physical
+// shard if the given physical shard was split. The virtual shards owned by the
+// source are ordered by token range and the upper half is selected, so both
+// resulting physical shards own roughly the same amount of data.
+//
+// It doesn't change the internal state.
+func (s *State) PlanSplit(source string) ([]string, error) {
+	if err := s.validateReshardingSource(source); err != nil {
+		return nil, err
+	}
+
+	owned := make([]Virtual, 0, len(s.Physical[source].OwnsVirtual))
+	for _, name := range s.Physical[source].OwnsVirtual {
+		v := s.VirtualByName(name)
+		if v == nil {
+			return nil, fmt.Errorf("virtual shard %q of shard %q does not exist", name, source)
+		}
+		owned = append(owned, *v)
+	}
+	if len(owned) < 2 {
+		return nil, fmt.Errorf("shard %q owns %d virtual shard(s), at least 2 are required to split it",
+			source, len(owned))
+	}
+
+	sort.Slice(owned, func(a, b int) bool {
+		return owned[a].Upper < owned[b].Upper
+	})
+
+	moving := make([]string, 0, len(owned)/2)
+	for _, v := range owned[len(owned)/2:] {
+		moving = append(moving, v.Name)
+	}
+	return moving, nil
+}
+
+// StartSplit registers the split of the source shard. The target shard is
+// created on the same nodes as the source shard, but doesn't own any virtual
+// shard until the operation is committed.
+func (s *State) StartSplit(source, target string, virtual []string) error {
+	if err := s.validateReshardingSource(source); err != nil {
+		return err
+	}
+	if _, ok := s.Physical[target]; ok {
+		return fmt.Errorf("target shard %q already exists", target)
+	}
+	if len(virtual) == 0 {
+		return fmt.Errorf("no virtual shards to move from shard %q", source)
+	}
+	for _, name := range virtual {
+		if !slices.Contains(s.Physical[source].OwnsVirtual, name) {
+			return fmt.Errorf("virtual shard %q is not owned by shard %q", name, source)
+		}
+	}
+
+	s.Physical[target] = Physical{
+		Name:           target,
+		BelongsToNodes: slices.Clone(s.Physical[source].BelongsToNodes),
+	}
+	s.setResharding(source, Resharding{
+		Operation:   ReshardingSplit,
+		TargetShard: target,
+		Phase:       ReshardingPhaseCopy,
+		Virtual:     slices.Clone(virtual),
+	})
+	return nil
+}
+
+// StartMerge registers the merge of the source shard into the target shard.
+// Both shards need to be hosted on the same set of nodes, so the objects can
+// be moved locally on each replica. Replicas can be moved beforehand using
+// the replication API.
+func (s *State) StartMerge(source, target string) error {
+	if source == target {
+		return fmt.Errorf("cannot merge shard %q into itself", source)
+	}
+	if err := s.validateReshardingSource(source); err != nil {
+		return err
+	}
+	targetShard, ok := s.Physical[target]
+	if !ok {
+		return fmt.Errorf("target shard %q does not exist", target)
+	}
+	if s.ReshardingInvolves(target) {
+		return fmt.Errorf("target shard %q is already being resharded", target)
+	}
+
+	sourceNodes := slices.Clone(s.Physical[source].BelongsToNodes)
+	targetNodes := slices.Clone(targetShard.BelongsToNodes)
+	slices.Sort(sourceNodes)
+	slices.Sort(targetNodes)
+	if !slices.Equal(sourceNodes, targetNodes) {
+		return fmt.Errorf("shards %q %v and %q %v are not hosted on the same nodes",
+			source, sourceNodes, target, targetNodes)
+	}
+
+	s.setResharding(source, Resharding{
+		Operation:   ReshardingMerge,
+		TargetShard: target,
+		Phase:       ReshardingPhaseCopy,
+		Virtual:     slices.Clone(s.Physical[source].OwnsVirtual),
+	})
+	return nil
+}
+
+// CommitResharding reassigns the moving virtual shards of the operation
+// started on the source shard to its target shard. From then on, all reads and
+// writes of these virtual shards are routed to the target shard.
+func (s *State) CommitResharding(source string) error {
+	r, ok := s.Resharding[source]
+	if !ok {
+		return fmt.Errorf("shard %q is not being resharded", source)
+	}
+	if r.Phase != ReshardingPhaseCopy {
+		return fmt.Errorf("resharding of shard %q is already in phase %s", source, r.Phase)
+	}
+
+	for _, name := range r.Virtual {
+		if err := s.moveVirtual(name, source, r.TargetShard); err != nil {
+			return err
+		}
+	}
+
+	r.Phase = ReshardingPhaseCleanup
+	s.Resharding[source] = r
+	return nil
+}
+
+// FinishResharding completes the operation started on the source shard. A
+// merged source shard doesn't own any virtual shard anymore and is removed.
+func (s *State) FinishResharding(source string) error {
+	r, ok := s.Resharding[source]
+	if !ok {
+		return fmt.Errorf("shard %q is not being resharded", source)
+	}
+	if r.Phase != ReshardingPhaseCleanup {
+		return fmt.Errorf("resharding of shard %q is still in phase %s", source, r.Phase)
+	}
+
+	if r.Operation == ReshardingMerge {
+		delete(s.Physical, source)
+	}
+	delete(s.Resharding, source)
+	return nil
+}
+
+// AbortResharding cancels the operation started on the source shard. This is
+// only possible during the copy phase, as routing hasn't changed yet. The
+// target shard of an aborted split is removed.
+func (s *State) AbortResharding(source string) error {
+	r, ok := s.Resharding[source]
+	if !ok {
+		return fmt.Errorf("shard %q is not being resharded", source)
+	}
+	if r.Phase != ReshardingPhaseCopy {
+		return fmt.Errorf("resharding of shard %q cannot be aborted in phase %s", source, r.Phase)
+	}
+
+	if r.Operation == ReshardingSplit {
+		delete(s.Physical, r.TargetShard)
+	}
+	delete(s.Resharding, source)
+	return nil
+}
+
+// VirtualShard returns the name of the virtual shard the given input (usually
+// the binary representation of an object's UUID) belongs to.
+func (s *State) VirtualShard(in []byte) string {
+	if len(s.Virtual) == 0 {
+		panic("no virtual shards present")
+	}
+
+	h := murmur3.New64()
+	h.Write(in)
+	return s.virtualByToken(h.Sum64()).Name
+}
+
+func (s *State) validateReshardingSource(source string) error {
+	if s.PartitioningEnabled {
+		return fmt.Errorf("resharding is not supported for multi-tenant collections")
+	}
+	if _, ok := s.Physical[source]; !ok {
+		return fmt.Errorf("shard %q does not exist", source)
+	}
+	if s.ReshardingInvolves(source) {
+		return fmt.Errorf("shard %q is already being resharded", source)
+	}
+	return nil
+}
+
+func (s *State) setResharding(source string, r Resharding) {
+	if s.Resharding == nil {
+		s.Resharding = make(map[string]Resharding, 1)
+	}
+	s.Resharding[source] = r
+}
+
+func (s *State) moveVirtual(name, from, to string) error {
+	virtual := s.VirtualByName(name)
+	if virtual == nil {
+		return fmt.Errorf("virtual shard %q does not exist", name)
+	}
+	if virtual.AssignedToPhysical != from {
+		return fmt.Errorf("virtual shard %q is assigned to %q, not %q", name, virtual.AssignedToPhysical, from)
+	}
+	source, ok := s.Physical[from]
+	if !ok {
+		return fmt.Errorf("shard %q does not exist", from)
+	}
+	target, ok := s.Physical[to]
+	if !ok {
+		return fmt.Errorf("shard %q does not exist", to)
+	}
+
+	virtual.AssignedToPhysical = to
+	if idx := slices.Index(source.OwnsVirtual, name); idx >= 0 {
+		source.OwnsVirtual = slices.Delete(source.OwnsVirtual, idx, idx+1)
+	}
+	source.OwnsPercentage -= virtual.OwnsPercentage
+	target.OwnsVirtual = append(target.OwnsVirtual, name)
+	target.OwnsPercentage += virtual.OwnsPercentage
+
+	s.Physical[from] = source
+	s.Physical[to] = target
+	return nil
+}
@@ -0,0 +1,216 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sharding
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/cluster/mocks"
+	"github.com/weaviate/weaviate/usecases/sharding/config"
+)
+
+func newReshardingTestState(t *testing.T, desiredCount int) *State {
+	cfg, err := config.ParseConfig(map[string]interface{}{"desiredCount": float64(desiredCount)}, 2)
+	require.Nil(t, err)
+
+	nodes := mocks.NewMockNodeSelector("node1", "node2")
+	state, err := InitState("my-index", cfg, nodes.LocalName(), nodes.StorageCandidates(), 2, false)
+	require.Nil(t, err)
+	return state
+}
+
+func TestStateSplitShard(t *testing.T) {
+	state := newReshardingTestState(t, 1)
+	source := state.AllPhysicalShards()[0]
+
+	ids := make([][]byte, 1000)
+	for i := range ids {
+		ids[i] = make([]byte, 16)
+		rand.Read(ids[i])
+		require.Equal(t, source, state.PhysicalShard(ids[i]))
+	}
+
+	moving, err := state.PlanSplit(source)
+	require.Nil(t, err)
+	require.Len(t, moving, len(state.Virtual)/2)
+
+	// the upper half of the token range is moved
+	for _, name := range moving {
+		assert.GreaterOrEqual(t, state.VirtualByName(name).Upper, state.Virtual[len(state.Virtual)/2].Upper)
+	}
+
+	require.Nil(t, state.StartSplit(source, "target", moving))
+	assert.True(t, state.IsResharding())
+	assert.True(t, state.ReshardingInvolves(source))
+	assert.True(t, state.ReshardingInvolves("target"))
+	assert.ElementsMatch(t, state.Physical[source].BelongsToNodes, state.Physical["target"].BelongsToNodes)
+	assert.Empty(t, state.Physical["target"].OwnsVirtual)
+
+	// routing doesn't change until the operation is committed
+	for _, id := range ids {
+		require.Equal(t, source, state.PhysicalShard(id))
+	}
+
+	require.NotNil(t, state.FinishResharding(source), "cannot finish before commit")
+	require.Nil(t, state.CommitResharding(source))
+	require.NotNil(t, state.CommitResharding(source), "cannot commit twice")
+
+	movingSet := map[string]bool{}
+	for _, name := range moving {
+		movingSet[name] = true
+	}
+	counts := map[string]int{}
+	for _, id := range ids {
+		shard := state.PhysicalShard(id)
+		counts[shard]++
+		if movingSet[state.VirtualShard(id)] {
+			assert.Equal(t, "target", shard)
+		} else {
+			assert.Equal(t, source, shard)
+		}
+	}
+	assert.Len(t, counts, 2)
+	assert.InDelta(t, 1.0, state.Physical[source].OwnsPercentage+state.Physical["target"].OwnsPercentage, 1e-9)
+
+	require.Nil(t, state.FinishResharding(source))
+	assert.False(t, state.IsResharding())
+	assert.Len(t, state.Physical, 2)
+}
+
+func TestStateMergeShards(t *testing.T) {
+	state := newReshardingTestState(t, 2)
+	shards := state.AllPhysicalShards()
+	source, target := shards[0], shards[1]
+	sourceVirtual := append([]string{}, state.Physical[source].OwnsVirtual...)
+
+	require.Nil(t, state.StartMerge(source, target))
+	require.NotNil(t, state.StartMerge(target, source), "shards are already involved")
+	require.Nil(t, state.CommitResharding(source))
+
+	assert.Empty(t, state.Physical[source].OwnsVirtual)
+	assert.Subset(t, state.Physical[target].OwnsVirtual, sourceVirtual)
+	assert.InDelta(t, 1.0, state.Physical[target].OwnsPercentage, 1e-9)
+	for _, v := range state.Virtual {
+		assert.Equal(t, target, v.AssignedToPhysical)
+	}
+
+	require.Nil(t, state.FinishResharding(source))
+	assert.Equal(t, []string{target}, state.AllPhysicalShards())
+	assert.False(t, state.IsResharding())
+}
+
+func TestStateAbortResharding(t *testing.T) {
+	t.Run("split", func(t *testing.T) {
+		state := newReshardingTestState(t, 1)
+		source := state.AllPhysicalShards()[0]
+		moving, err := state.PlanSplit(source)
+		require.Nil(t, err)
+		require.Nil(t, state.StartSplit(source, "target", moving))
+
+		require.Nil(t, state.AbortResharding(source))
+		assert.False(t, state.IsResharding())
+		assert.Equal(t, []string{source}, state.AllPhysicalShards())
+	})
+
+	t.Run("merge", func(t *testing.T) {
+		state := newReshardingTestState(t, 2)
+		shards := state.AllPhysicalShards()
+		require.Nil(t, state.StartMerge(shards[0], shards[1]))
+
+		require.Nil(t, state.AbortResharding(shards[0]))
+		assert.False(t, state.IsResharding())
+		assert.ElementsMatch(t, shards, state.AllPhysicalShards())
+	})
+
+	t.Run("after commit", func(t *testing.T) {
+		state := newReshardingTestState(t, 2)
+		shards := state.AllPhysicalShards()
+		require.Nil(t, state.StartMerge(shards[0], shards[1]))
+		require.Nil(t, state.CommitResharding(shards[0]))
+
+		require.NotNil(t, state.AbortResharding(shards[0]))
+		assert.True(t, state.IsResharding())
+	})
+}
+
+func TestStateReshardingValidation(t *testing.T) {
+	t.Run("split unknown shard", func(t *testing.T) {
+		state := newReshardingTestState(t, 1)
+		_, err := state.PlanSplit("unknown")
+		require.NotNil(t, err)
+	})
+
+	t.Run("split into existing shard", func(t *testing.T) {
+		state := newReshardingTestState(t, 2)
+		shards := state.AllPhysicalShards()
+		moving, err := state.PlanSplit(shards[0])
+		require.Nil(t, err)
+		require.NotNil(t, state.StartSplit(shards[0], shards[1], moving))
+	})
+
+	t.Run("split virtual shards of another shard", func(t *testing.T) {
+		state := newReshardingTestState(t, 2)
+		shards := state.AllPhysicalShards()
+		require.NotNil(t, state.StartSplit(shards[0], "target", state.Physical[shards[1]].OwnsVirtual))
+	})
+
+	t.Run("split shard with a single virtual shard", func(t *testing.T) {
+		state := newReshardingTestState(t, 1)
+		source := state.AllPhysicalShards()[0]
+		phys := state.Physical[source]
+		phys.OwnsVirtual = phys.OwnsVirtual[:1]
+		state.Physical[source] = phys
+		_, err := state.PlanSplit(source)
+		require.NotNil(t, err)
+	})
+
+	t.Run("merge shard into itself", func(t *testing.T) {
+		state := newReshardingTestState(t, 2)
+		source := state.AllPhysicalShards()[0]
+		require.NotNil(t, state.StartMerge(source, source))
+	})
+
+	t.Run("merge shards on different nodes", func(t *testing.T) {
+		state := newReshardingTestState(t, 2)
+		shards := state.AllPhysicalShards()
+		target := state.Physical[shards[1]]
+		target.BelongsToNodes = []string{"node3"}
+		state.Physical[shards[1]] = target
+		require.NotNil(t, state.StartMerge(shards[0], shards[1]))
+	})
+
+	t.Run("multi-tenant collection", func(t *testing.T) {
+		state := &State{PartitioningEnabled: true, Physical: map[string]Physical{}}
+		state.AddPartition("tenant1", []string{"node1"}, "HOT")
+		_, err := state.PlanSplit("tenant1")
+		require.NotNil(t, err)
+	})
+}
+
+func TestStateReshardingSerialization(t *testing.T) {
+	state := newReshardingTestState(t, 1)
+	source := state.AllPhysicalShards()[0]
+	moving, err := state.PlanSplit(source)
+	require.Nil(t, err)
+	require.Nil(t, state.StartSplit(source, "target", moving))
+
+	bytes, err := state.JSON()
+	require.Nil(t, err)
+
+	reloaded, err := StateFromJSON(bytes, mocks.NewMockNodeSelector("node1", "node2"))
+	require.Nil(t, err)
+	assert.Equal(t, state.Resharding, reloaded.Resharding)
+	assert.Zero(t, reloaded.Physical["target"].OwnsPercentage)
+}
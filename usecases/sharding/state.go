@@ -34,6 +34,9 @@ type State struct {
 	Virtual             []Virtual           `json:"virtual"`
 	PartitioningEnabled bool                `json:"partitioningEnabled"`
 
+	// Resharding holds in-flight split and merge operations keyed by source shard
+	Resharding map[string]Resharding `json:"resharding,omitempty"`
+
 	// different for each node, not to be serialized
 	localNodeName string // TODO: localNodeName is static it is better to store just once
 }
@@ -543,6 +546,14 @@ func (s State) DeepCopy() State {
 		virtualCopy[i] = virtual.DeepCopy()
 	}
 
+	var reshardingCopy map[string]Resharding
+	if len(s.Resharding) > 0 {
+		reshardingCopy = make(map[string]Resharding, len(s.Resharding))
+		for source, r := range s.Resharding {
+			reshardingCopy[source] = r.DeepCopy()
+		}
+	}
+
 	return State{
 		localNodeName:       s.localNodeName,
 		IndexID:             s.IndexID,
@@ -550,6 +561,7 @@ func (s State) DeepCopy() State {
 		Physical:            physicalCopy,
 		Virtual:             virtualCopy,
 		PartitioningEnabled: s.PartitioningEnabled,
+		Resharding:          reshardingCopy,
 	}
 }
 
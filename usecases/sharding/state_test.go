@@ -329,6 +329,14 @@ func TestStateDeepCopy(t *testing.T) {
 				AssignedToPhysical: "original",
 			},
 		},
+		Resharding: map[string]Resharding{
+			"physical1": {
+				Operation:   ReshardingSplit,
+				TargetShard: "original",
+				Phase:       ReshardingPhaseCopy,
+				Virtual:     []string{"original"},
+			},
+		},
 	}
 
 	control := State{
@@ -361,6 +369,14 @@ func TestStateDeepCopy(t *testing.T) {
 				AssignedToPhysical: "original",
 			},
 		},
+		Resharding: map[string]Resharding{
+			"physical1": {
+				Operation:   ReshardingSplit,
+				TargetShard: "original",
+				Phase:       ReshardingPhaseCopy,
+				Virtual:     []string{"original"},
+			},
+		},
 	}
 
 	assert.Equal(t, control, original, "control matches initially")
@@ -392,6 +408,11 @@ func TestStateDeepCopy(t *testing.T) {
 	copied.Virtual[0].OwnsPercentage = 9
 	copied.Virtual[0].AssignedToPhysical = "original"
 	copied.Virtual = append(copied.Virtual, Virtual{})
+	resharding1 := copied.Resharding["physical1"]
+	resharding1.Phase = ReshardingPhaseCleanup
+	resharding1.Virtual[0] = "changed"
+	copied.Resharding["physical1"] = resharding1
+	copied.Resharding["physical2"] = Resharding{}
 
 	assert.Equal(t, control, original, "original still matches control even with changes in copy")
 }
@@ -0,0 +1,217 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package slowquery keeps a per-node log of the queries exceeding the slow
+// query threshold. Only the shape of a query is recorded, never the values
+// searched for, so the log can be shared with operators without exposing
+// user data.
+package slowquery
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/queryprofile"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/config/runtime"
+)
+
+// Log is a bounded, in-memory log of the slow queries of this node. Entries
+// are additionally appended to a rotating file if a path is configured.
+type Log struct {
+	node       string
+	threshold  *runtime.DynamicValue[time.Duration]
+	sampleRate *runtime.DynamicValue[float64]
+	logger     logrus.FieldLogger
+
+	mu      sync.Mutex
+	entries []*models.SlowQuery
+	// next is the position the next entry is written to, once the buffer is
+	// full it points to the oldest entry
+	next int
+	full bool
+	file *rotatingFile
+}
+
+func New(cfg config.SlowQueryLogConfig, node string, logger logrus.FieldLogger) (*Log, error) {
+	if cfg.BufferSize <= 0 {
+		cfg.BufferSize = config.DefaultSlowQueryLogBufferSize
+	}
+	if cfg.InvalidThreshold != "" {
+		logger.WithField("action", "startup").Warningf("Unexpected value \"%s\" for %s. Please set a duration (i.e. 10s). Continuing with default value (%s).",
+			cfg.InvalidThreshold, "QUERY_SLOW_LOG_THRESHOLD", cfg.Threshold.Get())
+	}
+	l := &Log{
+		node:       node,
+		threshold:  cfg.Threshold,
+		sampleRate: cfg.SampleRate,
+		logger:     logger.WithField("action", "slow_query_log"),
+		entries:    make([]*models.SlowQuery, cfg.BufferSize),
+	}
+	if cfg.FilePath != "" {
+		f, err := openRotatingFile(cfg.FilePath, int64(cfg.MaxFileSizeMB)*1024*1024, cfg.MaxFiles)
+		if err != nil {
+			return nil, err
+		}
+		l.file = f
+	}
+	return l, nil
+}
+
+// Query is a query tracked by the log
+type Query struct {
+	log     *Log
+	start   time.Time
+	profile *queryprofile.Profile
+}
+
+// Start tracks a query. Queries are sampled up front, so the profile
+// collecting the per-phase and per-shard timings is only built for the
+// sampled ones: the returned context carries it and a profile already present
+// is shared. Start is a no-op on a nil log and for queries not sampled.
+func (l *Log) Start(ctx context.Context) (context.Context, *Query) {
+	if l == nil || rand.Float64() >= l.sampleRate.Get() {
+		return ctx, nil
+	}
+	p := queryprofile.FromContext(ctx)
+	if p == nil {
+		p = queryprofile.New()
+		ctx = queryprofile.ContextWithProfile(ctx, p)
+	}
+	return ctx, &Query{log: l, start: time.Now(), profile: p}
+}
+
+// Finish records the query if it exceeded the threshold. The shape is only
+// built for recorded queries.
+func (q *Query) Finish(principal *models.Principal, shape func() *models.SlowQueryShape, err error) {
+	if q == nil {
+		return
+	}
+	took := time.Since(q.start)
+	if took < q.log.threshold.Get() {
+		return
+	}
+
+	entry := &models.SlowQuery{
+		Node:      q.log.node,
+		Timestamp: time.Now().UnixMilli(),
+		Took:      milliseconds(took),
+		Principal: principalName(principal),
+		Shape:     shape(),
+		Phases:    phases(q.profile.Details()),
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	for _, shard := range q.profile.Shards() {
+		entry.Shards = append(entry.Shards, &models.SlowQueryShard{
+			Name:   shard.Name,
+			Node:   shard.Node,
+			Remote: shard.Remote,
+			Took:   milliseconds(shard.Took),
+		})
+	}
+	q.log.add(entry)
+}
+
+func (l *Log) add(entry *models.SlowQuery) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.entries[l.next] = entry
+	l.next = (l.next + 1) % len(l.entries)
+	if l.next == 0 {
+		l.full = true
+	}
+
+	if l.file == nil {
+		return
+	}
+	line, err := json.Marshal(entry)
+	if err == nil {
+		err = l.file.writeLine(line)
+	}
+	if err != nil {
+		l.logger.WithError(err).Warn("write slow query log file")
+	}
+}
+
+// Entries returns up to limit entries, most recent first. If collection is
+// set only the entries of that collection are returned. A nil log has no
+// entries.
+func (l *Log) Entries(collection string, limit int) []*models.SlowQuery {
+	out := []*models.SlowQuery{}
+	if l == nil {
+		return out
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	count := l.next
+	if l.full {
+		count = len(l.entries)
+	}
+
+	for i := 1; i <= count && len(out) < limit; i++ {
+		entry := l.entries[(l.next-i+len(l.entries))%len(l.entries)]
+		if collection != "" && (entry.Shape == nil || entry.Shape.Collection != collection) {
+			continue
+		}
+		out = append(out, entry)
+	}
+	return out
+}
+
+// Close closes the log file
+func (l *Log) Close() error {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.file == nil {
+		return nil
+	}
+	return l.file.close()
+}
+
+func principalName(principal *models.Principal) string {
+	if principal == nil {
+		return "anonymous"
+	}
+	return principal.Username
+}
+
+// phases picks the phase timings out of the query details, e.g.
+// "vector_search_took" becomes "vector_search"
+func phases(details map[string]any) map[string]float64 {
+	out := map[string]float64{}
+	for key, value := range details {
+		d, ok := value.(time.Duration)
+		if !ok || !strings.HasSuffix(key, "_took") {
+			continue
+		}
+		out[strings.TrimSuffix(key, "_took")] = milliseconds(d)
+	}
+	return out
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
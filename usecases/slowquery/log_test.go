@@ -0,0 +1,192 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package slowquery
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/queryprofile"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/config/runtime"
+)
+
+func testConfig(threshold time.Duration, bufferSize int) config.SlowQueryLogConfig {
+	return config.SlowQueryLogConfig{
+		Enabled:    true,
+		Threshold:  runtime.NewDynamicValue(threshold),
+		SampleRate: runtime.NewDynamicValue(1.0),
+		BufferSize: bufferSize,
+	}
+}
+
+func shapeOf(collection string) func() *models.SlowQueryShape {
+	return func() *models.SlowQueryShape {
+		return &models.SlowQueryShape{Collection: collection, QueryType: QueryTypeGet}
+	}
+}
+
+func TestLogRecordsSlowQueries(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	l, err := New(testConfig(0, 10), "node1", logger)
+	require.NoError(t, err)
+
+	ctx, q := l.Start(context.Background())
+	p := queryprofile.FromContext(ctx)
+	require.NotNil(t, p)
+	p.Annotate("vector_search_took", 3*time.Millisecond)
+	p.Annotate("vector_search_visited", 17)
+	p.AddShard(queryprofile.Shard{Name: "shard1", Node: "node2", Remote: true, Took: 2 * time.Millisecond})
+	q.Finish(&models.Principal{Username: "jane", Groups: []string{"admins"}}, shapeOf("Article"), errors.New("boom"))
+
+	entries := l.Entries("", 10)
+	require.Len(t, entries, 1)
+	entry := entries[0]
+	assert.Equal(t, "node1", entry.Node)
+	assert.Equal(t, "jane", entry.Principal)
+	assert.Equal(t, "Article", entry.Shape.Collection)
+	assert.Equal(t, "boom", entry.Error)
+	assert.Equal(t, map[string]float64{"vector_search": 3}, entry.Phases)
+	assert.Equal(t, []*models.SlowQueryShard{{Name: "shard1", Node: "node2", Remote: true, Took: 2}}, entry.Shards)
+	assert.NotZero(t, entry.Timestamp)
+}
+
+func TestLogSharesExistingProfile(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	l, err := New(testConfig(0, 10), "node1", logger)
+	require.NoError(t, err)
+
+	p := queryprofile.New()
+	ctx, _ := l.Start(queryprofile.ContextWithProfile(context.Background(), p))
+	assert.Same(t, p, queryprofile.FromContext(ctx))
+}
+
+func TestLogThresholdAndSampling(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	cfg := testConfig(time.Hour, 10)
+	l, err := New(cfg, "node1", logger)
+	require.NoError(t, err)
+
+	_, q := l.Start(context.Background())
+	q.Finish(nil, shapeOf("Article"), nil)
+	assert.Empty(t, l.Entries("", 10), "below threshold")
+
+	cfg.Threshold.SetValue(0)
+	cfg.SampleRate.SetValue(0)
+	ctx, q := l.Start(context.Background())
+	assert.Nil(t, queryprofile.FromContext(ctx), "no profile for queries not sampled")
+	q.Finish(nil, shapeOf("Article"), nil)
+	assert.Empty(t, l.Entries("", 10), "not sampled")
+
+	cfg.SampleRate.SetValue(1)
+	_, q = l.Start(context.Background())
+	q.Finish(nil, shapeOf("Article"), nil)
+	entries := l.Entries("", 10)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "anonymous", entries[0].Principal)
+}
+
+func TestLogWarnsAboutInvalidThreshold(t *testing.T) {
+	logger, hook := test.NewNullLogger()
+	cfg := testConfig(config.DefaultSlowQueryLogThreshold, 10)
+	cfg.InvalidThreshold = "fast"
+	_, err := New(cfg, "node1", logger)
+	require.NoError(t, err)
+
+	require.NotNil(t, hook.LastEntry())
+	assert.Equal(t, logrus.WarnLevel, hook.LastEntry().Level)
+	assert.Contains(t, hook.LastEntry().Message, `"fast"`)
+	assert.Contains(t, hook.LastEntry().Message, "5s")
+}
+
+func TestLogRingBuffer(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	l, err := New(testConfig(0, 3), "node1", logger)
+	require.NoError(t, err)
+
+	for _, collection := range []string{"A", "B", "A", "B", "A"} {
+		_, q := l.Start(context.Background())
+		q.Finish(nil, shapeOf(collection), nil)
+	}
+
+	collections := func(entries []*models.SlowQuery) []string {
+		out := []string{}
+		for _, e := range entries {
+			out = append(out, e.Shape.Collection)
+		}
+		return out
+	}
+	assert.Equal(t, []string{"A", "B", "A"}, collections(l.Entries("", 10)))
+	assert.Equal(t, []string{"A", "B"}, collections(l.Entries("", 2)))
+	assert.Equal(t, []string{"A", "A"}, collections(l.Entries("A", 10)))
+	assert.Empty(t, l.Entries("C", 10))
+}
+
+func TestLogNil(t *testing.T) {
+	var l *Log
+	ctx, q := l.Start(context.Background())
+	assert.Nil(t, queryprofile.FromContext(ctx))
+	q.Finish(nil, shapeOf("Article"), nil)
+	assert.NoError(t, l.Close())
+}
+
+func TestLogFileRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs", "slow.log")
+	f, err := openRotatingFile(path, 20, 3)
+	require.NoError(t, err)
+
+	for _, line := range []string{"first-line-aaaaa", "second-line-bbbb", "third-line-ccccc", "fourth-line-dddd"} {
+		require.NoError(t, f.writeLine([]byte(line)))
+	}
+	require.NoError(t, f.close())
+
+	read := func(p string) string {
+		b, err := os.ReadFile(p)
+		require.NoError(t, err)
+		return strings.TrimSpace(string(b))
+	}
+	assert.Equal(t, "fourth-line-dddd", read(path))
+	assert.Equal(t, "third-line-ccccc", read(path+".1"))
+	assert.Equal(t, "second-line-bbbb", read(path+".2"))
+	assert.NoFileExists(t, path+".3")
+}
+
+func TestLogWritesFile(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	cfg := testConfig(0, 10)
+	cfg.FilePath = filepath.Join(t.TempDir(), "slow.log")
+	cfg.MaxFileSizeMB = 1
+	cfg.MaxFiles = 2
+	l, err := New(cfg, "node1", logger)
+	require.NoError(t, err)
+
+	_, q := l.Start(context.Background())
+	q.Finish(nil, shapeOf("Article"), nil)
+	require.NoError(t, l.Close())
+
+	b, err := os.ReadFile(cfg.FilePath)
+	require.NoError(t, err)
+	var entry models.SlowQuery
+	require.NoError(t, entry.UnmarshalBinary([]byte(strings.TrimSpace(string(b)))))
+	assert.Equal(t, "Article", entry.Shape.Collection)
+}
@@ -0,0 +1,122 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package slowquery
+
+import (
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+const (
+	QueryTypeGet       = "get"
+	QueryTypeAggregate = "aggregate"
+)
+
+// GetShape returns the sanitized shape of a Get query
+func GetShape(params dto.GetParams) *models.SlowQueryShape {
+	shape := &models.SlowQueryShape{
+		Collection: params.ClassName,
+		QueryType:  QueryTypeGet,
+		Filter:     FilterShape(params.Filters),
+		Tenant:     params.Tenant,
+	}
+	if params.Pagination != nil {
+		shape.Limit = int64(params.Pagination.Limit)
+		shape.Offset = int64(params.Pagination.Offset)
+	}
+
+	switch {
+	case params.NearVector != nil:
+		shape.SearchType = "nearVector"
+	case params.NearObject != nil:
+		shape.SearchType = "nearObject"
+	case params.HybridSearch != nil:
+		shape.SearchType = "hybrid"
+	case params.KeywordRanking != nil:
+		shape.SearchType = "bm25"
+	case len(params.ModuleParams) > 0:
+		shape.SearchType = moduleSearchType(params.ModuleParams)
+	case params.Cursor != nil:
+		shape.SearchType = "cursor"
+	}
+	return shape
+}
+
+// AggregateShape returns the sanitized shape of an Aggregate query
+func AggregateShape(params aggregation.Params) *models.SlowQueryShape {
+	shape := &models.SlowQueryShape{
+		Collection: params.ClassName.String(),
+		QueryType:  QueryTypeAggregate,
+		Filter:     FilterShape(params.Filters),
+		Tenant:     params.Tenant,
+	}
+	if params.Limit != nil {
+		shape.Limit = int64(*params.Limit)
+	}
+
+	switch {
+	case params.NearVector != nil:
+		shape.SearchType = "nearVector"
+	case params.NearObject != nil:
+		shape.SearchType = "nearObject"
+	case params.Hybrid != nil:
+		shape.SearchType = "hybrid"
+	case len(params.ModuleParams) > 0:
+		shape.SearchType = moduleSearchType(params.ModuleParams)
+	}
+	return shape
+}
+
+// moduleSearchType is the name of the module provided search argument, e.g.
+// nearText
+func moduleSearchType(moduleParams map[string]interface{}) string {
+	names := make([]string, 0, len(moduleParams))
+	for name := range moduleParams {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names[0]
+}
+
+// FilterShape returns the structure of a where filter without the values it
+// compares against
+func FilterShape(filter *filters.LocalFilter) *models.SlowQueryFilter {
+	if filter == nil || filter.Root == nil {
+		return nil
+	}
+	return clauseShape(filter.Root)
+}
+
+func clauseShape(clause *filters.Clause) *models.SlowQueryFilter {
+	shape := &models.SlowQueryFilter{Operator: operatorName(clause.Operator)}
+	if clause.On != nil {
+		shape.On = clause.On.Slice()
+	}
+	if clause.Value != nil {
+		shape.ValueType = string(clause.Value.Type)
+	}
+	for i := range clause.Operands {
+		shape.Operands = append(shape.Operands, clauseShape(&clause.Operands[i]))
+	}
+	return shape
+}
+
+func operatorName(op filters.Operator) string {
+	if op < filters.OperatorEqual || op > filters.ContainsAll {
+		return "Unknown"
+	}
+	return op.Name()
+}
@@ -0,0 +1,103 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package slowquery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func TestGetShape(t *testing.T) {
+	params := dto.GetParams{
+		ClassName:  "Article",
+		Tenant:     "tenant1",
+		Pagination: &filters.Pagination{Limit: 10, Offset: 5},
+		NearVector: &searchparams.NearVector{Vectors: []models.Vector{[]float32{1, 2, 3}}},
+		Filters: &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorAnd,
+			Operands: []filters.Clause{
+				{
+					Operator: filters.OperatorEqual,
+					On:       &filters.Path{Class: "Article", Property: "title"},
+					Value:    &filters.Value{Value: "secret title", Type: schema.DataTypeText},
+				},
+				{
+					Operator: filters.OperatorLessThan,
+					On: &filters.Path{
+						Class: "Article", Property: "ofAuthor",
+						Child: &filters.Path{Class: "Author", Property: "age"},
+					},
+					Value: &filters.Value{Value: 42, Type: schema.DataTypeInt},
+				},
+			},
+		}},
+	}
+
+	assert.Equal(t, &models.SlowQueryShape{
+		Collection: "Article",
+		QueryType:  QueryTypeGet,
+		SearchType: "nearVector",
+		Tenant:     "tenant1",
+		Limit:      10,
+		Offset:     5,
+		Filter: &models.SlowQueryFilter{
+			Operator: "And",
+			Operands: []*models.SlowQueryFilter{
+				{Operator: "Equal", On: []string{"title"}, ValueType: "text"},
+				{Operator: "LessThan", On: []string{"ofAuthor", "Author", "age"}, ValueType: "int"},
+			},
+		},
+	}, GetShape(params))
+}
+
+func TestGetShapeSearchType(t *testing.T) {
+	tests := []struct {
+		name     string
+		params   dto.GetParams
+		expected string
+	}{
+		{name: "list", params: dto.GetParams{}, expected: ""},
+		{name: "hybrid", params: dto.GetParams{HybridSearch: &searchparams.HybridSearch{Query: "q"}}, expected: "hybrid"},
+		{name: "bm25", params: dto.GetParams{KeywordRanking: &searchparams.KeywordRanking{Query: "q"}}, expected: "bm25"},
+		{name: "module", params: dto.GetParams{ModuleParams: map[string]interface{}{"nearText": nil}}, expected: "nearText"},
+		{name: "cursor", params: dto.GetParams{Cursor: &filters.Cursor{Limit: 10}}, expected: "cursor"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, GetShape(tt.params).SearchType)
+		})
+	}
+}
+
+func TestAggregateShape(t *testing.T) {
+	limit := 3
+	params := aggregation.Params{
+		ClassName: "Article",
+		Limit:     &limit,
+		Hybrid:    &searchparams.HybridSearch{Query: "secret"},
+	}
+
+	assert.Equal(t, &models.SlowQueryShape{
+		Collection: "Article",
+		QueryType:  QueryTypeAggregate,
+		SearchType: "hybrid",
+		Limit:      3,
+	}, AggregateShape(params))
+}
@@ -15,8 +15,10 @@ import (
 	"context"
 	"fmt"
 	"runtime"
+	"time"
 
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/queryprofile"
 	"github.com/weaviate/weaviate/entities/schema/configvalidation"
 
 	enterrors "github.com/weaviate/weaviate/entities/errors"
@@ -82,6 +84,8 @@ type ModulesProvider interface {
 		moduleParams map[string]interface{},
 		argumentModuleParams map[string]interface{}) ([]search.Result, error)
 	VectorFromInput(ctx context.Context, className, input, targetVector string) ([]float32, error)
+	VectorFromInputWithVectorizer(ctx context.Context, className, input, targetVector string,
+		vectorizer map[string]interface{}) ([]float32, error)
 	MultiVectorFromInput(ctx context.Context, className, input, targetVector string) ([][]float32, error)
 }
 
@@ -107,6 +111,7 @@ type hybridSearcher interface {
 	SparseObjectSearch(ctx context.Context, params dto.GetParams) ([]*storobj.Object, []float32, error)
 	ResolveReferences(ctx context.Context, objs search.Results, props search.SelectProperties,
 		groupBy *searchparams.GroupBy, additional additional.Properties, tenant string) (search.Results, error)
+	SortResults(objs search.Results, sort []filters.Sort) (search.Results, error)
 }
 
 // NewExplorer with search and connector repo
@@ -138,7 +143,7 @@ func (e *Explorer) GetClass(ctx context.Context,
 		}
 	}
 
-	if err := e.validateSort(params.ClassName, params.Sort); err != nil {
+	if err := e.validateSort(params); err != nil {
 		return nil, errors.Wrap(err, "invalid 'sort' parameter")
 	}
 
@@ -146,6 +151,10 @@ func (e *Explorer) GetClass(ctx context.Context,
 		return nil, errors.Wrap(err, "cursor api: invalid 'after' parameter")
 	}
 
+	if params.CollapseChunks {
+		return e.getClassChunks(ctx, params)
+	}
+
 	if params.KeywordRanking != nil {
 		res, err := e.getClassKeywordBased(ctx, params)
 		if err != nil {
@@ -195,7 +204,9 @@ func (e *Explorer) getClassKeywordBased(ctx context.Context, params dto.GetParam
 	}
 
 	if e.modulesProvider != nil {
-		res, err = e.modulesProvider.GetExploreAdditionalExtend(ctx, res, params.AdditionalProperties.ModuleParams, nil, params.ModuleParams)
+		res, err = e.extendQueryPipelineStages(res, params, func(in []search.Result, moduleParams map[string]interface{}) ([]search.Result, error) {
+			return e.modulesProvider.GetExploreAdditionalExtend(ctx, in, moduleParams, nil, params.ModuleParams)
+		})
 		if err != nil {
 			return nil, errors.Errorf("explorer: get class: extend: %v", err)
 		}
@@ -230,6 +241,14 @@ func (e *Explorer) getClassVectorSearch(ctx context.Context,
 		return nil, nil, errors.Wrap(err, "explorer: get class: concurrentTargetVectorSearch)")
 	}
 
+	if params.GroupBy != nil && len(params.Sort) > 0 {
+		// the shards group by distance, the sort orders the groups and their hits
+		res, err = e.searcher.SortResults(res, params.Sort)
+		if err != nil {
+			return nil, nil, errors.Errorf("explorer: get class: %v", err)
+		}
+	}
+
 	if len(searchVectors) > 0 {
 		return res, searchVectors[0], nil
 	}
@@ -239,6 +258,7 @@ func (e *Explorer) getClassVectorSearch(ctx context.Context,
 func (e *Explorer) searchForTargets(ctx context.Context, params dto.GetParams, targetVectors []string, searchVectorParams *searchparams.NearVector) ([]search.Result, []models.Vector, error) {
 	var err error
 	searchVectors := make([]models.Vector, len(targetVectors))
+	beforeVectorize := time.Now()
 	eg := enterrors.NewErrorGroupWrapper(e.logger)
 	eg.SetLimit(2 * _NUMCPU)
 	for i := range targetVectors {
@@ -263,6 +283,7 @@ func (e *Explorer) searchForTargets(ctx context.Context, params dto.GetParams, t
 	if err := eg.Wait(); err != nil {
 		return nil, nil, err
 	}
+	profileSince(ctx, "vectorize", beforeVectorize)
 
 	if len(params.AdditionalProperties.ModuleParams) > 0 || params.Group != nil {
 		// if a module-specific additional prop is set, assume it needs the vector
@@ -272,10 +293,12 @@ func (e *Explorer) searchForTargets(ctx context.Context, params dto.GetParams, t
 		params.AdditionalProperties.Vector = true
 	}
 
+	beforeSearch := time.Now()
 	res, err := e.searcher.VectorSearch(ctx, params, targetVectors, searchVectors)
 	if err != nil {
 		return nil, nil, errors.Errorf("explorer: get class: vector search: %v", err)
 	}
+	profileSince(ctx, "vector_search", beforeSearch)
 
 	if params.Pagination.Autocut > 0 {
 		scores := make([]float32, len(res))
@@ -390,8 +413,9 @@ func (e *Explorer) getClassList(ctx context.Context,
 
 	if e.modulesProvider != nil {
 
-		res, err = e.modulesProvider.ListExploreAdditionalExtend(ctx, res,
-			params.AdditionalProperties.ModuleParams, params.ModuleParams)
+		res, err = e.extendQueryPipelineStages(res, params, func(in []search.Result, moduleParams map[string]interface{}) ([]search.Result, error) {
+			return e.modulesProvider.ListExploreAdditionalExtend(ctx, in, moduleParams, params.ModuleParams)
+		})
 		if err != nil {
 			return nil, errors.Errorf("explorer: list class: extend: %v", err)
 		}
@@ -406,10 +430,12 @@ func (e *Explorer) getClassList(ctx context.Context,
 
 func (e *Explorer) searchResultsToGetResponse(ctx context.Context, input []search.Result, searchVector models.Vector, params dto.GetParams) ([]interface{}, error) {
 	output := make([]interface{}, 0, len(input))
+	before := time.Now()
 	results, err := e.searchResultsToGetResponseWithType(ctx, input, searchVector, params)
 	if err != nil {
 		return nil, err
 	}
+	profileSince(ctx, "additional_properties", before)
 
 	if params.GroupBy != nil {
 		for _, result := range results {
@@ -933,3 +959,11 @@ func (e *Explorer) usageOperationFromExploreParams(params ExploreParams) string
 
 	return "n/a"
 }
+
+// profileSince records the time spent in a phase of the query since start,
+// if the query is profiled
+func profileSince(ctx context.Context, phase string, start time.Time) {
+	if profile := queryprofile.FromContext(ctx); profile != nil {
+		profile.Annotate(phase+"_took", time.Since(start))
+	}
+}
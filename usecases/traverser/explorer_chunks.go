@@ -0,0 +1,217 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/settings"
+)
+
+// chunkOverFetchFactor is the number of chunk hits fetched per requested
+// parent, as several chunks of the same parent usually match a query.
+const chunkOverFetchFactor = 4
+
+// chunkClass returns the class the chunks of the given class are stored in,
+// or an error if the class is not chunked.
+func (e *Explorer) chunkClass(className string) (string, error) {
+	class := e.schemaGetter.ReadOnlyClass(className)
+	if class == nil {
+		return "", fmt.Errorf("class %q not found", className)
+	}
+	cs, err := settings.ClassChunkingSettings(class)
+	if err != nil {
+		return "", err
+	}
+	if cs == nil {
+		return "", fmt.Errorf("class %q has no chunking configured", className)
+	}
+	return cs.ChildClass, nil
+}
+
+// getClassChunks searches the chunks of the class and collapses the hits to
+// their parents. Each parent is returned once, with the score or distance of
+// its best matching chunk.
+func (e *Explorer) getClassChunks(ctx context.Context, params dto.GetParams) ([]interface{}, error) {
+	if params.Group != nil || params.GroupBy != nil {
+		return nil, errors.New("collapseChunks cannot be combined with group or groupBy")
+	}
+	if len(params.Sort) > 0 || params.Cursor != nil {
+		return nil, errors.New("collapseChunks cannot be combined with sort or after")
+	}
+
+	childClass, err := e.chunkClass(params.ClassName)
+	if err != nil {
+		return nil, errors.Wrap(err, "collapseChunks")
+	}
+
+	childParams := params
+	childParams.ClassName = childClass
+	childParams.Properties = search.SelectProperties{{Name: settings.ChunkParentProperty}}
+	childParams.AdditionalProperties = additional.Properties{
+		Distance:     params.AdditionalProperties.Distance,
+		Certainty:    params.AdditionalProperties.Certainty,
+		Score:        params.AdditionalProperties.Score,
+		ExplainScore: params.AdditionalProperties.ExplainScore,
+	}
+	childParams.QueryPipeline = nil
+	childParams.Filters = chunkFilters(params.Filters, childClass)
+	childParams.Pagination = &filters.Pagination{
+		Offset:  0,
+		Limit:   params.Pagination.Limit,
+		Autocut: params.Pagination.Autocut,
+	}
+	if params.Pagination.Limit != filters.LimitFlagSearchByDist {
+		limit, err := e.CalculateTotalLimit(params.Pagination)
+		if err != nil {
+			return nil, err
+		}
+		childParams.Pagination.Limit = limit * chunkOverFetchFactor
+	}
+
+	var (
+		hits         []search.Result
+		searchVector models.Vector
+	)
+	switch {
+	case params.KeywordRanking != nil:
+		hits, err = e.getClassKeywordBased(ctx, childParams)
+	case params.NearVector != nil || params.NearObject != nil || len(params.ModuleParams) > 0:
+		hits, searchVector, err = e.getClassVectorSearch(ctx, childParams)
+	default:
+		hits, err = e.getClassList(ctx, childParams)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := e.collapseChunks(ctx, params, hits)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.modulesProvider != nil {
+		res, err = e.extendQueryPipelineStages(res, params, func(in []search.Result, moduleParams map[string]interface{}) ([]search.Result, error) {
+			if searchVector != nil {
+				return e.modulesProvider.GetExploreAdditionalExtend(ctx, in, moduleParams, searchVector, params.ModuleParams)
+			}
+			return e.modulesProvider.ListExploreAdditionalExtend(ctx, in, moduleParams, params.ModuleParams)
+		})
+		if err != nil {
+			return nil, errors.Errorf("explorer: collapse chunks: extend: %v", err)
+		}
+	}
+
+	return e.searchResultsToGetResponse(ctx, res, searchVector, params)
+}
+
+// collapseChunks replaces the chunk hits by their parents, in the order of
+// their best matching chunk, and applies the pagination of the request.
+// Parents that were deleted after the chunk was found are skipped.
+func (e *Explorer) collapseChunks(ctx context.Context, params dto.GetParams,
+	hits []search.Result,
+) ([]search.Result, error) {
+	var (
+		seen    = make(map[strfmt.UUID]struct{}, len(hits))
+		parents = make([]search.Result, 0, len(hits))
+		offset  = params.Pagination.Offset
+		limit   = params.Pagination.Limit
+	)
+	for _, hit := range hits {
+		if limit >= 0 && len(parents) >= offset+limit {
+			break
+		}
+		parentID, ok := chunkParentID(hit)
+		if !ok {
+			continue
+		}
+		if _, ok := seen[parentID]; ok {
+			continue
+		}
+		seen[parentID] = struct{}{}
+
+		parent, err := e.searcher.Object(ctx, params.ClassName, parentID, params.Properties,
+			params.AdditionalProperties, params.ReplicationProperties, params.Tenant)
+		if err != nil {
+			return nil, errors.Wrapf(err, "explorer: collapse chunks: get parent %s", parentID)
+		}
+		if parent == nil {
+			continue
+		}
+		parent.Dist = hit.Dist
+		parent.Certainty = hit.Certainty
+		parent.Score = hit.Score
+		parent.ExplainScore = hit.ExplainScore
+		parents = append(parents, *parent)
+	}
+
+	if offset >= len(parents) {
+		return nil, nil
+	}
+	return parents[offset:], nil
+}
+
+// chunkParentID returns the id of the parent the chunk hit references
+func chunkParentID(hit search.Result) (strfmt.UUID, bool) {
+	props, ok := hit.Schema.(map[string]interface{})
+	if !ok {
+		return "", false
+	}
+	refs, ok := props[settings.ChunkParentProperty].(models.MultipleRef)
+	if !ok || len(refs) == 0 {
+		return "", false
+	}
+	ref, err := crossref.Parse(refs[0].Beacon.String())
+	if err != nil {
+		return "", false
+	}
+	return ref.TargetID, true
+}
+
+// chunkFilters rewrites a filter on the parent class into a filter on its
+// chunks, by prefixing every path with the reference to the parent.
+func chunkFilters(in *filters.LocalFilter, childClass string) *filters.LocalFilter {
+	if in == nil || in.Root == nil {
+		return in
+	}
+	root := chunkClause(*in.Root, childClass)
+	return &filters.LocalFilter{Root: &root}
+}
+
+func chunkClause(in filters.Clause, childClass string) filters.Clause {
+	out := in
+	if in.On != nil {
+		out.On = &filters.Path{
+			Class:    schema.ClassName(childClass),
+			Property: schema.PropertyName(settings.ChunkParentProperty),
+			Child:    in.On,
+		}
+	}
+	if len(in.Operands) > 0 {
+		out.Operands = make([]filters.Clause, len(in.Operands))
+		for i := range in.Operands {
+			out.Operands[i] = chunkClause(in.Operands[i], childClass)
+		}
+	}
+	return out
+}
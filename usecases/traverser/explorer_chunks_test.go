@@ -0,0 +1,162 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func Test_Explorer_GetClass_CollapseChunks(t *testing.T) {
+	var (
+		parentA = strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+		parentB = strfmt.UUID("8d5a3aa2-3c8d-4589-9ae1-3f638f506970")
+	)
+
+	chunkHit := func(id strfmt.UUID, parent strfmt.UUID, dist float32) search.Result {
+		return search.Result{
+			ID: id,
+			Schema: map[string]interface{}{
+				"parent": models.MultipleRef{crossref.NewLocalhost("Document", parent).SingleRef()},
+			},
+			Dist: dist,
+		}
+	}
+
+	newExplorer := func() (*Explorer, *fakeVectorSearcher) {
+		searcher := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(searcher, log, getFakeModulesProvider(), nil, defaultConfig)
+		explorer.SetSchemaGetter(&fakeSchemaGetter{
+			schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+				{
+					Class:      "Document",
+					Vectorizer: "text2vec-contextionary",
+					ModuleConfig: map[string]interface{}{
+						"text2vec-contextionary": map[string]interface{}{
+							"chunking": map[string]interface{}{
+								"property":   "body",
+								"childClass": "DocumentChunk",
+							},
+						},
+					},
+				},
+				{Class: "DocumentChunk"},
+			}}},
+		})
+		return explorer, searcher
+	}
+
+	t.Run("chunk hits are collapsed to their parents", func(t *testing.T) {
+		explorer, searcher := newExplorer()
+		params := dto.GetParams{
+			ClassName: "Document",
+			NearVector: &searchparams.NearVector{
+				Vectors: []models.Vector{[]float32{0.8, 0.2, 0.7}},
+			},
+			Pagination:           &filters.Pagination{Limit: 2},
+			AdditionalProperties: additional.Properties{Distance: true},
+			CollapseChunks:       true,
+		}
+
+		searcher.On("VectorSearch", mock.MatchedBy(func(p dto.GetParams) bool {
+			return p.ClassName == "DocumentChunk" && p.Pagination.Limit == 2*chunkOverFetchFactor
+		}), mock.Anything).Return([]search.Result{
+			chunkHit("c1", parentA, 0.1),
+			chunkHit("c2", parentA, 0.2),
+			chunkHit("c3", parentB, 0.3),
+		}, nil).Once()
+		searcher.On("Object", "Document", parentA).
+			Return(&search.Result{ID: parentA, Schema: map[string]interface{}{"body": "a"}}, nil).Once()
+		searcher.On("Object", "Document", parentB).
+			Return(&search.Result{ID: parentB, Schema: map[string]interface{}{"body": "b"}}, nil).Once()
+
+		res, err := explorer.GetClass(context.Background(), params)
+		require.NoError(t, err)
+		searcher.AssertExpectations(t)
+		require.Len(t, res, 2)
+		assert.Equal(t, map[string]interface{}{
+			"body":        "a",
+			"_additional": map[string]interface{}{"distance": float32(0.1)},
+		}, res[0])
+		assert.Equal(t, map[string]interface{}{
+			"body":        "b",
+			"_additional": map[string]interface{}{"distance": float32(0.3)},
+		}, res[1])
+	})
+
+	t.Run("parents deleted since their chunk was found are skipped", func(t *testing.T) {
+		explorer, searcher := newExplorer()
+		params := dto.GetParams{
+			ClassName:      "Document",
+			Pagination:     &filters.Pagination{Limit: 10},
+			CollapseChunks: true,
+		}
+
+		searcher.On("Search", mock.Anything).Return([]search.Result{
+			chunkHit("c1", parentA, 0),
+			chunkHit("c2", parentB, 0),
+		}, nil).Once()
+		searcher.On("Object", "Document", parentA).Return((*search.Result)(nil), nil).Once()
+		searcher.On("Object", "Document", parentB).
+			Return(&search.Result{ID: parentB, Schema: map[string]interface{}{"body": "b"}}, nil).Once()
+
+		res, err := explorer.GetClass(context.Background(), params)
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{map[string]interface{}{"body": "b"}}, res)
+	})
+
+	t.Run("filters on the parent apply through the parent reference", func(t *testing.T) {
+		in := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorAnd,
+			Operands: []filters.Clause{{
+				Operator: filters.OperatorEqual,
+				On:       &filters.Path{Class: "Document", Property: "title"},
+				Value:    &filters.Value{Value: "foo", Type: schema.DataTypeText},
+			}},
+		}}
+
+		out := chunkFilters(in, "DocumentChunk")
+		assert.Equal(t, &filters.Path{
+			Class:    "DocumentChunk",
+			Property: "parent",
+			Child:    &filters.Path{Class: "Document", Property: "title"},
+		}, out.Root.Operands[0].On)
+		assert.Equal(t, &filters.Path{Class: "Document", Property: "title"}, in.Root.Operands[0].On,
+			"the filter of the request must not be changed")
+	})
+
+	t.Run("classes without chunking are rejected", func(t *testing.T) {
+		explorer, _ := newExplorer()
+		_, err := explorer.GetClass(context.Background(), dto.GetParams{
+			ClassName:      "DocumentChunk",
+			Pagination:     &filters.Pagination{Limit: 10},
+			CollapseChunks: true,
+		})
+		assert.ErrorContains(t, err, `class "DocumentChunk" has no chunking configured`)
+	})
+}
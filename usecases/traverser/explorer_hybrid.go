@@ -14,6 +14,7 @@ package traverser
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 
@@ -142,6 +143,7 @@ func nearTextSubSearch(ctx context.Context, e *Explorer, params dto.GetParams, t
 	subSearchParams.WithDistance = params.HybridSearch.NearTextParams.WithDistance
 
 	subSearchParams.TargetVectors = targetVectors // TODO support multiple target vectors
+	subSearchParams.Vectorizer = params.HybridSearch.Vectorizer
 
 	subsearchWrap := params
 	if subsearchWrap.ModuleParams == nil {
@@ -180,6 +182,8 @@ func nearTextSubSearch(ctx context.Context, e *Explorer, params dto.GetParams, t
 
 // Hybrid search.  This is the main entry point to the hybrid search algorithm
 func (e *Explorer) Hybrid(ctx context.Context, params dto.GetParams) ([]search.Result, error) {
+	defer profileSince(ctx, "hybrid", time.Now())
+
 	var err error
 	var results [][]*search.Result
 	var weights []float64
@@ -197,6 +201,10 @@ func (e *Explorer) Hybrid(ctx context.Context, params dto.GetParams) ([]search.R
 		Autocut: params.Pagination.Autocut,
 	}
 
+	// sorting is applied to the combined results, the sub-searches need to
+	// return their best matches
+	params.Sort = nil
+
 	// pagination is handled after combining results
 	vectorParams := params
 	vectorParams.Pagination = &filters.Pagination{
@@ -284,6 +292,16 @@ func (e *Explorer) Hybrid(ctx context.Context, params dto.GetParams) ([]search.R
 							if err != nil {
 								return fmt.Errorf("hybrid: is target vector multi vector: %w", err)
 							}
+							if params.HybridSearch.Vectorizer != nil {
+								if isMultiVector {
+									return fmt.Errorf("hybrid: vectorizer override: multi-vector target vector %q is not supported", targetVector)
+								}
+								searchVectors.TargetVectors[i] = targetVector
+								searchVector, err := e.modulesProvider.VectorFromInputWithVectorizer(ctx, params.ClassName,
+									params.HybridSearch.Query, targetVector, params.HybridSearch.Vectorizer)
+								searchVectors.Vectors[i] = searchVector
+								return err
+							}
 							if isMultiVector {
 								searchVectors.TargetVectors[i] = targetVector
 								searchVector, err := e.modulesProvider.MultiVectorFromInput(ctx, params.ClassName, params.HybridSearch.Query, targetVector)
@@ -429,6 +447,13 @@ func (e *Explorer) Hybrid(ctx context.Context, params dto.GetParams) ([]search.R
 		out = append(out, *pointerResult)
 	}
 
+	if len(origParams.Sort) > 0 {
+		out, err = e.searcher.SortResults(out, origParams.Sort)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if origParams.GroupBy != nil {
 		groupedResults, err := e.groupSearchResults(ctx, out, origParams.GroupBy)
 		if err != nil {
@@ -2751,6 +2751,12 @@ func (p *fakeModulesProvider) VectorFromInput(ctx context.Context, className, in
 	panic("not implemented")
 }
 
+func (p *fakeModulesProvider) VectorFromInputWithVectorizer(ctx context.Context, className, input, targetVector string,
+	vectorizer map[string]interface{},
+) ([]float32, error) {
+	panic("not implemented")
+}
+
 func (p *fakeModulesProvider) MultiVectorFromInput(ctx context.Context, className, input, targetVector string) ([][]float32, error) {
 	panic("not implemented")
 }
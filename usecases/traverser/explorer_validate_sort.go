@@ -12,13 +12,26 @@
 package traverser
 
 import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/schema"
 )
 
-func (e *Explorer) validateSort(className string, sort []filters.Sort) error {
-	if len(sort) == 0 {
+func (e *Explorer) validateSort(params dto.GetParams) error {
+	if len(params.Sort) == 0 {
 		return nil
 	}
-	return filters.ValidateSort(e.schemaGetter.ReadOnlyClass, schema.ClassName(className), sort)
+	if filters.UsesSearchSortProperty(params.Sort) && !isSearchQuery(params) {
+		return fmt.Errorf("sorting by %s or %s requires a vector, bm25 or hybrid search",
+			filters.SortPropDistance, filters.SortPropScore)
+	}
+	return filters.ValidateSort(e.schemaGetter.ReadOnlyClass, schema.ClassName(params.ClassName), params.Sort)
+}
+
+func isSearchQuery(params dto.GetParams) bool {
+	return params.NearVector != nil || params.NearObject != nil ||
+		len(params.ModuleParams) > 0 || params.KeywordRanking != nil ||
+		params.HybridSearch != nil
 }
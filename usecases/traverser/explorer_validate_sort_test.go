@@ -101,6 +101,15 @@ func Test_Explorer_GetClass_WithSort(t *testing.T) {
 			expectedError: errors.New(`invalid 'sort' parameter: sort parameter at position 0: ` +
 				`invalid order parameter, possible values are: ["asc", "desc"] not: "asce"`),
 		},
+		{
+			name: "distance without a search",
+			params: dto.GetParams{
+				ClassName: "ClassOne",
+				Sort:      []filters.Sort{{Path: []string{"_distance"}, Order: "asc"}},
+			},
+			expectedError: errors.New("invalid 'sort' parameter: " +
+				"sorting by _distance or _score requires a vector, bm25 or hybrid search"),
+		},
 	}
 
 	twoSortFilters := []testData{
@@ -138,6 +138,10 @@ func (f *fakeVectorSearcher) ResolveReferences(ctx context.Context, objs search.
 	return nil, nil
 }
 
+func (f *fakeVectorSearcher) SortResults(objs search.Results, sort []filters.Sort) (search.Results, error) {
+	return objs, nil
+}
+
 type fakeVectorRepo struct {
 	mock.Mock
 }
@@ -215,6 +219,10 @@ func (f *fakeSchemaGetter) CopyShardingState(class string) *sharding.State {
 	panic("not implemented")
 }
 
+func (f *fakeSchemaGetter) IsResharding(class string) bool {
+	return false
+}
+
 func (f *fakeSchemaGetter) ShardOwner(class, shard string) (string, error) {
 	return shard, nil
 }
@@ -52,6 +52,10 @@ func (f *fakeSchemaManager) CopyShardingState(class string) *sharding.State {
 	return nil
 }
 
+func (f *fakeSchemaManager) IsResharding(class string) bool {
+	return false
+}
+
 func (f *fakeSchemaManager) ShardOwner(class, shard string) (string, error) {
 	return "", nil
 }
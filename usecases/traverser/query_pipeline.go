@@ -0,0 +1,330 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/local/common_filters"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/filterext"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/additional"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/additional/generate"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/additional/rank"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+var queryPipelineParameter = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// ResolveQueryPipeline expands the query pipeline referenced by the params
+// into the regular search, filter, rerank and generate parameters. The
+// retrieval stage becomes a hybrid or bm25 search, filter stages are added
+// to the where filter and rerank and generate stages become additional
+// module params. As the where filter is applied while retrieving, filter
+// stages have to precede the retrieval stage. Resolving is idempotent, so callers that need the expanded
+// params before calling GetClass can resolve them upfront.
+//
+// An unknown class or pipeline is an objects.ErrNotFound, a request the
+// pipeline cannot be resolved with an objects.ErrInvalidUserInput.
+func (t *Traverser) ResolveQueryPipeline(params *dto.GetParams) error {
+	ref := params.QueryPipeline
+	if ref == nil || ref.Resolved {
+		return nil
+	}
+
+	class := t.schemaGetter.ReadOnlyClass(params.ClassName)
+	if class == nil {
+		return objects.NewErrNotFound("query pipeline %q: class %q not found", ref.Name, params.ClassName)
+	}
+	var pipeline *models.QueryPipeline
+	for _, p := range class.QueryPipelines {
+		if p != nil && p.Name == ref.Name {
+			pipeline = p
+			break
+		}
+	}
+	if pipeline == nil {
+		return objects.NewErrNotFound("query pipeline %q is not declared on class %q", ref.Name, params.ClassName)
+	}
+
+	if err := resolveQueryPipeline(params, class, pipeline, ref.Parameters); err != nil {
+		return objects.NewErrInvalidUserInput("query pipeline %q: %v", ref.Name, err)
+	}
+	addQueryPipelineProperties(params, class)
+	ref.Resolved = true
+	return nil
+}
+
+func resolveQueryPipeline(params *dto.GetParams, class *models.Class,
+	pipeline *models.QueryPipeline, parameters map[string]string,
+) error {
+	if params.NearVector != nil || params.NearObject != nil || params.KeywordRanking != nil ||
+		params.HybridSearch != nil || len(params.ModuleParams) > 0 {
+		return fmt.Errorf("cannot be combined with other search operators")
+	}
+
+	r := &queryPipelineResolver{parameters: parameters}
+	var query string
+	var retrieved bool
+	for i, stage := range pipeline.Stages {
+		if stage == nil {
+			return fmt.Errorf("stage %d is empty", i)
+		}
+
+		switch stage.Type {
+		case models.QueryPipelineStageTypeHybrid:
+			query = r.substitute(stage.Query)
+			alpha := common_filters.DefaultAlpha
+			if stage.Alpha != nil {
+				alpha = *stage.Alpha
+			}
+			params.HybridSearch = &searchparams.HybridSearch{
+				Query:           query,
+				Alpha:           alpha,
+				Properties:      schema.LowercaseFirstLetterOfStrings(stage.Properties),
+				FusionAlgorithm: common_filters.HybridFusionDefault,
+			}
+			setQueryPipelineLimit(params, stage.Limit)
+			retrieved = true
+		case models.QueryPipelineStageTypeBm25:
+			query = r.substitute(stage.Query)
+			params.KeywordRanking = &searchparams.KeywordRanking{
+				Type:                   "bm25",
+				Query:                  query,
+				Properties:             schema.LowercaseFirstLetterOfStrings(stage.Properties),
+				AdditionalExplanations: params.AdditionalProperties.ExplainScore,
+			}
+			setQueryPipelineLimit(params, stage.Limit)
+			retrieved = true
+		case models.QueryPipelineStageTypeFilter:
+			// pipelines declared before the order was validated may still
+			// filter after retrieving, which a pre-filter cannot express
+			if retrieved {
+				return fmt.Errorf("stage %d: %q stages must precede the retrieval stage", i, stage.Type)
+			}
+			filter, err := filterext.Parse(r.where(stage.Where), params.ClassName)
+			if err != nil {
+				return fmt.Errorf("stage %d: %w", i, err)
+			}
+			params.Filters = andFilters(params.Filters, filter)
+		case models.QueryPipelineStageTypeRerank:
+			property := stage.Property
+			rerankQuery := query
+			if stage.Query != "" {
+				rerankQuery = r.substitute(stage.Query)
+			}
+			if err := r.setModuleParam(params, "rerank", &rank.Params{Property: &property, Query: &rerankQuery}); err != nil {
+				return err
+			}
+			params.QueryPipeline.RerankLimit = int(stage.Limit)
+		case models.QueryPipelineStageTypeGenerate:
+			generative := &generate.Params{}
+			if stage.SinglePrompt != "" {
+				prompt := r.substitute(stage.SinglePrompt)
+				generative.Prompt = &prompt
+				generative.PropertiesToExtract = append(generative.PropertiesToExtract,
+					generate.ExtractPropsFromPrompt(&prompt)...)
+			}
+			if stage.GroupedTask != "" {
+				task := r.substitute(stage.GroupedTask)
+				generative.Task = &task
+				if len(stage.GroupedProperties) > 0 {
+					generative.Properties = stage.GroupedProperties
+					generative.PropertiesToExtract = append(generative.PropertiesToExtract, stage.GroupedProperties...)
+				} else {
+					// without grouped properties the task sees all properties
+					generative.PropertiesToExtract = append(generative.PropertiesToExtract,
+						schema.GetPropertyNamesFromClass(class, false)...)
+				}
+			}
+			if err := r.setModuleParam(params, "generate", generative); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("stage %d: unknown type %q", i, stage.Type)
+		}
+	}
+
+	if len(r.missing) > 0 {
+		return fmt.Errorf("missing parameters %v", r.missing)
+	}
+	return nil
+}
+
+// queryPipelineResolver substitutes the query time parameters into the
+// stages and remembers the ones that were referenced but not provided
+type queryPipelineResolver struct {
+	parameters map[string]string
+	missing    []string
+}
+
+func (r *queryPipelineResolver) substitute(in string) string {
+	return queryPipelineParameter.ReplaceAllStringFunc(in, func(match string) string {
+		name := queryPipelineParameter.FindStringSubmatch(match)[1]
+		value, ok := r.parameters[name]
+		if !ok && !slices.Contains(r.missing, name) {
+			r.missing = append(r.missing, name)
+		}
+		return value
+	})
+}
+
+func (r *queryPipelineResolver) substituteAll(in []string) []string {
+	out := make([]string, len(in))
+	for i := range in {
+		out[i] = r.substitute(in[i])
+	}
+	return out
+}
+
+// where returns a copy of the filter with the parameters substituted into
+// its text values, the schema-owned filter is left untouched
+func (r *queryPipelineResolver) where(in *models.WhereFilter) *models.WhereFilter {
+	if in == nil {
+		return nil
+	}
+	out := *in
+	if in.ValueText != nil {
+		valueText := r.substitute(*in.ValueText)
+		out.ValueText = &valueText
+	}
+	if in.ValueString != nil {
+		valueString := r.substitute(*in.ValueString)
+		out.ValueString = &valueString
+	}
+	if in.ValueTextArray != nil {
+		out.ValueTextArray = r.substituteAll(in.ValueTextArray)
+	}
+	if in.ValueStringArray != nil {
+		out.ValueStringArray = r.substituteAll(in.ValueStringArray)
+	}
+	if in.Operands != nil {
+		out.Operands = make([]*models.WhereFilter, len(in.Operands))
+		for i := range in.Operands {
+			out.Operands[i] = r.where(in.Operands[i])
+		}
+	}
+	return &out
+}
+
+func setQueryPipelineLimit(params *dto.GetParams, limit int64) {
+	if limit <= 0 {
+		return
+	}
+	if params.Pagination == nil {
+		params.Pagination = &filters.Pagination{}
+	}
+	params.Pagination.Limit = int(limit)
+}
+
+// setModuleParam sets a module param owned by the pipeline. The request may
+// only select the result of the module, as GraphQL does with an _additional
+// field without arguments, but not configure it.
+func (r *queryPipelineResolver) setModuleParam(params *dto.GetParams, name string, value interface{}) error {
+	if params.AdditionalProperties.ModuleParams == nil {
+		params.AdditionalProperties.ModuleParams = map[string]interface{}{}
+	}
+	if existing, ok := params.AdditionalProperties.ModuleParams[name]; ok && !emptyModuleParam(existing) {
+		return fmt.Errorf("%s is already defined by the pipeline and cannot be set on the request", name)
+	}
+	params.AdditionalProperties.ModuleParams[name] = value
+	return nil
+}
+
+func emptyModuleParam(value interface{}) bool {
+	switch p := value.(type) {
+	case *rank.Params:
+		return p == nil || (p.Property == nil && p.Query == nil)
+	case *generate.Params:
+		return p == nil || (p.Prompt == nil && p.Task == nil)
+	default:
+		return false
+	}
+}
+
+// addQueryPipelineProperties selects the properties the rerank and generate
+// stages read, which the request did not necessarily select
+func addQueryPipelineProperties(params *dto.GetParams, class *models.Class) {
+	for _, value := range params.AdditionalProperties.ModuleParams {
+		extractor, ok := value.(additional.PropertyExtractor)
+		if !ok {
+			continue
+		}
+		for _, name := range extractor.GetPropertiesToExtract() {
+			if params.Properties.FindProperty(name) != nil {
+				continue
+			}
+			prop, err := schema.GetPropertyByName(class, name)
+			if err != nil {
+				continue
+			}
+			params.Properties = append(params.Properties, search.SelectProperty{
+				Name:        name,
+				IsPrimitive: schema.DataType(prop.DataType[0]) != schema.DataTypeBlob,
+			})
+		}
+	}
+}
+
+func andFilters(a, b *filters.LocalFilter) *filters.LocalFilter {
+	if a == nil || a.Root == nil {
+		return b
+	}
+	if b == nil || b.Root == nil {
+		return a
+	}
+	return &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorAnd,
+		Operands: []filters.Clause{*a.Root, *b.Root},
+	}}
+}
+
+// extendQueryPipelineStages runs the additional module params through
+// extend. Without a query pipeline this is a single call. For a pipeline the
+// stages run in order: everything but generate first, then the results are
+// cut to the rerank limit, so that only the top results reach the generate
+// stage.
+func (e *Explorer) extendQueryPipelineStages(res []search.Result, params dto.GetParams,
+	extend func([]search.Result, map[string]interface{}) ([]search.Result, error),
+) ([]search.Result, error) {
+	moduleParams := params.AdditionalProperties.ModuleParams
+	if params.QueryPipeline == nil {
+		return extend(res, moduleParams)
+	}
+
+	staged := make(map[string]interface{}, len(moduleParams))
+	for name, value := range moduleParams {
+		if name != "generate" {
+			staged[name] = value
+		}
+	}
+	res, err := extend(res, staged)
+	if err != nil {
+		return nil, err
+	}
+	if limit := params.QueryPipeline.RerankLimit; limit > 0 && len(res) > limit {
+		res = res[:limit]
+	}
+
+	generative, ok := moduleParams["generate"]
+	if !ok {
+		return res, nil
+	}
+	return extend(res, map[string]interface{}{"generate": generative})
+}
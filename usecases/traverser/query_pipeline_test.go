@@ -0,0 +1,283 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/additional/generate"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/additional/rank"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestResolveQueryPipeline(t *testing.T) {
+	title := "title"
+	category := "${category}"
+	alpha := 0.5
+	class := &models.Class{
+		Class: "Article",
+		Properties: []*models.Property{
+			{Name: "title", DataType: schema.DataTypeText.PropString()},
+			{Name: "body", DataType: schema.DataTypeText.PropString()},
+		},
+		QueryPipelines: []*models.QueryPipeline{
+			{
+				Name: "rag",
+				Stages: []*models.QueryPipelineStage{
+					{Type: models.QueryPipelineStageTypeFilter, Where: &models.WhereFilter{
+						Operator: models.WhereFilterOperatorEqual, Path: []string{"category"}, ValueText: &category,
+					}},
+					{Type: models.QueryPipelineStageTypeHybrid, Query: "${q}", Alpha: &alpha, Properties: []string{"Title"}, Limit: 200},
+					{Type: models.QueryPipelineStageTypeRerank, Property: "body", Limit: 20},
+					{Type: models.QueryPipelineStageTypeGenerate, SinglePrompt: "Translate {title} to ${lang}", GroupedTask: "Summarize"},
+				},
+			},
+			{
+				Name: "keyword",
+				Stages: []*models.QueryPipelineStage{
+					{Type: models.QueryPipelineStageTypeBm25, Query: "${q}"},
+				},
+			},
+			{
+				Name: "postfilter",
+				Stages: []*models.QueryPipelineStage{
+					{Type: models.QueryPipelineStageTypeBm25, Query: "${q}"},
+					{Type: models.QueryPipelineStageTypeFilter, Where: &models.WhereFilter{
+						Operator: models.WhereFilterOperatorEqual, Path: []string{"category"}, ValueText: &category,
+					}},
+				},
+			},
+		},
+	}
+	traverser := &Traverser{schemaGetter: &fakeSchemaGetter{schema: schema.Schema{
+		Objects: &models.Schema{Classes: []*models.Class{class}},
+	}}}
+
+	t.Run("expands all stages", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName:  "Article",
+			Pagination: &filters.Pagination{Limit: 10},
+			QueryPipeline: &dto.QueryPipeline{Name: "rag", Parameters: map[string]string{
+				"q": "vector databases", "category": "tech", "lang": "German",
+			}},
+		}
+		require.NoError(t, traverser.ResolveQueryPipeline(&params))
+
+		require.NotNil(t, params.HybridSearch)
+		assert.Equal(t, "vector databases", params.HybridSearch.Query)
+		assert.Equal(t, 0.5, params.HybridSearch.Alpha)
+		assert.Equal(t, []string{"title"}, params.HybridSearch.Properties)
+		assert.Equal(t, 200, params.Pagination.Limit)
+
+		require.NotNil(t, params.Filters)
+		assert.Equal(t, filters.OperatorEqual, params.Filters.Root.Operator)
+		assert.Equal(t, "tech", params.Filters.Root.Value.Value)
+		assert.Equal(t, category, *class.QueryPipelines[0].Stages[0].Where.ValueText, "schema filter is not modified")
+
+		rerank, ok := params.AdditionalProperties.ModuleParams["rerank"].(*rank.Params)
+		require.True(t, ok)
+		assert.Equal(t, "body", rerank.GetProperty())
+		assert.Equal(t, "vector databases", rerank.GetQuery())
+		assert.Equal(t, 20, params.QueryPipeline.RerankLimit)
+
+		generative, ok := params.AdditionalProperties.ModuleParams["generate"].(*generate.Params)
+		require.True(t, ok)
+		assert.Equal(t, "Translate {title} to German", *generative.Prompt)
+		assert.Equal(t, "Summarize", *generative.Task)
+		assert.ElementsMatch(t, []string{"title", "title", "body"}, generative.PropertiesToExtract)
+
+		assert.True(t, params.QueryPipeline.Resolved)
+		require.NoError(t, traverser.ResolveQueryPipeline(&params), "resolving twice is a no-op")
+	})
+
+	t.Run("ands filter stages with the request filter", func(t *testing.T) {
+		requestFilter := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On:       &filters.Path{Class: "Article", Property: "title"},
+			Value:    &filters.Value{Value: "weaviate", Type: schema.DataTypeText},
+		}}
+		params := dto.GetParams{
+			ClassName: "Article",
+			Filters:   requestFilter,
+			QueryPipeline: &dto.QueryPipeline{Name: "rag", Parameters: map[string]string{
+				"q": "q", "category": "tech", "lang": "German",
+			}},
+		}
+		require.NoError(t, traverser.ResolveQueryPipeline(&params))
+		assert.Equal(t, filters.OperatorAnd, params.Filters.Root.Operator)
+		require.Len(t, params.Filters.Root.Operands, 2)
+		assert.Equal(t, *requestFilter.Root, params.Filters.Root.Operands[0])
+	})
+
+	t.Run("bm25 keeps the request limit", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName:     "Article",
+			Pagination:    &filters.Pagination{Limit: 10},
+			QueryPipeline: &dto.QueryPipeline{Name: "keyword", Parameters: map[string]string{"q": "hello"}},
+		}
+		require.NoError(t, traverser.ResolveQueryPipeline(&params))
+		assert.Equal(t, &searchparams.KeywordRanking{Type: "bm25", Query: "hello"}, params.KeywordRanking)
+		assert.Equal(t, 10, params.Pagination.Limit)
+		assert.Nil(t, params.AdditionalProperties.ModuleParams)
+	})
+
+	t.Run("results of the pipeline modules can be selected", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName:  "Article",
+			Properties: search.SelectProperties{{Name: "title", IsPrimitive: true}},
+			AdditionalProperties: additional.Properties{ModuleParams: map[string]interface{}{
+				"rerank":   &rank.Params{},
+				"generate": &generate.Params{},
+			}},
+			QueryPipeline: &dto.QueryPipeline{Name: "rag", Parameters: map[string]string{
+				"q": "q", "category": "tech", "lang": "German",
+			}},
+		}
+		require.NoError(t, traverser.ResolveQueryPipeline(&params))
+		assert.Equal(t, "body", params.AdditionalProperties.ModuleParams["rerank"].(*rank.Params).GetProperty())
+		assert.Equal(t, "Summarize", *params.AdditionalProperties.ModuleParams["generate"].(*generate.Params).Task)
+		assert.Equal(t, search.SelectProperties{
+			{Name: "title", IsPrimitive: true},
+			{Name: "body", IsPrimitive: true},
+		}, params.Properties, "the properties read by the stages are selected")
+	})
+
+	t.Run("error kinds", func(t *testing.T) {
+		err := traverser.ResolveQueryPipeline(&dto.GetParams{
+			ClassName: "Article", QueryPipeline: &dto.QueryPipeline{Name: "unknown"},
+		})
+		assert.ErrorAs(t, err, &objects.ErrNotFound{})
+
+		err = traverser.ResolveQueryPipeline(&dto.GetParams{
+			ClassName: "Article", QueryPipeline: &dto.QueryPipeline{Name: "keyword"},
+		})
+		assert.ErrorAs(t, err, &objects.ErrInvalidUserInput{})
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		tests := []struct {
+			name        string
+			params      dto.GetParams
+			expectedErr string
+		}{
+			{
+				name: "unknown pipeline",
+				params: dto.GetParams{
+					ClassName: "Article", QueryPipeline: &dto.QueryPipeline{Name: "unknown"},
+				},
+				expectedErr: `query pipeline "unknown" is not declared on class "Article"`,
+			},
+			{
+				name: "missing parameters",
+				params: dto.GetParams{
+					ClassName: "Article", QueryPipeline: &dto.QueryPipeline{Name: "rag", Parameters: map[string]string{"lang": "en"}},
+				},
+				expectedErr: "missing parameters [category q]",
+			},
+			{
+				name: "combined with other search operators",
+				params: dto.GetParams{
+					ClassName:      "Article",
+					KeywordRanking: &searchparams.KeywordRanking{Query: "hello"},
+					QueryPipeline:  &dto.QueryPipeline{Name: "keyword", Parameters: map[string]string{"q": "hello"}},
+				},
+				expectedErr: "cannot be combined with other search operators",
+			},
+			{
+				name: "rerank set on the request",
+				params: dto.GetParams{
+					ClassName: "Article",
+					AdditionalProperties: additional.Properties{ModuleParams: map[string]interface{}{
+						"rerank": &rank.Params{Property: &title},
+					}},
+					QueryPipeline: &dto.QueryPipeline{Name: "rag", Parameters: map[string]string{
+						"q": "q", "category": "tech", "lang": "German",
+					}},
+				},
+				expectedErr: "rerank is already defined by the pipeline",
+			},
+			{
+				name: "filter after retrieval",
+				params: dto.GetParams{
+					ClassName: "Article", QueryPipeline: &dto.QueryPipeline{Name: "postfilter", Parameters: map[string]string{
+						"q": "q", "category": "tech",
+					}},
+				},
+				expectedErr: `stage 1: "filter" stages must precede the retrieval stage`,
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				err := traverser.ResolveQueryPipeline(&tt.params)
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErr)
+			})
+		}
+	})
+}
+
+func TestExtendQueryPipelineStages(t *testing.T) {
+	results := func(n int) []search.Result {
+		res := make([]search.Result, n)
+		for i := range res {
+			res[i] = search.Result{ClassName: "Article", Score: float32(i)}
+		}
+		return res
+	}
+	moduleParams := map[string]interface{}{
+		"rerank":   &rank.Params{},
+		"generate": &generate.Params{},
+	}
+
+	t.Run("without pipeline", func(t *testing.T) {
+		var calls []int
+		res, err := (&Explorer{}).extendQueryPipelineStages(results(5), dto.GetParams{
+			AdditionalProperties: additional.Properties{ModuleParams: moduleParams},
+		}, func(in []search.Result, params map[string]interface{}) ([]search.Result, error) {
+			calls = append(calls, len(params))
+			return in, nil
+		})
+		require.NoError(t, err)
+		assert.Len(t, res, 5)
+		assert.Equal(t, []int{2}, calls)
+	})
+
+	t.Run("reranks, cuts and then generates", func(t *testing.T) {
+		var stages []string
+		var generated int
+		res, err := (&Explorer{}).extendQueryPipelineStages(results(5), dto.GetParams{
+			AdditionalProperties: additional.Properties{ModuleParams: moduleParams},
+			QueryPipeline:        &dto.QueryPipeline{Name: "rag", Resolved: true, RerankLimit: 2},
+		}, func(in []search.Result, params map[string]interface{}) ([]search.Result, error) {
+			for name := range params {
+				stages = append(stages, name)
+			}
+			if _, ok := params["generate"]; ok {
+				generated = len(in)
+			}
+			return in, nil
+		})
+		require.NoError(t, err)
+		assert.Len(t, res, 2)
+		assert.Equal(t, []string{"rerank", "generate"}, stages)
+		assert.Equal(t, 2, generated)
+	})
+}
@@ -0,0 +1,107 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"sync"
+
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+type searchBatchCtxKey struct{}
+
+// ContextWithSearchBatch returns a copy of ctx carrying a batch for the
+// given number of concurrently executed queries. Every query has to call
+// Done on the batch once it is finished.
+func ContextWithSearchBatch(ctx context.Context, queries int) (context.Context, *SearchBatch) {
+	b := &SearchBatch{active: queries}
+	ctx = context.WithValue(ctx, searchBatchCtxKey{}, b)
+	b.ctx = ctx
+	return ctx, b
+}
+
+// SearchBatchFromContext returns the batch carried by ctx, or nil.
+func SearchBatchFromContext(ctx context.Context) *SearchBatch {
+	b, _ := ctx.Value(searchBatchCtxKey{}).(*SearchBatch)
+	return b
+}
+
+// BatchedVectorSearch is a vector search waiting to be executed as part of a
+// batch, the executor sets its results.
+type BatchedVectorSearch struct {
+	Params        dto.GetParams
+	TargetVectors []string
+	SearchVectors []models.Vector
+
+	Results []search.Result
+	Err     error
+}
+
+// SearchBatchExecutor executes the vector searches of a batch together
+type SearchBatchExecutor func(ctx context.Context, searches []*BatchedVectorSearch)
+
+// SearchBatch collects the vector searches of concurrently executed queries.
+// The searches are held back until every unfinished query is waiting for
+// one, then all of them are executed together.
+type SearchBatch struct {
+	ctx context.Context
+
+	mu sync.Mutex
+	// active is the number of unfinished queries
+	active   int
+	pending  []*BatchedVectorSearch
+	waiters  []chan struct{}
+	executor SearchBatchExecutor
+}
+
+// Search adds s to the batch and blocks until it got executed.
+func (b *SearchBatch) Search(s *BatchedVectorSearch, executor SearchBatchExecutor) {
+	done := make(chan struct{})
+
+	b.mu.Lock()
+	b.executor = executor
+	b.pending = append(b.pending, s)
+	b.waiters = append(b.waiters, done)
+	b.flushIfComplete()
+
+	<-done
+}
+
+// Done marks a query of the batch as finished.
+func (b *SearchBatch) Done() {
+	b.mu.Lock()
+	b.active--
+	b.flushIfComplete()
+}
+
+// flushIfComplete is called with the lock held and releases it. If all
+// unfinished queries are waiting it executes their searches.
+func (b *SearchBatch) flushIfComplete() {
+	if len(b.pending) == 0 || len(b.pending) < b.active {
+		b.mu.Unlock()
+		return
+	}
+
+	searches, waiters, executor := b.pending, b.waiters, b.executor
+	b.pending, b.waiters = nil, nil
+	b.mu.Unlock()
+
+	defer func() {
+		for _, done := range waiters {
+			close(done)
+		}
+	}()
+	executor(b.ctx, searches)
+}
@@ -0,0 +1,103 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+func TestSearchBatch(t *testing.T) {
+	t.Run("searches of all queries are executed together", func(t *testing.T) {
+		ctx, batch := ContextWithSearchBatch(context.Background(), 5)
+		require.Same(t, batch, SearchBatchFromContext(ctx))
+
+		var mu sync.Mutex
+		var executions [][]string
+		executor := func(ctx context.Context, searches []*BatchedVectorSearch) {
+			mu.Lock()
+			defer mu.Unlock()
+			var classes []string
+			for _, s := range searches {
+				classes = append(classes, s.Params.ClassName)
+				s.Results = []search.Result{{ClassName: s.Params.ClassName}}
+			}
+			executions = append(executions, classes)
+		}
+
+		results := make([][]search.Result, 5)
+		wg := sync.WaitGroup{}
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer batch.Done()
+				// the last query does not run a vector search at all
+				if i == 4 {
+					return
+				}
+				s := &BatchedVectorSearch{Params: dto.GetParams{ClassName: string(rune('A' + i))}}
+				batch.Search(s, executor)
+				results[i] = s.Results
+			}()
+		}
+		wg.Wait()
+
+		require.Len(t, executions, 1)
+		assert.ElementsMatch(t, []string{"A", "B", "C", "D"}, executions[0])
+		for i := 0; i < 4; i++ {
+			require.Len(t, results[i], 1)
+			assert.Equal(t, string(rune('A'+i)), results[i][0].ClassName)
+		}
+		assert.Nil(t, results[4])
+	})
+
+	t.Run("later searches form a new batch", func(t *testing.T) {
+		_, batch := ContextWithSearchBatch(context.Background(), 2)
+
+		executions := 0
+		executor := func(ctx context.Context, searches []*BatchedVectorSearch) {
+			executions++
+			for _, s := range searches {
+				s.Err = errors.New("failed")
+			}
+		}
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			defer batch.Done()
+			batch.Search(&BatchedVectorSearch{}, executor)
+			batch.Search(&BatchedVectorSearch{}, executor)
+		}()
+
+		s := &BatchedVectorSearch{}
+		batch.Search(s, executor)
+		batch.Done()
+		<-done
+
+		assert.EqualError(t, s.Err, "failed")
+		assert.Equal(t, 2, executions)
+	})
+
+	t.Run("no batch in context", func(t *testing.T) {
+		assert.Nil(t, SearchBatchFromContext(context.Background()))
+	})
+}
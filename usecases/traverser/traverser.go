@@ -25,6 +25,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/ratelimiter"
 	"github.com/weaviate/weaviate/usecases/schema"
+	"github.com/weaviate/weaviate/usecases/slowquery"
 )
 
 // Traverser can be used to dynamically traverse the knowledge graph
@@ -39,6 +40,7 @@ type Traverser struct {
 	targetVectorParamHelper *TargetVectorParamHelper
 	metrics                 *Metrics
 	ratelimiter             *ratelimiter.Limiter
+	slowQueries             *slowquery.Log
 }
 
 type VectorSearcher interface {
@@ -76,6 +78,12 @@ func NewTraverser(config *config.WeaviateConfig, logger logrus.FieldLogger, auth
 	}
 }
 
+// SetSlowQueryLog enables recording the Get and Aggregate queries exceeding
+// the slow query threshold
+func (t *Traverser) SetSlowQueryLog(l *slowquery.Log) {
+	t.slowQueries = l
+}
+
 // SearchResult is a single search result. See wrapping Search Results for the Type
 type SearchResult struct {
 	Name      string
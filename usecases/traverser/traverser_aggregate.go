@@ -19,12 +19,18 @@ import (
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/modules"
+	"github.com/weaviate/weaviate/usecases/slowquery"
 )
 
 // Aggregate resolves meta queries
 func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 	params *aggregation.Params,
-) (interface{}, error) {
+) (res interface{}, err error) {
+	ctx, slowQuery := t.slowQueries.Start(ctx)
+	defer func() {
+		slowQuery.Finish(principal, func() *models.SlowQueryShape { return slowquery.AggregateShape(*params) }, err)
+	}()
+
 	t.metrics.QueriesAggregateInc(params.ClassName.String())
 	defer t.metrics.QueriesAggregateDec(params.ClassName.String())
 
@@ -35,6 +41,14 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 		return nil, errors.Wrap(err, "invalid 'where' filter")
 	}
 
+	if err := aggregation.ValidateAggregators(params.Properties); err != nil {
+		return nil, err
+	}
+
+	if err := params.SubAggregation.Validate(); err != nil {
+		return nil, err
+	}
+
 	if params.NearVector != nil || params.NearObject != nil || len(params.ModuleParams) > 0 {
 		className := params.ClassName.String()
 		err := t.nearParamsVector.validateNearParams(params.NearVector,
@@ -103,10 +117,10 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 		mp = t.nearParamsVector.modulesProvider.(*modules.Provider)
 	}
 
-	res, err := t.vectorSearcher.Aggregate(ctx, *params, mp)
-	if err != nil || res == nil {
+	result, err := t.vectorSearcher.Aggregate(ctx, *params, mp)
+	if err != nil || result == nil {
 		return nil, err
 	}
 
-	return inspector.WithTypes(res, *params)
+	return inspector.WithTypes(result, *params)
 }
@@ -24,13 +24,20 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/modulecomponents/settings"
+	"github.com/weaviate/weaviate/usecases/slowquery"
 )
 
 func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 	params dto.GetParams,
-) ([]interface{}, error) {
+) (res []interface{}, err error) {
 	before := time.Now()
 
+	ctx, slowQuery := t.slowQueries.Start(ctx)
+	defer func() {
+		slowQuery.Finish(principal, func() *models.SlowQueryShape { return slowquery.GetShape(params) }, err)
+	}()
+
 	ok := t.ratelimiter.TryInc()
 	if !ok {
 		// we currently have no concept of error status code or typed errors in
@@ -49,11 +56,21 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 		return nil, err
 	}
 
+	if err := t.ResolveQueryPipeline(&params); err != nil {
+		return nil, err
+	}
+
 	// validate here, because filters can contain references that need to be authorized
 	if err := t.validateFilters(principal, params.Filters); err != nil {
 		return nil, errors.Wrap(err, "invalid 'where' filter")
 	}
 
+	if params.CollapseChunks {
+		if err := t.authorizeChunks(principal, params); err != nil {
+			return nil, err
+		}
+	}
+
 	certainty := ExtractCertaintyFromParams(params)
 	if certainty != 0 || params.AdditionalProperties.Certainty {
 		// if certainty is provided as input, we must ensure
@@ -67,6 +84,20 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 	return t.explorer.GetClass(ctx, params)
 }
 
+// authorizeChunks checks that the principal may read the chunks that are
+// searched instead of the class itself
+func (t *Traverser) authorizeChunks(principal *models.Principal, params dto.GetParams) error {
+	class := t.schemaGetter.ReadOnlyClass(params.ClassName)
+	if class == nil {
+		return nil
+	}
+	cs, err := settings.ClassChunkingSettings(class)
+	if err != nil || cs == nil {
+		return err
+	}
+	return t.authorizer.Authorize(principal, authorization.READ, authorization.ShardsData(cs.ChildClass, params.Tenant)...)
+}
+
 // probeForRefDepthLimit checks to ensure reference nesting depth doesn't exceed the limit
 // provided by QUERY_CROSS_REFERENCE_DEPTH_LIMIT
 func (t *Traverser) probeForRefDepthLimit(props search.SelectProperties) error {